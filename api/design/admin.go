@@ -23,6 +23,7 @@ var _ = Service("admin", func() {
 	Description("Admin service")
 
 	Error("invalid-payload", ErrorResult, "Invalid request body")
+	Error("not-found", ErrorResult, "Resource Not Found Error")
 	Error("invalid-token", ErrorResult, "Invalid User token")
 	Error("invalid-scopes", ErrorResult, "Invalid Token scopes ")
 	Error("internal-error", ErrorResult, "Internal server error")
@@ -69,6 +70,84 @@ var _ = Service("admin", func() {
 		})
 	})
 
+	Method("ListAgents", func() {
+		Description("List the agents along with the id of their valid token")
+		Security(types.JWTAuth, func() {
+			Scope("agent:create")
+		})
+		Payload(func() {
+			Token("token", String, "User JWT", func() {
+				Example("token", token)
+			})
+			Required("token")
+		})
+		Result(ArrayOf(types.Agent))
+
+		HTTP(func() {
+			GET("/system/user/agents")
+			Header("token:Authorization")
+
+			Response(StatusOK)
+			Response("invalid-token", StatusUnauthorized)
+			Response("invalid-scopes", StatusForbidden)
+			Response("internal-error", StatusInternalServerError)
+		})
+	})
+
+	Method("RevokeAgent", func() {
+		Description("Revoke the token of an agent, a new token can be created by updating the agent")
+		Security(types.JWTAuth, func() {
+			Scope("agent:create")
+		})
+		Payload(func() {
+			Token("token", String, "User JWT", func() {
+				Example("token", token)
+			})
+			Attribute("name", String, "Name of Agent", func() {
+				Example("name", "abc")
+			})
+			Required("name", "token")
+		})
+
+		HTTP(func() {
+			DELETE("/system/user/agent/{name}")
+			Header("token:Authorization")
+
+			Response(StatusOK)
+			Response("not-found", StatusNotFound)
+			Response("invalid-token", StatusUnauthorized)
+			Response("invalid-scopes", StatusForbidden)
+			Response("internal-error", StatusInternalServerError)
+		})
+	})
+
+	Method("RevokeUserToken", func() {
+		Description("Revoke the refresh token of a user, the user has to login again once the access token expires")
+		Security(types.JWTAuth, func() {
+			Scope("agent:create")
+		})
+		Payload(func() {
+			Token("token", String, "User JWT", func() {
+				Example("token", token)
+			})
+			Attribute("id", UInt, "ID of the user", func() {
+				Example("id", 11)
+			})
+			Required("id", "token")
+		})
+
+		HTTP(func() {
+			DELETE("/system/user/{id}/refresh-token")
+			Header("token:Authorization")
+
+			Response(StatusOK)
+			Response("not-found", StatusNotFound)
+			Response("invalid-token", StatusUnauthorized)
+			Response("invalid-scopes", StatusForbidden)
+			Response("internal-error", StatusInternalServerError)
+		})
+	})
+
 	Method("RefreshConfig", func() {
 		Description("Refresh the changes in config file")
 		Security(types.JWTAuth, func() {
@@ -97,4 +176,52 @@ var _ = Service("admin", func() {
 			Response("internal-error", StatusInternalServerError)
 		})
 	})
+
+	Method("Audit", func() {
+		Description("List the audit events of write operations, latest first")
+		Security(types.JWTAuth, func() {
+			Scope("audit:read")
+		})
+		Payload(func() {
+			Token("token", String, "User JWT", func() {
+				Example("token", token)
+			})
+			Attribute("actor", UInt, "ID of the user or agent who did the operations", func() {
+				Example("actor", 11)
+			})
+			Attribute("action", String, "Operation done by the actor", func() {
+				Example("action", "agent.update")
+			})
+			Attribute("since", String, "List the events which occurred at or after the time", func() {
+				Format(FormatDateTime)
+				Example("since", "2026-10-01T00:00:00Z")
+			})
+			Attribute("until", String, "List the events which occurred before the time", func() {
+				Format(FormatDateTime)
+				Example("until", "2026-10-17T00:00:00Z")
+			})
+			Attribute("limit", UInt, "Maximum number of events", func() {
+				Default(100)
+				Example("limit", 100)
+			})
+			Required("token")
+		})
+		Result(ArrayOf(types.AuditEvent))
+
+		HTTP(func() {
+			GET("/system/audit")
+			Header("token:Authorization")
+			Param("actor")
+			Param("action")
+			Param("since")
+			Param("until")
+			Param("limit")
+
+			Response(StatusOK)
+			Response("invalid-payload", StatusBadRequest)
+			Response("invalid-token", StatusUnauthorized)
+			Response("invalid-scopes", StatusForbidden)
+			Response("internal-error", StatusInternalServerError)
+		})
+	})
 })
@@ -24,6 +24,10 @@ var _ = Service("catalog", func() {
 
 	Error("internal-error", ErrorResult, "Internal Server Error")
 	Error("not-found", ErrorResult, "Resource Not Found Error")
+	Error("invalid-payload", ErrorResult, "Invalid request body")
+	Error("invalid-signature", ErrorResult, "Invalid webhook signature")
+	Error("already-exists", ErrorResult, "Catalog already exists")
+	Error("invalid-state", ErrorResult, "Job has already finished")
 
 	Method("Refresh", func() {
 		Description("Refresh a Catalog by it's name")
@@ -34,6 +38,9 @@ var _ = Service("catalog", func() {
 			Attribute("catalogName", String, "Name of catalog", func() {
 				Example("catalogName", "tekton")
 			})
+			Attribute("full", Boolean, "Parse all resources of the catalog instead of the ones changed since the last refresh", func() {
+				Default(false)
+			})
 			Token("token", String, "JWT")
 			Required("token", "catalogName")
 		})
@@ -42,6 +49,7 @@ var _ = Service("catalog", func() {
 		HTTP(func() {
 			POST("/catalog/{catalogName}/refresh")
 			Header("token:Authorization")
+			Param("full")
 
 			Response(StatusOK)
 			Response("not-found", StatusNotFound)
@@ -55,6 +63,9 @@ var _ = Service("catalog", func() {
 			Scope("catalog:refresh")
 		})
 		Payload(func() {
+			Attribute("full", Boolean, "Parse all resources of the catalogs instead of the ones changed since the last refresh", func() {
+				Default(false)
+			})
 			Token("token", String, "JWT")
 			Required("token")
 		})
@@ -63,8 +74,272 @@ var _ = Service("catalog", func() {
 		HTTP(func() {
 			POST("/catalog/refresh")
 			Header("token:Authorization")
+			Param("full")
+
+			Response(StatusOK)
+			Response("internal-error", StatusInternalServerError)
+		})
+	})
+
+	Method("Create", func() {
+		Description("Add a catalog and refresh it")
+		Security(types.JWTAuth, func() {
+			Scope("catalog:manage")
+		})
+		Payload(func() {
+			Token("token", String, "JWT")
+			Attribute("name", String, "Name of catalog", func() {
+				Example("name", "tekton")
+			})
+			Attribute("org", String, "Organization of catalog", func() {
+				Example("org", "tektoncd")
+			})
+			Attribute("type", String, "Type of catalog", func() {
+				Example("type", "community")
+			})
+			Attribute("provider", String, "Git provider of the catalog repository", func() {
+				Enum("github", "gitlab", "bitbucket")
+				Default("github")
+			})
+			Attribute("url", String, "URL of the catalog repository", func() {
+				Example("url", "https://github.com/tektoncd/catalog")
+			})
+			Attribute("sshUrl", String, "SSH URL of the catalog repository if it is private", func() {
+				Example("sshUrl", "git@github.com:tektoncd/catalog.git")
+			})
+			Attribute("revision", String, "Branch of the catalog repository", func() {
+				Example("revision", "main")
+			})
+			Attribute("contextDir", String, "Path to the resources in the catalog repository", func() {
+				Example("contextDir", "")
+			})
+			Attribute("webhookSecret", String, "Secret of the push webhook of the catalog repository")
+			Attribute("credential", String, "Name of the credential of the api used to fetch the catalog repository if it is private", func() {
+				Pattern("^([a-zA-Z0-9][a-zA-Z0-9._-]*)?$")
+				Example("credential", "tekton-catalog")
+			})
+			Attribute("insecureSkipTLSVerify", Boolean, "Skip the verification of the TLS certificate of the catalog repository", func() {
+				Example("insecureSkipTLSVerify", false)
+			})
+			Attribute("private", Boolean, "Show the resources of the catalog only to the users having its scope", func() {
+				Example("private", false)
+			})
+			Attribute("scope", String, "Scope required to see the private catalog, catalog:private by default", func() {
+				Example("scope", "catalog:private")
+			})
+			Required("token", "name", "org", "type", "url", "revision")
+		})
+		Result(types.Job)
+
+		HTTP(func() {
+			POST("/catalog")
+			Header("token:Authorization")
+
+			Response(StatusOK)
+			Response("invalid-payload", StatusBadRequest)
+			Response("already-exists", StatusConflict)
+			Response("internal-error", StatusInternalServerError)
+		})
+	})
+
+	Method("Update", func() {
+		Description("Update the repository of a catalog by it's name and refresh it")
+		Security(types.JWTAuth, func() {
+			Scope("catalog:manage")
+		})
+		Payload(func() {
+			Token("token", String, "JWT")
+			Attribute("catalogName", String, "Name of catalog", func() {
+				Example("catalogName", "tekton")
+			})
+			Attribute("provider", String, "Git provider of the catalog repository", func() {
+				Enum("github", "gitlab", "bitbucket")
+			})
+			Attribute("url", String, "URL of the catalog repository", func() {
+				Example("url", "https://github.com/tektoncd/catalog")
+			})
+			Attribute("sshUrl", String, "SSH URL of the catalog repository if it is private", func() {
+				Example("sshUrl", "git@github.com:tektoncd/catalog.git")
+			})
+			Attribute("revision", String, "Branch of the catalog repository", func() {
+				Example("revision", "main")
+			})
+			Attribute("contextDir", String, "Path to the resources in the catalog repository", func() {
+				Example("contextDir", "")
+			})
+			Attribute("webhookSecret", String, "Secret of the push webhook of the catalog repository")
+			Attribute("credential", String, "Name of the credential of the api used to fetch the catalog repository if it is private", func() {
+				Pattern("^([a-zA-Z0-9][a-zA-Z0-9._-]*)?$")
+				Example("credential", "tekton-catalog")
+			})
+			Attribute("insecureSkipTLSVerify", Boolean, "Skip the verification of the TLS certificate of the catalog repository", func() {
+				Example("insecureSkipTLSVerify", false)
+			})
+			Attribute("private", Boolean, "Show the resources of the catalog only to the users having its scope", func() {
+				Example("private", false)
+			})
+			Attribute("scope", String, "Scope required to see the private catalog, catalog:private by default", func() {
+				Example("scope", "catalog:private")
+			})
+			Required("token", "catalogName")
+		})
+		Result(types.Job)
+
+		HTTP(func() {
+			PUT("/catalog/{catalogName}")
+			Header("token:Authorization")
+
+			Response(StatusOK)
+			Response("invalid-payload", StatusBadRequest)
+			Response("not-found", StatusNotFound)
+			Response("internal-error", StatusInternalServerError)
+		})
+	})
+
+	Method("Delete", func() {
+		Description("Delete a catalog and its resources by it's name")
+		Security(types.JWTAuth, func() {
+			Scope("catalog:manage")
+		})
+		Payload(func() {
+			Token("token", String, "JWT")
+			Attribute("catalogName", String, "Name of catalog", func() {
+				Example("catalogName", "tekton")
+			})
+			Required("token", "catalogName")
+		})
+
+		HTTP(func() {
+			DELETE("/catalog/{catalogName}")
+			Header("token:Authorization")
+
+			Response(StatusOK)
+			Response("not-found", StatusNotFound)
+			Response("internal-error", StatusInternalServerError)
+		})
+	})
+
+	Method("Webhook", func() {
+		Description("Refresh the catalogs of a repository on a push event sent by GitHub, GitLab or Bitbucket")
+		Payload(func() {
+			Attribute("provider", String, "Git provider sending the event", func() {
+				Enum("github", "gitlab", "bitbucket")
+			})
+			Attribute("githubEvent", String, "Type of GitHub event", func() {
+				Example("githubEvent", "push")
+			})
+			Attribute("githubSignature", String, "HMAC SHA256 signature of the GitHub event", func() {
+				Example("githubSignature", "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17")
+			})
+			Attribute("gitlabEvent", String, "Type of GitLab event", func() {
+				Example("gitlabEvent", "Push Hook")
+			})
+			Attribute("gitlabToken", String, "Secret token of the GitLab webhook", func() {
+				Example("gitlabToken", "secret")
+			})
+			Attribute("bitbucketEvent", String, "Type of Bitbucket event", func() {
+				Example("bitbucketEvent", "repo:push")
+			})
+			Attribute("bitbucketSignature", String, "HMAC SHA256 signature of the Bitbucket event", func() {
+				Example("bitbucketSignature", "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17")
+			})
+			Required("provider")
+		})
+		Result(ArrayOf(types.Job))
+
+		HTTP(func() {
+			POST("/catalog/webhook/{provider}")
+			Header("githubEvent:X-GitHub-Event")
+			Header("githubSignature:X-Hub-Signature-256")
+			Header("gitlabEvent:X-Gitlab-Event")
+			Header("gitlabToken:X-Gitlab-Token")
+			Header("bitbucketEvent:X-Event-Key")
+			Header("bitbucketSignature:X-Hub-Signature")
+
+			// the raw body is required to verify the signature
+			SkipRequestBodyEncodeDecode()
+
+			Response(StatusOK)
+			Response("invalid-payload", StatusBadRequest)
+			Response("invalid-signature", StatusUnauthorized)
+			Response("internal-error", StatusInternalServerError)
+		})
+	})
+
+	Method("GetJob", func() {
+		Description("Get a catalog refresh job by it's id")
+		Security(types.JWTAuth, func() {
+			Scope("catalog:refresh")
+		})
+		Payload(func() {
+			Token("token", String, "JWT")
+			Attribute("id", UInt, "ID of the job", func() {
+				Example("id", 1)
+			})
+			Required("token", "id")
+		})
+		Result(types.Job)
+
+		HTTP(func() {
+			GET("/catalog/job/{id}")
+			Header("token:Authorization")
 
 			Response(StatusOK)
+			Response("not-found", StatusNotFound)
+			Response("internal-error", StatusInternalServerError)
+		})
+	})
+
+	Method("ListJobs", func() {
+		Description("List the refresh jobs of a catalog, latest first")
+		Security(types.JWTAuth, func() {
+			Scope("catalog:refresh")
+		})
+		Payload(func() {
+			Token("token", String, "JWT")
+			Attribute("catalogName", String, "Name of catalog", func() {
+				Example("catalogName", "tekton")
+			})
+			Attribute("limit", UInt, "Maximum number of jobs", func() {
+				Default(20)
+				Example("limit", 20)
+			})
+			Required("token", "catalogName")
+		})
+		Result(ArrayOf(types.Job))
+
+		HTTP(func() {
+			GET("/catalog/{catalogName}/jobs")
+			Header("token:Authorization")
+			Param("limit")
+
+			Response(StatusOK)
+			Response("not-found", StatusNotFound)
+			Response("internal-error", StatusInternalServerError)
+		})
+	})
+
+	Method("CancelJob", func() {
+		Description("Cancel a queued or running catalog refresh job")
+		Security(types.JWTAuth, func() {
+			Scope("catalog:refresh")
+		})
+		Payload(func() {
+			Token("token", String, "JWT")
+			Attribute("id", UInt, "ID of the job", func() {
+				Example("id", 1)
+			})
+			Required("token", "id")
+		})
+		Result(types.Job)
+
+		HTTP(func() {
+			DELETE("/catalog/job/{id}")
+			Header("token:Authorization")
+
+			Response(StatusOK)
+			Response("not-found", StatusNotFound)
+			Response("invalid-state", StatusConflict)
 			Response("internal-error", StatusInternalServerError)
 		})
 	})
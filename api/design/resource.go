@@ -127,7 +127,7 @@ var _ = Service("resource", func() {
 				Example("catalog", "tektoncd")
 			})
 			Attribute("kind", String, "kind of resource", func() {
-				Enum("task", "pipeline")
+				Enum("task", "pipeline", "stepaction")
 			})
 			Attribute("name", String, "name of resource", func() {
 				Example("name", "buildah")
@@ -185,7 +185,7 @@ var _ = Service("resource", func() {
 				Example("catalog", "tektoncd")
 			})
 			Attribute("kind", String, "kind of resource", func() {
-				Enum("task", "pipeline")
+				Enum("task", "pipeline", "stepaction")
 			})
 			Attribute("name", String, "Name of resource", func() {
 				Example("name", "buildah")
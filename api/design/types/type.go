@@ -157,6 +157,19 @@ var ResourceVersionData = ResultType("application/vnd.hub.resource.version.data"
 	Attribute("hubURLPath", String, "Url path of the resource in hub", func() {
 		Example("hubURLPath", "tekton/task/buildah")
 	})
+	Attribute("bundle", String, "Tekton bundle (OCI image) reference of the version", func() {
+		Example("bundle", "gcr.io/tekton-releases/catalog/upstream/buildah:0.1")
+	})
+	Attribute("apiVersion", String, "Tekton apiVersion of the resource manifest", func() {
+		Example("apiVersion", "tekton.dev/v1")
+	})
+	Attribute("verification", String, "Verification of the trusted resource signature of the version with the public keys of its catalog", func() {
+		Enum("verified", "unsigned", "invalid")
+		Example("verification", "verified")
+	})
+	Attribute("params", ArrayOf(ParamSpec), "Params of the version")
+	Attribute("workspaces", ArrayOf(WorkspaceSpec), "Workspaces of the version")
+	Attribute("results", ArrayOf(ResultSpec), "Results of the version")
 	Attribute("resource", ResourceData, "Resource to which the version belongs", func() {
 		View("info")
 		Example("resource", func() {
@@ -176,6 +189,7 @@ var ResourceVersionData = ResultType("application/vnd.hub.resource.version.data"
 	View("tiny", func() {
 		Attribute("id")
 		Attribute("version")
+		Attribute("bundle")
 	})
 
 	View("min", func() {
@@ -201,6 +215,9 @@ var ResourceVersionData = ResultType("application/vnd.hub.resource.version.data"
 		Attribute("hubURLPath")
 		Attribute("updatedAt")
 		Attribute("platforms")
+		Attribute("bundle")
+		Attribute("apiVersion")
+		Attribute("verification")
 	})
 
 	View("default", func() {
@@ -217,6 +234,12 @@ var ResourceVersionData = ResultType("application/vnd.hub.resource.version.data"
 		Attribute("updatedAt")
 		Attribute("resource")
 		Attribute("platforms")
+		Attribute("bundle")
+		Attribute("apiVersion")
+		Attribute("verification")
+		Attribute("params")
+		Attribute("workspaces")
+		Attribute("results")
 	})
 
 	Required("id", "version", "displayName", "description", "minPipelinesVersion", "rawURL", "webURL", "updatedAt", "platforms", "resource", "hubURLPath", "hubRawURLPath")
@@ -288,6 +311,9 @@ var ResourceData = ResultType("application/vnd.hub.resource.data", "ResourceData
 	Attribute("rating", Float64, "Rating of resource", func() {
 		Example("rating", 4.3)
 	})
+	Attribute("downloads", UInt, "Number of downloads of all versions of resource", func() {
+		Example("downloads", 120)
+	})
 	Attribute("versions", ArrayOf("ResourceVersionData"), "List of all versions of a resource", func() {
 		Example("versions", func() {
 			Value([]Val{{
@@ -326,6 +352,7 @@ var ResourceData = ResultType("application/vnd.hub.resource.data", "ResourceData
 		Attribute("tags")
 		Attribute("platforms")
 		Attribute("rating")
+		Attribute("downloads")
 	})
 
 	View("default", func() {
@@ -340,12 +367,13 @@ var ResourceData = ResultType("application/vnd.hub.resource.data", "ResourceData
 		Attribute("tags")
 		Attribute("platforms")
 		Attribute("rating")
+		Attribute("downloads")
 		Attribute("versions", func() {
 			View("tiny")
 		})
 	})
 
-	Required("id", "name", "catalog", "categories", "kind", "hubURLPath", "latestVersion", "tags", "platforms", "rating", "versions", "hubRawURLPath")
+	Required("id", "name", "catalog", "categories", "kind", "hubURLPath", "latestVersion", "tags", "platforms", "rating", "downloads", "versions", "hubRawURLPath")
 })
 
 var Versions = ResultType("application/vnd.hub.versions", "Versions", func() {
@@ -398,7 +426,9 @@ var JWTAuth = JWTSecurity("jwt", func() {
 	Scope("rating:write", "Read and write access to rating")
 	Scope("agent:create", "Access to create or update an agent")
 	Scope("catalog:refresh", "Access to refresh catalog")
+	Scope("catalog:manage", "Access to add, update and delete catalogs")
 	Scope("config:refresh", "Access to refresh config file")
+	Scope("audit:read", "Access to read the audit events")
 	Scope("refresh:token", "Access to refresh user access token")
 })
 
@@ -429,13 +459,283 @@ var Job = ResultType("application/vnd.hub.job", "Job", func() {
 	Attribute("status", String, "status of the job", func() {
 		Example("status", "queued")
 	})
+	Attribute("createdAt", String, "Time at which the job was queued", func() {
+		Format(FormatDateTime)
+		Example("createdAt", "2026-10-17T10:00:00Z")
+	})
+	Attribute("startedAt", String, "Time at which the job started to run", func() {
+		Format(FormatDateTime)
+		Example("startedAt", "2026-10-17T10:00:05Z")
+	})
+	Attribute("finishedAt", String, "Time at which the job finished", func() {
+		Format(FormatDateTime)
+		Example("finishedAt", "2026-10-17T10:01:00Z")
+	})
+	Attribute("sha", String, "Commit of the catalog synced by the job", func() {
+		Example("sha", "a4f8e5b3e2d7c8f0e1c7f6b0e0e5a1d7c3b2a1f0")
+	})
+	Attribute("reason", String, "Cause of the failure of the job", func() {
+		Example("reason", "failed to clone the catalog")
+	})
 	Required("id", "catalogName", "status")
 })
 
+var AuditEvent = Type("AuditEvent", func() {
+	Description("The AuditEvent type describes a write operation done by a user or an agent")
+	Attribute("id", UInt, "id of the event", func() {
+		Example("id", 1)
+	})
+	Attribute("actorId", UInt, "id of the user or agent who did the operation", func() {
+		Example("actorId", 11)
+	})
+	Attribute("action", String, "Operation done by the actor", func() {
+		Example("action", "agent.update")
+	})
+	Attribute("target", String, "Object of the operation", func() {
+		Example("target", "agent:hub-bot")
+	})
+	Attribute("digest", String, "SHA256 digest of the payload of the operation", func() {
+		Example("digest", "41ba391c8baf1fcd3c62c11272b913dc6613f4cf3b1833cfbb32431dc4384c93")
+	})
+	Attribute("createdAt", String, "Time at which the operation was done", func() {
+		Format(FormatDateTime)
+		Example("createdAt", "2026-10-17T10:00:00Z")
+	})
+	Required("id", "actorId", "action", "target", "digest", "createdAt")
+})
+
+var Agent = Type("Agent", func() {
+	Description("The Agent type describes an agent user and its token")
+	Attribute("id", UInt, "id of the agent", func() {
+		Example("id", 21)
+	})
+	Attribute("name", String, "Name of the agent", func() {
+		Example("name", "hub-bot")
+	})
+	Attribute("scopes", ArrayOf(String), "Scopes of the agent", func() {
+		Example("scopes", func() {
+			Value([]string{"catalog:refresh"})
+		})
+	})
+	Attribute("tokenId", String, "ID (jti) of the valid token of the agent, absent once the token is revoked", func() {
+		Example("tokenId", "1b4e28ba-2fa1-11d2-883f-0016d3cca427")
+	})
+	Required("id", "name", "scopes")
+})
+
+var Review = Type("Review", func() {
+	Description("The Review type describes the review of a user on a version of a resource")
+	Attribute("id", UInt, "ID of the review", func() {
+		Example("id", 1)
+	})
+	Attribute("resourceId", UInt, "ID of the resource", func() {
+		Example("resourceId", 1)
+	})
+	Attribute("version", String, "Version of the resource which is reviewed", func() {
+		Example("version", "0.1")
+	})
+	Attribute("userId", UInt, "ID of the user who wrote the review", func() {
+		Example("userId", 11)
+	})
+	Attribute("text", String, "Text of the review", func() {
+		Example("text", "Works well, the workspace for the credentials could be optional")
+	})
+	Attribute("createdAt", String, "Time at which the review was written", func() {
+		Format(FormatDateTime)
+		Example("createdAt", "2026-10-17T10:00:00Z")
+	})
+	Attribute("updatedAt", String, "Time at which the review was last edited", func() {
+		Format(FormatDateTime)
+		Example("updatedAt", "2026-10-17T10:00:00Z")
+	})
+	Required("id", "resourceId", "version", "userId", "text", "createdAt", "updatedAt")
+})
+
+var Reviews = Type("Reviews", func() {
+	Description("A page of the reviews of a resource, latest first")
+	Attribute("data", ArrayOf(Review))
+	Attribute("next", String, "Cursor to fetch the next page of reviews, absent on the last page")
+	Required("data")
+})
+
+var Dependency = Type("Dependency", func() {
+	Description("The Dependency type describes a task referenced by a pipeline")
+	Attribute("name", String, "Name of the task", func() {
+		Example("name", "git-clone")
+	})
+	Attribute("kind", String, "Kind of the task", func() {
+		Example("kind", "Task")
+	})
+	Attribute("via", String, "How the pipeline references the task", func() {
+		Enum("name", "bundle", "hub", "git")
+		Example("via", "hub")
+	})
+	Attribute("version", String, "Version of the task, absent if the reference doesn't pin it", func() {
+		Example("version", "0.9")
+	})
+	Attribute("catalog", String, "Name of the catalog of the task, if known", func() {
+		Example("catalog", "tekton")
+	})
+	Attribute("bundle", String, "Bundle of the task referenced through a bundle", func() {
+		Example("bundle", "gcr.io/tekton-releases/catalog/upstream/git-clone:0.9")
+	})
+	Attribute("url", String, "URL of the repository of the task referenced through git", func() {
+		Example("url", "https://github.com/tektoncd/catalog.git")
+	})
+	Attribute("resourceId", UInt, "ID of the resource in the hub the task resolves to", func() {
+		Example("resourceId", 1)
+	})
+	Required("name", "kind", "via")
+})
+
+var PipelineDependencies = Type("PipelineDependencies", func() {
+	Description("The tasks referenced by a version of a pipeline")
+	Attribute("version", String, "Version of the pipeline", func() {
+		Example("version", "0.1")
+	})
+	Attribute("data", ArrayOf(Dependency))
+	Required("version", "data")
+})
+
+var Dependent = Type("Dependent", func() {
+	Description("The Dependent type describes a version of a pipeline referencing a task")
+	Attribute("resourceId", UInt, "ID of the pipeline", func() {
+		Example("resourceId", 2)
+	})
+	Attribute("name", String, "Name of the pipeline", func() {
+		Example("name", "build-deploy")
+	})
+	Attribute("catalog", String, "Name of the catalog of the pipeline", func() {
+		Example("catalog", "tekton")
+	})
+	Attribute("version", String, "Version of the pipeline", func() {
+		Example("version", "0.1")
+	})
+	Attribute("taskVersion", String, "Version of the task referenced by the pipeline, absent if the reference doesn't pin it", func() {
+		Example("taskVersion", "0.9")
+	})
+	Required("resourceId", "name", "catalog", "version")
+})
+
+var TaskDependents = Type("TaskDependents", func() {
+	Description("The versions of the pipelines referencing a task")
+	Attribute("data", ArrayOf(Dependent))
+	Required("data")
+})
+
+var LintResult = Type("LintResult", func() {
+	Description("The LintResult type describes a problem found by a lint rule in a version of a resource")
+	Attribute("rule", String, "ID of the rule", func() {
+		Example("rule", "image-latest-tag")
+	})
+	Attribute("severity", String, "Severity of the problem", func() {
+		Enum("critical", "warning", "info")
+		Example("severity", "warning")
+	})
+	Attribute("message", String, "Description of the problem", func() {
+		Example("message", "step build uses image golang with the latest tag")
+	})
+	Required("rule", "severity", "message")
+})
+
+var VersionLint = Type("VersionLint", func() {
+	Description("The problems found by the lint rules in a version of a resource")
+	Attribute("data", ArrayOf(LintResult))
+	Required("data")
+})
+
+var VersionImages = Type("VersionImages", func() {
+	Description("The images of the steps and sidecars of a version of a resource")
+	Attribute("data", ArrayOf(String), "References of the images", func() {
+		Example([]string{"quay.io/buildah/stable:v1.31", "alpine:3.18"})
+	})
+	Required("data")
+})
+
+var ParamSpec = Type("ParamSpec", func() {
+	Description("The ParamSpec type describes a param of a version of a resource")
+	Attribute("name", String, "Name of the param", func() {
+		Example("name", "IMAGE")
+	})
+	Attribute("type", String, "Type of the param", func() {
+		Enum("string", "array", "object")
+		Example("type", "string")
+	})
+	Attribute("default", String, "Default of the param, JSON for array and object params", func() {
+		Example("default", "quay.io/buildah/stable:v1.31")
+	})
+	Attribute("description", String, "Description of the param", func() {
+		Example("description", "Reference of the image buildah will produce.")
+	})
+	Required("name", "type", "description")
+})
+
+var WorkspaceSpec = Type("WorkspaceSpec", func() {
+	Description("The WorkspaceSpec type describes a workspace of a version of a task or a pipeline")
+	Attribute("name", String, "Name of the workspace", func() {
+		Example("name", "source")
+	})
+	Attribute("description", String, "Description of the workspace", func() {
+		Example("description", "Source code to build")
+	})
+	Attribute("optional", Boolean, "Whether the workspace may not be bound", func() {
+		Example("optional", false)
+	})
+	Attribute("readOnly", Boolean, "Whether the task only reads the workspace", func() {
+		Example("readOnly", false)
+	})
+	Required("name", "description", "optional", "readOnly")
+})
+
+var ResultSpec = Type("ResultSpec", func() {
+	Description("The ResultSpec type describes a result of a version of a resource")
+	Attribute("name", String, "Name of the result", func() {
+		Example("name", "IMAGE_DIGEST")
+	})
+	Attribute("type", String, "Type of the result", func() {
+		Enum("string", "array", "object")
+		Example("type", "string")
+	})
+	Attribute("description", String, "Description of the result", func() {
+		Example("description", "Digest of the image just built.")
+	})
+	Required("name", "type", "description")
+})
+
+var ImageUser = Type("ImageUser", func() {
+	Description("The ImageUser type describes a version of a resource using an image")
+	Attribute("resourceId", UInt, "ID of the resource", func() {
+		Example("resourceId", 1)
+	})
+	Attribute("catalog", String, "Name of the catalog of the resource", func() {
+		Example("catalog", "tekton")
+	})
+	Attribute("kind", String, "Kind of the resource", func() {
+		Example("kind", "Task")
+	})
+	Attribute("name", String, "Name of the resource", func() {
+		Example("name", "buildah")
+	})
+	Attribute("version", String, "Version of the resource", func() {
+		Example("version", "0.1")
+	})
+	Attribute("image", String, "Reference of the image used by the version", func() {
+		Example("image", "quay.io/buildah/stable:v1.31")
+	})
+	Required("resourceId", "catalog", "kind", "name", "version", "image")
+})
+
+var ImageUsers = Type("ImageUsers", func() {
+	Description("The versions of the resources using an image")
+	Attribute("data", ArrayOf(ImageUser))
+	Required("data")
+})
+
 var Resources = ResultType("application/vnd.hub.resources", "Resources", func() {
 	Attribute("data", CollectionOf(ResourceData), func() {
 		View("withoutVersion")
 	})
+	Attribute("next", String, "Cursor to fetch the next page of resources, absent on the last page")
 	Required("data")
 })
 
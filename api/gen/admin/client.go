@@ -15,21 +15,30 @@ import (
 
 // Client is the "admin" service client.
 type Client struct {
-	UpdateAgentEndpoint   goa.Endpoint
-	RefreshConfigEndpoint goa.Endpoint
+	UpdateAgentEndpoint     goa.Endpoint
+	ListAgentsEndpoint      goa.Endpoint
+	RevokeAgentEndpoint     goa.Endpoint
+	RevokeUserTokenEndpoint goa.Endpoint
+	RefreshConfigEndpoint   goa.Endpoint
+	AuditEndpoint           goa.Endpoint
 }
 
 // NewClient initializes a "admin" service client given the endpoints.
-func NewClient(updateAgent, refreshConfig goa.Endpoint) *Client {
+func NewClient(updateAgent, listAgents, revokeAgent, revokeUserToken, refreshConfig, audit goa.Endpoint) *Client {
 	return &Client{
-		UpdateAgentEndpoint:   updateAgent,
-		RefreshConfigEndpoint: refreshConfig,
+		UpdateAgentEndpoint:     updateAgent,
+		ListAgentsEndpoint:      listAgents,
+		RevokeAgentEndpoint:     revokeAgent,
+		RevokeUserTokenEndpoint: revokeUserToken,
+		RefreshConfigEndpoint:   refreshConfig,
+		AuditEndpoint:           audit,
 	}
 }
 
 // UpdateAgent calls the "UpdateAgent" endpoint of the "admin" service.
 // UpdateAgent may return the following errors:
 //   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
 //   - "invalid-token" (type *goa.ServiceError): Invalid User token
 //   - "invalid-scopes" (type *goa.ServiceError): Invalid Token scopes
 //   - "internal-error" (type *goa.ServiceError): Internal server error
@@ -43,9 +52,53 @@ func (c *Client) UpdateAgent(ctx context.Context, p *UpdateAgentPayload) (res *U
 	return ires.(*UpdateAgentResult), nil
 }
 
+// ListAgents calls the "ListAgents" endpoint of the "admin" service.
+// ListAgents may return the following errors:
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid Token scopes
+//   - "internal-error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ListAgents(ctx context.Context, p *ListAgentsPayload) (res []*Agent, err error) {
+	var ires any
+	ires, err = c.ListAgentsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*Agent), nil
+}
+
+// RevokeAgent calls the "RevokeAgent" endpoint of the "admin" service.
+// RevokeAgent may return the following errors:
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid Token scopes
+//   - "internal-error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) RevokeAgent(ctx context.Context, p *RevokeAgentPayload) (err error) {
+	_, err = c.RevokeAgentEndpoint(ctx, p)
+	return
+}
+
+// RevokeUserToken calls the "RevokeUserToken" endpoint of the "admin" service.
+// RevokeUserToken may return the following errors:
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid Token scopes
+//   - "internal-error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) RevokeUserToken(ctx context.Context, p *RevokeUserTokenPayload) (err error) {
+	_, err = c.RevokeUserTokenEndpoint(ctx, p)
+	return
+}
+
 // RefreshConfig calls the "RefreshConfig" endpoint of the "admin" service.
 // RefreshConfig may return the following errors:
 //   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
 //   - "invalid-token" (type *goa.ServiceError): Invalid User token
 //   - "invalid-scopes" (type *goa.ServiceError): Invalid Token scopes
 //   - "internal-error" (type *goa.ServiceError): Internal server error
@@ -58,3 +111,20 @@ func (c *Client) RefreshConfig(ctx context.Context, p *RefreshConfigPayload) (re
 	}
 	return ires.(*RefreshConfigResult), nil
 }
+
+// Audit calls the "Audit" endpoint of the "admin" service.
+// Audit may return the following errors:
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid Token scopes
+//   - "internal-error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) Audit(ctx context.Context, p *AuditPayload) (res []*AuditEvent, err error) {
+	var ires any
+	ires, err = c.AuditEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*AuditEvent), nil
+}
@@ -16,8 +16,12 @@ import (
 
 // Endpoints wraps the "admin" service endpoints.
 type Endpoints struct {
-	UpdateAgent   goa.Endpoint
-	RefreshConfig goa.Endpoint
+	UpdateAgent     goa.Endpoint
+	ListAgents      goa.Endpoint
+	RevokeAgent     goa.Endpoint
+	RevokeUserToken goa.Endpoint
+	RefreshConfig   goa.Endpoint
+	Audit           goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "admin" service with endpoints.
@@ -25,15 +29,23 @@ func NewEndpoints(s Service) *Endpoints {
 	// Casting service to Auther interface
 	a := s.(Auther)
 	return &Endpoints{
-		UpdateAgent:   NewUpdateAgentEndpoint(s, a.JWTAuth),
-		RefreshConfig: NewRefreshConfigEndpoint(s, a.JWTAuth),
+		UpdateAgent:     NewUpdateAgentEndpoint(s, a.JWTAuth),
+		ListAgents:      NewListAgentsEndpoint(s, a.JWTAuth),
+		RevokeAgent:     NewRevokeAgentEndpoint(s, a.JWTAuth),
+		RevokeUserToken: NewRevokeUserTokenEndpoint(s, a.JWTAuth),
+		RefreshConfig:   NewRefreshConfigEndpoint(s, a.JWTAuth),
+		Audit:           NewAuditEndpoint(s, a.JWTAuth),
 	}
 }
 
 // Use applies the given middleware to all the "admin" service endpoints.
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.UpdateAgent = m(e.UpdateAgent)
+	e.ListAgents = m(e.ListAgents)
+	e.RevokeAgent = m(e.RevokeAgent)
+	e.RevokeUserToken = m(e.RevokeUserToken)
 	e.RefreshConfig = m(e.RefreshConfig)
+	e.Audit = m(e.Audit)
 }
 
 // NewUpdateAgentEndpoint returns an endpoint function that calls the method
@@ -44,7 +56,7 @@ func NewUpdateAgentEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpo
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "config:refresh", "refresh:token"},
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
 			RequiredScopes: []string{"agent:create"},
 		}
 		ctx, err = authJWTFn(ctx, p.Token, &sc)
@@ -55,6 +67,63 @@ func NewUpdateAgentEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpo
 	}
 }
 
+// NewListAgentsEndpoint returns an endpoint function that calls the method
+// "ListAgents" of service "admin".
+func NewListAgentsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListAgentsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{"agent:create"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListAgents(ctx, p)
+	}
+}
+
+// NewRevokeAgentEndpoint returns an endpoint function that calls the method
+// "RevokeAgent" of service "admin".
+func NewRevokeAgentEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RevokeAgentPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{"agent:create"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.RevokeAgent(ctx, p)
+	}
+}
+
+// NewRevokeUserTokenEndpoint returns an endpoint function that calls the
+// method "RevokeUserToken" of service "admin".
+func NewRevokeUserTokenEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RevokeUserTokenPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{"agent:create"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.RevokeUserToken(ctx, p)
+	}
+}
+
 // NewRefreshConfigEndpoint returns an endpoint function that calls the method
 // "RefreshConfig" of service "admin".
 func NewRefreshConfigEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -63,7 +132,7 @@ func NewRefreshConfigEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.End
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "config:refresh", "refresh:token"},
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
 			RequiredScopes: []string{"config:refresh"},
 		}
 		ctx, err = authJWTFn(ctx, p.Token, &sc)
@@ -73,3 +142,22 @@ func NewRefreshConfigEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.End
 		return s.RefreshConfig(ctx, p)
 	}
 }
+
+// NewAuditEndpoint returns an endpoint function that calls the method "Audit"
+// of service "admin".
+func NewAuditEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AuditPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{"audit:read"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Audit(ctx, p)
+	}
+}
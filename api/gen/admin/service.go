@@ -18,8 +18,18 @@ import (
 type Service interface {
 	// Create or Update an agent user with required scopes
 	UpdateAgent(context.Context, *UpdateAgentPayload) (res *UpdateAgentResult, err error)
+	// List the agents along with the id of their valid token
+	ListAgents(context.Context, *ListAgentsPayload) (res []*Agent, err error)
+	// Revoke the token of an agent, a new token can be created by updating the
+	// agent
+	RevokeAgent(context.Context, *RevokeAgentPayload) (err error)
+	// Revoke the refresh token of a user, the user has to login again once the
+	// access token expires
+	RevokeUserToken(context.Context, *RevokeUserTokenPayload) (err error)
 	// Refresh the changes in config file
 	RefreshConfig(context.Context, *RefreshConfigPayload) (res *RefreshConfigResult, err error)
+	// List the audit events of write operations, latest first
+	Audit(context.Context, *AuditPayload) (res []*AuditEvent, err error)
 }
 
 // Auther defines the authorization functions to be implemented by the service.
@@ -42,7 +52,57 @@ const ServiceName = "admin"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [2]string{"UpdateAgent", "RefreshConfig"}
+var MethodNames = [6]string{"UpdateAgent", "ListAgents", "RevokeAgent", "RevokeUserToken", "RefreshConfig", "Audit"}
+
+// The Agent type describes an agent user and its token
+type Agent struct {
+	// id of the agent
+	ID uint
+	// Name of the agent
+	Name string
+	// Scopes of the agent
+	Scopes []string
+	// ID (jti) of the valid token of the agent, absent once the token is revoked
+	TokenID *string
+}
+
+// The AuditEvent type describes a write operation done by a user or an agent
+type AuditEvent struct {
+	// id of the event
+	ID uint
+	// id of the user or agent who did the operation
+	ActorID uint
+	// Operation done by the actor
+	Action string
+	// Object of the operation
+	Target string
+	// SHA256 digest of the payload of the operation
+	Digest string
+	// Time at which the operation was done
+	CreatedAt string
+}
+
+// AuditPayload is the payload type of the admin service Audit method.
+type AuditPayload struct {
+	// User JWT
+	Token string
+	// ID of the user or agent who did the operations
+	Actor *uint
+	// Operation done by the actor
+	Action *string
+	// List the events which occurred at or after the time
+	Since *string
+	// List the events which occurred before the time
+	Until *string
+	// Maximum number of events
+	Limit uint
+}
+
+// ListAgentsPayload is the payload type of the admin service ListAgents method.
+type ListAgentsPayload struct {
+	// User JWT
+	Token string
+}
 
 // RefreshConfigPayload is the payload type of the admin service RefreshConfig
 // method.
@@ -58,6 +118,24 @@ type RefreshConfigResult struct {
 	Checksum string
 }
 
+// RevokeAgentPayload is the payload type of the admin service RevokeAgent
+// method.
+type RevokeAgentPayload struct {
+	// User JWT
+	Token string
+	// Name of Agent
+	Name string
+}
+
+// RevokeUserTokenPayload is the payload type of the admin service
+// RevokeUserToken method.
+type RevokeUserTokenPayload struct {
+	// User JWT
+	Token string
+	// ID of the user
+	ID uint
+}
+
 // UpdateAgentPayload is the payload type of the admin service UpdateAgent
 // method.
 type UpdateAgentPayload struct {
@@ -80,6 +158,11 @@ func MakeInvalidPayload(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "invalid-payload", false, false, false)
 }
 
+// MakeNotFound builds a goa.ServiceError from an error.
+func MakeNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "not-found", false, false, false)
+}
+
 // MakeInvalidToken builds a goa.ServiceError from an error.
 func MakeInvalidToken(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "invalid-token", false, false, false)
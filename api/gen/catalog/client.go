@@ -9,6 +9,7 @@ package catalog
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 )
@@ -17,14 +18,28 @@ import (
 type Client struct {
 	RefreshEndpoint      goa.Endpoint
 	RefreshAllEndpoint   goa.Endpoint
+	CreateEndpoint       goa.Endpoint
+	UpdateEndpoint       goa.Endpoint
+	DeleteEndpoint       goa.Endpoint
+	WebhookEndpoint      goa.Endpoint
+	GetJobEndpoint       goa.Endpoint
+	ListJobsEndpoint     goa.Endpoint
+	CancelJobEndpoint    goa.Endpoint
 	CatalogErrorEndpoint goa.Endpoint
 }
 
 // NewClient initializes a "catalog" service client given the endpoints.
-func NewClient(refresh, refreshAll, catalogError goa.Endpoint) *Client {
+func NewClient(refresh, refreshAll, create, update, delete_, webhook, getJob, listJobs, cancelJob, catalogError goa.Endpoint) *Client {
 	return &Client{
 		RefreshEndpoint:      refresh,
 		RefreshAllEndpoint:   refreshAll,
+		CreateEndpoint:       create,
+		UpdateEndpoint:       update,
+		DeleteEndpoint:       delete_,
+		WebhookEndpoint:      webhook,
+		GetJobEndpoint:       getJob,
+		ListJobsEndpoint:     listJobs,
+		CancelJobEndpoint:    cancelJob,
 		CatalogErrorEndpoint: catalogError,
 	}
 }
@@ -33,6 +48,10 @@ func NewClient(refresh, refreshAll, catalogError goa.Endpoint) *Client {
 // Refresh may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
 //   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - "invalid-signature" (type *goa.ServiceError): Invalid webhook signature
+//   - "already-exists" (type *goa.ServiceError): Catalog already exists
+//   - "invalid-state" (type *goa.ServiceError): Job has already finished
 //   - error: internal error
 func (c *Client) Refresh(ctx context.Context, p *RefreshPayload) (res *Job, err error) {
 	var ires any
@@ -47,6 +66,10 @@ func (c *Client) Refresh(ctx context.Context, p *RefreshPayload) (res *Job, err
 // RefreshAll may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
 //   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - "invalid-signature" (type *goa.ServiceError): Invalid webhook signature
+//   - "already-exists" (type *goa.ServiceError): Catalog already exists
+//   - "invalid-state" (type *goa.ServiceError): Job has already finished
 //   - error: internal error
 func (c *Client) RefreshAll(ctx context.Context, p *RefreshAllPayload) (res []*Job, err error) {
 	var ires any
@@ -57,10 +80,136 @@ func (c *Client) RefreshAll(ctx context.Context, p *RefreshAllPayload) (res []*J
 	return ires.([]*Job), nil
 }
 
+// Create calls the "Create" endpoint of the "catalog" service.
+// Create may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - "invalid-signature" (type *goa.ServiceError): Invalid webhook signature
+//   - "already-exists" (type *goa.ServiceError): Catalog already exists
+//   - "invalid-state" (type *goa.ServiceError): Job has already finished
+//   - error: internal error
+func (c *Client) Create(ctx context.Context, p *CreatePayload) (res *Job, err error) {
+	var ires any
+	ires, err = c.CreateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Job), nil
+}
+
+// Update calls the "Update" endpoint of the "catalog" service.
+// Update may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - "invalid-signature" (type *goa.ServiceError): Invalid webhook signature
+//   - "already-exists" (type *goa.ServiceError): Catalog already exists
+//   - "invalid-state" (type *goa.ServiceError): Job has already finished
+//   - error: internal error
+func (c *Client) Update(ctx context.Context, p *UpdatePayload) (res *Job, err error) {
+	var ires any
+	ires, err = c.UpdateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Job), nil
+}
+
+// Delete calls the "Delete" endpoint of the "catalog" service.
+// Delete may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - "invalid-signature" (type *goa.ServiceError): Invalid webhook signature
+//   - "already-exists" (type *goa.ServiceError): Catalog already exists
+//   - "invalid-state" (type *goa.ServiceError): Job has already finished
+//   - error: internal error
+func (c *Client) Delete(ctx context.Context, p *DeletePayload) (err error) {
+	_, err = c.DeleteEndpoint(ctx, p)
+	return
+}
+
+// Webhook calls the "Webhook" endpoint of the "catalog" service.
+// Webhook may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - "invalid-signature" (type *goa.ServiceError): Invalid webhook signature
+//   - "already-exists" (type *goa.ServiceError): Catalog already exists
+//   - "invalid-state" (type *goa.ServiceError): Job has already finished
+//   - error: internal error
+func (c *Client) Webhook(ctx context.Context, p *WebhookPayload, req io.ReadCloser) (res []*Job, err error) {
+	var ires any
+	ires, err = c.WebhookEndpoint(ctx, &WebhookRequestData{Payload: p, Body: req})
+	if err != nil {
+		return
+	}
+	return ires.([]*Job), nil
+}
+
+// GetJob calls the "GetJob" endpoint of the "catalog" service.
+// GetJob may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - "invalid-signature" (type *goa.ServiceError): Invalid webhook signature
+//   - "already-exists" (type *goa.ServiceError): Catalog already exists
+//   - "invalid-state" (type *goa.ServiceError): Job has already finished
+//   - error: internal error
+func (c *Client) GetJob(ctx context.Context, p *GetJobPayload) (res *Job, err error) {
+	var ires any
+	ires, err = c.GetJobEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Job), nil
+}
+
+// ListJobs calls the "ListJobs" endpoint of the "catalog" service.
+// ListJobs may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - "invalid-signature" (type *goa.ServiceError): Invalid webhook signature
+//   - "already-exists" (type *goa.ServiceError): Catalog already exists
+//   - "invalid-state" (type *goa.ServiceError): Job has already finished
+//   - error: internal error
+func (c *Client) ListJobs(ctx context.Context, p *ListJobsPayload) (res []*Job, err error) {
+	var ires any
+	ires, err = c.ListJobsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*Job), nil
+}
+
+// CancelJob calls the "CancelJob" endpoint of the "catalog" service.
+// CancelJob may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - "invalid-signature" (type *goa.ServiceError): Invalid webhook signature
+//   - "already-exists" (type *goa.ServiceError): Catalog already exists
+//   - "invalid-state" (type *goa.ServiceError): Job has already finished
+//   - error: internal error
+func (c *Client) CancelJob(ctx context.Context, p *CancelJobPayload) (res *Job, err error) {
+	var ires any
+	ires, err = c.CancelJobEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Job), nil
+}
+
 // CatalogError calls the "CatalogError" endpoint of the "catalog" service.
 // CatalogError may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
 //   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - "invalid-signature" (type *goa.ServiceError): Invalid webhook signature
+//   - "already-exists" (type *goa.ServiceError): Catalog already exists
+//   - "invalid-state" (type *goa.ServiceError): Job has already finished
 //   - error: internal error
 func (c *Client) CatalogError(ctx context.Context, p *CatalogErrorPayload) (res *CatalogErrorResult, err error) {
 	var ires any
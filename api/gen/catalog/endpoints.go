@@ -9,6 +9,7 @@ package catalog
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 	"goa.design/goa/v3/security"
@@ -18,9 +19,25 @@ import (
 type Endpoints struct {
 	Refresh      goa.Endpoint
 	RefreshAll   goa.Endpoint
+	Create       goa.Endpoint
+	Update       goa.Endpoint
+	Delete       goa.Endpoint
+	Webhook      goa.Endpoint
+	GetJob       goa.Endpoint
+	ListJobs     goa.Endpoint
+	CancelJob    goa.Endpoint
 	CatalogError goa.Endpoint
 }
 
+// WebhookRequestData holds both the payload and the HTTP request body reader
+// of the "Webhook" method.
+type WebhookRequestData struct {
+	// Payload is the method payload.
+	Payload *WebhookPayload
+	// Body streams the HTTP request body.
+	Body io.ReadCloser
+}
+
 // NewEndpoints wraps the methods of the "catalog" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	// Casting service to Auther interface
@@ -28,6 +45,13 @@ func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
 		Refresh:      NewRefreshEndpoint(s, a.JWTAuth),
 		RefreshAll:   NewRefreshAllEndpoint(s, a.JWTAuth),
+		Create:       NewCreateEndpoint(s, a.JWTAuth),
+		Update:       NewUpdateEndpoint(s, a.JWTAuth),
+		Delete:       NewDeleteEndpoint(s, a.JWTAuth),
+		Webhook:      NewWebhookEndpoint(s),
+		GetJob:       NewGetJobEndpoint(s, a.JWTAuth),
+		ListJobs:     NewListJobsEndpoint(s, a.JWTAuth),
+		CancelJob:    NewCancelJobEndpoint(s, a.JWTAuth),
 		CatalogError: NewCatalogErrorEndpoint(s, a.JWTAuth),
 	}
 }
@@ -36,6 +60,13 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Refresh = m(e.Refresh)
 	e.RefreshAll = m(e.RefreshAll)
+	e.Create = m(e.Create)
+	e.Update = m(e.Update)
+	e.Delete = m(e.Delete)
+	e.Webhook = m(e.Webhook)
+	e.GetJob = m(e.GetJob)
+	e.ListJobs = m(e.ListJobs)
+	e.CancelJob = m(e.CancelJob)
 	e.CatalogError = m(e.CatalogError)
 }
 
@@ -47,7 +78,7 @@ func NewRefreshEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "config:refresh", "refresh:token"},
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
 			RequiredScopes: []string{"catalog:refresh"},
 		}
 		ctx, err = authJWTFn(ctx, p.Token, &sc)
@@ -71,7 +102,7 @@ func NewRefreshAllEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoi
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "config:refresh", "refresh:token"},
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
 			RequiredScopes: []string{"catalog:refresh"},
 		}
 		ctx, err = authJWTFn(ctx, p.Token, &sc)
@@ -82,6 +113,149 @@ func NewRefreshAllEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoi
 	}
 }
 
+// NewCreateEndpoint returns an endpoint function that calls the method
+// "Create" of service "catalog".
+func NewCreateEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreatePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{"catalog:manage"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.Create(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedJob(res, "default")
+		return vres, nil
+	}
+}
+
+// NewUpdateEndpoint returns an endpoint function that calls the method
+// "Update" of service "catalog".
+func NewUpdateEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdatePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{"catalog:manage"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.Update(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedJob(res, "default")
+		return vres, nil
+	}
+}
+
+// NewDeleteEndpoint returns an endpoint function that calls the method
+// "Delete" of service "catalog".
+func NewDeleteEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeletePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{"catalog:manage"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.Delete(ctx, p)
+	}
+}
+
+// NewWebhookEndpoint returns an endpoint function that calls the method
+// "Webhook" of service "catalog".
+func NewWebhookEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*WebhookRequestData)
+		return s.Webhook(ctx, ep.Payload, ep.Body)
+	}
+}
+
+// NewGetJobEndpoint returns an endpoint function that calls the method
+// "GetJob" of service "catalog".
+func NewGetJobEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetJobPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{"catalog:refresh"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.GetJob(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedJob(res, "default")
+		return vres, nil
+	}
+}
+
+// NewListJobsEndpoint returns an endpoint function that calls the method
+// "ListJobs" of service "catalog".
+func NewListJobsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListJobsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{"catalog:refresh"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListJobs(ctx, p)
+	}
+}
+
+// NewCancelJobEndpoint returns an endpoint function that calls the method
+// "CancelJob" of service "catalog".
+func NewCancelJobEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CancelJobPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{"catalog:refresh"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		res, err := s.CancelJob(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedJob(res, "default")
+		return vres, nil
+	}
+}
+
 // NewCatalogErrorEndpoint returns an endpoint function that calls the method
 // "CatalogError" of service "catalog".
 func NewCatalogErrorEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
@@ -90,7 +264,7 @@ func NewCatalogErrorEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endp
 		var err error
 		sc := security.JWTScheme{
 			Name:           "jwt",
-			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "config:refresh", "refresh:token"},
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
 			RequiredScopes: []string{"catalog:refresh"},
 		}
 		ctx, err = authJWTFn(ctx, p.Token, &sc)
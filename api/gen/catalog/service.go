@@ -9,6 +9,7 @@ package catalog
 
 import (
 	"context"
+	"io"
 
 	catalogviews "github.com/tektoncd/hub/api/gen/catalog/views"
 	goa "goa.design/goa/v3/pkg"
@@ -21,6 +22,21 @@ type Service interface {
 	Refresh(context.Context, *RefreshPayload) (res *Job, err error)
 	// Refresh all catalogs
 	RefreshAll(context.Context, *RefreshAllPayload) (res []*Job, err error)
+	// Add a catalog and refresh it
+	Create(context.Context, *CreatePayload) (res *Job, err error)
+	// Update the repository of a catalog by it's name and refresh it
+	Update(context.Context, *UpdatePayload) (res *Job, err error)
+	// Delete a catalog and its resources by it's name
+	Delete(context.Context, *DeletePayload) (err error)
+	// Refresh the catalogs of a repository on a push event sent by GitHub, GitLab
+	// or Bitbucket
+	Webhook(context.Context, *WebhookPayload, io.ReadCloser) (res []*Job, err error)
+	// Get a catalog refresh job by it's id
+	GetJob(context.Context, *GetJobPayload) (res *Job, err error)
+	// List the refresh jobs of a catalog, latest first
+	ListJobs(context.Context, *ListJobsPayload) (res []*Job, err error)
+	// Cancel a queued or running catalog refresh job
+	CancelJob(context.Context, *CancelJobPayload) (res *Job, err error)
 	// List all errors occurred refreshing a catalog
 	CatalogError(context.Context, *CatalogErrorPayload) (res *CatalogErrorResult, err error)
 }
@@ -45,7 +61,15 @@ const ServiceName = "catalog"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [3]string{"Refresh", "RefreshAll", "CatalogError"}
+var MethodNames = [10]string{"Refresh", "RefreshAll", "Create", "Update", "Delete", "Webhook", "GetJob", "ListJobs", "CancelJob", "CatalogError"}
+
+// CancelJobPayload is the payload type of the catalog service CancelJob method.
+type CancelJobPayload struct {
+	// JWT
+	Token string
+	// ID of the job
+	ID uint
+}
 
 // CatalogErrorPayload is the payload type of the catalog service CatalogError
 // method.
@@ -71,6 +95,55 @@ type CatalogErrors struct {
 	Errors []string
 }
 
+// CreatePayload is the payload type of the catalog service Create method.
+type CreatePayload struct {
+	// JWT
+	Token string
+	// Name of catalog
+	Name string
+	// Organization of catalog
+	Org string
+	// Type of catalog
+	Type string
+	// Git provider of the catalog repository
+	Provider string
+	// URL of the catalog repository
+	URL string
+	// SSH URL of the catalog repository if it is private
+	SSHURL *string
+	// Branch of the catalog repository
+	Revision string
+	// Path to the resources in the catalog repository
+	ContextDir *string
+	// Secret of the push webhook of the catalog repository
+	WebhookSecret *string
+	// Name of the credential of the api used to fetch the catalog repository if it
+	// is private
+	Credential *string
+	// Skip the verification of the TLS certificate of the catalog repository
+	InsecureSkipTLSVerify *bool
+	// Show the resources of the catalog only to the users having its scope
+	Private *bool
+	// Scope required to see the private catalog, catalog:private by default
+	Scope *string
+}
+
+// DeletePayload is the payload type of the catalog service Delete method.
+type DeletePayload struct {
+	// JWT
+	Token string
+	// Name of catalog
+	CatalogName string
+}
+
+// GetJobPayload is the payload type of the catalog service GetJob method.
+type GetJobPayload struct {
+	// JWT
+	Token string
+	// ID of the job
+	ID uint
+}
+
 // Job is the result type of the catalog service Refresh method.
 type Job struct {
 	// id of the job
@@ -79,11 +152,34 @@ type Job struct {
 	CatalogName string
 	// status of the job
 	Status string
+	// Time at which the job was queued
+	CreatedAt *string
+	// Time at which the job started to run
+	StartedAt *string
+	// Time at which the job finished
+	FinishedAt *string
+	// Commit of the catalog synced by the job
+	Sha *string
+	// Cause of the failure of the job
+	Reason *string
+}
+
+// ListJobsPayload is the payload type of the catalog service ListJobs method.
+type ListJobsPayload struct {
+	// JWT
+	Token string
+	// Name of catalog
+	CatalogName string
+	// Maximum number of jobs
+	Limit uint
 }
 
 // RefreshAllPayload is the payload type of the catalog service RefreshAll
 // method.
 type RefreshAllPayload struct {
+	// Parse all resources of the catalogs instead of the ones changed since the
+	// last refresh
+	Full bool
 	// JWT
 	Token string
 }
@@ -92,10 +188,60 @@ type RefreshAllPayload struct {
 type RefreshPayload struct {
 	// Name of catalog
 	CatalogName string
+	// Parse all resources of the catalog instead of the ones changed since the
+	// last refresh
+	Full bool
 	// JWT
 	Token string
 }
 
+// UpdatePayload is the payload type of the catalog service Update method.
+type UpdatePayload struct {
+	// JWT
+	Token string
+	// Name of catalog
+	CatalogName string
+	// Git provider of the catalog repository
+	Provider *string
+	// URL of the catalog repository
+	URL *string
+	// SSH URL of the catalog repository if it is private
+	SSHURL *string
+	// Branch of the catalog repository
+	Revision *string
+	// Path to the resources in the catalog repository
+	ContextDir *string
+	// Secret of the push webhook of the catalog repository
+	WebhookSecret *string
+	// Name of the credential of the api used to fetch the catalog repository if it
+	// is private
+	Credential *string
+	// Skip the verification of the TLS certificate of the catalog repository
+	InsecureSkipTLSVerify *bool
+	// Show the resources of the catalog only to the users having its scope
+	Private *bool
+	// Scope required to see the private catalog, catalog:private by default
+	Scope *string
+}
+
+// WebhookPayload is the payload type of the catalog service Webhook method.
+type WebhookPayload struct {
+	// Git provider sending the event
+	Provider string
+	// Type of GitHub event
+	GithubEvent *string
+	// HMAC SHA256 signature of the GitHub event
+	GithubSignature *string
+	// Type of GitLab event
+	GitlabEvent *string
+	// Secret token of the GitLab webhook
+	GitlabToken *string
+	// Type of Bitbucket event
+	BitbucketEvent *string
+	// HMAC SHA256 signature of the Bitbucket event
+	BitbucketSignature *string
+}
+
 // MakeInternalError builds a goa.ServiceError from an error.
 func MakeInternalError(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "internal-error", false, false, false)
@@ -106,6 +252,26 @@ func MakeNotFound(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "not-found", false, false, false)
 }
 
+// MakeInvalidPayload builds a goa.ServiceError from an error.
+func MakeInvalidPayload(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid-payload", false, false, false)
+}
+
+// MakeInvalidSignature builds a goa.ServiceError from an error.
+func MakeInvalidSignature(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid-signature", false, false, false)
+}
+
+// MakeAlreadyExists builds a goa.ServiceError from an error.
+func MakeAlreadyExists(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "already-exists", false, false, false)
+}
+
+// MakeInvalidState builds a goa.ServiceError from an error.
+func MakeInvalidState(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid-state", false, false, false)
+}
+
 // NewJob initializes result type Job from viewed result type Job.
 func NewJob(vres *catalogviews.Job) *Job {
 	return newJob(vres.Projected)
@@ -120,7 +286,13 @@ func NewViewedJob(res *Job, view string) *catalogviews.Job {
 
 // newJob converts projected type Job to service type Job.
 func newJob(vres *catalogviews.JobView) *Job {
-	res := &Job{}
+	res := &Job{
+		CreatedAt:  vres.CreatedAt,
+		StartedAt:  vres.StartedAt,
+		FinishedAt: vres.FinishedAt,
+		Sha:        vres.Sha,
+		Reason:     vres.Reason,
+	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
 	}
@@ -140,6 +312,11 @@ func newJobView(res *Job) *catalogviews.JobView {
 		ID:          &res.ID,
 		CatalogName: &res.CatalogName,
 		Status:      &res.Status,
+		CreatedAt:   res.CreatedAt,
+		StartedAt:   res.StartedAt,
+		FinishedAt:  res.FinishedAt,
+		Sha:         res.Sha,
+		Reason:      res.Reason,
 	}
 	return vres
 }
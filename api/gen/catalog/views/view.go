@@ -27,6 +27,16 @@ type JobView struct {
 	CatalogName *string
 	// status of the job
 	Status *string
+	// Time at which the job was queued
+	CreatedAt *string
+	// Time at which the job started to run
+	StartedAt *string
+	// Time at which the job finished
+	FinishedAt *string
+	// Commit of the catalog synced by the job
+	Sha *string
+	// Cause of the failure of the job
+	Reason *string
 }
 
 var (
@@ -36,6 +46,11 @@ var (
 			"id",
 			"catalogName",
 			"status",
+			"createdAt",
+			"startedAt",
+			"finishedAt",
+			"sha",
+			"reason",
 		},
 	}
 )
@@ -63,5 +78,14 @@ func ValidateJobView(result *JobView) (err error) {
 	if result.Status == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("status", "result"))
 	}
+	if result.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.createdAt", *result.CreatedAt, goa.FormatDateTime))
+	}
+	if result.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.startedAt", *result.StartedAt, goa.FormatDateTime))
+	}
+	if result.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.finishedAt", *result.FinishedAt, goa.FormatDateTime))
+	}
 	return
 }
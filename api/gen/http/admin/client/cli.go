@@ -10,6 +10,7 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	admin "github.com/tektoncd/hub/api/gen/admin"
 	goa "goa.design/goa/v3/pkg"
@@ -52,6 +53,61 @@ func BuildUpdateAgentPayload(adminUpdateAgentBody string, adminUpdateAgentToken
 	return v, nil
 }
 
+// BuildListAgentsPayload builds the payload for the admin ListAgents endpoint
+// from CLI flags.
+func BuildListAgentsPayload(adminListAgentsToken string) (*admin.ListAgentsPayload, error) {
+	var token string
+	{
+		token = adminListAgentsToken
+	}
+	v := &admin.ListAgentsPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRevokeAgentPayload builds the payload for the admin RevokeAgent
+// endpoint from CLI flags.
+func BuildRevokeAgentPayload(adminRevokeAgentName string, adminRevokeAgentToken string) (*admin.RevokeAgentPayload, error) {
+	var name string
+	{
+		name = adminRevokeAgentName
+	}
+	var token string
+	{
+		token = adminRevokeAgentToken
+	}
+	v := &admin.RevokeAgentPayload{}
+	v.Name = name
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRevokeUserTokenPayload builds the payload for the admin RevokeUserToken
+// endpoint from CLI flags.
+func BuildRevokeUserTokenPayload(adminRevokeUserTokenID string, adminRevokeUserTokenToken string) (*admin.RevokeUserTokenPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(adminRevokeUserTokenID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var token string
+	{
+		token = adminRevokeUserTokenToken
+	}
+	v := &admin.RevokeUserTokenPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
 // BuildRefreshConfigPayload builds the payload for the admin RefreshConfig
 // endpoint from CLI flags.
 func BuildRefreshConfigPayload(adminRefreshConfigToken string) (*admin.RefreshConfigPayload, error) {
@@ -64,3 +120,71 @@ func BuildRefreshConfigPayload(adminRefreshConfigToken string) (*admin.RefreshCo
 
 	return v, nil
 }
+
+// BuildAuditPayload builds the payload for the admin Audit endpoint from CLI
+// flags.
+func BuildAuditPayload(adminAuditActor string, adminAuditAction string, adminAuditSince string, adminAuditUntil string, adminAuditLimit string, adminAuditToken string) (*admin.AuditPayload, error) {
+	var err error
+	var actor *uint
+	{
+		if adminAuditActor != "" {
+			var v uint64
+			v, err = strconv.ParseUint(adminAuditActor, 10, strconv.IntSize)
+			val := uint(v)
+			actor = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for actor, must be UINT")
+			}
+		}
+	}
+	var action *string
+	{
+		if adminAuditAction != "" {
+			action = &adminAuditAction
+		}
+	}
+	var since *string
+	{
+		if adminAuditSince != "" {
+			since = &adminAuditSince
+			err = goa.MergeErrors(err, goa.ValidateFormat("since", *since, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var until *string
+	{
+		if adminAuditUntil != "" {
+			until = &adminAuditUntil
+			err = goa.MergeErrors(err, goa.ValidateFormat("until", *until, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit uint
+	{
+		if adminAuditLimit != "" {
+			var v uint64
+			v, err = strconv.ParseUint(adminAuditLimit, 10, strconv.IntSize)
+			limit = uint(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be UINT")
+			}
+		}
+	}
+	var token string
+	{
+		token = adminAuditToken
+	}
+	v := &admin.AuditPayload{}
+	v.Actor = actor
+	v.Action = action
+	v.Since = since
+	v.Until = until
+	v.Limit = limit
+	v.Token = token
+
+	return v, nil
+}
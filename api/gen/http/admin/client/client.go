@@ -21,10 +21,25 @@ type Client struct {
 	// endpoint.
 	UpdateAgentDoer goahttp.Doer
 
+	// ListAgents Doer is the HTTP client used to make requests to the ListAgents
+	// endpoint.
+	ListAgentsDoer goahttp.Doer
+
+	// RevokeAgent Doer is the HTTP client used to make requests to the RevokeAgent
+	// endpoint.
+	RevokeAgentDoer goahttp.Doer
+
+	// RevokeUserToken Doer is the HTTP client used to make requests to the
+	// RevokeUserToken endpoint.
+	RevokeUserTokenDoer goahttp.Doer
+
 	// RefreshConfig Doer is the HTTP client used to make requests to the
 	// RefreshConfig endpoint.
 	RefreshConfigDoer goahttp.Doer
 
+	// Audit Doer is the HTTP client used to make requests to the Audit endpoint.
+	AuditDoer goahttp.Doer
+
 	// CORS Doer is the HTTP client used to make requests to the  endpoint.
 	CORSDoer goahttp.Doer
 
@@ -49,7 +64,11 @@ func NewClient(
 ) *Client {
 	return &Client{
 		UpdateAgentDoer:     doer,
+		ListAgentsDoer:      doer,
+		RevokeAgentDoer:     doer,
+		RevokeUserTokenDoer: doer,
 		RefreshConfigDoer:   doer,
+		AuditDoer:           doer,
 		CORSDoer:            doer,
 		RestoreResponseBody: restoreBody,
 		scheme:              scheme,
@@ -83,6 +102,78 @@ func (c *Client) UpdateAgent() goa.Endpoint {
 	}
 }
 
+// ListAgents returns an endpoint that makes HTTP requests to the admin service
+// ListAgents server.
+func (c *Client) ListAgents() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListAgentsRequest(c.encoder)
+		decodeResponse = DecodeListAgentsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListAgentsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListAgentsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "ListAgents", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RevokeAgent returns an endpoint that makes HTTP requests to the admin
+// service RevokeAgent server.
+func (c *Client) RevokeAgent() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRevokeAgentRequest(c.encoder)
+		decodeResponse = DecodeRevokeAgentResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRevokeAgentRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RevokeAgentDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "RevokeAgent", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RevokeUserToken returns an endpoint that makes HTTP requests to the admin
+// service RevokeUserToken server.
+func (c *Client) RevokeUserToken() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRevokeUserTokenRequest(c.encoder)
+		decodeResponse = DecodeRevokeUserTokenResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRevokeUserTokenRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RevokeUserTokenDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "RevokeUserToken", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // RefreshConfig returns an endpoint that makes HTTP requests to the admin
 // service RefreshConfig server.
 func (c *Client) RefreshConfig() goa.Endpoint {
@@ -106,3 +197,27 @@ func (c *Client) RefreshConfig() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// Audit returns an endpoint that makes HTTP requests to the admin service
+// Audit server.
+func (c *Client) Audit() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAuditRequest(c.encoder)
+		decodeResponse = DecodeAuditResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAuditRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AuditDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("admin", "Audit", err)
+		}
+		return decodeResponse(resp)
+	}
+}
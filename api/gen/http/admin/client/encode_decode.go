@@ -10,6 +10,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -17,6 +18,7 @@ import (
 
 	admin "github.com/tektoncd/hub/api/gen/admin"
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildUpdateAgentRequest instantiates a HTTP request object with method and
@@ -160,6 +162,402 @@ func DecodeUpdateAgentResponse(decoder func(*http.Response) goahttp.Decoder, res
 	}
 }
 
+// BuildListAgentsRequest instantiates a HTTP request object with method and
+// path set to call the "admin" service "ListAgents" endpoint
+func (c *Client) BuildListAgentsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListAgentsAdminPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "ListAgents", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListAgentsRequest returns an encoder for requests sent to the admin
+// ListAgents server.
+func EncodeListAgentsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.ListAgentsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "ListAgents", "*admin.ListAgentsPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeListAgentsResponse returns a decoder for responses returned by the
+// admin ListAgents endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeListAgentsResponse may return the following errors:
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "invalid-scopes" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListAgentsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListAgentsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "ListAgents", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateAgentResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "ListAgents", err)
+			}
+			res := NewListAgentsAgentOK(body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ListAgentsInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "ListAgents", err)
+			}
+			err = ValidateListAgentsInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "ListAgents", err)
+			}
+			return nil, NewListAgentsInvalidToken(&body)
+		case http.StatusForbidden:
+			var (
+				body ListAgentsInvalidScopesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "ListAgents", err)
+			}
+			err = ValidateListAgentsInvalidScopesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "ListAgents", err)
+			}
+			return nil, NewListAgentsInvalidScopes(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListAgentsInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "ListAgents", err)
+			}
+			err = ValidateListAgentsInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "ListAgents", err)
+			}
+			return nil, NewListAgentsInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "ListAgents", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRevokeAgentRequest instantiates a HTTP request object with method and
+// path set to call the "admin" service "RevokeAgent" endpoint
+func (c *Client) BuildRevokeAgentRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		name string
+	)
+	{
+		p, ok := v.(*admin.RevokeAgentPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("admin", "RevokeAgent", "*admin.RevokeAgentPayload", v)
+		}
+		name = p.Name
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RevokeAgentAdminPath(name)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "RevokeAgent", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRevokeAgentRequest returns an encoder for requests sent to the admin
+// RevokeAgent server.
+func EncodeRevokeAgentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.RevokeAgentPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "RevokeAgent", "*admin.RevokeAgentPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeRevokeAgentResponse returns a decoder for responses returned by the
+// admin RevokeAgent endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeRevokeAgentResponse may return the following errors:
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "invalid-scopes" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeRevokeAgentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body RevokeAgentNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "RevokeAgent", err)
+			}
+			err = ValidateRevokeAgentNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "RevokeAgent", err)
+			}
+			return nil, NewRevokeAgentNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body RevokeAgentInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "RevokeAgent", err)
+			}
+			err = ValidateRevokeAgentInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "RevokeAgent", err)
+			}
+			return nil, NewRevokeAgentInvalidToken(&body)
+		case http.StatusForbidden:
+			var (
+				body RevokeAgentInvalidScopesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "RevokeAgent", err)
+			}
+			err = ValidateRevokeAgentInvalidScopesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "RevokeAgent", err)
+			}
+			return nil, NewRevokeAgentInvalidScopes(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RevokeAgentInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "RevokeAgent", err)
+			}
+			err = ValidateRevokeAgentInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "RevokeAgent", err)
+			}
+			return nil, NewRevokeAgentInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "RevokeAgent", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRevokeUserTokenRequest instantiates a HTTP request object with method
+// and path set to call the "admin" service "RevokeUserToken" endpoint
+func (c *Client) BuildRevokeUserTokenRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*admin.RevokeUserTokenPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("admin", "RevokeUserToken", "*admin.RevokeUserTokenPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RevokeUserTokenAdminPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "RevokeUserToken", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRevokeUserTokenRequest returns an encoder for requests sent to the
+// admin RevokeUserToken server.
+func EncodeRevokeUserTokenRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.RevokeUserTokenPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "RevokeUserToken", "*admin.RevokeUserTokenPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeRevokeUserTokenResponse returns a decoder for responses returned by
+// the admin RevokeUserToken endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeRevokeUserTokenResponse may return the following errors:
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "invalid-scopes" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeRevokeUserTokenResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body RevokeUserTokenNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "RevokeUserToken", err)
+			}
+			err = ValidateRevokeUserTokenNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "RevokeUserToken", err)
+			}
+			return nil, NewRevokeUserTokenNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body RevokeUserTokenInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "RevokeUserToken", err)
+			}
+			err = ValidateRevokeUserTokenInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "RevokeUserToken", err)
+			}
+			return nil, NewRevokeUserTokenInvalidToken(&body)
+		case http.StatusForbidden:
+			var (
+				body RevokeUserTokenInvalidScopesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "RevokeUserToken", err)
+			}
+			err = ValidateRevokeUserTokenInvalidScopesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "RevokeUserToken", err)
+			}
+			return nil, NewRevokeUserTokenInvalidScopes(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RevokeUserTokenInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "RevokeUserToken", err)
+			}
+			err = ValidateRevokeUserTokenInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "RevokeUserToken", err)
+			}
+			return nil, NewRevokeUserTokenInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "RevokeUserToken", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildRefreshConfigRequest instantiates a HTTP request object with method and
 // path set to call the "admin" service "RefreshConfig" endpoint
 func (c *Client) BuildRefreshConfigRequest(ctx context.Context, v any) (*http.Request, error) {
@@ -281,3 +679,192 @@ func DecodeRefreshConfigResponse(decoder func(*http.Response) goahttp.Decoder, r
 		}
 	}
 }
+
+// BuildAuditRequest instantiates a HTTP request object with method and path
+// set to call the "admin" service "Audit" endpoint
+func (c *Client) BuildAuditRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AuditAdminPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("admin", "Audit", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAuditRequest returns an encoder for requests sent to the admin Audit
+// server.
+func EncodeAuditRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*admin.AuditPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("admin", "Audit", "*admin.AuditPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Actor != nil {
+			values.Add("actor", fmt.Sprintf("%v", *p.Actor))
+		}
+		if p.Action != nil {
+			values.Add("action", *p.Action)
+		}
+		if p.Since != nil {
+			values.Add("since", *p.Since)
+		}
+		if p.Until != nil {
+			values.Add("until", *p.Until)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeAuditResponse returns a decoder for responses returned by the admin
+// Audit endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeAuditResponse may return the following errors:
+//   - "invalid-payload" (type *goa.ServiceError): http.StatusBadRequest
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "invalid-scopes" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeAuditResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body AuditResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "Audit", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateAuditEventResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "Audit", err)
+			}
+			res := NewAuditEvent2OK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body AuditInvalidPayloadResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "Audit", err)
+			}
+			err = ValidateAuditInvalidPayloadResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "Audit", err)
+			}
+			return nil, NewAuditInvalidPayload(&body)
+		case http.StatusUnauthorized:
+			var (
+				body AuditInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "Audit", err)
+			}
+			err = ValidateAuditInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "Audit", err)
+			}
+			return nil, NewAuditInvalidToken(&body)
+		case http.StatusForbidden:
+			var (
+				body AuditInvalidScopesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "Audit", err)
+			}
+			err = ValidateAuditInvalidScopesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "Audit", err)
+			}
+			return nil, NewAuditInvalidScopes(&body)
+		case http.StatusInternalServerError:
+			var (
+				body AuditInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("admin", "Audit", err)
+			}
+			err = ValidateAuditInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("admin", "Audit", err)
+			}
+			return nil, NewAuditInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("admin", "Audit", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalAgentResponseToAdminAgent builds a value of type *admin.Agent from
+// a value of type *AgentResponse.
+func unmarshalAgentResponseToAdminAgent(v *AgentResponse) *admin.Agent {
+	res := &admin.Agent{
+		ID:      *v.ID,
+		Name:    *v.Name,
+		TokenID: v.TokenID,
+	}
+	res.Scopes = make([]string, len(v.Scopes))
+	for i, val := range v.Scopes {
+		res.Scopes[i] = val
+	}
+
+	return res
+}
+
+// unmarshalAuditEventResponseToAdminAuditEvent builds a value of type
+// *admin.AuditEvent from a value of type *AuditEventResponse.
+func unmarshalAuditEventResponseToAdminAuditEvent(v *AuditEventResponse) *admin.AuditEvent {
+	res := &admin.AuditEvent{
+		ID:        *v.ID,
+		ActorID:   *v.ActorID,
+		Action:    *v.Action,
+		Target:    *v.Target,
+		Digest:    *v.Digest,
+		CreatedAt: *v.CreatedAt,
+	}
+
+	return res
+}
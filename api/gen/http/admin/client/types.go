@@ -28,6 +28,10 @@ type UpdateAgentResponseBody struct {
 	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
 }
 
+// ListAgentsResponseBody is the type of the "admin" service "ListAgents"
+// endpoint HTTP response body.
+type ListAgentsResponseBody []*AgentResponse
+
 // RefreshConfigResponseBody is the type of the "admin" service "RefreshConfig"
 // endpoint HTTP response body.
 type RefreshConfigResponseBody struct {
@@ -35,6 +39,10 @@ type RefreshConfigResponseBody struct {
 	Checksum *string `form:"checksum,omitempty" json:"checksum,omitempty" xml:"checksum,omitempty"`
 }
 
+// AuditResponseBody is the type of the "admin" service "Audit" endpoint HTTP
+// response body.
+type AuditResponseBody []*AuditEventResponse
+
 // UpdateAgentInvalidPayloadResponseBody is the type of the "admin" service
 // "UpdateAgent" endpoint HTTP response body for the "invalid-payload" error.
 type UpdateAgentInvalidPayloadResponseBody struct {
@@ -107,6 +115,204 @@ type UpdateAgentInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// ListAgentsInvalidTokenResponseBody is the type of the "admin" service
+// "ListAgents" endpoint HTTP response body for the "invalid-token" error.
+type ListAgentsInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListAgentsInvalidScopesResponseBody is the type of the "admin" service
+// "ListAgents" endpoint HTTP response body for the "invalid-scopes" error.
+type ListAgentsInvalidScopesResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListAgentsInternalErrorResponseBody is the type of the "admin" service
+// "ListAgents" endpoint HTTP response body for the "internal-error" error.
+type ListAgentsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevokeAgentNotFoundResponseBody is the type of the "admin" service
+// "RevokeAgent" endpoint HTTP response body for the "not-found" error.
+type RevokeAgentNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevokeAgentInvalidTokenResponseBody is the type of the "admin" service
+// "RevokeAgent" endpoint HTTP response body for the "invalid-token" error.
+type RevokeAgentInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevokeAgentInvalidScopesResponseBody is the type of the "admin" service
+// "RevokeAgent" endpoint HTTP response body for the "invalid-scopes" error.
+type RevokeAgentInvalidScopesResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevokeAgentInternalErrorResponseBody is the type of the "admin" service
+// "RevokeAgent" endpoint HTTP response body for the "internal-error" error.
+type RevokeAgentInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevokeUserTokenNotFoundResponseBody is the type of the "admin" service
+// "RevokeUserToken" endpoint HTTP response body for the "not-found" error.
+type RevokeUserTokenNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevokeUserTokenInvalidTokenResponseBody is the type of the "admin" service
+// "RevokeUserToken" endpoint HTTP response body for the "invalid-token" error.
+type RevokeUserTokenInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevokeUserTokenInvalidScopesResponseBody is the type of the "admin" service
+// "RevokeUserToken" endpoint HTTP response body for the "invalid-scopes" error.
+type RevokeUserTokenInvalidScopesResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevokeUserTokenInternalErrorResponseBody is the type of the "admin" service
+// "RevokeUserToken" endpoint HTTP response body for the "internal-error" error.
+type RevokeUserTokenInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // RefreshConfigInvalidTokenResponseBody is the type of the "admin" service
 // "RefreshConfig" endpoint HTTP response body for the "invalid-token" error.
 type RefreshConfigInvalidTokenResponseBody struct {
@@ -161,6 +367,106 @@ type RefreshConfigInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// AuditInvalidPayloadResponseBody is the type of the "admin" service "Audit"
+// endpoint HTTP response body for the "invalid-payload" error.
+type AuditInvalidPayloadResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AuditInvalidTokenResponseBody is the type of the "admin" service "Audit"
+// endpoint HTTP response body for the "invalid-token" error.
+type AuditInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AuditInvalidScopesResponseBody is the type of the "admin" service "Audit"
+// endpoint HTTP response body for the "invalid-scopes" error.
+type AuditInvalidScopesResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AuditInternalErrorResponseBody is the type of the "admin" service "Audit"
+// endpoint HTTP response body for the "internal-error" error.
+type AuditInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// AgentResponse is used to define fields on response body types.
+type AgentResponse struct {
+	// id of the agent
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the agent
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Scopes of the agent
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+	// ID (jti) of the valid token of the agent, absent once the token is revoked
+	TokenID *string `form:"tokenId,omitempty" json:"tokenId,omitempty" xml:"tokenId,omitempty"`
+}
+
+// AuditEventResponse is used to define fields on response body types.
+type AuditEventResponse struct {
+	// id of the event
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// id of the user or agent who did the operation
+	ActorID *uint `form:"actorId,omitempty" json:"actorId,omitempty" xml:"actorId,omitempty"`
+	// Operation done by the actor
+	Action *string `form:"action,omitempty" json:"action,omitempty" xml:"action,omitempty"`
+	// Object of the operation
+	Target *string `form:"target,omitempty" json:"target,omitempty" xml:"target,omitempty"`
+	// SHA256 digest of the payload of the operation
+	Digest *string `form:"digest,omitempty" json:"digest,omitempty" xml:"digest,omitempty"`
+	// Time at which the operation was done
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+}
+
 // NewUpdateAgentRequestBody builds the HTTP request body from the payload of
 // the "UpdateAgent" endpoint of the "admin" service.
 func NewUpdateAgentRequestBody(p *admin.UpdateAgentPayload) *UpdateAgentRequestBody {
@@ -248,19 +554,20 @@ func NewUpdateAgentInternalError(body *UpdateAgentInternalErrorResponseBody) *go
 	return v
 }
 
-// NewRefreshConfigResultOK builds a "admin" service "RefreshConfig" endpoint
-// result from a HTTP "OK" response.
-func NewRefreshConfigResultOK(body *RefreshConfigResponseBody) *admin.RefreshConfigResult {
-	v := &admin.RefreshConfigResult{
-		Checksum: *body.Checksum,
+// NewListAgentsAgentOK builds a "admin" service "ListAgents" endpoint result
+// from a HTTP "OK" response.
+func NewListAgentsAgentOK(body []*AgentResponse) []*admin.Agent {
+	v := make([]*admin.Agent, len(body))
+	for i, val := range body {
+		v[i] = unmarshalAgentResponseToAdminAgent(val)
 	}
 
 	return v
 }
 
-// NewRefreshConfigInvalidToken builds a admin service RefreshConfig endpoint
+// NewListAgentsInvalidToken builds a admin service ListAgents endpoint
 // invalid-token error.
-func NewRefreshConfigInvalidToken(body *RefreshConfigInvalidTokenResponseBody) *goa.ServiceError {
+func NewListAgentsInvalidToken(body *ListAgentsInvalidTokenResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -273,9 +580,9 @@ func NewRefreshConfigInvalidToken(body *RefreshConfigInvalidTokenResponseBody) *
 	return v
 }
 
-// NewRefreshConfigInvalidScopes builds a admin service RefreshConfig endpoint
+// NewListAgentsInvalidScopes builds a admin service ListAgents endpoint
 // invalid-scopes error.
-func NewRefreshConfigInvalidScopes(body *RefreshConfigInvalidScopesResponseBody) *goa.ServiceError {
+func NewListAgentsInvalidScopes(body *ListAgentsInvalidScopesResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -288,9 +595,9 @@ func NewRefreshConfigInvalidScopes(body *RefreshConfigInvalidScopesResponseBody)
 	return v
 }
 
-// NewRefreshConfigInternalError builds a admin service RefreshConfig endpoint
+// NewListAgentsInternalError builds a admin service ListAgents endpoint
 // internal-error error.
-func NewRefreshConfigInternalError(body *RefreshConfigInternalErrorResponseBody) *goa.ServiceError {
+func NewListAgentsInternalError(body *ListAgentsInternalErrorResponseBody) *goa.ServiceError {
 	v := &goa.ServiceError{
 		Name:      *body.Name,
 		ID:        *body.ID,
@@ -303,11 +610,257 @@ func NewRefreshConfigInternalError(body *RefreshConfigInternalErrorResponseBody)
 	return v
 }
 
-// ValidateUpdateAgentResponseBody runs the validations defined on
-// UpdateAgentResponseBody
-func ValidateUpdateAgentResponseBody(body *UpdateAgentResponseBody) (err error) {
-	if body.Token == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("token", "body"))
+// NewRevokeAgentNotFound builds a admin service RevokeAgent endpoint not-found
+// error.
+func NewRevokeAgentNotFound(body *RevokeAgentNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRevokeAgentInvalidToken builds a admin service RevokeAgent endpoint
+// invalid-token error.
+func NewRevokeAgentInvalidToken(body *RevokeAgentInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRevokeAgentInvalidScopes builds a admin service RevokeAgent endpoint
+// invalid-scopes error.
+func NewRevokeAgentInvalidScopes(body *RevokeAgentInvalidScopesResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRevokeAgentInternalError builds a admin service RevokeAgent endpoint
+// internal-error error.
+func NewRevokeAgentInternalError(body *RevokeAgentInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRevokeUserTokenNotFound builds a admin service RevokeUserToken endpoint
+// not-found error.
+func NewRevokeUserTokenNotFound(body *RevokeUserTokenNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRevokeUserTokenInvalidToken builds a admin service RevokeUserToken
+// endpoint invalid-token error.
+func NewRevokeUserTokenInvalidToken(body *RevokeUserTokenInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRevokeUserTokenInvalidScopes builds a admin service RevokeUserToken
+// endpoint invalid-scopes error.
+func NewRevokeUserTokenInvalidScopes(body *RevokeUserTokenInvalidScopesResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRevokeUserTokenInternalError builds a admin service RevokeUserToken
+// endpoint internal-error error.
+func NewRevokeUserTokenInternalError(body *RevokeUserTokenInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRefreshConfigResultOK builds a "admin" service "RefreshConfig" endpoint
+// result from a HTTP "OK" response.
+func NewRefreshConfigResultOK(body *RefreshConfigResponseBody) *admin.RefreshConfigResult {
+	v := &admin.RefreshConfigResult{
+		Checksum: *body.Checksum,
+	}
+
+	return v
+}
+
+// NewRefreshConfigInvalidToken builds a admin service RefreshConfig endpoint
+// invalid-token error.
+func NewRefreshConfigInvalidToken(body *RefreshConfigInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRefreshConfigInvalidScopes builds a admin service RefreshConfig endpoint
+// invalid-scopes error.
+func NewRefreshConfigInvalidScopes(body *RefreshConfigInvalidScopesResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRefreshConfigInternalError builds a admin service RefreshConfig endpoint
+// internal-error error.
+func NewRefreshConfigInternalError(body *RefreshConfigInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewAuditEvent2OK builds a "admin" service "Audit" endpoint result from a
+// HTTP "OK" response.
+func NewAuditEvent2OK(body []*AuditEventResponse) []*admin.AuditEvent {
+	v := make([]*admin.AuditEvent, len(body))
+	for i, val := range body {
+		v[i] = unmarshalAuditEventResponseToAdminAuditEvent(val)
+	}
+
+	return v
+}
+
+// NewAuditInvalidPayload builds a admin service Audit endpoint invalid-payload
+// error.
+func NewAuditInvalidPayload(body *AuditInvalidPayloadResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewAuditInvalidToken builds a admin service Audit endpoint invalid-token
+// error.
+func NewAuditInvalidToken(body *AuditInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewAuditInvalidScopes builds a admin service Audit endpoint invalid-scopes
+// error.
+func NewAuditInvalidScopes(body *AuditInvalidScopesResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewAuditInternalError builds a admin service Audit endpoint internal-error
+// error.
+func NewAuditInternalError(body *AuditInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// ValidateUpdateAgentResponseBody runs the validations defined on
+// UpdateAgentResponseBody
+func ValidateUpdateAgentResponseBody(body *UpdateAgentResponseBody) (err error) {
+	if body.Token == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token", "body"))
 	}
 	return
 }
@@ -417,9 +970,9 @@ func ValidateUpdateAgentInternalErrorResponseBody(body *UpdateAgentInternalError
 	return
 }
 
-// ValidateRefreshConfigInvalidTokenResponseBody runs the validations defined
-// on RefreshConfig_invalid-token_Response_Body
-func ValidateRefreshConfigInvalidTokenResponseBody(body *RefreshConfigInvalidTokenResponseBody) (err error) {
+// ValidateListAgentsInvalidTokenResponseBody runs the validations defined on
+// ListAgents_invalid-token_Response_Body
+func ValidateListAgentsInvalidTokenResponseBody(body *ListAgentsInvalidTokenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -441,9 +994,9 @@ func ValidateRefreshConfigInvalidTokenResponseBody(body *RefreshConfigInvalidTok
 	return
 }
 
-// ValidateRefreshConfigInvalidScopesResponseBody runs the validations defined
-// on RefreshConfig_invalid-scopes_Response_Body
-func ValidateRefreshConfigInvalidScopesResponseBody(body *RefreshConfigInvalidScopesResponseBody) (err error) {
+// ValidateListAgentsInvalidScopesResponseBody runs the validations defined on
+// ListAgents_invalid-scopes_Response_Body
+func ValidateListAgentsInvalidScopesResponseBody(body *ListAgentsInvalidScopesResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -465,9 +1018,57 @@ func ValidateRefreshConfigInvalidScopesResponseBody(body *RefreshConfigInvalidSc
 	return
 }
 
-// ValidateRefreshConfigInternalErrorResponseBody runs the validations defined
-// on RefreshConfig_internal-error_Response_Body
-func ValidateRefreshConfigInternalErrorResponseBody(body *RefreshConfigInternalErrorResponseBody) (err error) {
+// ValidateListAgentsInternalErrorResponseBody runs the validations defined on
+// ListAgents_internal-error_Response_Body
+func ValidateListAgentsInternalErrorResponseBody(body *ListAgentsInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRevokeAgentNotFoundResponseBody runs the validations defined on
+// RevokeAgent_not-found_Response_Body
+func ValidateRevokeAgentNotFoundResponseBody(body *RevokeAgentNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRevokeAgentInvalidTokenResponseBody runs the validations defined on
+// RevokeAgent_invalid-token_Response_Body
+func ValidateRevokeAgentInvalidTokenResponseBody(body *RevokeAgentInvalidTokenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -488,3 +1089,355 @@ func ValidateRefreshConfigInternalErrorResponseBody(body *RefreshConfigInternalE
 	}
 	return
 }
+
+// ValidateRevokeAgentInvalidScopesResponseBody runs the validations defined on
+// RevokeAgent_invalid-scopes_Response_Body
+func ValidateRevokeAgentInvalidScopesResponseBody(body *RevokeAgentInvalidScopesResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRevokeAgentInternalErrorResponseBody runs the validations defined on
+// RevokeAgent_internal-error_Response_Body
+func ValidateRevokeAgentInternalErrorResponseBody(body *RevokeAgentInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRevokeUserTokenNotFoundResponseBody runs the validations defined on
+// RevokeUserToken_not-found_Response_Body
+func ValidateRevokeUserTokenNotFoundResponseBody(body *RevokeUserTokenNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRevokeUserTokenInvalidTokenResponseBody runs the validations defined
+// on RevokeUserToken_invalid-token_Response_Body
+func ValidateRevokeUserTokenInvalidTokenResponseBody(body *RevokeUserTokenInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRevokeUserTokenInvalidScopesResponseBody runs the validations
+// defined on RevokeUserToken_invalid-scopes_Response_Body
+func ValidateRevokeUserTokenInvalidScopesResponseBody(body *RevokeUserTokenInvalidScopesResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRevokeUserTokenInternalErrorResponseBody runs the validations
+// defined on RevokeUserToken_internal-error_Response_Body
+func ValidateRevokeUserTokenInternalErrorResponseBody(body *RevokeUserTokenInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRefreshConfigInvalidTokenResponseBody runs the validations defined
+// on RefreshConfig_invalid-token_Response_Body
+func ValidateRefreshConfigInvalidTokenResponseBody(body *RefreshConfigInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRefreshConfigInvalidScopesResponseBody runs the validations defined
+// on RefreshConfig_invalid-scopes_Response_Body
+func ValidateRefreshConfigInvalidScopesResponseBody(body *RefreshConfigInvalidScopesResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRefreshConfigInternalErrorResponseBody runs the validations defined
+// on RefreshConfig_internal-error_Response_Body
+func ValidateRefreshConfigInternalErrorResponseBody(body *RefreshConfigInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateAuditInvalidPayloadResponseBody runs the validations defined on
+// Audit_invalid-payload_Response_Body
+func ValidateAuditInvalidPayloadResponseBody(body *AuditInvalidPayloadResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateAuditInvalidTokenResponseBody runs the validations defined on
+// Audit_invalid-token_Response_Body
+func ValidateAuditInvalidTokenResponseBody(body *AuditInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateAuditInvalidScopesResponseBody runs the validations defined on
+// Audit_invalid-scopes_Response_Body
+func ValidateAuditInvalidScopesResponseBody(body *AuditInvalidScopesResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateAuditInternalErrorResponseBody runs the validations defined on
+// Audit_internal-error_Response_Body
+func ValidateAuditInternalErrorResponseBody(body *AuditInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateAgentResponse runs the validations defined on AgentResponse
+func ValidateAgentResponse(body *AgentResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Scopes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scopes", "body"))
+	}
+	return
+}
+
+// ValidateAuditEventResponse runs the validations defined on AuditEventResponse
+func ValidateAuditEventResponse(body *AuditEventResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ActorID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actorId", "body"))
+	}
+	if body.Action == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("action", "body"))
+	}
+	if body.Target == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("target", "body"))
+	}
+	if body.Digest == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("digest", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
@@ -7,12 +7,36 @@
 
 package server
 
+import (
+	"fmt"
+)
+
 // UpdateAgentAdminPath returns the URL path to the admin service UpdateAgent HTTP endpoint.
 func UpdateAgentAdminPath() string {
 	return "/system/user/agent"
 }
 
+// ListAgentsAdminPath returns the URL path to the admin service ListAgents HTTP endpoint.
+func ListAgentsAdminPath() string {
+	return "/system/user/agents"
+}
+
+// RevokeAgentAdminPath returns the URL path to the admin service RevokeAgent HTTP endpoint.
+func RevokeAgentAdminPath(name string) string {
+	return fmt.Sprintf("/system/user/agent/%v", name)
+}
+
+// RevokeUserTokenAdminPath returns the URL path to the admin service RevokeUserToken HTTP endpoint.
+func RevokeUserTokenAdminPath(id uint) string {
+	return fmt.Sprintf("/system/user/%v/refresh-token", id)
+}
+
 // RefreshConfigAdminPath returns the URL path to the admin service RefreshConfig HTTP endpoint.
 func RefreshConfigAdminPath() string {
 	return "/system/config/refresh"
 }
+
+// AuditAdminPath returns the URL path to the admin service Audit HTTP endpoint.
+func AuditAdminPath() string {
+	return "/system/audit"
+}
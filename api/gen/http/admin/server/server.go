@@ -19,10 +19,14 @@ import (
 
 // Server lists the admin service endpoint HTTP handlers.
 type Server struct {
-	Mounts        []*MountPoint
-	UpdateAgent   http.Handler
-	RefreshConfig http.Handler
-	CORS          http.Handler
+	Mounts          []*MountPoint
+	UpdateAgent     http.Handler
+	ListAgents      http.Handler
+	RevokeAgent     http.Handler
+	RevokeUserToken http.Handler
+	RefreshConfig   http.Handler
+	Audit           http.Handler
+	CORS            http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -53,13 +57,25 @@ func New(
 	return &Server{
 		Mounts: []*MountPoint{
 			{"UpdateAgent", "PUT", "/system/user/agent"},
+			{"ListAgents", "GET", "/system/user/agents"},
+			{"RevokeAgent", "DELETE", "/system/user/agent/{name}"},
+			{"RevokeUserToken", "DELETE", "/system/user/{id}/refresh-token"},
 			{"RefreshConfig", "POST", "/system/config/refresh"},
+			{"Audit", "GET", "/system/audit"},
 			{"CORS", "OPTIONS", "/system/user/agent"},
+			{"CORS", "OPTIONS", "/system/user/agents"},
+			{"CORS", "OPTIONS", "/system/user/agent/{name}"},
+			{"CORS", "OPTIONS", "/system/user/{id}/refresh-token"},
 			{"CORS", "OPTIONS", "/system/config/refresh"},
+			{"CORS", "OPTIONS", "/system/audit"},
 		},
-		UpdateAgent:   NewUpdateAgentHandler(e.UpdateAgent, mux, decoder, encoder, errhandler, formatter),
-		RefreshConfig: NewRefreshConfigHandler(e.RefreshConfig, mux, decoder, encoder, errhandler, formatter),
-		CORS:          NewCORSHandler(),
+		UpdateAgent:     NewUpdateAgentHandler(e.UpdateAgent, mux, decoder, encoder, errhandler, formatter),
+		ListAgents:      NewListAgentsHandler(e.ListAgents, mux, decoder, encoder, errhandler, formatter),
+		RevokeAgent:     NewRevokeAgentHandler(e.RevokeAgent, mux, decoder, encoder, errhandler, formatter),
+		RevokeUserToken: NewRevokeUserTokenHandler(e.RevokeUserToken, mux, decoder, encoder, errhandler, formatter),
+		RefreshConfig:   NewRefreshConfigHandler(e.RefreshConfig, mux, decoder, encoder, errhandler, formatter),
+		Audit:           NewAuditHandler(e.Audit, mux, decoder, encoder, errhandler, formatter),
+		CORS:            NewCORSHandler(),
 	}
 }
 
@@ -69,7 +85,11 @@ func (s *Server) Service() string { return "admin" }
 // Use wraps the server handlers with the given middleware.
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.UpdateAgent = m(s.UpdateAgent)
+	s.ListAgents = m(s.ListAgents)
+	s.RevokeAgent = m(s.RevokeAgent)
+	s.RevokeUserToken = m(s.RevokeUserToken)
 	s.RefreshConfig = m(s.RefreshConfig)
+	s.Audit = m(s.Audit)
 	s.CORS = m(s.CORS)
 }
 
@@ -79,7 +99,11 @@ func (s *Server) MethodNames() []string { return admin.MethodNames[:] }
 // Mount configures the mux to serve the admin endpoints.
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountUpdateAgentHandler(mux, h.UpdateAgent)
+	MountListAgentsHandler(mux, h.ListAgents)
+	MountRevokeAgentHandler(mux, h.RevokeAgent)
+	MountRevokeUserTokenHandler(mux, h.RevokeUserToken)
 	MountRefreshConfigHandler(mux, h.RefreshConfig)
+	MountAuditHandler(mux, h.Audit)
 	MountCORSHandler(mux, h.CORS)
 }
 
@@ -139,6 +163,159 @@ func NewUpdateAgentHandler(
 	})
 }
 
+// MountListAgentsHandler configures the mux to serve the "admin" service
+// "ListAgents" endpoint.
+func MountListAgentsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleAdminOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/system/user/agents", f)
+}
+
+// NewListAgentsHandler creates a HTTP handler which loads the HTTP request and
+// calls the "admin" service "ListAgents" endpoint.
+func NewListAgentsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListAgentsRequest(mux, decoder)
+		encodeResponse = EncodeListAgentsResponse(encoder)
+		encodeError    = EncodeListAgentsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ListAgents")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "admin")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountRevokeAgentHandler configures the mux to serve the "admin" service
+// "RevokeAgent" endpoint.
+func MountRevokeAgentHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleAdminOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/system/user/agent/{name}", f)
+}
+
+// NewRevokeAgentHandler creates a HTTP handler which loads the HTTP request
+// and calls the "admin" service "RevokeAgent" endpoint.
+func NewRevokeAgentHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRevokeAgentRequest(mux, decoder)
+		encodeResponse = EncodeRevokeAgentResponse(encoder)
+		encodeError    = EncodeRevokeAgentError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "RevokeAgent")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "admin")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountRevokeUserTokenHandler configures the mux to serve the "admin" service
+// "RevokeUserToken" endpoint.
+func MountRevokeUserTokenHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleAdminOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/system/user/{id}/refresh-token", f)
+}
+
+// NewRevokeUserTokenHandler creates a HTTP handler which loads the HTTP
+// request and calls the "admin" service "RevokeUserToken" endpoint.
+func NewRevokeUserTokenHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRevokeUserTokenRequest(mux, decoder)
+		encodeResponse = EncodeRevokeUserTokenResponse(encoder)
+		encodeError    = EncodeRevokeUserTokenError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "RevokeUserToken")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "admin")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountRefreshConfigHandler configures the mux to serve the "admin" service
 // "RefreshConfig" endpoint.
 func MountRefreshConfigHandler(mux goahttp.Muxer, h http.Handler) {
@@ -190,12 +367,67 @@ func NewRefreshConfigHandler(
 	})
 }
 
+// MountAuditHandler configures the mux to serve the "admin" service "Audit"
+// endpoint.
+func MountAuditHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleAdminOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/system/audit", f)
+}
+
+// NewAuditHandler creates a HTTP handler which loads the HTTP request and
+// calls the "admin" service "Audit" endpoint.
+func NewAuditHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeAuditRequest(mux, decoder)
+		encodeResponse = EncodeAuditResponse(encoder)
+		encodeError    = EncodeAuditError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Audit")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "admin")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountCORSHandler configures the mux to serve the CORS endpoints for the
 // service admin.
 func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
 	h = HandleAdminOrigin(h)
 	mux.Handle("OPTIONS", "/system/user/agent", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/system/user/agents", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/system/user/agent/{name}", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/system/user/{id}/refresh-token", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/system/config/refresh", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/system/audit", h.ServeHTTP)
 }
 
 // NewCORSHandler creates a HTTP handler which returns a simple 204 response.
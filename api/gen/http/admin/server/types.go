@@ -28,6 +28,10 @@ type UpdateAgentResponseBody struct {
 	Token string `form:"token" json:"token" xml:"token"`
 }
 
+// ListAgentsResponseBody is the type of the "admin" service "ListAgents"
+// endpoint HTTP response body.
+type ListAgentsResponseBody []*AgentResponse
+
 // RefreshConfigResponseBody is the type of the "admin" service "RefreshConfig"
 // endpoint HTTP response body.
 type RefreshConfigResponseBody struct {
@@ -35,6 +39,10 @@ type RefreshConfigResponseBody struct {
 	Checksum string `form:"checksum" json:"checksum" xml:"checksum"`
 }
 
+// AuditResponseBody is the type of the "admin" service "Audit" endpoint HTTP
+// response body.
+type AuditResponseBody []*AuditEventResponse
+
 // UpdateAgentInvalidPayloadResponseBody is the type of the "admin" service
 // "UpdateAgent" endpoint HTTP response body for the "invalid-payload" error.
 type UpdateAgentInvalidPayloadResponseBody struct {
@@ -107,6 +115,204 @@ type UpdateAgentInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// ListAgentsInvalidTokenResponseBody is the type of the "admin" service
+// "ListAgents" endpoint HTTP response body for the "invalid-token" error.
+type ListAgentsInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListAgentsInvalidScopesResponseBody is the type of the "admin" service
+// "ListAgents" endpoint HTTP response body for the "invalid-scopes" error.
+type ListAgentsInvalidScopesResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListAgentsInternalErrorResponseBody is the type of the "admin" service
+// "ListAgents" endpoint HTTP response body for the "internal-error" error.
+type ListAgentsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevokeAgentNotFoundResponseBody is the type of the "admin" service
+// "RevokeAgent" endpoint HTTP response body for the "not-found" error.
+type RevokeAgentNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevokeAgentInvalidTokenResponseBody is the type of the "admin" service
+// "RevokeAgent" endpoint HTTP response body for the "invalid-token" error.
+type RevokeAgentInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevokeAgentInvalidScopesResponseBody is the type of the "admin" service
+// "RevokeAgent" endpoint HTTP response body for the "invalid-scopes" error.
+type RevokeAgentInvalidScopesResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevokeAgentInternalErrorResponseBody is the type of the "admin" service
+// "RevokeAgent" endpoint HTTP response body for the "internal-error" error.
+type RevokeAgentInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevokeUserTokenNotFoundResponseBody is the type of the "admin" service
+// "RevokeUserToken" endpoint HTTP response body for the "not-found" error.
+type RevokeUserTokenNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevokeUserTokenInvalidTokenResponseBody is the type of the "admin" service
+// "RevokeUserToken" endpoint HTTP response body for the "invalid-token" error.
+type RevokeUserTokenInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevokeUserTokenInvalidScopesResponseBody is the type of the "admin" service
+// "RevokeUserToken" endpoint HTTP response body for the "invalid-scopes" error.
+type RevokeUserTokenInvalidScopesResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevokeUserTokenInternalErrorResponseBody is the type of the "admin" service
+// "RevokeUserToken" endpoint HTTP response body for the "internal-error" error.
+type RevokeUserTokenInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // RefreshConfigInvalidTokenResponseBody is the type of the "admin" service
 // "RefreshConfig" endpoint HTTP response body for the "invalid-token" error.
 type RefreshConfigInvalidTokenResponseBody struct {
@@ -161,6 +367,106 @@ type RefreshConfigInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// AuditInvalidPayloadResponseBody is the type of the "admin" service "Audit"
+// endpoint HTTP response body for the "invalid-payload" error.
+type AuditInvalidPayloadResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AuditInvalidTokenResponseBody is the type of the "admin" service "Audit"
+// endpoint HTTP response body for the "invalid-token" error.
+type AuditInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AuditInvalidScopesResponseBody is the type of the "admin" service "Audit"
+// endpoint HTTP response body for the "invalid-scopes" error.
+type AuditInvalidScopesResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AuditInternalErrorResponseBody is the type of the "admin" service "Audit"
+// endpoint HTTP response body for the "internal-error" error.
+type AuditInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AgentResponse is used to define fields on response body types.
+type AgentResponse struct {
+	// id of the agent
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Name of the agent
+	Name string `form:"name" json:"name" xml:"name"`
+	// Scopes of the agent
+	Scopes []string `form:"scopes" json:"scopes" xml:"scopes"`
+	// ID (jti) of the valid token of the agent, absent once the token is revoked
+	TokenID *string `form:"tokenId,omitempty" json:"tokenId,omitempty" xml:"tokenId,omitempty"`
+}
+
+// AuditEventResponse is used to define fields on response body types.
+type AuditEventResponse struct {
+	// id of the event
+	ID uint `form:"id" json:"id" xml:"id"`
+	// id of the user or agent who did the operation
+	ActorID uint `form:"actorId" json:"actorId" xml:"actorId"`
+	// Operation done by the actor
+	Action string `form:"action" json:"action" xml:"action"`
+	// Object of the operation
+	Target string `form:"target" json:"target" xml:"target"`
+	// SHA256 digest of the payload of the operation
+	Digest string `form:"digest" json:"digest" xml:"digest"`
+	// Time at which the operation was done
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+}
+
 // NewUpdateAgentResponseBody builds the HTTP response body from the result of
 // the "UpdateAgent" endpoint of the "admin" service.
 func NewUpdateAgentResponseBody(res *admin.UpdateAgentResult) *UpdateAgentResponseBody {
@@ -170,6 +476,16 @@ func NewUpdateAgentResponseBody(res *admin.UpdateAgentResult) *UpdateAgentRespon
 	return body
 }
 
+// NewListAgentsResponseBody builds the HTTP response body from the result of
+// the "ListAgents" endpoint of the "admin" service.
+func NewListAgentsResponseBody(res []*admin.Agent) ListAgentsResponseBody {
+	body := make([]*AgentResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalAdminAgentToAgentResponse(val)
+	}
+	return body
+}
+
 // NewRefreshConfigResponseBody builds the HTTP response body from the result
 // of the "RefreshConfig" endpoint of the "admin" service.
 func NewRefreshConfigResponseBody(res *admin.RefreshConfigResult) *RefreshConfigResponseBody {
@@ -179,6 +495,16 @@ func NewRefreshConfigResponseBody(res *admin.RefreshConfigResult) *RefreshConfig
 	return body
 }
 
+// NewAuditResponseBody builds the HTTP response body from the result of the
+// "Audit" endpoint of the "admin" service.
+func NewAuditResponseBody(res []*admin.AuditEvent) AuditResponseBody {
+	body := make([]*AuditEventResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalAdminAuditEventToAuditEventResponse(val)
+	}
+	return body
+}
+
 // NewUpdateAgentInvalidPayloadResponseBody builds the HTTP response body from
 // the result of the "UpdateAgent" endpoint of the "admin" service.
 func NewUpdateAgentInvalidPayloadResponseBody(res *goa.ServiceError) *UpdateAgentInvalidPayloadResponseBody {
@@ -235,6 +561,160 @@ func NewUpdateAgentInternalErrorResponseBody(res *goa.ServiceError) *UpdateAgent
 	return body
 }
 
+// NewListAgentsInvalidTokenResponseBody builds the HTTP response body from the
+// result of the "ListAgents" endpoint of the "admin" service.
+func NewListAgentsInvalidTokenResponseBody(res *goa.ServiceError) *ListAgentsInvalidTokenResponseBody {
+	body := &ListAgentsInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListAgentsInvalidScopesResponseBody builds the HTTP response body from
+// the result of the "ListAgents" endpoint of the "admin" service.
+func NewListAgentsInvalidScopesResponseBody(res *goa.ServiceError) *ListAgentsInvalidScopesResponseBody {
+	body := &ListAgentsInvalidScopesResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListAgentsInternalErrorResponseBody builds the HTTP response body from
+// the result of the "ListAgents" endpoint of the "admin" service.
+func NewListAgentsInternalErrorResponseBody(res *goa.ServiceError) *ListAgentsInternalErrorResponseBody {
+	body := &ListAgentsInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevokeAgentNotFoundResponseBody builds the HTTP response body from the
+// result of the "RevokeAgent" endpoint of the "admin" service.
+func NewRevokeAgentNotFoundResponseBody(res *goa.ServiceError) *RevokeAgentNotFoundResponseBody {
+	body := &RevokeAgentNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevokeAgentInvalidTokenResponseBody builds the HTTP response body from
+// the result of the "RevokeAgent" endpoint of the "admin" service.
+func NewRevokeAgentInvalidTokenResponseBody(res *goa.ServiceError) *RevokeAgentInvalidTokenResponseBody {
+	body := &RevokeAgentInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevokeAgentInvalidScopesResponseBody builds the HTTP response body from
+// the result of the "RevokeAgent" endpoint of the "admin" service.
+func NewRevokeAgentInvalidScopesResponseBody(res *goa.ServiceError) *RevokeAgentInvalidScopesResponseBody {
+	body := &RevokeAgentInvalidScopesResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevokeAgentInternalErrorResponseBody builds the HTTP response body from
+// the result of the "RevokeAgent" endpoint of the "admin" service.
+func NewRevokeAgentInternalErrorResponseBody(res *goa.ServiceError) *RevokeAgentInternalErrorResponseBody {
+	body := &RevokeAgentInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevokeUserTokenNotFoundResponseBody builds the HTTP response body from
+// the result of the "RevokeUserToken" endpoint of the "admin" service.
+func NewRevokeUserTokenNotFoundResponseBody(res *goa.ServiceError) *RevokeUserTokenNotFoundResponseBody {
+	body := &RevokeUserTokenNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevokeUserTokenInvalidTokenResponseBody builds the HTTP response body
+// from the result of the "RevokeUserToken" endpoint of the "admin" service.
+func NewRevokeUserTokenInvalidTokenResponseBody(res *goa.ServiceError) *RevokeUserTokenInvalidTokenResponseBody {
+	body := &RevokeUserTokenInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevokeUserTokenInvalidScopesResponseBody builds the HTTP response body
+// from the result of the "RevokeUserToken" endpoint of the "admin" service.
+func NewRevokeUserTokenInvalidScopesResponseBody(res *goa.ServiceError) *RevokeUserTokenInvalidScopesResponseBody {
+	body := &RevokeUserTokenInvalidScopesResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRevokeUserTokenInternalErrorResponseBody builds the HTTP response body
+// from the result of the "RevokeUserToken" endpoint of the "admin" service.
+func NewRevokeUserTokenInternalErrorResponseBody(res *goa.ServiceError) *RevokeUserTokenInternalErrorResponseBody {
+	body := &RevokeUserTokenInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewRefreshConfigInvalidTokenResponseBody builds the HTTP response body from
 // the result of the "RefreshConfig" endpoint of the "admin" service.
 func NewRefreshConfigInvalidTokenResponseBody(res *goa.ServiceError) *RefreshConfigInvalidTokenResponseBody {
@@ -277,6 +757,62 @@ func NewRefreshConfigInternalErrorResponseBody(res *goa.ServiceError) *RefreshCo
 	return body
 }
 
+// NewAuditInvalidPayloadResponseBody builds the HTTP response body from the
+// result of the "Audit" endpoint of the "admin" service.
+func NewAuditInvalidPayloadResponseBody(res *goa.ServiceError) *AuditInvalidPayloadResponseBody {
+	body := &AuditInvalidPayloadResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAuditInvalidTokenResponseBody builds the HTTP response body from the
+// result of the "Audit" endpoint of the "admin" service.
+func NewAuditInvalidTokenResponseBody(res *goa.ServiceError) *AuditInvalidTokenResponseBody {
+	body := &AuditInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAuditInvalidScopesResponseBody builds the HTTP response body from the
+// result of the "Audit" endpoint of the "admin" service.
+func NewAuditInvalidScopesResponseBody(res *goa.ServiceError) *AuditInvalidScopesResponseBody {
+	body := &AuditInvalidScopesResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAuditInternalErrorResponseBody builds the HTTP response body from the
+// result of the "Audit" endpoint of the "admin" service.
+func NewAuditInternalErrorResponseBody(res *goa.ServiceError) *AuditInternalErrorResponseBody {
+	body := &AuditInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewUpdateAgentPayload builds a admin service UpdateAgent endpoint payload.
 func NewUpdateAgentPayload(body *UpdateAgentRequestBody, token string) *admin.UpdateAgentPayload {
 	v := &admin.UpdateAgentPayload{
@@ -291,6 +827,33 @@ func NewUpdateAgentPayload(body *UpdateAgentRequestBody, token string) *admin.Up
 	return v
 }
 
+// NewListAgentsPayload builds a admin service ListAgents endpoint payload.
+func NewListAgentsPayload(token string) *admin.ListAgentsPayload {
+	v := &admin.ListAgentsPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewRevokeAgentPayload builds a admin service RevokeAgent endpoint payload.
+func NewRevokeAgentPayload(name string, token string) *admin.RevokeAgentPayload {
+	v := &admin.RevokeAgentPayload{}
+	v.Name = name
+	v.Token = token
+
+	return v
+}
+
+// NewRevokeUserTokenPayload builds a admin service RevokeUserToken endpoint
+// payload.
+func NewRevokeUserTokenPayload(id uint, token string) *admin.RevokeUserTokenPayload {
+	v := &admin.RevokeUserTokenPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
 // NewRefreshConfigPayload builds a admin service RefreshConfig endpoint
 // payload.
 func NewRefreshConfigPayload(token string) *admin.RefreshConfigPayload {
@@ -300,6 +863,19 @@ func NewRefreshConfigPayload(token string) *admin.RefreshConfigPayload {
 	return v
 }
 
+// NewAuditPayload builds a admin service Audit endpoint payload.
+func NewAuditPayload(actor *uint, action *string, since *string, until *string, limit uint, token string) *admin.AuditPayload {
+	v := &admin.AuditPayload{}
+	v.Actor = actor
+	v.Action = action
+	v.Since = since
+	v.Until = until
+	v.Limit = limit
+	v.Token = token
+
+	return v
+}
+
 // ValidateUpdateAgentRequestBody runs the validations defined on
 // UpdateAgentRequestBody
 func ValidateUpdateAgentRequestBody(body *UpdateAgentRequestBody) (err error) {
@@ -8,22 +8,38 @@
 package client
 
 import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
 	catalog "github.com/tektoncd/hub/api/gen/catalog"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildRefreshPayload builds the payload for the catalog Refresh endpoint from
 // CLI flags.
-func BuildRefreshPayload(catalogRefreshCatalogName string, catalogRefreshToken string) (*catalog.RefreshPayload, error) {
+func BuildRefreshPayload(catalogRefreshCatalogName string, catalogRefreshFull string, catalogRefreshToken string) (*catalog.RefreshPayload, error) {
+	var err error
 	var catalogName string
 	{
 		catalogName = catalogRefreshCatalogName
 	}
+	var full bool
+	{
+		if catalogRefreshFull != "" {
+			full, err = strconv.ParseBool(catalogRefreshFull)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for full, must be BOOL")
+			}
+		}
+	}
 	var token string
 	{
 		token = catalogRefreshToken
 	}
 	v := &catalog.RefreshPayload{}
 	v.CatalogName = catalogName
+	v.Full = full
 	v.Token = token
 
 	return v, nil
@@ -31,12 +47,280 @@ func BuildRefreshPayload(catalogRefreshCatalogName string, catalogRefreshToken s
 
 // BuildRefreshAllPayload builds the payload for the catalog RefreshAll
 // endpoint from CLI flags.
-func BuildRefreshAllPayload(catalogRefreshAllToken string) (*catalog.RefreshAllPayload, error) {
+func BuildRefreshAllPayload(catalogRefreshAllFull string, catalogRefreshAllToken string) (*catalog.RefreshAllPayload, error) {
+	var err error
+	var full bool
+	{
+		if catalogRefreshAllFull != "" {
+			full, err = strconv.ParseBool(catalogRefreshAllFull)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for full, must be BOOL")
+			}
+		}
+	}
 	var token string
 	{
 		token = catalogRefreshAllToken
 	}
 	v := &catalog.RefreshAllPayload{}
+	v.Full = full
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCreatePayload builds the payload for the catalog Create endpoint from
+// CLI flags.
+func BuildCreatePayload(catalogCreateBody string, catalogCreateToken string) (*catalog.CreatePayload, error) {
+	var err error
+	var body CreateRequestBody
+	{
+		err = json.Unmarshal([]byte(catalogCreateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"contextDir\": \"\",\n      \"credential\": \"tekton-catalog\",\n      \"insecureSkipTLSVerify\": false,\n      \"name\": \"tekton\",\n      \"org\": \"tektoncd\",\n      \"private\": false,\n      \"provider\": \"gitlab\",\n      \"revision\": \"main\",\n      \"scope\": \"catalog:private\",\n      \"sshUrl\": \"git@github.com:tektoncd/catalog.git\",\n      \"type\": \"community\",\n      \"url\": \"https://github.com/tektoncd/catalog\",\n      \"webhookSecret\": \"Mollitia ut quos voluptas molestiae quia.\"\n   }'")
+		}
+		if !(body.Provider == "github" || body.Provider == "gitlab" || body.Provider == "bitbucket") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.provider", body.Provider, []any{"github", "gitlab", "bitbucket"}))
+		}
+		if body.Credential != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.credential", *body.Credential, "^([a-zA-Z0-9][a-zA-Z0-9._-]*)?$"))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = catalogCreateToken
+	}
+	v := &catalog.CreatePayload{
+		Name:                  body.Name,
+		Org:                   body.Org,
+		Type:                  body.Type,
+		Provider:              body.Provider,
+		URL:                   body.URL,
+		SSHURL:                body.SSHURL,
+		Revision:              body.Revision,
+		ContextDir:            body.ContextDir,
+		WebhookSecret:         body.WebhookSecret,
+		Credential:            body.Credential,
+		InsecureSkipTLSVerify: body.InsecureSkipTLSVerify,
+		Private:               body.Private,
+		Scope:                 body.Scope,
+	}
+	{
+		var zero string
+		if v.Provider == zero {
+			v.Provider = "github"
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildUpdatePayload builds the payload for the catalog Update endpoint from
+// CLI flags.
+func BuildUpdatePayload(catalogUpdateBody string, catalogUpdateCatalogName string, catalogUpdateToken string) (*catalog.UpdatePayload, error) {
+	var err error
+	var body UpdateRequestBody
+	{
+		err = json.Unmarshal([]byte(catalogUpdateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"contextDir\": \"\",\n      \"credential\": \"tekton-catalog\",\n      \"insecureSkipTLSVerify\": false,\n      \"private\": false,\n      \"provider\": \"bitbucket\",\n      \"revision\": \"main\",\n      \"scope\": \"catalog:private\",\n      \"sshUrl\": \"git@github.com:tektoncd/catalog.git\",\n      \"url\": \"https://github.com/tektoncd/catalog\",\n      \"webhookSecret\": \"Dolores numquam animi vel dolores expedita.\"\n   }'")
+		}
+		if body.Provider != nil {
+			if !(*body.Provider == "github" || *body.Provider == "gitlab" || *body.Provider == "bitbucket") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.provider", *body.Provider, []any{"github", "gitlab", "bitbucket"}))
+			}
+		}
+		if body.Credential != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.credential", *body.Credential, "^([a-zA-Z0-9][a-zA-Z0-9._-]*)?$"))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var catalogName string
+	{
+		catalogName = catalogUpdateCatalogName
+	}
+	var token string
+	{
+		token = catalogUpdateToken
+	}
+	v := &catalog.UpdatePayload{
+		Provider:              body.Provider,
+		URL:                   body.URL,
+		SSHURL:                body.SSHURL,
+		Revision:              body.Revision,
+		ContextDir:            body.ContextDir,
+		WebhookSecret:         body.WebhookSecret,
+		Credential:            body.Credential,
+		InsecureSkipTLSVerify: body.InsecureSkipTLSVerify,
+		Private:               body.Private,
+		Scope:                 body.Scope,
+	}
+	v.CatalogName = catalogName
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildDeletePayload builds the payload for the catalog Delete endpoint from
+// CLI flags.
+func BuildDeletePayload(catalogDeleteCatalogName string, catalogDeleteToken string) (*catalog.DeletePayload, error) {
+	var catalogName string
+	{
+		catalogName = catalogDeleteCatalogName
+	}
+	var token string
+	{
+		token = catalogDeleteToken
+	}
+	v := &catalog.DeletePayload{}
+	v.CatalogName = catalogName
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildWebhookPayload builds the payload for the catalog Webhook endpoint from
+// CLI flags.
+func BuildWebhookPayload(catalogWebhookProvider string, catalogWebhookGithubEvent string, catalogWebhookGithubSignature string, catalogWebhookGitlabEvent string, catalogWebhookGitlabToken string, catalogWebhookBitbucketEvent string, catalogWebhookBitbucketSignature string) (*catalog.WebhookPayload, error) {
+	var err error
+	var provider string
+	{
+		provider = catalogWebhookProvider
+		if !(provider == "github" || provider == "gitlab" || provider == "bitbucket") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("provider", provider, []any{"github", "gitlab", "bitbucket"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var githubEvent *string
+	{
+		if catalogWebhookGithubEvent != "" {
+			githubEvent = &catalogWebhookGithubEvent
+		}
+	}
+	var githubSignature *string
+	{
+		if catalogWebhookGithubSignature != "" {
+			githubSignature = &catalogWebhookGithubSignature
+		}
+	}
+	var gitlabEvent *string
+	{
+		if catalogWebhookGitlabEvent != "" {
+			gitlabEvent = &catalogWebhookGitlabEvent
+		}
+	}
+	var gitlabToken *string
+	{
+		if catalogWebhookGitlabToken != "" {
+			gitlabToken = &catalogWebhookGitlabToken
+		}
+	}
+	var bitbucketEvent *string
+	{
+		if catalogWebhookBitbucketEvent != "" {
+			bitbucketEvent = &catalogWebhookBitbucketEvent
+		}
+	}
+	var bitbucketSignature *string
+	{
+		if catalogWebhookBitbucketSignature != "" {
+			bitbucketSignature = &catalogWebhookBitbucketSignature
+		}
+	}
+	v := &catalog.WebhookPayload{}
+	v.Provider = provider
+	v.GithubEvent = githubEvent
+	v.GithubSignature = githubSignature
+	v.GitlabEvent = gitlabEvent
+	v.GitlabToken = gitlabToken
+	v.BitbucketEvent = bitbucketEvent
+	v.BitbucketSignature = bitbucketSignature
+
+	return v, nil
+}
+
+// BuildGetJobPayload builds the payload for the catalog GetJob endpoint from
+// CLI flags.
+func BuildGetJobPayload(catalogGetJobID string, catalogGetJobToken string) (*catalog.GetJobPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(catalogGetJobID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var token string
+	{
+		token = catalogGetJobToken
+	}
+	v := &catalog.GetJobPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListJobsPayload builds the payload for the catalog ListJobs endpoint
+// from CLI flags.
+func BuildListJobsPayload(catalogListJobsCatalogName string, catalogListJobsLimit string, catalogListJobsToken string) (*catalog.ListJobsPayload, error) {
+	var err error
+	var catalogName string
+	{
+		catalogName = catalogListJobsCatalogName
+	}
+	var limit uint
+	{
+		if catalogListJobsLimit != "" {
+			var v uint64
+			v, err = strconv.ParseUint(catalogListJobsLimit, 10, strconv.IntSize)
+			limit = uint(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be UINT")
+			}
+		}
+	}
+	var token string
+	{
+		token = catalogListJobsToken
+	}
+	v := &catalog.ListJobsPayload{}
+	v.CatalogName = catalogName
+	v.Limit = limit
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCancelJobPayload builds the payload for the catalog CancelJob endpoint
+// from CLI flags.
+func BuildCancelJobPayload(catalogCancelJobID string, catalogCancelJobToken string) (*catalog.CancelJobPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(catalogCancelJobID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var token string
+	{
+		token = catalogCancelJobToken
+	}
+	v := &catalog.CancelJobPayload{}
+	v.ID = id
 	v.Token = token
 
 	return v, nil
@@ -25,6 +25,30 @@ type Client struct {
 	// endpoint.
 	RefreshAllDoer goahttp.Doer
 
+	// Create Doer is the HTTP client used to make requests to the Create endpoint.
+	CreateDoer goahttp.Doer
+
+	// Update Doer is the HTTP client used to make requests to the Update endpoint.
+	UpdateDoer goahttp.Doer
+
+	// Delete Doer is the HTTP client used to make requests to the Delete endpoint.
+	DeleteDoer goahttp.Doer
+
+	// Webhook Doer is the HTTP client used to make requests to the Webhook
+	// endpoint.
+	WebhookDoer goahttp.Doer
+
+	// GetJob Doer is the HTTP client used to make requests to the GetJob endpoint.
+	GetJobDoer goahttp.Doer
+
+	// ListJobs Doer is the HTTP client used to make requests to the ListJobs
+	// endpoint.
+	ListJobsDoer goahttp.Doer
+
+	// CancelJob Doer is the HTTP client used to make requests to the CancelJob
+	// endpoint.
+	CancelJobDoer goahttp.Doer
+
 	// CatalogError Doer is the HTTP client used to make requests to the
 	// CatalogError endpoint.
 	CatalogErrorDoer goahttp.Doer
@@ -54,6 +78,13 @@ func NewClient(
 	return &Client{
 		RefreshDoer:         doer,
 		RefreshAllDoer:      doer,
+		CreateDoer:          doer,
+		UpdateDoer:          doer,
+		DeleteDoer:          doer,
+		WebhookDoer:         doer,
+		GetJobDoer:          doer,
+		ListJobsDoer:        doer,
+		CancelJobDoer:       doer,
 		CatalogErrorDoer:    doer,
 		CORSDoer:            doer,
 		RestoreResponseBody: restoreBody,
@@ -112,6 +143,174 @@ func (c *Client) RefreshAll() goa.Endpoint {
 	}
 }
 
+// Create returns an endpoint that makes HTTP requests to the catalog service
+// Create server.
+func (c *Client) Create() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateRequest(c.encoder)
+		decodeResponse = DecodeCreateResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("catalog", "Create", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Update returns an endpoint that makes HTTP requests to the catalog service
+// Update server.
+func (c *Client) Update() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateRequest(c.encoder)
+		decodeResponse = DecodeUpdateResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("catalog", "Update", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Delete returns an endpoint that makes HTTP requests to the catalog service
+// Delete server.
+func (c *Client) Delete() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteRequest(c.encoder)
+		decodeResponse = DecodeDeleteResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("catalog", "Delete", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Webhook returns an endpoint that makes HTTP requests to the catalog service
+// Webhook server.
+func (c *Client) Webhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeWebhookRequest(c.encoder)
+		decodeResponse = DecodeWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.WebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("catalog", "Webhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetJob returns an endpoint that makes HTTP requests to the catalog service
+// GetJob server.
+func (c *Client) GetJob() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetJobRequest(c.encoder)
+		decodeResponse = DecodeGetJobResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetJobRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetJobDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("catalog", "GetJob", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListJobs returns an endpoint that makes HTTP requests to the catalog service
+// ListJobs server.
+func (c *Client) ListJobs() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListJobsRequest(c.encoder)
+		decodeResponse = DecodeListJobsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListJobsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListJobsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("catalog", "ListJobs", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CancelJob returns an endpoint that makes HTTP requests to the catalog
+// service CancelJob server.
+func (c *Client) CancelJob() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCancelJobRequest(c.encoder)
+		decodeResponse = DecodeCancelJobResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCancelJobRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CancelJobDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("catalog", "CancelJob", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // CatalogError returns an endpoint that makes HTTP requests to the catalog
 // service CatalogError server.
 func (c *Client) CatalogError() goa.Endpoint {
@@ -10,9 +10,11 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"strings"
 
 	catalog "github.com/tektoncd/hub/api/gen/catalog"
@@ -62,6 +64,9 @@ func EncodeRefreshRequest(encoder func(*http.Request) goahttp.Encoder) func(*htt
 				req.Header.Set("Authorization", head)
 			}
 		}
+		values := req.URL.Query()
+		values.Add("full", fmt.Sprintf("%v", p.Full))
+		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
@@ -171,6 +176,9 @@ func EncodeRefreshAllRequest(encoder func(*http.Request) goahttp.Encoder) func(*
 				req.Header.Set("Authorization", head)
 			}
 		}
+		values := req.URL.Query()
+		values.Add("full", fmt.Sprintf("%v", p.Full))
+		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
@@ -238,6 +246,926 @@ func DecodeRefreshAllResponse(decoder func(*http.Response) goahttp.Decoder, rest
 	}
 }
 
+// BuildCreateRequest instantiates a HTTP request object with method and path
+// set to call the "catalog" service "Create" endpoint
+func (c *Client) BuildCreateRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateCatalogPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("catalog", "Create", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateRequest returns an encoder for requests sent to the catalog
+// Create server.
+func EncodeCreateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*catalog.CreatePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("catalog", "Create", "*catalog.CreatePayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewCreateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("catalog", "Create", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateResponse returns a decoder for responses returned by the catalog
+// Create endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeCreateResponse may return the following errors:
+//   - "invalid-payload" (type *goa.ServiceError): http.StatusBadRequest
+//   - "already-exists" (type *goa.ServiceError): http.StatusConflict
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeCreateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CreateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Create", err)
+			}
+			p := NewCreateJobOK(&body)
+			view := "default"
+			vres := &catalogviews.Job{Projected: p, View: view}
+			if err = catalogviews.ValidateJob(vres); err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Create", err)
+			}
+			res := catalog.NewJob(vres)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateInvalidPayloadResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Create", err)
+			}
+			err = ValidateCreateInvalidPayloadResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Create", err)
+			}
+			return nil, NewCreateInvalidPayload(&body)
+		case http.StatusConflict:
+			var (
+				body CreateAlreadyExistsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Create", err)
+			}
+			err = ValidateCreateAlreadyExistsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Create", err)
+			}
+			return nil, NewCreateAlreadyExists(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Create", err)
+			}
+			err = ValidateCreateInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Create", err)
+			}
+			return nil, NewCreateInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("catalog", "Create", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateRequest instantiates a HTTP request object with method and path
+// set to call the "catalog" service "Update" endpoint
+func (c *Client) BuildUpdateRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		catalogName string
+	)
+	{
+		p, ok := v.(*catalog.UpdatePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("catalog", "Update", "*catalog.UpdatePayload", v)
+		}
+		catalogName = p.CatalogName
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateCatalogPath(catalogName)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("catalog", "Update", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateRequest returns an encoder for requests sent to the catalog
+// Update server.
+func EncodeUpdateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*catalog.UpdatePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("catalog", "Update", "*catalog.UpdatePayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewUpdateRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("catalog", "Update", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateResponse returns a decoder for responses returned by the catalog
+// Update endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeUpdateResponse may return the following errors:
+//   - "invalid-payload" (type *goa.ServiceError): http.StatusBadRequest
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeUpdateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Update", err)
+			}
+			p := NewUpdateJobOK(&body)
+			view := "default"
+			vres := &catalogviews.Job{Projected: p, View: view}
+			if err = catalogviews.ValidateJob(vres); err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Update", err)
+			}
+			res := catalog.NewJob(vres)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body UpdateInvalidPayloadResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Update", err)
+			}
+			err = ValidateUpdateInvalidPayloadResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Update", err)
+			}
+			return nil, NewUpdateInvalidPayload(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Update", err)
+			}
+			err = ValidateUpdateNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Update", err)
+			}
+			return nil, NewUpdateNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Update", err)
+			}
+			err = ValidateUpdateInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Update", err)
+			}
+			return nil, NewUpdateInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("catalog", "Update", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteRequest instantiates a HTTP request object with method and path
+// set to call the "catalog" service "Delete" endpoint
+func (c *Client) BuildDeleteRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		catalogName string
+	)
+	{
+		p, ok := v.(*catalog.DeletePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("catalog", "Delete", "*catalog.DeletePayload", v)
+		}
+		catalogName = p.CatalogName
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteCatalogPath(catalogName)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("catalog", "Delete", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteRequest returns an encoder for requests sent to the catalog
+// Delete server.
+func EncodeDeleteRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*catalog.DeletePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("catalog", "Delete", "*catalog.DeletePayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteResponse returns a decoder for responses returned by the catalog
+// Delete endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeDeleteResponse may return the following errors:
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeDeleteResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body DeleteNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Delete", err)
+			}
+			err = ValidateDeleteNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Delete", err)
+			}
+			return nil, NewDeleteNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Delete", err)
+			}
+			err = ValidateDeleteInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Delete", err)
+			}
+			return nil, NewDeleteInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("catalog", "Delete", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildWebhookRequest instantiates a HTTP request object with method and path
+// set to call the "catalog" service "Webhook" endpoint
+func (c *Client) BuildWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		provider string
+		body     io.Reader
+	)
+	{
+		rd, ok := v.(*catalog.WebhookRequestData)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("catalog", "Webhook", "catalog.WebhookRequestData", v)
+		}
+		p := rd.Payload
+		body = rd.Body
+		provider = p.Provider
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: WebhookCatalogPath(provider)}
+	req, err := http.NewRequest("POST", u.String(), body)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("catalog", "Webhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeWebhookRequest returns an encoder for requests sent to the catalog
+// Webhook server.
+func EncodeWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		data, ok := v.(*catalog.WebhookRequestData)
+		if !ok {
+			return goahttp.ErrInvalidType("catalog", "Webhook", "*catalog.WebhookRequestData", v)
+		}
+		p := data.Payload
+		if p.GithubEvent != nil {
+			head := *p.GithubEvent
+			req.Header.Set("X-GitHub-Event", head)
+		}
+		if p.GithubSignature != nil {
+			head := *p.GithubSignature
+			req.Header.Set("X-Hub-Signature-256", head)
+		}
+		if p.GitlabEvent != nil {
+			head := *p.GitlabEvent
+			req.Header.Set("X-Gitlab-Event", head)
+		}
+		if p.GitlabToken != nil {
+			head := *p.GitlabToken
+			req.Header.Set("X-Gitlab-Token", head)
+		}
+		if p.BitbucketEvent != nil {
+			head := *p.BitbucketEvent
+			req.Header.Set("X-Event-Key", head)
+		}
+		if p.BitbucketSignature != nil {
+			head := *p.BitbucketSignature
+			req.Header.Set("X-Hub-Signature", head)
+		}
+		return nil
+	}
+}
+
+// DecodeWebhookResponse returns a decoder for responses returned by the
+// catalog Webhook endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeWebhookResponse may return the following errors:
+//   - "invalid-payload" (type *goa.ServiceError): http.StatusBadRequest
+//   - "invalid-signature" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body WebhookResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Webhook", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateJobResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Webhook", err)
+			}
+			res := NewWebhookJobOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body WebhookInvalidPayloadResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Webhook", err)
+			}
+			err = ValidateWebhookInvalidPayloadResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Webhook", err)
+			}
+			return nil, NewWebhookInvalidPayload(&body)
+		case http.StatusUnauthorized:
+			var (
+				body WebhookInvalidSignatureResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Webhook", err)
+			}
+			err = ValidateWebhookInvalidSignatureResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Webhook", err)
+			}
+			return nil, NewWebhookInvalidSignature(&body)
+		case http.StatusInternalServerError:
+			var (
+				body WebhookInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "Webhook", err)
+			}
+			err = ValidateWebhookInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "Webhook", err)
+			}
+			return nil, NewWebhookInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("catalog", "Webhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// // BuildWebhookStreamPayload creates a streaming endpoint request payload from
+// the method payload and the path to the file to be streamed
+func BuildWebhookStreamPayload(payload any, fpath string) (*catalog.WebhookRequestData, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	return &catalog.WebhookRequestData{
+		Payload: payload.(*catalog.WebhookPayload),
+		Body:    f,
+	}, nil
+}
+
+// BuildGetJobRequest instantiates a HTTP request object with method and path
+// set to call the "catalog" service "GetJob" endpoint
+func (c *Client) BuildGetJobRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*catalog.GetJobPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("catalog", "GetJob", "*catalog.GetJobPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetJobCatalogPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("catalog", "GetJob", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetJobRequest returns an encoder for requests sent to the catalog
+// GetJob server.
+func EncodeGetJobRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*catalog.GetJobPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("catalog", "GetJob", "*catalog.GetJobPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeGetJobResponse returns a decoder for responses returned by the catalog
+// GetJob endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeGetJobResponse may return the following errors:
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetJobResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetJobResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "GetJob", err)
+			}
+			p := NewGetJobJobOK(&body)
+			view := "default"
+			vres := &catalogviews.Job{Projected: p, View: view}
+			if err = catalogviews.ValidateJob(vres); err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "GetJob", err)
+			}
+			res := catalog.NewJob(vres)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body GetJobNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "GetJob", err)
+			}
+			err = ValidateGetJobNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "GetJob", err)
+			}
+			return nil, NewGetJobNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetJobInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "GetJob", err)
+			}
+			err = ValidateGetJobInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "GetJob", err)
+			}
+			return nil, NewGetJobInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("catalog", "GetJob", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListJobsRequest instantiates a HTTP request object with method and path
+// set to call the "catalog" service "ListJobs" endpoint
+func (c *Client) BuildListJobsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		catalogName string
+	)
+	{
+		p, ok := v.(*catalog.ListJobsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("catalog", "ListJobs", "*catalog.ListJobsPayload", v)
+		}
+		catalogName = p.CatalogName
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListJobsCatalogPath(catalogName)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("catalog", "ListJobs", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListJobsRequest returns an encoder for requests sent to the catalog
+// ListJobs server.
+func EncodeListJobsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*catalog.ListJobsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("catalog", "ListJobs", "*catalog.ListJobsPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListJobsResponse returns a decoder for responses returned by the
+// catalog ListJobs endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeListJobsResponse may return the following errors:
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListJobsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListJobsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "ListJobs", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateJobResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "ListJobs", err)
+			}
+			res := NewListJobsJobOK(body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body ListJobsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "ListJobs", err)
+			}
+			err = ValidateListJobsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "ListJobs", err)
+			}
+			return nil, NewListJobsNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListJobsInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "ListJobs", err)
+			}
+			err = ValidateListJobsInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "ListJobs", err)
+			}
+			return nil, NewListJobsInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("catalog", "ListJobs", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCancelJobRequest instantiates a HTTP request object with method and
+// path set to call the "catalog" service "CancelJob" endpoint
+func (c *Client) BuildCancelJobRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*catalog.CancelJobPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("catalog", "CancelJob", "*catalog.CancelJobPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CancelJobCatalogPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("catalog", "CancelJob", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCancelJobRequest returns an encoder for requests sent to the catalog
+// CancelJob server.
+func EncodeCancelJobRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*catalog.CancelJobPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("catalog", "CancelJob", "*catalog.CancelJobPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeCancelJobResponse returns a decoder for responses returned by the
+// catalog CancelJob endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCancelJobResponse may return the following errors:
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-state" (type *goa.ServiceError): http.StatusConflict
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeCancelJobResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CancelJobResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "CancelJob", err)
+			}
+			p := NewCancelJobJobOK(&body)
+			view := "default"
+			vres := &catalogviews.Job{Projected: p, View: view}
+			if err = catalogviews.ValidateJob(vres); err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "CancelJob", err)
+			}
+			res := catalog.NewJob(vres)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body CancelJobNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "CancelJob", err)
+			}
+			err = ValidateCancelJobNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "CancelJob", err)
+			}
+			return nil, NewCancelJobNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body CancelJobInvalidStateResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "CancelJob", err)
+			}
+			err = ValidateCancelJobInvalidStateResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "CancelJob", err)
+			}
+			return nil, NewCancelJobInvalidState(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CancelJobInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "CancelJob", err)
+			}
+			err = ValidateCancelJobInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "CancelJob", err)
+			}
+			return nil, NewCancelJobInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("catalog", "CancelJob", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildCatalogErrorRequest instantiates a HTTP request object with method and
 // path set to call the "catalog" service "CatalogError" endpoint
 func (c *Client) BuildCatalogErrorRequest(ctx context.Context, v any) (*http.Request, error) {
@@ -347,6 +1275,11 @@ func unmarshalJobResponseToCatalogJob(v *JobResponse) *catalog.Job {
 		ID:          *v.ID,
 		CatalogName: *v.CatalogName,
 		Status:      *v.Status,
+		CreatedAt:   v.CreatedAt,
+		StartedAt:   v.StartedAt,
+		FinishedAt:  v.FinishedAt,
+		Sha:         v.Sha,
+		Reason:      v.Reason,
 	}
 
 	return res
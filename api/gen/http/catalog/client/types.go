@@ -13,6 +13,64 @@ import (
 	goa "goa.design/goa/v3/pkg"
 )
 
+// CreateRequestBody is the type of the "catalog" service "Create" endpoint
+// HTTP request body.
+type CreateRequestBody struct {
+	// Name of catalog
+	Name string `form:"name" json:"name" xml:"name"`
+	// Organization of catalog
+	Org string `form:"org" json:"org" xml:"org"`
+	// Type of catalog
+	Type string `form:"type" json:"type" xml:"type"`
+	// Git provider of the catalog repository
+	Provider string `form:"provider" json:"provider" xml:"provider"`
+	// URL of the catalog repository
+	URL string `form:"url" json:"url" xml:"url"`
+	// SSH URL of the catalog repository if it is private
+	SSHURL *string `form:"sshUrl,omitempty" json:"sshUrl,omitempty" xml:"sshUrl,omitempty"`
+	// Branch of the catalog repository
+	Revision string `form:"revision" json:"revision" xml:"revision"`
+	// Path to the resources in the catalog repository
+	ContextDir *string `form:"contextDir,omitempty" json:"contextDir,omitempty" xml:"contextDir,omitempty"`
+	// Secret of the push webhook of the catalog repository
+	WebhookSecret *string `form:"webhookSecret,omitempty" json:"webhookSecret,omitempty" xml:"webhookSecret,omitempty"`
+	// Name of the credential of the api used to fetch the catalog repository if it
+	// is private
+	Credential *string `form:"credential,omitempty" json:"credential,omitempty" xml:"credential,omitempty"`
+	// Skip the verification of the TLS certificate of the catalog repository
+	InsecureSkipTLSVerify *bool `form:"insecureSkipTLSVerify,omitempty" json:"insecureSkipTLSVerify,omitempty" xml:"insecureSkipTLSVerify,omitempty"`
+	// Show the resources of the catalog only to the users having its scope
+	Private *bool `form:"private,omitempty" json:"private,omitempty" xml:"private,omitempty"`
+	// Scope required to see the private catalog, catalog:private by default
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+}
+
+// UpdateRequestBody is the type of the "catalog" service "Update" endpoint
+// HTTP request body.
+type UpdateRequestBody struct {
+	// Git provider of the catalog repository
+	Provider *string `form:"provider,omitempty" json:"provider,omitempty" xml:"provider,omitempty"`
+	// URL of the catalog repository
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// SSH URL of the catalog repository if it is private
+	SSHURL *string `form:"sshUrl,omitempty" json:"sshUrl,omitempty" xml:"sshUrl,omitempty"`
+	// Branch of the catalog repository
+	Revision *string `form:"revision,omitempty" json:"revision,omitempty" xml:"revision,omitempty"`
+	// Path to the resources in the catalog repository
+	ContextDir *string `form:"contextDir,omitempty" json:"contextDir,omitempty" xml:"contextDir,omitempty"`
+	// Secret of the push webhook of the catalog repository
+	WebhookSecret *string `form:"webhookSecret,omitempty" json:"webhookSecret,omitempty" xml:"webhookSecret,omitempty"`
+	// Name of the credential of the api used to fetch the catalog repository if it
+	// is private
+	Credential *string `form:"credential,omitempty" json:"credential,omitempty" xml:"credential,omitempty"`
+	// Skip the verification of the TLS certificate of the catalog repository
+	InsecureSkipTLSVerify *bool `form:"insecureSkipTLSVerify,omitempty" json:"insecureSkipTLSVerify,omitempty" xml:"insecureSkipTLSVerify,omitempty"`
+	// Show the resources of the catalog only to the users having its scope
+	Private *bool `form:"private,omitempty" json:"private,omitempty" xml:"private,omitempty"`
+	// Scope required to see the private catalog, catalog:private by default
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+}
+
 // RefreshResponseBody is the type of the "catalog" service "Refresh" endpoint
 // HTTP response body.
 type RefreshResponseBody struct {
@@ -22,12 +80,114 @@ type RefreshResponseBody struct {
 	CatalogName *string `form:"catalogName,omitempty" json:"catalogName,omitempty" xml:"catalogName,omitempty"`
 	// status of the job
 	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Time at which the job was queued
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Time at which the job started to run
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Time at which the job finished
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Commit of the catalog synced by the job
+	Sha *string `form:"sha,omitempty" json:"sha,omitempty" xml:"sha,omitempty"`
+	// Cause of the failure of the job
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
 }
 
 // RefreshAllResponseBody is the type of the "catalog" service "RefreshAll"
 // endpoint HTTP response body.
 type RefreshAllResponseBody []*JobResponse
 
+// CreateResponseBody is the type of the "catalog" service "Create" endpoint
+// HTTP response body.
+type CreateResponseBody struct {
+	// id of the job
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the catalog
+	CatalogName *string `form:"catalogName,omitempty" json:"catalogName,omitempty" xml:"catalogName,omitempty"`
+	// status of the job
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Time at which the job was queued
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Time at which the job started to run
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Time at which the job finished
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Commit of the catalog synced by the job
+	Sha *string `form:"sha,omitempty" json:"sha,omitempty" xml:"sha,omitempty"`
+	// Cause of the failure of the job
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// UpdateResponseBody is the type of the "catalog" service "Update" endpoint
+// HTTP response body.
+type UpdateResponseBody struct {
+	// id of the job
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the catalog
+	CatalogName *string `form:"catalogName,omitempty" json:"catalogName,omitempty" xml:"catalogName,omitempty"`
+	// status of the job
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Time at which the job was queued
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Time at which the job started to run
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Time at which the job finished
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Commit of the catalog synced by the job
+	Sha *string `form:"sha,omitempty" json:"sha,omitempty" xml:"sha,omitempty"`
+	// Cause of the failure of the job
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// WebhookResponseBody is the type of the "catalog" service "Webhook" endpoint
+// HTTP response body.
+type WebhookResponseBody []*JobResponse
+
+// GetJobResponseBody is the type of the "catalog" service "GetJob" endpoint
+// HTTP response body.
+type GetJobResponseBody struct {
+	// id of the job
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the catalog
+	CatalogName *string `form:"catalogName,omitempty" json:"catalogName,omitempty" xml:"catalogName,omitempty"`
+	// status of the job
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Time at which the job was queued
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Time at which the job started to run
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Time at which the job finished
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Commit of the catalog synced by the job
+	Sha *string `form:"sha,omitempty" json:"sha,omitempty" xml:"sha,omitempty"`
+	// Cause of the failure of the job
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// ListJobsResponseBody is the type of the "catalog" service "ListJobs"
+// endpoint HTTP response body.
+type ListJobsResponseBody []*JobResponse
+
+// CancelJobResponseBody is the type of the "catalog" service "CancelJob"
+// endpoint HTTP response body.
+type CancelJobResponseBody struct {
+	// id of the job
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the catalog
+	CatalogName *string `form:"catalogName,omitempty" json:"catalogName,omitempty" xml:"catalogName,omitempty"`
+	// status of the job
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Time at which the job was queued
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Time at which the job started to run
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Time at which the job finished
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Commit of the catalog synced by the job
+	Sha *string `form:"sha,omitempty" json:"sha,omitempty" xml:"sha,omitempty"`
+	// Cause of the failure of the job
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
 // CatalogErrorResponseBody is the type of the "catalog" service "CatalogError"
 // endpoint HTTP response body.
 type CatalogErrorResponseBody struct {
@@ -89,9 +249,9 @@ type RefreshAllInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// CatalogErrorInternalErrorResponseBody is the type of the "catalog" service
-// "CatalogError" endpoint HTTP response body for the "internal-error" error.
-type CatalogErrorInternalErrorResponseBody struct {
+// CreateInvalidPayloadResponseBody is the type of the "catalog" service
+// "Create" endpoint HTTP response body for the "invalid-payload" error.
+type CreateInvalidPayloadResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -107,137 +267,1310 @@ type CatalogErrorInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// JobResponse is used to define fields on response body types.
-type JobResponse struct {
-	// id of the job
-	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Name of the catalog
-	CatalogName *string `form:"catalogName,omitempty" json:"catalogName,omitempty" xml:"catalogName,omitempty"`
-	// status of the job
-	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+// CreateAlreadyExistsResponseBody is the type of the "catalog" service
+// "Create" endpoint HTTP response body for the "already-exists" error.
+type CreateAlreadyExistsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// CatalogErrorsResponseBody is used to define fields on response body types.
-type CatalogErrorsResponseBody struct {
-	// Catalog Errror type
-	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
-	// Catalog Error message
-	Errors []string `form:"errors,omitempty" json:"errors,omitempty" xml:"errors,omitempty"`
+// CreateInternalErrorResponseBody is the type of the "catalog" service
+// "Create" endpoint HTTP response body for the "internal-error" error.
+type CreateInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// NewRefreshJobOK builds a "catalog" service "Refresh" endpoint result from a
-// HTTP "OK" response.
-func NewRefreshJobOK(body *RefreshResponseBody) *catalogviews.JobView {
-	v := &catalogviews.JobView{
-		ID:          body.ID,
-		CatalogName: body.CatalogName,
-		Status:      body.Status,
+// UpdateInvalidPayloadResponseBody is the type of the "catalog" service
+// "Update" endpoint HTTP response body for the "invalid-payload" error.
+type UpdateInvalidPayloadResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateNotFoundResponseBody is the type of the "catalog" service "Update"
+// endpoint HTTP response body for the "not-found" error.
+type UpdateNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateInternalErrorResponseBody is the type of the "catalog" service
+// "Update" endpoint HTTP response body for the "internal-error" error.
+type UpdateInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteNotFoundResponseBody is the type of the "catalog" service "Delete"
+// endpoint HTTP response body for the "not-found" error.
+type DeleteNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteInternalErrorResponseBody is the type of the "catalog" service
+// "Delete" endpoint HTTP response body for the "internal-error" error.
+type DeleteInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// WebhookInvalidPayloadResponseBody is the type of the "catalog" service
+// "Webhook" endpoint HTTP response body for the "invalid-payload" error.
+type WebhookInvalidPayloadResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// WebhookInvalidSignatureResponseBody is the type of the "catalog" service
+// "Webhook" endpoint HTTP response body for the "invalid-signature" error.
+type WebhookInvalidSignatureResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// WebhookInternalErrorResponseBody is the type of the "catalog" service
+// "Webhook" endpoint HTTP response body for the "internal-error" error.
+type WebhookInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetJobNotFoundResponseBody is the type of the "catalog" service "GetJob"
+// endpoint HTTP response body for the "not-found" error.
+type GetJobNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetJobInternalErrorResponseBody is the type of the "catalog" service
+// "GetJob" endpoint HTTP response body for the "internal-error" error.
+type GetJobInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListJobsNotFoundResponseBody is the type of the "catalog" service "ListJobs"
+// endpoint HTTP response body for the "not-found" error.
+type ListJobsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListJobsInternalErrorResponseBody is the type of the "catalog" service
+// "ListJobs" endpoint HTTP response body for the "internal-error" error.
+type ListJobsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CancelJobNotFoundResponseBody is the type of the "catalog" service
+// "CancelJob" endpoint HTTP response body for the "not-found" error.
+type CancelJobNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CancelJobInvalidStateResponseBody is the type of the "catalog" service
+// "CancelJob" endpoint HTTP response body for the "invalid-state" error.
+type CancelJobInvalidStateResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CancelJobInternalErrorResponseBody is the type of the "catalog" service
+// "CancelJob" endpoint HTTP response body for the "internal-error" error.
+type CancelJobInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CatalogErrorInternalErrorResponseBody is the type of the "catalog" service
+// "CatalogError" endpoint HTTP response body for the "internal-error" error.
+type CatalogErrorInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// JobResponse is used to define fields on response body types.
+type JobResponse struct {
+	// id of the job
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the catalog
+	CatalogName *string `form:"catalogName,omitempty" json:"catalogName,omitempty" xml:"catalogName,omitempty"`
+	// status of the job
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Time at which the job was queued
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Time at which the job started to run
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Time at which the job finished
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Commit of the catalog synced by the job
+	Sha *string `form:"sha,omitempty" json:"sha,omitempty" xml:"sha,omitempty"`
+	// Cause of the failure of the job
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// CatalogErrorsResponseBody is used to define fields on response body types.
+type CatalogErrorsResponseBody struct {
+	// Catalog Errror type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Catalog Error message
+	Errors []string `form:"errors,omitempty" json:"errors,omitempty" xml:"errors,omitempty"`
+}
+
+// NewCreateRequestBody builds the HTTP request body from the payload of the
+// "Create" endpoint of the "catalog" service.
+func NewCreateRequestBody(p *catalog.CreatePayload) *CreateRequestBody {
+	body := &CreateRequestBody{
+		Name:                  p.Name,
+		Org:                   p.Org,
+		Type:                  p.Type,
+		Provider:              p.Provider,
+		URL:                   p.URL,
+		SSHURL:                p.SSHURL,
+		Revision:              p.Revision,
+		ContextDir:            p.ContextDir,
+		WebhookSecret:         p.WebhookSecret,
+		Credential:            p.Credential,
+		InsecureSkipTLSVerify: p.InsecureSkipTLSVerify,
+		Private:               p.Private,
+		Scope:                 p.Scope,
+	}
+	{
+		var zero string
+		if body.Provider == zero {
+			body.Provider = "github"
+		}
+	}
+	return body
+}
+
+// NewUpdateRequestBody builds the HTTP request body from the payload of the
+// "Update" endpoint of the "catalog" service.
+func NewUpdateRequestBody(p *catalog.UpdatePayload) *UpdateRequestBody {
+	body := &UpdateRequestBody{
+		Provider:              p.Provider,
+		URL:                   p.URL,
+		SSHURL:                p.SSHURL,
+		Revision:              p.Revision,
+		ContextDir:            p.ContextDir,
+		WebhookSecret:         p.WebhookSecret,
+		Credential:            p.Credential,
+		InsecureSkipTLSVerify: p.InsecureSkipTLSVerify,
+		Private:               p.Private,
+		Scope:                 p.Scope,
+	}
+	return body
+}
+
+// NewRefreshJobOK builds a "catalog" service "Refresh" endpoint result from a
+// HTTP "OK" response.
+func NewRefreshJobOK(body *RefreshResponseBody) *catalogviews.JobView {
+	v := &catalogviews.JobView{
+		ID:          body.ID,
+		CatalogName: body.CatalogName,
+		Status:      body.Status,
+		CreatedAt:   body.CreatedAt,
+		StartedAt:   body.StartedAt,
+		FinishedAt:  body.FinishedAt,
+		Sha:         body.Sha,
+		Reason:      body.Reason,
+	}
+
+	return v
+}
+
+// NewRefreshNotFound builds a catalog service Refresh endpoint not-found error.
+func NewRefreshNotFound(body *RefreshNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRefreshInternalError builds a catalog service Refresh endpoint
+// internal-error error.
+func NewRefreshInternalError(body *RefreshInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRefreshAllJobOK builds a "catalog" service "RefreshAll" endpoint result
+// from a HTTP "OK" response.
+func NewRefreshAllJobOK(body []*JobResponse) []*catalog.Job {
+	v := make([]*catalog.Job, len(body))
+	for i, val := range body {
+		v[i] = unmarshalJobResponseToCatalogJob(val)
+	}
+
+	return v
+}
+
+// NewRefreshAllInternalError builds a catalog service RefreshAll endpoint
+// internal-error error.
+func NewRefreshAllInternalError(body *RefreshAllInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateJobOK builds a "catalog" service "Create" endpoint result from a
+// HTTP "OK" response.
+func NewCreateJobOK(body *CreateResponseBody) *catalogviews.JobView {
+	v := &catalogviews.JobView{
+		ID:          body.ID,
+		CatalogName: body.CatalogName,
+		Status:      body.Status,
+		CreatedAt:   body.CreatedAt,
+		StartedAt:   body.StartedAt,
+		FinishedAt:  body.FinishedAt,
+		Sha:         body.Sha,
+		Reason:      body.Reason,
+	}
+
+	return v
+}
+
+// NewCreateInvalidPayload builds a catalog service Create endpoint
+// invalid-payload error.
+func NewCreateInvalidPayload(body *CreateInvalidPayloadResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateAlreadyExists builds a catalog service Create endpoint
+// already-exists error.
+func NewCreateAlreadyExists(body *CreateAlreadyExistsResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateInternalError builds a catalog service Create endpoint
+// internal-error error.
+func NewCreateInternalError(body *CreateInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateJobOK builds a "catalog" service "Update" endpoint result from a
+// HTTP "OK" response.
+func NewUpdateJobOK(body *UpdateResponseBody) *catalogviews.JobView {
+	v := &catalogviews.JobView{
+		ID:          body.ID,
+		CatalogName: body.CatalogName,
+		Status:      body.Status,
+		CreatedAt:   body.CreatedAt,
+		StartedAt:   body.StartedAt,
+		FinishedAt:  body.FinishedAt,
+		Sha:         body.Sha,
+		Reason:      body.Reason,
+	}
+
+	return v
+}
+
+// NewUpdateInvalidPayload builds a catalog service Update endpoint
+// invalid-payload error.
+func NewUpdateInvalidPayload(body *UpdateInvalidPayloadResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateNotFound builds a catalog service Update endpoint not-found error.
+func NewUpdateNotFound(body *UpdateNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateInternalError builds a catalog service Update endpoint
+// internal-error error.
+func NewUpdateInternalError(body *UpdateInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteNotFound builds a catalog service Delete endpoint not-found error.
+func NewDeleteNotFound(body *DeleteNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteInternalError builds a catalog service Delete endpoint
+// internal-error error.
+func NewDeleteInternalError(body *DeleteInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewWebhookJobOK builds a "catalog" service "Webhook" endpoint result from a
+// HTTP "OK" response.
+func NewWebhookJobOK(body []*JobResponse) []*catalog.Job {
+	v := make([]*catalog.Job, len(body))
+	for i, val := range body {
+		v[i] = unmarshalJobResponseToCatalogJob(val)
+	}
+
+	return v
+}
+
+// NewWebhookInvalidPayload builds a catalog service Webhook endpoint
+// invalid-payload error.
+func NewWebhookInvalidPayload(body *WebhookInvalidPayloadResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewWebhookInvalidSignature builds a catalog service Webhook endpoint
+// invalid-signature error.
+func NewWebhookInvalidSignature(body *WebhookInvalidSignatureResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewWebhookInternalError builds a catalog service Webhook endpoint
+// internal-error error.
+func NewWebhookInternalError(body *WebhookInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetJobJobOK builds a "catalog" service "GetJob" endpoint result from a
+// HTTP "OK" response.
+func NewGetJobJobOK(body *GetJobResponseBody) *catalogviews.JobView {
+	v := &catalogviews.JobView{
+		ID:          body.ID,
+		CatalogName: body.CatalogName,
+		Status:      body.Status,
+		CreatedAt:   body.CreatedAt,
+		StartedAt:   body.StartedAt,
+		FinishedAt:  body.FinishedAt,
+		Sha:         body.Sha,
+		Reason:      body.Reason,
+	}
+
+	return v
+}
+
+// NewGetJobNotFound builds a catalog service GetJob endpoint not-found error.
+func NewGetJobNotFound(body *GetJobNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetJobInternalError builds a catalog service GetJob endpoint
+// internal-error error.
+func NewGetJobInternalError(body *GetJobInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListJobsJobOK builds a "catalog" service "ListJobs" endpoint result from
+// a HTTP "OK" response.
+func NewListJobsJobOK(body []*JobResponse) []*catalog.Job {
+	v := make([]*catalog.Job, len(body))
+	for i, val := range body {
+		v[i] = unmarshalJobResponseToCatalogJob(val)
+	}
+
+	return v
+}
+
+// NewListJobsNotFound builds a catalog service ListJobs endpoint not-found
+// error.
+func NewListJobsNotFound(body *ListJobsNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListJobsInternalError builds a catalog service ListJobs endpoint
+// internal-error error.
+func NewListJobsInternalError(body *ListJobsInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCancelJobJobOK builds a "catalog" service "CancelJob" endpoint result
+// from a HTTP "OK" response.
+func NewCancelJobJobOK(body *CancelJobResponseBody) *catalogviews.JobView {
+	v := &catalogviews.JobView{
+		ID:          body.ID,
+		CatalogName: body.CatalogName,
+		Status:      body.Status,
+		CreatedAt:   body.CreatedAt,
+		StartedAt:   body.StartedAt,
+		FinishedAt:  body.FinishedAt,
+		Sha:         body.Sha,
+		Reason:      body.Reason,
+	}
+
+	return v
+}
+
+// NewCancelJobNotFound builds a catalog service CancelJob endpoint not-found
+// error.
+func NewCancelJobNotFound(body *CancelJobNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCancelJobInvalidState builds a catalog service CancelJob endpoint
+// invalid-state error.
+func NewCancelJobInvalidState(body *CancelJobInvalidStateResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCancelJobInternalError builds a catalog service CancelJob endpoint
+// internal-error error.
+func NewCancelJobInternalError(body *CancelJobInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCatalogErrorResultOK builds a "catalog" service "CatalogError" endpoint
+// result from a HTTP "OK" response.
+func NewCatalogErrorResultOK(body *CatalogErrorResponseBody) *catalog.CatalogErrorResult {
+	v := &catalog.CatalogErrorResult{}
+	v.Data = make([]*catalog.CatalogErrors, len(body.Data))
+	for i, val := range body.Data {
+		v.Data[i] = unmarshalCatalogErrorsResponseBodyToCatalogCatalogErrors(val)
+	}
+
+	return v
+}
+
+// NewCatalogErrorInternalError builds a catalog service CatalogError endpoint
+// internal-error error.
+func NewCatalogErrorInternalError(body *CatalogErrorInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// ValidateCatalogErrorResponseBody runs the validations defined on
+// CatalogErrorResponseBody
+func ValidateCatalogErrorResponseBody(body *CatalogErrorResponseBody) (err error) {
+	if body.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "body"))
+	}
+	for _, e := range body.Data {
+		if e != nil {
+			if err2 := ValidateCatalogErrorsResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRefreshNotFoundResponseBody runs the validations defined on
+// Refresh_not-found_Response_Body
+func ValidateRefreshNotFoundResponseBody(body *RefreshNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRefreshInternalErrorResponseBody runs the validations defined on
+// Refresh_internal-error_Response_Body
+func ValidateRefreshInternalErrorResponseBody(body *RefreshInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRefreshAllInternalErrorResponseBody runs the validations defined on
+// RefreshAll_internal-error_Response_Body
+func ValidateRefreshAllInternalErrorResponseBody(body *RefreshAllInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateInvalidPayloadResponseBody runs the validations defined on
+// Create_invalid-payload_Response_Body
+func ValidateCreateInvalidPayloadResponseBody(body *CreateInvalidPayloadResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateAlreadyExistsResponseBody runs the validations defined on
+// Create_already-exists_Response_Body
+func ValidateCreateAlreadyExistsResponseBody(body *CreateAlreadyExistsResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateInternalErrorResponseBody runs the validations defined on
+// Create_internal-error_Response_Body
+func ValidateCreateInternalErrorResponseBody(body *CreateInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateInvalidPayloadResponseBody runs the validations defined on
+// Update_invalid-payload_Response_Body
+func ValidateUpdateInvalidPayloadResponseBody(body *UpdateInvalidPayloadResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateNotFoundResponseBody runs the validations defined on
+// Update_not-found_Response_Body
+func ValidateUpdateNotFoundResponseBody(body *UpdateNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateInternalErrorResponseBody runs the validations defined on
+// Update_internal-error_Response_Body
+func ValidateUpdateInternalErrorResponseBody(body *UpdateInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-
-	return v
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewRefreshNotFound builds a catalog service Refresh endpoint not-found error.
-func NewRefreshNotFound(body *RefreshNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDeleteNotFoundResponseBody runs the validations defined on
+// Delete_not-found_Response_Body
+func ValidateDeleteNotFoundResponseBody(body *DeleteNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewRefreshInternalError builds a catalog service Refresh endpoint
-// internal-error error.
-func NewRefreshInternalError(body *RefreshInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDeleteInternalErrorResponseBody runs the validations defined on
+// Delete_internal-error_Response_Body
+func ValidateDeleteInternalErrorResponseBody(body *DeleteInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewRefreshAllJobOK builds a "catalog" service "RefreshAll" endpoint result
-// from a HTTP "OK" response.
-func NewRefreshAllJobOK(body []*JobResponse) []*catalog.Job {
-	v := make([]*catalog.Job, len(body))
-	for i, val := range body {
-		v[i] = unmarshalJobResponseToCatalogJob(val)
+// ValidateWebhookInvalidPayloadResponseBody runs the validations defined on
+// Webhook_invalid-payload_Response_Body
+func ValidateWebhookInvalidPayloadResponseBody(body *WebhookInvalidPayloadResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewRefreshAllInternalError builds a catalog service RefreshAll endpoint
-// internal-error error.
-func NewRefreshAllInternalError(body *RefreshAllInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateWebhookInvalidSignatureResponseBody runs the validations defined on
+// Webhook_invalid-signature_Response_Body
+func ValidateWebhookInvalidSignatureResponseBody(body *WebhookInvalidSignatureResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewCatalogErrorResultOK builds a "catalog" service "CatalogError" endpoint
-// result from a HTTP "OK" response.
-func NewCatalogErrorResultOK(body *CatalogErrorResponseBody) *catalog.CatalogErrorResult {
-	v := &catalog.CatalogErrorResult{}
-	v.Data = make([]*catalog.CatalogErrors, len(body.Data))
-	for i, val := range body.Data {
-		v.Data[i] = unmarshalCatalogErrorsResponseBodyToCatalogCatalogErrors(val)
+// ValidateWebhookInternalErrorResponseBody runs the validations defined on
+// Webhook_internal-error_Response_Body
+func ValidateWebhookInternalErrorResponseBody(body *WebhookInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
+	return
+}
 
-	return v
+// ValidateGetJobNotFoundResponseBody runs the validations defined on
+// GetJob_not-found_Response_Body
+func ValidateGetJobNotFoundResponseBody(body *GetJobNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewCatalogErrorInternalError builds a catalog service CatalogError endpoint
-// internal-error error.
-func NewCatalogErrorInternalError(body *CatalogErrorInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateGetJobInternalErrorResponseBody runs the validations defined on
+// GetJob_internal-error_Response_Body
+func ValidateGetJobInternalErrorResponseBody(body *GetJobInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
 
-	return v
+// ValidateListJobsNotFoundResponseBody runs the validations defined on
+// ListJobs_not-found_Response_Body
+func ValidateListJobsNotFoundResponseBody(body *ListJobsNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// ValidateCatalogErrorResponseBody runs the validations defined on
-// CatalogErrorResponseBody
-func ValidateCatalogErrorResponseBody(body *CatalogErrorResponseBody) (err error) {
-	if body.Data == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("data", "body"))
+// ValidateListJobsInternalErrorResponseBody runs the validations defined on
+// ListJobs_internal-error_Response_Body
+func ValidateListJobsInternalErrorResponseBody(body *ListJobsInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	for _, e := range body.Data {
-		if e != nil {
-			if err2 := ValidateCatalogErrorsResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
 	return
 }
 
-// ValidateRefreshNotFoundResponseBody runs the validations defined on
-// Refresh_not-found_Response_Body
-func ValidateRefreshNotFoundResponseBody(body *RefreshNotFoundResponseBody) (err error) {
+// ValidateCancelJobNotFoundResponseBody runs the validations defined on
+// CancelJob_not-found_Response_Body
+func ValidateCancelJobNotFoundResponseBody(body *CancelJobNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -259,9 +1592,9 @@ func ValidateRefreshNotFoundResponseBody(body *RefreshNotFoundResponseBody) (err
 	return
 }
 
-// ValidateRefreshInternalErrorResponseBody runs the validations defined on
-// Refresh_internal-error_Response_Body
-func ValidateRefreshInternalErrorResponseBody(body *RefreshInternalErrorResponseBody) (err error) {
+// ValidateCancelJobInvalidStateResponseBody runs the validations defined on
+// CancelJob_invalid-state_Response_Body
+func ValidateCancelJobInvalidStateResponseBody(body *CancelJobInvalidStateResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -283,9 +1616,9 @@ func ValidateRefreshInternalErrorResponseBody(body *RefreshInternalErrorResponse
 	return
 }
 
-// ValidateRefreshAllInternalErrorResponseBody runs the validations defined on
-// RefreshAll_internal-error_Response_Body
-func ValidateRefreshAllInternalErrorResponseBody(body *RefreshAllInternalErrorResponseBody) (err error) {
+// ValidateCancelJobInternalErrorResponseBody runs the validations defined on
+// CancelJob_internal-error_Response_Body
+func ValidateCancelJobInternalErrorResponseBody(body *CancelJobInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -342,6 +1675,15 @@ func ValidateJobResponse(body *JobResponse) (err error) {
 	if body.Status == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
 	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.StartedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.startedAt", *body.StartedAt, goa.FormatDateTime))
+	}
+	if body.FinishedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.finishedAt", *body.FinishedAt, goa.FormatDateTime))
+	}
 	return
 }
 
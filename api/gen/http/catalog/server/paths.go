@@ -21,6 +21,41 @@ func RefreshAllCatalogPath() string {
 	return "/catalog/refresh"
 }
 
+// CreateCatalogPath returns the URL path to the catalog service Create HTTP endpoint.
+func CreateCatalogPath() string {
+	return "/catalog"
+}
+
+// UpdateCatalogPath returns the URL path to the catalog service Update HTTP endpoint.
+func UpdateCatalogPath(catalogName string) string {
+	return fmt.Sprintf("/catalog/%v", catalogName)
+}
+
+// DeleteCatalogPath returns the URL path to the catalog service Delete HTTP endpoint.
+func DeleteCatalogPath(catalogName string) string {
+	return fmt.Sprintf("/catalog/%v", catalogName)
+}
+
+// WebhookCatalogPath returns the URL path to the catalog service Webhook HTTP endpoint.
+func WebhookCatalogPath(provider string) string {
+	return fmt.Sprintf("/catalog/webhook/%v", provider)
+}
+
+// GetJobCatalogPath returns the URL path to the catalog service GetJob HTTP endpoint.
+func GetJobCatalogPath(id uint) string {
+	return fmt.Sprintf("/catalog/job/%v", id)
+}
+
+// ListJobsCatalogPath returns the URL path to the catalog service ListJobs HTTP endpoint.
+func ListJobsCatalogPath(catalogName string) string {
+	return fmt.Sprintf("/catalog/%v/jobs", catalogName)
+}
+
+// CancelJobCatalogPath returns the URL path to the catalog service CancelJob HTTP endpoint.
+func CancelJobCatalogPath(id uint) string {
+	return fmt.Sprintf("/catalog/job/%v", id)
+}
+
 // CatalogErrorCatalogPath returns the URL path to the catalog service CatalogError HTTP endpoint.
 func CatalogErrorCatalogPath(catalogName string) string {
 	return fmt.Sprintf("/catalog/%v/error", catalogName)
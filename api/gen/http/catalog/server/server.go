@@ -22,6 +22,13 @@ type Server struct {
 	Mounts       []*MountPoint
 	Refresh      http.Handler
 	RefreshAll   http.Handler
+	Create       http.Handler
+	Update       http.Handler
+	Delete       http.Handler
+	Webhook      http.Handler
+	GetJob       http.Handler
+	ListJobs     http.Handler
+	CancelJob    http.Handler
 	CatalogError http.Handler
 	CORS         http.Handler
 }
@@ -55,13 +62,32 @@ func New(
 		Mounts: []*MountPoint{
 			{"Refresh", "POST", "/catalog/{catalogName}/refresh"},
 			{"RefreshAll", "POST", "/catalog/refresh"},
+			{"Create", "POST", "/catalog"},
+			{"Update", "PUT", "/catalog/{catalogName}"},
+			{"Delete", "DELETE", "/catalog/{catalogName}"},
+			{"Webhook", "POST", "/catalog/webhook/{provider}"},
+			{"GetJob", "GET", "/catalog/job/{id}"},
+			{"ListJobs", "GET", "/catalog/{catalogName}/jobs"},
+			{"CancelJob", "DELETE", "/catalog/job/{id}"},
 			{"CatalogError", "GET", "/catalog/{catalogName}/error"},
 			{"CORS", "OPTIONS", "/catalog/{catalogName}/refresh"},
 			{"CORS", "OPTIONS", "/catalog/refresh"},
+			{"CORS", "OPTIONS", "/catalog"},
+			{"CORS", "OPTIONS", "/catalog/{catalogName}"},
+			{"CORS", "OPTIONS", "/catalog/webhook/{provider}"},
+			{"CORS", "OPTIONS", "/catalog/job/{id}"},
+			{"CORS", "OPTIONS", "/catalog/{catalogName}/jobs"},
 			{"CORS", "OPTIONS", "/catalog/{catalogName}/error"},
 		},
 		Refresh:      NewRefreshHandler(e.Refresh, mux, decoder, encoder, errhandler, formatter),
 		RefreshAll:   NewRefreshAllHandler(e.RefreshAll, mux, decoder, encoder, errhandler, formatter),
+		Create:       NewCreateHandler(e.Create, mux, decoder, encoder, errhandler, formatter),
+		Update:       NewUpdateHandler(e.Update, mux, decoder, encoder, errhandler, formatter),
+		Delete:       NewDeleteHandler(e.Delete, mux, decoder, encoder, errhandler, formatter),
+		Webhook:      NewWebhookHandler(e.Webhook, mux, decoder, encoder, errhandler, formatter),
+		GetJob:       NewGetJobHandler(e.GetJob, mux, decoder, encoder, errhandler, formatter),
+		ListJobs:     NewListJobsHandler(e.ListJobs, mux, decoder, encoder, errhandler, formatter),
+		CancelJob:    NewCancelJobHandler(e.CancelJob, mux, decoder, encoder, errhandler, formatter),
 		CatalogError: NewCatalogErrorHandler(e.CatalogError, mux, decoder, encoder, errhandler, formatter),
 		CORS:         NewCORSHandler(),
 	}
@@ -74,6 +100,13 @@ func (s *Server) Service() string { return "catalog" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Refresh = m(s.Refresh)
 	s.RefreshAll = m(s.RefreshAll)
+	s.Create = m(s.Create)
+	s.Update = m(s.Update)
+	s.Delete = m(s.Delete)
+	s.Webhook = m(s.Webhook)
+	s.GetJob = m(s.GetJob)
+	s.ListJobs = m(s.ListJobs)
+	s.CancelJob = m(s.CancelJob)
 	s.CatalogError = m(s.CatalogError)
 	s.CORS = m(s.CORS)
 }
@@ -85,6 +118,13 @@ func (s *Server) MethodNames() []string { return catalog.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountRefreshHandler(mux, h.Refresh)
 	MountRefreshAllHandler(mux, h.RefreshAll)
+	MountCreateHandler(mux, h.Create)
+	MountUpdateHandler(mux, h.Update)
+	MountDeleteHandler(mux, h.Delete)
+	MountWebhookHandler(mux, h.Webhook)
+	MountGetJobHandler(mux, h.GetJob)
+	MountListJobsHandler(mux, h.ListJobs)
+	MountCancelJobHandler(mux, h.CancelJob)
 	MountCatalogErrorHandler(mux, h.CatalogError)
 	MountCORSHandler(mux, h.CORS)
 }
@@ -196,6 +236,364 @@ func NewRefreshAllHandler(
 	})
 }
 
+// MountCreateHandler configures the mux to serve the "catalog" service
+// "Create" endpoint.
+func MountCreateHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleCatalogOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/catalog", f)
+}
+
+// NewCreateHandler creates a HTTP handler which loads the HTTP request and
+// calls the "catalog" service "Create" endpoint.
+func NewCreateHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateRequest(mux, decoder)
+		encodeResponse = EncodeCreateResponse(encoder)
+		encodeError    = EncodeCreateError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Create")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "catalog")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountUpdateHandler configures the mux to serve the "catalog" service
+// "Update" endpoint.
+func MountUpdateHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleCatalogOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/catalog/{catalogName}", f)
+}
+
+// NewUpdateHandler creates a HTTP handler which loads the HTTP request and
+// calls the "catalog" service "Update" endpoint.
+func NewUpdateHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateRequest(mux, decoder)
+		encodeResponse = EncodeUpdateResponse(encoder)
+		encodeError    = EncodeUpdateError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Update")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "catalog")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteHandler configures the mux to serve the "catalog" service
+// "Delete" endpoint.
+func MountDeleteHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleCatalogOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/catalog/{catalogName}", f)
+}
+
+// NewDeleteHandler creates a HTTP handler which loads the HTTP request and
+// calls the "catalog" service "Delete" endpoint.
+func NewDeleteHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteRequest(mux, decoder)
+		encodeResponse = EncodeDeleteResponse(encoder)
+		encodeError    = EncodeDeleteError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Delete")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "catalog")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountWebhookHandler configures the mux to serve the "catalog" service
+// "Webhook" endpoint.
+func MountWebhookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleCatalogOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/catalog/webhook/{provider}", f)
+}
+
+// NewWebhookHandler creates a HTTP handler which loads the HTTP request and
+// calls the "catalog" service "Webhook" endpoint.
+func NewWebhookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeWebhookRequest(mux, decoder)
+		encodeResponse = EncodeWebhookResponse(encoder)
+		encodeError    = EncodeWebhookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Webhook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "catalog")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		data := &catalog.WebhookRequestData{Payload: payload.(*catalog.WebhookPayload), Body: r.Body}
+		res, err := endpoint(ctx, data)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetJobHandler configures the mux to serve the "catalog" service
+// "GetJob" endpoint.
+func MountGetJobHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleCatalogOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/catalog/job/{id}", f)
+}
+
+// NewGetJobHandler creates a HTTP handler which loads the HTTP request and
+// calls the "catalog" service "GetJob" endpoint.
+func NewGetJobHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetJobRequest(mux, decoder)
+		encodeResponse = EncodeGetJobResponse(encoder)
+		encodeError    = EncodeGetJobError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetJob")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "catalog")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListJobsHandler configures the mux to serve the "catalog" service
+// "ListJobs" endpoint.
+func MountListJobsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleCatalogOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/catalog/{catalogName}/jobs", f)
+}
+
+// NewListJobsHandler creates a HTTP handler which loads the HTTP request and
+// calls the "catalog" service "ListJobs" endpoint.
+func NewListJobsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListJobsRequest(mux, decoder)
+		encodeResponse = EncodeListJobsResponse(encoder)
+		encodeError    = EncodeListJobsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ListJobs")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "catalog")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCancelJobHandler configures the mux to serve the "catalog" service
+// "CancelJob" endpoint.
+func MountCancelJobHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleCatalogOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/catalog/job/{id}", f)
+}
+
+// NewCancelJobHandler creates a HTTP handler which loads the HTTP request and
+// calls the "catalog" service "CancelJob" endpoint.
+func NewCancelJobHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCancelJobRequest(mux, decoder)
+		encodeResponse = EncodeCancelJobResponse(encoder)
+		encodeError    = EncodeCancelJobError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CancelJob")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "catalog")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountCatalogErrorHandler configures the mux to serve the "catalog" service
 // "CatalogError" endpoint.
 func MountCatalogErrorHandler(mux goahttp.Muxer, h http.Handler) {
@@ -253,6 +651,11 @@ func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
 	h = HandleCatalogOrigin(h)
 	mux.Handle("OPTIONS", "/catalog/{catalogName}/refresh", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/catalog/refresh", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/catalog", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/catalog/{catalogName}", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/catalog/webhook/{provider}", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/catalog/job/{id}", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/catalog/{catalogName}/jobs", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/catalog/{catalogName}/error", h.ServeHTTP)
 }
 
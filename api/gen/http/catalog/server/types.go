@@ -13,6 +13,64 @@ import (
 	goa "goa.design/goa/v3/pkg"
 )
 
+// CreateRequestBody is the type of the "catalog" service "Create" endpoint
+// HTTP request body.
+type CreateRequestBody struct {
+	// Name of catalog
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Organization of catalog
+	Org *string `form:"org,omitempty" json:"org,omitempty" xml:"org,omitempty"`
+	// Type of catalog
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Git provider of the catalog repository
+	Provider *string `form:"provider,omitempty" json:"provider,omitempty" xml:"provider,omitempty"`
+	// URL of the catalog repository
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// SSH URL of the catalog repository if it is private
+	SSHURL *string `form:"sshUrl,omitempty" json:"sshUrl,omitempty" xml:"sshUrl,omitempty"`
+	// Branch of the catalog repository
+	Revision *string `form:"revision,omitempty" json:"revision,omitempty" xml:"revision,omitempty"`
+	// Path to the resources in the catalog repository
+	ContextDir *string `form:"contextDir,omitempty" json:"contextDir,omitempty" xml:"contextDir,omitempty"`
+	// Secret of the push webhook of the catalog repository
+	WebhookSecret *string `form:"webhookSecret,omitempty" json:"webhookSecret,omitempty" xml:"webhookSecret,omitempty"`
+	// Name of the credential of the api used to fetch the catalog repository if it
+	// is private
+	Credential *string `form:"credential,omitempty" json:"credential,omitempty" xml:"credential,omitempty"`
+	// Skip the verification of the TLS certificate of the catalog repository
+	InsecureSkipTLSVerify *bool `form:"insecureSkipTLSVerify,omitempty" json:"insecureSkipTLSVerify,omitempty" xml:"insecureSkipTLSVerify,omitempty"`
+	// Show the resources of the catalog only to the users having its scope
+	Private *bool `form:"private,omitempty" json:"private,omitempty" xml:"private,omitempty"`
+	// Scope required to see the private catalog, catalog:private by default
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+}
+
+// UpdateRequestBody is the type of the "catalog" service "Update" endpoint
+// HTTP request body.
+type UpdateRequestBody struct {
+	// Git provider of the catalog repository
+	Provider *string `form:"provider,omitempty" json:"provider,omitempty" xml:"provider,omitempty"`
+	// URL of the catalog repository
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// SSH URL of the catalog repository if it is private
+	SSHURL *string `form:"sshUrl,omitempty" json:"sshUrl,omitempty" xml:"sshUrl,omitempty"`
+	// Branch of the catalog repository
+	Revision *string `form:"revision,omitempty" json:"revision,omitempty" xml:"revision,omitempty"`
+	// Path to the resources in the catalog repository
+	ContextDir *string `form:"contextDir,omitempty" json:"contextDir,omitempty" xml:"contextDir,omitempty"`
+	// Secret of the push webhook of the catalog repository
+	WebhookSecret *string `form:"webhookSecret,omitempty" json:"webhookSecret,omitempty" xml:"webhookSecret,omitempty"`
+	// Name of the credential of the api used to fetch the catalog repository if it
+	// is private
+	Credential *string `form:"credential,omitempty" json:"credential,omitempty" xml:"credential,omitempty"`
+	// Skip the verification of the TLS certificate of the catalog repository
+	InsecureSkipTLSVerify *bool `form:"insecureSkipTLSVerify,omitempty" json:"insecureSkipTLSVerify,omitempty" xml:"insecureSkipTLSVerify,omitempty"`
+	// Show the resources of the catalog only to the users having its scope
+	Private *bool `form:"private,omitempty" json:"private,omitempty" xml:"private,omitempty"`
+	// Scope required to see the private catalog, catalog:private by default
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+}
+
 // RefreshResponseBody is the type of the "catalog" service "Refresh" endpoint
 // HTTP response body.
 type RefreshResponseBody struct {
@@ -22,12 +80,114 @@ type RefreshResponseBody struct {
 	CatalogName string `form:"catalogName" json:"catalogName" xml:"catalogName"`
 	// status of the job
 	Status string `form:"status" json:"status" xml:"status"`
+	// Time at which the job was queued
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Time at which the job started to run
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Time at which the job finished
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Commit of the catalog synced by the job
+	Sha *string `form:"sha,omitempty" json:"sha,omitempty" xml:"sha,omitempty"`
+	// Cause of the failure of the job
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
 }
 
 // RefreshAllResponseBody is the type of the "catalog" service "RefreshAll"
 // endpoint HTTP response body.
 type RefreshAllResponseBody []*JobResponse
 
+// CreateResponseBody is the type of the "catalog" service "Create" endpoint
+// HTTP response body.
+type CreateResponseBody struct {
+	// id of the job
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Name of the catalog
+	CatalogName string `form:"catalogName" json:"catalogName" xml:"catalogName"`
+	// status of the job
+	Status string `form:"status" json:"status" xml:"status"`
+	// Time at which the job was queued
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Time at which the job started to run
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Time at which the job finished
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Commit of the catalog synced by the job
+	Sha *string `form:"sha,omitempty" json:"sha,omitempty" xml:"sha,omitempty"`
+	// Cause of the failure of the job
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// UpdateResponseBody is the type of the "catalog" service "Update" endpoint
+// HTTP response body.
+type UpdateResponseBody struct {
+	// id of the job
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Name of the catalog
+	CatalogName string `form:"catalogName" json:"catalogName" xml:"catalogName"`
+	// status of the job
+	Status string `form:"status" json:"status" xml:"status"`
+	// Time at which the job was queued
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Time at which the job started to run
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Time at which the job finished
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Commit of the catalog synced by the job
+	Sha *string `form:"sha,omitempty" json:"sha,omitempty" xml:"sha,omitempty"`
+	// Cause of the failure of the job
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// WebhookResponseBody is the type of the "catalog" service "Webhook" endpoint
+// HTTP response body.
+type WebhookResponseBody []*JobResponse
+
+// GetJobResponseBody is the type of the "catalog" service "GetJob" endpoint
+// HTTP response body.
+type GetJobResponseBody struct {
+	// id of the job
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Name of the catalog
+	CatalogName string `form:"catalogName" json:"catalogName" xml:"catalogName"`
+	// status of the job
+	Status string `form:"status" json:"status" xml:"status"`
+	// Time at which the job was queued
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Time at which the job started to run
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Time at which the job finished
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Commit of the catalog synced by the job
+	Sha *string `form:"sha,omitempty" json:"sha,omitempty" xml:"sha,omitempty"`
+	// Cause of the failure of the job
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// ListJobsResponseBody is the type of the "catalog" service "ListJobs"
+// endpoint HTTP response body.
+type ListJobsResponseBody []*JobResponse
+
+// CancelJobResponseBody is the type of the "catalog" service "CancelJob"
+// endpoint HTTP response body.
+type CancelJobResponseBody struct {
+	// id of the job
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Name of the catalog
+	CatalogName string `form:"catalogName" json:"catalogName" xml:"catalogName"`
+	// status of the job
+	Status string `form:"status" json:"status" xml:"status"`
+	// Time at which the job was queued
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Time at which the job started to run
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Time at which the job finished
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Commit of the catalog synced by the job
+	Sha *string `form:"sha,omitempty" json:"sha,omitempty" xml:"sha,omitempty"`
+	// Cause of the failure of the job
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
 // CatalogErrorResponseBody is the type of the "catalog" service "CatalogError"
 // endpoint HTTP response body.
 type CatalogErrorResponseBody struct {
@@ -89,6 +249,330 @@ type RefreshAllInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// CreateInvalidPayloadResponseBody is the type of the "catalog" service
+// "Create" endpoint HTTP response body for the "invalid-payload" error.
+type CreateInvalidPayloadResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateAlreadyExistsResponseBody is the type of the "catalog" service
+// "Create" endpoint HTTP response body for the "already-exists" error.
+type CreateAlreadyExistsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateInternalErrorResponseBody is the type of the "catalog" service
+// "Create" endpoint HTTP response body for the "internal-error" error.
+type CreateInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateInvalidPayloadResponseBody is the type of the "catalog" service
+// "Update" endpoint HTTP response body for the "invalid-payload" error.
+type UpdateInvalidPayloadResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateNotFoundResponseBody is the type of the "catalog" service "Update"
+// endpoint HTTP response body for the "not-found" error.
+type UpdateNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateInternalErrorResponseBody is the type of the "catalog" service
+// "Update" endpoint HTTP response body for the "internal-error" error.
+type UpdateInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteNotFoundResponseBody is the type of the "catalog" service "Delete"
+// endpoint HTTP response body for the "not-found" error.
+type DeleteNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteInternalErrorResponseBody is the type of the "catalog" service
+// "Delete" endpoint HTTP response body for the "internal-error" error.
+type DeleteInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// WebhookInvalidPayloadResponseBody is the type of the "catalog" service
+// "Webhook" endpoint HTTP response body for the "invalid-payload" error.
+type WebhookInvalidPayloadResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// WebhookInvalidSignatureResponseBody is the type of the "catalog" service
+// "Webhook" endpoint HTTP response body for the "invalid-signature" error.
+type WebhookInvalidSignatureResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// WebhookInternalErrorResponseBody is the type of the "catalog" service
+// "Webhook" endpoint HTTP response body for the "internal-error" error.
+type WebhookInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetJobNotFoundResponseBody is the type of the "catalog" service "GetJob"
+// endpoint HTTP response body for the "not-found" error.
+type GetJobNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetJobInternalErrorResponseBody is the type of the "catalog" service
+// "GetJob" endpoint HTTP response body for the "internal-error" error.
+type GetJobInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListJobsNotFoundResponseBody is the type of the "catalog" service "ListJobs"
+// endpoint HTTP response body for the "not-found" error.
+type ListJobsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListJobsInternalErrorResponseBody is the type of the "catalog" service
+// "ListJobs" endpoint HTTP response body for the "internal-error" error.
+type ListJobsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CancelJobNotFoundResponseBody is the type of the "catalog" service
+// "CancelJob" endpoint HTTP response body for the "not-found" error.
+type CancelJobNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CancelJobInvalidStateResponseBody is the type of the "catalog" service
+// "CancelJob" endpoint HTTP response body for the "invalid-state" error.
+type CancelJobInvalidStateResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CancelJobInternalErrorResponseBody is the type of the "catalog" service
+// "CancelJob" endpoint HTTP response body for the "internal-error" error.
+type CancelJobInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // CatalogErrorInternalErrorResponseBody is the type of the "catalog" service
 // "CatalogError" endpoint HTTP response body for the "internal-error" error.
 type CatalogErrorInternalErrorResponseBody struct {
@@ -115,6 +599,16 @@ type JobResponse struct {
 	CatalogName string `form:"catalogName" json:"catalogName" xml:"catalogName"`
 	// status of the job
 	Status string `form:"status" json:"status" xml:"status"`
+	// Time at which the job was queued
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Time at which the job started to run
+	StartedAt *string `form:"startedAt,omitempty" json:"startedAt,omitempty" xml:"startedAt,omitempty"`
+	// Time at which the job finished
+	FinishedAt *string `form:"finishedAt,omitempty" json:"finishedAt,omitempty" xml:"finishedAt,omitempty"`
+	// Commit of the catalog synced by the job
+	Sha *string `form:"sha,omitempty" json:"sha,omitempty" xml:"sha,omitempty"`
+	// Cause of the failure of the job
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
 }
 
 // CatalogErrorsResponseBody is used to define fields on response body types.
@@ -125,20 +619,93 @@ type CatalogErrorsResponseBody struct {
 	Errors []string `form:"errors" json:"errors" xml:"errors"`
 }
 
-// NewRefreshResponseBody builds the HTTP response body from the result of the
-// "Refresh" endpoint of the "catalog" service.
-func NewRefreshResponseBody(res *catalogviews.JobView) *RefreshResponseBody {
-	body := &RefreshResponseBody{
+// NewRefreshResponseBody builds the HTTP response body from the result of the
+// "Refresh" endpoint of the "catalog" service.
+func NewRefreshResponseBody(res *catalogviews.JobView) *RefreshResponseBody {
+	body := &RefreshResponseBody{
+		ID:          *res.ID,
+		CatalogName: *res.CatalogName,
+		Status:      *res.Status,
+		CreatedAt:   res.CreatedAt,
+		StartedAt:   res.StartedAt,
+		FinishedAt:  res.FinishedAt,
+		Sha:         res.Sha,
+		Reason:      res.Reason,
+	}
+	return body
+}
+
+// NewRefreshAllResponseBody builds the HTTP response body from the result of
+// the "RefreshAll" endpoint of the "catalog" service.
+func NewRefreshAllResponseBody(res []*catalog.Job) RefreshAllResponseBody {
+	body := make([]*JobResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalCatalogJobToJobResponse(val)
+	}
+	return body
+}
+
+// NewCreateResponseBody builds the HTTP response body from the result of the
+// "Create" endpoint of the "catalog" service.
+func NewCreateResponseBody(res *catalogviews.JobView) *CreateResponseBody {
+	body := &CreateResponseBody{
+		ID:          *res.ID,
+		CatalogName: *res.CatalogName,
+		Status:      *res.Status,
+		CreatedAt:   res.CreatedAt,
+		StartedAt:   res.StartedAt,
+		FinishedAt:  res.FinishedAt,
+		Sha:         res.Sha,
+		Reason:      res.Reason,
+	}
+	return body
+}
+
+// NewUpdateResponseBody builds the HTTP response body from the result of the
+// "Update" endpoint of the "catalog" service.
+func NewUpdateResponseBody(res *catalogviews.JobView) *UpdateResponseBody {
+	body := &UpdateResponseBody{
+		ID:          *res.ID,
+		CatalogName: *res.CatalogName,
+		Status:      *res.Status,
+		CreatedAt:   res.CreatedAt,
+		StartedAt:   res.StartedAt,
+		FinishedAt:  res.FinishedAt,
+		Sha:         res.Sha,
+		Reason:      res.Reason,
+	}
+	return body
+}
+
+// NewWebhookResponseBody builds the HTTP response body from the result of the
+// "Webhook" endpoint of the "catalog" service.
+func NewWebhookResponseBody(res []*catalog.Job) WebhookResponseBody {
+	body := make([]*JobResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalCatalogJobToJobResponse(val)
+	}
+	return body
+}
+
+// NewGetJobResponseBody builds the HTTP response body from the result of the
+// "GetJob" endpoint of the "catalog" service.
+func NewGetJobResponseBody(res *catalogviews.JobView) *GetJobResponseBody {
+	body := &GetJobResponseBody{
 		ID:          *res.ID,
 		CatalogName: *res.CatalogName,
 		Status:      *res.Status,
+		CreatedAt:   res.CreatedAt,
+		StartedAt:   res.StartedAt,
+		FinishedAt:  res.FinishedAt,
+		Sha:         res.Sha,
+		Reason:      res.Reason,
 	}
 	return body
 }
 
-// NewRefreshAllResponseBody builds the HTTP response body from the result of
-// the "RefreshAll" endpoint of the "catalog" service.
-func NewRefreshAllResponseBody(res []*catalog.Job) RefreshAllResponseBody {
+// NewListJobsResponseBody builds the HTTP response body from the result of the
+// "ListJobs" endpoint of the "catalog" service.
+func NewListJobsResponseBody(res []*catalog.Job) ListJobsResponseBody {
 	body := make([]*JobResponse, len(res))
 	for i, val := range res {
 		body[i] = marshalCatalogJobToJobResponse(val)
@@ -146,6 +713,22 @@ func NewRefreshAllResponseBody(res []*catalog.Job) RefreshAllResponseBody {
 	return body
 }
 
+// NewCancelJobResponseBody builds the HTTP response body from the result of
+// the "CancelJob" endpoint of the "catalog" service.
+func NewCancelJobResponseBody(res *catalogviews.JobView) *CancelJobResponseBody {
+	body := &CancelJobResponseBody{
+		ID:          *res.ID,
+		CatalogName: *res.CatalogName,
+		Status:      *res.Status,
+		CreatedAt:   res.CreatedAt,
+		StartedAt:   res.StartedAt,
+		FinishedAt:  res.FinishedAt,
+		Sha:         res.Sha,
+		Reason:      res.Reason,
+	}
+	return body
+}
+
 // NewCatalogErrorResponseBody builds the HTTP response body from the result of
 // the "CatalogError" endpoint of the "catalog" service.
 func NewCatalogErrorResponseBody(res *catalog.CatalogErrorResult) *CatalogErrorResponseBody {
@@ -203,6 +786,258 @@ func NewRefreshAllInternalErrorResponseBody(res *goa.ServiceError) *RefreshAllIn
 	return body
 }
 
+// NewCreateInvalidPayloadResponseBody builds the HTTP response body from the
+// result of the "Create" endpoint of the "catalog" service.
+func NewCreateInvalidPayloadResponseBody(res *goa.ServiceError) *CreateInvalidPayloadResponseBody {
+	body := &CreateInvalidPayloadResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateAlreadyExistsResponseBody builds the HTTP response body from the
+// result of the "Create" endpoint of the "catalog" service.
+func NewCreateAlreadyExistsResponseBody(res *goa.ServiceError) *CreateAlreadyExistsResponseBody {
+	body := &CreateAlreadyExistsResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateInternalErrorResponseBody builds the HTTP response body from the
+// result of the "Create" endpoint of the "catalog" service.
+func NewCreateInternalErrorResponseBody(res *goa.ServiceError) *CreateInternalErrorResponseBody {
+	body := &CreateInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateInvalidPayloadResponseBody builds the HTTP response body from the
+// result of the "Update" endpoint of the "catalog" service.
+func NewUpdateInvalidPayloadResponseBody(res *goa.ServiceError) *UpdateInvalidPayloadResponseBody {
+	body := &UpdateInvalidPayloadResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateNotFoundResponseBody builds the HTTP response body from the result
+// of the "Update" endpoint of the "catalog" service.
+func NewUpdateNotFoundResponseBody(res *goa.ServiceError) *UpdateNotFoundResponseBody {
+	body := &UpdateNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateInternalErrorResponseBody builds the HTTP response body from the
+// result of the "Update" endpoint of the "catalog" service.
+func NewUpdateInternalErrorResponseBody(res *goa.ServiceError) *UpdateInternalErrorResponseBody {
+	body := &UpdateInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteNotFoundResponseBody builds the HTTP response body from the result
+// of the "Delete" endpoint of the "catalog" service.
+func NewDeleteNotFoundResponseBody(res *goa.ServiceError) *DeleteNotFoundResponseBody {
+	body := &DeleteNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteInternalErrorResponseBody builds the HTTP response body from the
+// result of the "Delete" endpoint of the "catalog" service.
+func NewDeleteInternalErrorResponseBody(res *goa.ServiceError) *DeleteInternalErrorResponseBody {
+	body := &DeleteInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewWebhookInvalidPayloadResponseBody builds the HTTP response body from the
+// result of the "Webhook" endpoint of the "catalog" service.
+func NewWebhookInvalidPayloadResponseBody(res *goa.ServiceError) *WebhookInvalidPayloadResponseBody {
+	body := &WebhookInvalidPayloadResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewWebhookInvalidSignatureResponseBody builds the HTTP response body from
+// the result of the "Webhook" endpoint of the "catalog" service.
+func NewWebhookInvalidSignatureResponseBody(res *goa.ServiceError) *WebhookInvalidSignatureResponseBody {
+	body := &WebhookInvalidSignatureResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewWebhookInternalErrorResponseBody builds the HTTP response body from the
+// result of the "Webhook" endpoint of the "catalog" service.
+func NewWebhookInternalErrorResponseBody(res *goa.ServiceError) *WebhookInternalErrorResponseBody {
+	body := &WebhookInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetJobNotFoundResponseBody builds the HTTP response body from the result
+// of the "GetJob" endpoint of the "catalog" service.
+func NewGetJobNotFoundResponseBody(res *goa.ServiceError) *GetJobNotFoundResponseBody {
+	body := &GetJobNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetJobInternalErrorResponseBody builds the HTTP response body from the
+// result of the "GetJob" endpoint of the "catalog" service.
+func NewGetJobInternalErrorResponseBody(res *goa.ServiceError) *GetJobInternalErrorResponseBody {
+	body := &GetJobInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListJobsNotFoundResponseBody builds the HTTP response body from the
+// result of the "ListJobs" endpoint of the "catalog" service.
+func NewListJobsNotFoundResponseBody(res *goa.ServiceError) *ListJobsNotFoundResponseBody {
+	body := &ListJobsNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListJobsInternalErrorResponseBody builds the HTTP response body from the
+// result of the "ListJobs" endpoint of the "catalog" service.
+func NewListJobsInternalErrorResponseBody(res *goa.ServiceError) *ListJobsInternalErrorResponseBody {
+	body := &ListJobsInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCancelJobNotFoundResponseBody builds the HTTP response body from the
+// result of the "CancelJob" endpoint of the "catalog" service.
+func NewCancelJobNotFoundResponseBody(res *goa.ServiceError) *CancelJobNotFoundResponseBody {
+	body := &CancelJobNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCancelJobInvalidStateResponseBody builds the HTTP response body from the
+// result of the "CancelJob" endpoint of the "catalog" service.
+func NewCancelJobInvalidStateResponseBody(res *goa.ServiceError) *CancelJobInvalidStateResponseBody {
+	body := &CancelJobInvalidStateResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCancelJobInternalErrorResponseBody builds the HTTP response body from the
+// result of the "CancelJob" endpoint of the "catalog" service.
+func NewCancelJobInternalErrorResponseBody(res *goa.ServiceError) *CancelJobInternalErrorResponseBody {
+	body := &CancelJobInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewCatalogErrorInternalErrorResponseBody builds the HTTP response body from
 // the result of the "CatalogError" endpoint of the "catalog" service.
 func NewCatalogErrorInternalErrorResponseBody(res *goa.ServiceError) *CatalogErrorInternalErrorResponseBody {
@@ -218,17 +1053,117 @@ func NewCatalogErrorInternalErrorResponseBody(res *goa.ServiceError) *CatalogErr
 }
 
 // NewRefreshPayload builds a catalog service Refresh endpoint payload.
-func NewRefreshPayload(catalogName string, token string) *catalog.RefreshPayload {
+func NewRefreshPayload(catalogName string, full bool, token string) *catalog.RefreshPayload {
 	v := &catalog.RefreshPayload{}
 	v.CatalogName = catalogName
+	v.Full = full
 	v.Token = token
 
 	return v
 }
 
 // NewRefreshAllPayload builds a catalog service RefreshAll endpoint payload.
-func NewRefreshAllPayload(token string) *catalog.RefreshAllPayload {
+func NewRefreshAllPayload(full bool, token string) *catalog.RefreshAllPayload {
 	v := &catalog.RefreshAllPayload{}
+	v.Full = full
+	v.Token = token
+
+	return v
+}
+
+// NewCreatePayload builds a catalog service Create endpoint payload.
+func NewCreatePayload(body *CreateRequestBody, token string) *catalog.CreatePayload {
+	v := &catalog.CreatePayload{
+		Name:                  *body.Name,
+		Org:                   *body.Org,
+		Type:                  *body.Type,
+		URL:                   *body.URL,
+		SSHURL:                body.SSHURL,
+		Revision:              *body.Revision,
+		ContextDir:            body.ContextDir,
+		WebhookSecret:         body.WebhookSecret,
+		Credential:            body.Credential,
+		InsecureSkipTLSVerify: body.InsecureSkipTLSVerify,
+		Private:               body.Private,
+		Scope:                 body.Scope,
+	}
+	if body.Provider != nil {
+		v.Provider = *body.Provider
+	}
+	if body.Provider == nil {
+		v.Provider = "github"
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewUpdatePayload builds a catalog service Update endpoint payload.
+func NewUpdatePayload(body *UpdateRequestBody, catalogName string, token string) *catalog.UpdatePayload {
+	v := &catalog.UpdatePayload{
+		Provider:              body.Provider,
+		URL:                   body.URL,
+		SSHURL:                body.SSHURL,
+		Revision:              body.Revision,
+		ContextDir:            body.ContextDir,
+		WebhookSecret:         body.WebhookSecret,
+		Credential:            body.Credential,
+		InsecureSkipTLSVerify: body.InsecureSkipTLSVerify,
+		Private:               body.Private,
+		Scope:                 body.Scope,
+	}
+	v.CatalogName = catalogName
+	v.Token = token
+
+	return v
+}
+
+// NewDeletePayload builds a catalog service Delete endpoint payload.
+func NewDeletePayload(catalogName string, token string) *catalog.DeletePayload {
+	v := &catalog.DeletePayload{}
+	v.CatalogName = catalogName
+	v.Token = token
+
+	return v
+}
+
+// NewWebhookPayload builds a catalog service Webhook endpoint payload.
+func NewWebhookPayload(provider string, githubEvent *string, githubSignature *string, gitlabEvent *string, gitlabToken *string, bitbucketEvent *string, bitbucketSignature *string) *catalog.WebhookPayload {
+	v := &catalog.WebhookPayload{}
+	v.Provider = provider
+	v.GithubEvent = githubEvent
+	v.GithubSignature = githubSignature
+	v.GitlabEvent = gitlabEvent
+	v.GitlabToken = gitlabToken
+	v.BitbucketEvent = bitbucketEvent
+	v.BitbucketSignature = bitbucketSignature
+
+	return v
+}
+
+// NewGetJobPayload builds a catalog service GetJob endpoint payload.
+func NewGetJobPayload(id uint, token string) *catalog.GetJobPayload {
+	v := &catalog.GetJobPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewListJobsPayload builds a catalog service ListJobs endpoint payload.
+func NewListJobsPayload(catalogName string, limit uint, token string) *catalog.ListJobsPayload {
+	v := &catalog.ListJobsPayload{}
+	v.CatalogName = catalogName
+	v.Limit = limit
+	v.Token = token
+
+	return v
+}
+
+// NewCancelJobPayload builds a catalog service CancelJob endpoint payload.
+func NewCancelJobPayload(id uint, token string) *catalog.CancelJobPayload {
+	v := &catalog.CancelJobPayload{}
+	v.ID = id
 	v.Token = token
 
 	return v
@@ -243,3 +1178,44 @@ func NewCatalogErrorPayload(catalogName string, token string) *catalog.CatalogEr
 
 	return v
 }
+
+// ValidateCreateRequestBody runs the validations defined on CreateRequestBody
+func ValidateCreateRequestBody(body *CreateRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Org == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("org", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.Revision == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("revision", "body"))
+	}
+	if body.Provider != nil {
+		if !(*body.Provider == "github" || *body.Provider == "gitlab" || *body.Provider == "bitbucket") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.provider", *body.Provider, []any{"github", "gitlab", "bitbucket"}))
+		}
+	}
+	if body.Credential != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.credential", *body.Credential, "^([a-zA-Z0-9][a-zA-Z0-9._-]*)?$"))
+	}
+	return
+}
+
+// ValidateUpdateRequestBody runs the validations defined on UpdateRequestBody
+func ValidateUpdateRequestBody(body *UpdateRequestBody) (err error) {
+	if body.Provider != nil {
+		if !(*body.Provider == "github" || *body.Provider == "gitlab" || *body.Provider == "bitbucket") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.provider", *body.Provider, []any{"github", "gitlab", "bitbucket"}))
+		}
+	}
+	if body.Credential != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.credential", *body.Credential, "^([a-zA-Z0-9][a-zA-Z0-9._-]*)?$"))
+	}
+	return
+}
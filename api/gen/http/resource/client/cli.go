@@ -137,8 +137,8 @@ func BuildByCatalogKindNameVersionPayload(resourceByCatalogKindNameVersionCatalo
 	var kind string
 	{
 		kind = resourceByCatalogKindNameVersionKind
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		if err != nil {
 			return nil, err
@@ -191,8 +191,8 @@ func BuildByCatalogKindNamePayload(resourceByCatalogKindNameCatalog string, reso
 	var kind string
 	{
 		kind = resourceByCatalogKindNameKind
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		if err != nil {
 			return nil, err
@@ -535,6 +535,7 @@ func unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(v *Resourc
 		HubURLPath:    v.HubURLPath,
 		HubRawURLPath: v.HubRawURLPath,
 		Rating:        v.Rating,
+		Downloads:     v.Downloads,
 	}
 	res.Catalog = unmarshalCatalogResponseBodyToResourceviewsCatalogView(v.Catalog)
 	res.Categories = make([]*resourceviews.CategoryView, len(v.Categories))
@@ -599,11 +600,32 @@ func unmarshalResourceVersionDataResponseBodyToResourceviewsResourceVersionDataV
 		HubRawURLPath:       v.HubRawURLPath,
 		UpdatedAt:           v.UpdatedAt,
 		HubURLPath:          v.HubURLPath,
+		Bundle:              v.Bundle,
+		APIVersion:          v.APIVersion,
+		Verification:        v.Verification,
 	}
 	res.Platforms = make([]*resourceviews.PlatformView, len(v.Platforms))
 	for i, val := range v.Platforms {
 		res.Platforms[i] = unmarshalPlatformResponseBodyToResourceviewsPlatformView(val)
 	}
+	if v.Params != nil {
+		res.Params = make([]*resourceviews.ParamSpecView, len(v.Params))
+		for i, val := range v.Params {
+			res.Params[i] = unmarshalParamSpecResponseBodyToResourceviewsParamSpecView(val)
+		}
+	}
+	if v.Workspaces != nil {
+		res.Workspaces = make([]*resourceviews.WorkspaceSpecView, len(v.Workspaces))
+		for i, val := range v.Workspaces {
+			res.Workspaces[i] = unmarshalWorkspaceSpecResponseBodyToResourceviewsWorkspaceSpecView(val)
+		}
+	}
+	if v.Results != nil {
+		res.Results = make([]*resourceviews.ResultSpecView, len(v.Results))
+		for i, val := range v.Results {
+			res.Results[i] = unmarshalResultSpecResponseBodyToResourceviewsResultSpecView(val)
+		}
+	}
 	res.Resource = unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(v.Resource)
 
 	return res
@@ -620,6 +642,56 @@ func unmarshalPlatformResponseBodyToResourceviewsPlatformView(v *PlatformRespons
 	return res
 }
 
+// unmarshalParamSpecResponseBodyToResourceviewsParamSpecView builds a value of
+// type *resourceviews.ParamSpecView from a value of type
+// *ParamSpecResponseBody.
+func unmarshalParamSpecResponseBodyToResourceviewsParamSpecView(v *ParamSpecResponseBody) *resourceviews.ParamSpecView {
+	if v == nil {
+		return nil
+	}
+	res := &resourceviews.ParamSpecView{
+		Name:        v.Name,
+		Type:        v.Type,
+		Default:     v.Default,
+		Description: v.Description,
+	}
+
+	return res
+}
+
+// unmarshalWorkspaceSpecResponseBodyToResourceviewsWorkspaceSpecView builds a
+// value of type *resourceviews.WorkspaceSpecView from a value of type
+// *WorkspaceSpecResponseBody.
+func unmarshalWorkspaceSpecResponseBodyToResourceviewsWorkspaceSpecView(v *WorkspaceSpecResponseBody) *resourceviews.WorkspaceSpecView {
+	if v == nil {
+		return nil
+	}
+	res := &resourceviews.WorkspaceSpecView{
+		Name:        v.Name,
+		Description: v.Description,
+		Optional:    v.Optional,
+		ReadOnly:    v.ReadOnly,
+	}
+
+	return res
+}
+
+// unmarshalResultSpecResponseBodyToResourceviewsResultSpecView builds a value
+// of type *resourceviews.ResultSpecView from a value of type
+// *ResultSpecResponseBody.
+func unmarshalResultSpecResponseBodyToResourceviewsResultSpecView(v *ResultSpecResponseBody) *resourceviews.ResultSpecView {
+	if v == nil {
+		return nil
+	}
+	res := &resourceviews.ResultSpecView{
+		Name:        v.Name,
+		Type:        v.Type,
+		Description: v.Description,
+	}
+
+	return res
+}
+
 // unmarshalTagResponseBodyToResourceviewsTagView builds a value of type
 // *resourceviews.TagView from a value of type *TagResponseBody.
 func unmarshalTagResponseBodyToResourceviewsTagView(v *TagResponseBody) *resourceviews.TagView {
@@ -17,6 +17,8 @@ import (
 // response body.
 type ListResponseBody struct {
 	Data ResourceDataCollectionResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Cursor to fetch the next page of resources, absent on the last page
+	Next *string `form:"next,omitempty" json:"next,omitempty" xml:"next,omitempty"`
 }
 
 // ResourceDataCollectionResponseBody is used to define fields on response body
@@ -47,6 +49,8 @@ type ResourceDataResponseBody struct {
 	Platforms []*PlatformResponseBody `form:"platforms,omitempty" json:"platforms,omitempty" xml:"platforms,omitempty"`
 	// Rating of resource
 	Rating *float64 `form:"rating,omitempty" json:"rating,omitempty" xml:"rating,omitempty"`
+	// Number of downloads of all versions of resource
+	Downloads *uint `form:"downloads,omitempty" json:"downloads,omitempty" xml:"downloads,omitempty"`
 	// List of all versions of a resource
 	Versions []*ResourceVersionDataResponseBody `form:"versions,omitempty" json:"versions,omitempty" xml:"versions,omitempty"`
 }
@@ -100,6 +104,19 @@ type ResourceVersionDataResponseBody struct {
 	Platforms []*PlatformResponseBody `form:"platforms,omitempty" json:"platforms,omitempty" xml:"platforms,omitempty"`
 	// Url path of the resource in hub
 	HubURLPath *string `form:"hubURLPath,omitempty" json:"hubURLPath,omitempty" xml:"hubURLPath,omitempty"`
+	// Tekton bundle (OCI image) reference of the version
+	Bundle *string `form:"bundle,omitempty" json:"bundle,omitempty" xml:"bundle,omitempty"`
+	// Tekton apiVersion of the resource manifest
+	APIVersion *string `form:"apiVersion,omitempty" json:"apiVersion,omitempty" xml:"apiVersion,omitempty"`
+	// Verification of the trusted resource signature of the version with the
+	// public keys of its catalog
+	Verification *string `form:"verification,omitempty" json:"verification,omitempty" xml:"verification,omitempty"`
+	// Params of the version
+	Params []*ParamSpecResponseBody `form:"params,omitempty" json:"params,omitempty" xml:"params,omitempty"`
+	// Workspaces of the version
+	Workspaces []*WorkspaceSpecResponseBody `form:"workspaces,omitempty" json:"workspaces,omitempty" xml:"workspaces,omitempty"`
+	// Results of the version
+	Results []*ResultSpecResponseBody `form:"results,omitempty" json:"results,omitempty" xml:"results,omitempty"`
 	// Resource to which the version belongs
 	Resource *ResourceDataResponseBody `form:"resource,omitempty" json:"resource,omitempty" xml:"resource,omitempty"`
 }
@@ -112,6 +129,40 @@ type PlatformResponseBody struct {
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 }
 
+// ParamSpecResponseBody is used to define fields on response body types.
+type ParamSpecResponseBody struct {
+	// Name of the param
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Type of the param
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Default of the param, JSON for array and object params
+	Default *string `form:"default,omitempty" json:"default,omitempty" xml:"default,omitempty"`
+	// Description of the param
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+}
+
+// WorkspaceSpecResponseBody is used to define fields on response body types.
+type WorkspaceSpecResponseBody struct {
+	// Name of the workspace
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Description of the workspace
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Whether the workspace may not be bound
+	Optional *bool `form:"optional,omitempty" json:"optional,omitempty" xml:"optional,omitempty"`
+	// Whether the task only reads the workspace
+	ReadOnly *bool `form:"readOnly,omitempty" json:"readOnly,omitempty" xml:"readOnly,omitempty"`
+}
+
+// ResultSpecResponseBody is used to define fields on response body types.
+type ResultSpecResponseBody struct {
+	// Name of the result
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Type of the result
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Description of the result
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+}
+
 // TagResponseBody is used to define fields on response body types.
 type TagResponseBody struct {
 	// ID is the unique id of tag
@@ -132,7 +183,9 @@ func NewQueryResultFound(location string) *resource.QueryResult {
 // NewListResourcesMovedPermanently builds a "resource" service "List" endpoint
 // result from a HTTP "MovedPermanently" response.
 func NewListResourcesMovedPermanently(body *ListResponseBody) *resourceviews.ResourcesView {
-	v := &resourceviews.ResourcesView{}
+	v := &resourceviews.ResourcesView{
+		Next: body.Next,
+	}
 	v.Data = make([]*resourceviews.ResourceDataView, len(body.Data))
 	for i, val := range body.Data {
 		v.Data[i] = unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(val)
@@ -232,6 +285,9 @@ func ValidateResourceDataResponseBody(body *ResourceDataResponseBody) (err error
 	if body.Rating == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("rating", "body"))
 	}
+	if body.Downloads == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("downloads", "body"))
+	}
 	if body.Versions == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("versions", "body"))
 	}
@@ -372,6 +428,32 @@ func ValidateResourceVersionDataResponseBody(body *ResourceVersionDataResponseBo
 			}
 		}
 	}
+	if body.Verification != nil {
+		if !(*body.Verification == "verified" || *body.Verification == "unsigned" || *body.Verification == "invalid") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.verification", *body.Verification, []any{"verified", "unsigned", "invalid"}))
+		}
+	}
+	for _, e := range body.Params {
+		if e != nil {
+			if err2 := ValidateParamSpecResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Workspaces {
+		if e != nil {
+			if err2 := ValidateWorkspaceSpecResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Results {
+		if e != nil {
+			if err2 := ValidateResultSpecResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	if body.Resource != nil {
 		if err2 := ValidateResourceDataResponseBody(body.Resource); err2 != nil {
 			err = goa.MergeErrors(err, err2)
@@ -392,6 +474,64 @@ func ValidatePlatformResponseBody(body *PlatformResponseBody) (err error) {
 	return
 }
 
+// ValidateParamSpecResponseBody runs the validations defined on
+// ParamSpecResponseBody
+func ValidateParamSpecResponseBody(body *ParamSpecResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "string" || *body.Type == "array" || *body.Type == "object") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"string", "array", "object"}))
+		}
+	}
+	return
+}
+
+// ValidateWorkspaceSpecResponseBody runs the validations defined on
+// WorkspaceSpecResponseBody
+func ValidateWorkspaceSpecResponseBody(body *WorkspaceSpecResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.Optional == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("optional", "body"))
+	}
+	if body.ReadOnly == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("readOnly", "body"))
+	}
+	return
+}
+
+// ValidateResultSpecResponseBody runs the validations defined on
+// ResultSpecResponseBody
+func ValidateResultSpecResponseBody(body *ResultSpecResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "string" || *body.Type == "array" || *body.Type == "object") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"string", "array", "object"}))
+		}
+	}
+	return
+}
+
 // ValidateTagResponseBody runs the validations defined on TagResponseBody
 func ValidateTagResponseBody(body *TagResponseBody) (err error) {
 	if body.ID == nil {
@@ -148,8 +148,8 @@ func DecodeByCatalogKindNameVersionRequest(mux goahttp.Muxer, decoder func(*http
 		)
 		catalog = params["catalog"]
 		kind = params["kind"]
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		name = params["name"]
 		version = params["version"]
@@ -226,8 +226,8 @@ func DecodeByCatalogKindNameRequest(mux goahttp.Muxer, decoder func(*http.Reques
 		)
 		catalog = params["catalog"]
 		kind = params["kind"]
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		name = params["name"]
 		pipelinesversionRaw := r.URL.Query().Get("pipelinesversion")
@@ -295,6 +295,7 @@ func marshalResourceviewsResourceDataViewToResourceDataResponseBodyWithoutVersio
 		HubURLPath:    *v.HubURLPath,
 		HubRawURLPath: *v.HubRawURLPath,
 		Rating:        *v.Rating,
+		Downloads:     *v.Downloads,
 	}
 	if v.Catalog != nil {
 		res.Catalog = marshalResourceviewsCatalogViewToCatalogResponseBodyMin(v.Catalog)
@@ -369,6 +370,9 @@ func marshalResourceviewsResourceVersionDataViewToResourceVersionDataResponseBod
 		HubRawURLPath:       *v.HubRawURLPath,
 		UpdatedAt:           *v.UpdatedAt,
 		HubURLPath:          *v.HubURLPath,
+		Bundle:              v.Bundle,
+		APIVersion:          v.APIVersion,
+		Verification:        v.Verification,
 	}
 	if v.Platforms != nil {
 		res.Platforms = make([]*PlatformResponseBody, len(v.Platforms))
@@ -16,6 +16,8 @@ import (
 // response body.
 type ListResponseBody struct {
 	Data ResourceDataResponseBodyWithoutVersionCollection `form:"data" json:"data" xml:"data"`
+	// Cursor to fetch the next page of resources, absent on the last page
+	Next *string `form:"next,omitempty" json:"next,omitempty" xml:"next,omitempty"`
 }
 
 // ResourceDataResponseBodyWithoutVersionCollection is used to define fields on
@@ -47,6 +49,8 @@ type ResourceDataResponseBodyWithoutVersion struct {
 	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
 	// Rating of resource
 	Rating float64 `form:"rating" json:"rating" xml:"rating"`
+	// Number of downloads of all versions of resource
+	Downloads uint `form:"downloads" json:"downloads" xml:"downloads"`
 }
 
 // CatalogResponseBodyMin is used to define fields on response body types.
@@ -94,6 +98,13 @@ type ResourceVersionDataResponseBodyWithoutResource struct {
 	UpdatedAt string `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
 	// Platforms related to resource version
 	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
+	// Tekton bundle (OCI image) reference of the version
+	Bundle *string `form:"bundle,omitempty" json:"bundle,omitempty" xml:"bundle,omitempty"`
+	// Tekton apiVersion of the resource manifest
+	APIVersion *string `form:"apiVersion,omitempty" json:"apiVersion,omitempty" xml:"apiVersion,omitempty"`
+	// Verification of the trusted resource signature of the version with the
+	// public keys of its catalog
+	Verification *string `form:"verification,omitempty" json:"verification,omitempty" xml:"verification,omitempty"`
 }
 
 // PlatformResponseBody is used to define fields on response body types.
@@ -115,7 +126,9 @@ type TagResponseBody struct {
 // NewListResponseBody builds the HTTP response body from the result of the
 // "List" endpoint of the "resource" service.
 func NewListResponseBody(res *resourceviews.ResourcesView) *ListResponseBody {
-	body := &ListResponseBody{}
+	body := &ListResponseBody{
+		Next: res.Next,
+	}
 	if res.Data != nil {
 		body.Data = make([]*ResourceDataResponseBodyWithoutVersion, len(res.Data))
 		for i, val := range res.Data {
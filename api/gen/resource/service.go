@@ -135,6 +135,18 @@ type Category struct {
 	Name string
 }
 
+// The ParamSpec type describes a param of a version of a resource
+type ParamSpec struct {
+	// Name of the param
+	Name string
+	// Type of the param
+	Type string
+	// Default of the param, JSON for array and object params
+	Default *string
+	// Description of the param
+	Description string
+}
+
 type Platform struct {
 	// ID is the unique id of platform
 	ID uint
@@ -192,6 +204,8 @@ type ResourceData struct {
 	Platforms []*Platform
 	// Rating of resource
 	Rating float64
+	// Number of downloads of all versions of resource
+	Downloads uint
 	// List of all versions of a resource
 	Versions []*ResourceVersionData
 }
@@ -224,6 +238,19 @@ type ResourceVersionData struct {
 	Platforms []*Platform
 	// Url path of the resource in hub
 	HubURLPath string
+	// Tekton bundle (OCI image) reference of the version
+	Bundle *string
+	// Tekton apiVersion of the resource manifest
+	APIVersion *string
+	// Verification of the trusted resource signature of the version with the
+	// public keys of its catalog
+	Verification *string
+	// Params of the version
+	Params []*ParamSpec
+	// Workspaces of the version
+	Workspaces []*WorkspaceSpec
+	// Results of the version
+	Results []*ResultSpec
 	// Resource to which the version belongs
 	Resource *ResourceData
 }
@@ -231,6 +258,18 @@ type ResourceVersionData struct {
 // Resources is the result type of the resource service List method.
 type Resources struct {
 	Data ResourceDataCollection
+	// Cursor to fetch the next page of resources, absent on the last page
+	Next *string
+}
+
+// The ResultSpec type describes a result of a version of a resource
+type ResultSpec struct {
+	// Name of the result
+	Name string
+	// Type of the result
+	Type string
+	// Description of the result
+	Description string
 }
 
 type Tag struct {
@@ -254,6 +293,19 @@ type VersionsByIDResult struct {
 	Location string
 }
 
+// The WorkspaceSpec type describes a workspace of a version of a task or a
+// pipeline
+type WorkspaceSpec struct {
+	// Name of the workspace
+	Name string
+	// Description of the workspace
+	Description string
+	// Whether the workspace may not be bound
+	Optional bool
+	// Whether the task only reads the workspace
+	ReadOnly bool
+}
+
 // MakeInternalError builds a goa.ServiceError from an error.
 func MakeInternalError(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "internal-error", false, false, false)
@@ -279,7 +331,9 @@ func NewViewedResources(res *Resources, view string) *resourceviews.Resources {
 
 // newResources converts projected type Resources to service type Resources.
 func newResources(vres *resourceviews.ResourcesView) *Resources {
-	res := &Resources{}
+	res := &Resources{
+		Next: vres.Next,
+	}
 	if vres.Data != nil {
 		res.Data = newResourceDataCollectionWithoutVersion(vres.Data)
 	}
@@ -289,7 +343,9 @@ func newResources(vres *resourceviews.ResourcesView) *Resources {
 // newResourcesView projects result type Resources to projected type
 // ResourcesView using the "default" view.
 func newResourcesView(res *Resources) *resourceviews.ResourcesView {
-	vres := &resourceviews.ResourcesView{}
+	vres := &resourceviews.ResourcesView{
+		Next: res.Next,
+	}
 	if res.Data != nil {
 		vres.Data = newResourceDataCollectionViewWithoutVersion(res.Data)
 	}
@@ -426,6 +482,9 @@ func newResourceDataWithoutVersion(vres *resourceviews.ResourceDataView) *Resour
 	if vres.Rating != nil {
 		res.Rating = *vres.Rating
 	}
+	if vres.Downloads != nil {
+		res.Downloads = *vres.Downloads
+	}
 	if vres.Categories != nil {
 		res.Categories = make([]*Category, len(vres.Categories))
 		for i, val := range vres.Categories {
@@ -475,6 +534,9 @@ func newResourceData(vres *resourceviews.ResourceDataView) *ResourceData {
 	if vres.Rating != nil {
 		res.Rating = *vres.Rating
 	}
+	if vres.Downloads != nil {
+		res.Downloads = *vres.Downloads
+	}
 	if vres.Categories != nil {
 		res.Categories = make([]*Category, len(vres.Categories))
 		for i, val := range vres.Categories {
@@ -558,6 +620,7 @@ func newResourceDataViewWithoutVersion(res *ResourceData) *resourceviews.Resourc
 		HubURLPath:    &res.HubURLPath,
 		HubRawURLPath: &res.HubRawURLPath,
 		Rating:        &res.Rating,
+		Downloads:     &res.Downloads,
 	}
 	if res.Categories != nil {
 		vres.Categories = make([]*resourceviews.CategoryView, len(res.Categories))
@@ -602,6 +665,7 @@ func newResourceDataView(res *ResourceData) *resourceviews.ResourceDataView {
 		HubURLPath:    &res.HubURLPath,
 		HubRawURLPath: &res.HubRawURLPath,
 		Rating:        &res.Rating,
+		Downloads:     &res.Downloads,
 	}
 	if res.Categories != nil {
 		vres.Categories = make([]*resourceviews.CategoryView, len(res.Categories))
@@ -707,7 +771,9 @@ func newCatalogView(res *Catalog) *resourceviews.CatalogView {
 // newResourceVersionDataTiny converts projected type ResourceVersionData to
 // service type ResourceVersionData.
 func newResourceVersionDataTiny(vres *resourceviews.ResourceVersionDataView) *ResourceVersionData {
-	res := &ResourceVersionData{}
+	res := &ResourceVersionData{
+		Bundle: vres.Bundle,
+	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
 	}
@@ -758,7 +824,10 @@ func newResourceVersionDataMin(vres *resourceviews.ResourceVersionDataView) *Res
 // ResourceVersionData to service type ResourceVersionData.
 func newResourceVersionDataWithoutResource(vres *resourceviews.ResourceVersionDataView) *ResourceVersionData {
 	res := &ResourceVersionData{
-		Deprecated: vres.Deprecated,
+		Deprecated:   vres.Deprecated,
+		Bundle:       vres.Bundle,
+		APIVersion:   vres.APIVersion,
+		Verification: vres.Verification,
 	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
@@ -806,7 +875,10 @@ func newResourceVersionDataWithoutResource(vres *resourceviews.ResourceVersionDa
 // service type ResourceVersionData.
 func newResourceVersionData(vres *resourceviews.ResourceVersionDataView) *ResourceVersionData {
 	res := &ResourceVersionData{
-		Deprecated: vres.Deprecated,
+		Deprecated:   vres.Deprecated,
+		Bundle:       vres.Bundle,
+		APIVersion:   vres.APIVersion,
+		Verification: vres.Verification,
 	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
@@ -844,6 +916,24 @@ func newResourceVersionData(vres *resourceviews.ResourceVersionDataView) *Resour
 			res.Platforms[i] = transformResourceviewsPlatformViewToPlatform(val)
 		}
 	}
+	if vres.Params != nil {
+		res.Params = make([]*ParamSpec, len(vres.Params))
+		for i, val := range vres.Params {
+			res.Params[i] = transformResourceviewsParamSpecViewToParamSpec(val)
+		}
+	}
+	if vres.Workspaces != nil {
+		res.Workspaces = make([]*WorkspaceSpec, len(vres.Workspaces))
+		for i, val := range vres.Workspaces {
+			res.Workspaces[i] = transformResourceviewsWorkspaceSpecViewToWorkspaceSpec(val)
+		}
+	}
+	if vres.Results != nil {
+		res.Results = make([]*ResultSpec, len(vres.Results))
+		for i, val := range vres.Results {
+			res.Results[i] = transformResourceviewsResultSpecViewToResultSpec(val)
+		}
+	}
 	if vres.Resource != nil {
 		res.Resource = newResourceDataInfo(vres.Resource)
 	}
@@ -856,6 +946,7 @@ func newResourceVersionDataViewTiny(res *ResourceVersionData) *resourceviews.Res
 	vres := &resourceviews.ResourceVersionDataView{
 		ID:      &res.ID,
 		Version: &res.Version,
+		Bundle:  res.Bundle,
 	}
 	return vres
 }
@@ -898,6 +989,9 @@ func newResourceVersionDataViewWithoutResource(res *ResourceVersionData) *resour
 		HubRawURLPath:       &res.HubRawURLPath,
 		UpdatedAt:           &res.UpdatedAt,
 		HubURLPath:          &res.HubURLPath,
+		Bundle:              res.Bundle,
+		APIVersion:          res.APIVersion,
+		Verification:        res.Verification,
 	}
 	if res.Platforms != nil {
 		vres.Platforms = make([]*resourceviews.PlatformView, len(res.Platforms))
@@ -925,6 +1019,9 @@ func newResourceVersionDataView(res *ResourceVersionData) *resourceviews.Resourc
 		HubRawURLPath:       &res.HubRawURLPath,
 		UpdatedAt:           &res.UpdatedAt,
 		HubURLPath:          &res.HubURLPath,
+		Bundle:              res.Bundle,
+		APIVersion:          res.APIVersion,
+		Verification:        res.Verification,
 	}
 	if res.Platforms != nil {
 		vres.Platforms = make([]*resourceviews.PlatformView, len(res.Platforms))
@@ -934,6 +1031,24 @@ func newResourceVersionDataView(res *ResourceVersionData) *resourceviews.Resourc
 	} else {
 		vres.Platforms = []*resourceviews.PlatformView{}
 	}
+	if res.Params != nil {
+		vres.Params = make([]*resourceviews.ParamSpecView, len(res.Params))
+		for i, val := range res.Params {
+			vres.Params[i] = transformParamSpecToResourceviewsParamSpecView(val)
+		}
+	}
+	if res.Workspaces != nil {
+		vres.Workspaces = make([]*resourceviews.WorkspaceSpecView, len(res.Workspaces))
+		for i, val := range res.Workspaces {
+			vres.Workspaces[i] = transformWorkspaceSpecToResourceviewsWorkspaceSpecView(val)
+		}
+	}
+	if res.Results != nil {
+		vres.Results = make([]*resourceviews.ResultSpecView, len(res.Results))
+		for i, val := range res.Results {
+			vres.Results[i] = transformResultSpecToResourceviewsResultSpecView(val)
+		}
+	}
 	if res.Resource != nil {
 		vres.Resource = newResourceDataViewInfo(res.Resource)
 	}
@@ -1001,6 +1116,9 @@ func transformResourceviewsResourceVersionDataViewToResourceVersionData(v *resou
 		HubRawURLPath:       *v.HubRawURLPath,
 		UpdatedAt:           *v.UpdatedAt,
 		HubURLPath:          *v.HubURLPath,
+		Bundle:              v.Bundle,
+		APIVersion:          v.APIVersion,
+		Verification:        v.Verification,
 	}
 	if v.Platforms != nil {
 		res.Platforms = make([]*Platform, len(v.Platforms))
@@ -1010,6 +1128,24 @@ func transformResourceviewsResourceVersionDataViewToResourceVersionData(v *resou
 	} else {
 		res.Platforms = []*Platform{}
 	}
+	if v.Params != nil {
+		res.Params = make([]*ParamSpec, len(v.Params))
+		for i, val := range v.Params {
+			res.Params[i] = transformResourceviewsParamSpecViewToParamSpec(val)
+		}
+	}
+	if v.Workspaces != nil {
+		res.Workspaces = make([]*WorkspaceSpec, len(v.Workspaces))
+		for i, val := range v.Workspaces {
+			res.Workspaces[i] = transformResourceviewsWorkspaceSpecViewToWorkspaceSpec(val)
+		}
+	}
+	if v.Results != nil {
+		res.Results = make([]*ResultSpec, len(v.Results))
+		for i, val := range v.Results {
+			res.Results[i] = transformResourceviewsResultSpecViewToResultSpec(val)
+		}
+	}
 	if v.Resource != nil {
 		res.Resource = transformResourceviewsResourceDataViewToResourceData(v.Resource)
 	}
@@ -1017,6 +1153,53 @@ func transformResourceviewsResourceVersionDataViewToResourceVersionData(v *resou
 	return res
 }
 
+// transformResourceviewsParamSpecViewToParamSpec builds a value of type
+// *ParamSpec from a value of type *resourceviews.ParamSpecView.
+func transformResourceviewsParamSpecViewToParamSpec(v *resourceviews.ParamSpecView) *ParamSpec {
+	if v == nil {
+		return nil
+	}
+	res := &ParamSpec{
+		Name:        *v.Name,
+		Type:        *v.Type,
+		Default:     v.Default,
+		Description: *v.Description,
+	}
+
+	return res
+}
+
+// transformResourceviewsWorkspaceSpecViewToWorkspaceSpec builds a value of
+// type *WorkspaceSpec from a value of type *resourceviews.WorkspaceSpecView.
+func transformResourceviewsWorkspaceSpecViewToWorkspaceSpec(v *resourceviews.WorkspaceSpecView) *WorkspaceSpec {
+	if v == nil {
+		return nil
+	}
+	res := &WorkspaceSpec{
+		Name:        *v.Name,
+		Description: *v.Description,
+		Optional:    *v.Optional,
+		ReadOnly:    *v.ReadOnly,
+	}
+
+	return res
+}
+
+// transformResourceviewsResultSpecViewToResultSpec builds a value of type
+// *ResultSpec from a value of type *resourceviews.ResultSpecView.
+func transformResourceviewsResultSpecViewToResultSpec(v *resourceviews.ResultSpecView) *ResultSpec {
+	if v == nil {
+		return nil
+	}
+	res := &ResultSpec{
+		Name:        *v.Name,
+		Type:        *v.Type,
+		Description: *v.Description,
+	}
+
+	return res
+}
+
 // transformResourceviewsResourceDataViewToResourceData builds a value of type
 // *ResourceData from a value of type *resourceviews.ResourceDataView.
 func transformResourceviewsResourceDataViewToResourceData(v *resourceviews.ResourceDataView) *ResourceData {
@@ -1039,6 +1222,9 @@ func transformResourceviewsResourceDataViewToResourceData(v *resourceviews.Resou
 	if v.Rating != nil {
 		res.Rating = *v.Rating
 	}
+	if v.Downloads != nil {
+		res.Downloads = *v.Downloads
+	}
 	if v.Categories != nil {
 		res.Categories = make([]*Category, len(v.Categories))
 		for i, val := range v.Categories {
@@ -1116,6 +1302,9 @@ func transformResourceVersionDataToResourceviewsResourceVersionDataView(v *Resou
 		HubRawURLPath:       &v.HubRawURLPath,
 		UpdatedAt:           &v.UpdatedAt,
 		HubURLPath:          &v.HubURLPath,
+		Bundle:              v.Bundle,
+		APIVersion:          v.APIVersion,
+		Verification:        v.Verification,
 	}
 	if v.Platforms != nil {
 		res.Platforms = make([]*resourceviews.PlatformView, len(v.Platforms))
@@ -1125,6 +1314,24 @@ func transformResourceVersionDataToResourceviewsResourceVersionDataView(v *Resou
 	} else {
 		res.Platforms = []*resourceviews.PlatformView{}
 	}
+	if v.Params != nil {
+		res.Params = make([]*resourceviews.ParamSpecView, len(v.Params))
+		for i, val := range v.Params {
+			res.Params[i] = transformParamSpecToResourceviewsParamSpecView(val)
+		}
+	}
+	if v.Workspaces != nil {
+		res.Workspaces = make([]*resourceviews.WorkspaceSpecView, len(v.Workspaces))
+		for i, val := range v.Workspaces {
+			res.Workspaces[i] = transformWorkspaceSpecToResourceviewsWorkspaceSpecView(val)
+		}
+	}
+	if v.Results != nil {
+		res.Results = make([]*resourceviews.ResultSpecView, len(v.Results))
+		for i, val := range v.Results {
+			res.Results[i] = transformResultSpecToResourceviewsResultSpecView(val)
+		}
+	}
 	if v.Resource != nil {
 		res.Resource = transformResourceDataToResourceviewsResourceDataView(v.Resource)
 	}
@@ -1132,6 +1339,53 @@ func transformResourceVersionDataToResourceviewsResourceVersionDataView(v *Resou
 	return res
 }
 
+// transformParamSpecToResourceviewsParamSpecView builds a value of type
+// *resourceviews.ParamSpecView from a value of type *ParamSpec.
+func transformParamSpecToResourceviewsParamSpecView(v *ParamSpec) *resourceviews.ParamSpecView {
+	if v == nil {
+		return nil
+	}
+	res := &resourceviews.ParamSpecView{
+		Name:        &v.Name,
+		Type:        &v.Type,
+		Default:     v.Default,
+		Description: &v.Description,
+	}
+
+	return res
+}
+
+// transformWorkspaceSpecToResourceviewsWorkspaceSpecView builds a value of
+// type *resourceviews.WorkspaceSpecView from a value of type *WorkspaceSpec.
+func transformWorkspaceSpecToResourceviewsWorkspaceSpecView(v *WorkspaceSpec) *resourceviews.WorkspaceSpecView {
+	if v == nil {
+		return nil
+	}
+	res := &resourceviews.WorkspaceSpecView{
+		Name:        &v.Name,
+		Description: &v.Description,
+		Optional:    &v.Optional,
+		ReadOnly:    &v.ReadOnly,
+	}
+
+	return res
+}
+
+// transformResultSpecToResourceviewsResultSpecView builds a value of type
+// *resourceviews.ResultSpecView from a value of type *ResultSpec.
+func transformResultSpecToResourceviewsResultSpecView(v *ResultSpec) *resourceviews.ResultSpecView {
+	if v == nil {
+		return nil
+	}
+	res := &resourceviews.ResultSpecView{
+		Name:        &v.Name,
+		Type:        &v.Type,
+		Description: &v.Description,
+	}
+
+	return res
+}
+
 // transformResourceDataToResourceviewsResourceDataView builds a value of type
 // *resourceviews.ResourceDataView from a value of type *ResourceData.
 func transformResourceDataToResourceviewsResourceDataView(v *ResourceData) *resourceviews.ResourceDataView {
@@ -1142,6 +1396,7 @@ func transformResourceDataToResourceviewsResourceDataView(v *ResourceData) *reso
 		HubURLPath:    &v.HubURLPath,
 		HubRawURLPath: &v.HubRawURLPath,
 		Rating:        &v.Rating,
+		Downloads:     &v.Downloads,
 	}
 	if v.Categories != nil {
 		res.Categories = make([]*resourceviews.CategoryView, len(v.Categories))
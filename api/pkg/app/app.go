@@ -68,7 +68,12 @@ type APIConfig struct {
 
 // JWTConfig defines configuration requires to create token
 type JWTConfig struct {
-	SigningKey       string
+	SigningKey string
+	// Keys are used for signing the tokens with RS256 or ES256 instead of
+	// the SigningKey, new tokens are signed using the key with SigningKeyID
+	// and the other keys are kept for verifying the tokens signed before
+	Keys             []JWTKey
+	SigningKeyID     string
 	AccessExpiresIn  time.Duration
 	RefreshExpiresIn time.Duration
 }
@@ -416,8 +421,27 @@ func jwtConfig() (*JWTConfig, error) {
 	}
 
 	conf.SigningKey = viper.GetString("JWT_SIGNING_KEY")
-	if conf.SigningKey == "" {
-		return nil, fmt.Errorf("no JWT_SIGNING_KEY environment variable defined")
+
+	if keysDir := viper.GetString("JWT_KEYS_DIR"); keysDir != "" {
+		keys, err := readJWTKeys(keysDir)
+		if err != nil {
+			return nil, err
+		}
+		conf.Keys = keys
+
+		// By default the tokens are signed with the last key so that a
+		// new key can be rolled out by naming it after the existing ones
+		conf.SigningKeyID = viper.GetString("JWT_SIGNING_KEY_ID")
+		if conf.SigningKeyID == "" {
+			conf.SigningKeyID = keys[len(keys)-1].ID
+		}
+		if _, ok := conf.Key(conf.SigningKeyID); !ok {
+			return nil, fmt.Errorf("no jwt key found with JWT_SIGNING_KEY_ID %s in %s", conf.SigningKeyID, keysDir)
+		}
+	}
+
+	if conf.SigningKey == "" && len(conf.Keys) == 0 {
+		return nil, fmt.Errorf("no JWT_SIGNING_KEY or JWT_KEYS_DIR environment variable defined")
 	}
 
 	accessExpiresIn := viper.GetString("ACCESS_JWT_EXPIRES_IN")
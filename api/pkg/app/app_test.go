@@ -15,6 +15,14 @@
 package app
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -32,3 +40,61 @@ func TestComputeDurationError(t *testing.T) {
 	_, err := ComputeDuration(duration)
 	assert.Equal(t, err.Error(), "JWT doesn't support the duration specified 5M. \nSupported formats are w(weeks), d(days), h(hours), m(min), s(sec)")
 }
+
+func writeKey(t *testing.T, dir, name, typ string, der []byte) {
+	data := pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der})
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, name), data, 0600))
+}
+
+func TestReadJWTKeys(t *testing.T) {
+	dir := t.TempDir()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	writeKey(t, dir, "2026-01.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey))
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	assert.NoError(t, err)
+	writeKey(t, dir, "2026-02.pem", "PRIVATE KEY", der)
+
+	// files which are not keys are skipped
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "README"), []byte("keys"), 0600))
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "..data"), 0700))
+
+	keys, err := readJWTKeys(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(keys))
+	assert.Equal(t, "2026-01", keys[0].ID)
+	assert.Equal(t, "RS256", keys[0].Algorithm())
+	assert.Equal(t, "2026-02", keys[1].ID)
+	assert.Equal(t, "ES256", keys[1].Algorithm())
+
+	conf := &JWTConfig{Keys: keys}
+	key, ok := conf.Key("2026-02")
+	assert.True(t, ok)
+	assert.Equal(t, "2026-02", key.ID)
+	_, ok = conf.Key("2025-12")
+	assert.False(t, ok)
+}
+
+func TestReadJWTKeys_UnsupportedCurve(t *testing.T) {
+	dir := t.TempDir()
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(ecKey)
+	assert.NoError(t, err)
+	writeKey(t, dir, "p384.pem", "EC PRIVATE KEY", der)
+
+	_, err = readJWTKeys(dir)
+	assert.EqualError(t, err, "invalid jwt key p384.pem: only P-256 curve is supported for ES256")
+}
+
+func TestReadJWTKeys_Empty(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := readJWTKeys(dir)
+	assert.Error(t, err)
+}
@@ -43,6 +43,22 @@ type Catalog struct {
 	ContextDir string
 	Revision   string
 	Provider   string
+	// WebhookSecret may refer to environment variables e.g.
+	// ${TEKTON_CATALOG_WEBHOOK_SECRET}
+	WebhookSecret string
+	// Credential is the name of a directory in the credentials
+	// directory of the api used to fetch a private repository
+	Credential string
+	// InsecureSkipTLSVerify disables the verification of the TLS
+	// certificate of the repository, which is verified by default
+	InsecureSkipTLSVerify bool
+	// Private catalogs are visible only to the users having the Scope
+	// of the catalog, listed under scopes along with its users
+	Private bool
+	Scope   string
+	// PublicKeys are the PEM encoded keys verifying the signatures of
+	// the trusted resources of the catalog
+	PublicKeys []string
 }
 
 type Scope struct {
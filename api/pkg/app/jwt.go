@@ -0,0 +1,122 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// JWTKey is a private key used to sign and verify the tokens, it is
+// identified in the tokens by its ID as kid
+type JWTKey struct {
+	ID     string
+	Signer crypto.Signer
+}
+
+// Algorithm returns the JWT algorithm of the key, RS256 or ES256
+func (k JWTKey) Algorithm() string {
+	if _, ok := k.Signer.(*ecdsa.PrivateKey); ok {
+		return "ES256"
+	}
+	return "RS256"
+}
+
+// readJWTKeys reads the PEM encoded private keys in dir, the name of a
+// file without the .pem extension is the ID of its key. The keys are
+// sorted by their ID.
+func readJWTKeys(dir string) ([]JWTKey, error) {
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []JWTKey{}
+	for _, e := range entries {
+		// skips the hidden files and dirs created by mounting a secret
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") || filepath.Ext(e.Name()) != ".pem" {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		signer, err := parsePrivateKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid jwt key %s: %v", e.Name(), err)
+		}
+
+		keys = append(keys, JWTKey{ID: strings.TrimSuffix(e.Name(), ".pem"), Signer: signer})
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no jwt key found in %s", dir)
+	}
+	return keys, nil
+}
+
+func parsePrivateKey(data []byte) (crypto.Signer, error) {
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found")
+	}
+
+	var key interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return k, nil
+	case *ecdsa.PrivateKey:
+		if k.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("only P-256 curve is supported for ES256")
+		}
+		return k, nil
+	}
+	return nil, fmt.Errorf("only RSA and ECDSA keys are supported")
+}
+
+// Key returns the key having the id
+func (c *JWTConfig) Key(id string) (JWTKey, bool) {
+	for _, k := range c.Keys {
+		if k.ID == id {
+			return k, true
+		}
+	}
+	return JWTKey{}, false
+}
@@ -0,0 +1,72 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// Actions recorded in the audit events
+const (
+	AgentUpdate       = "agent.update"
+	AgentRevoke       = "agent.revoke"
+	UserTokenRevoke   = "user.token-revoke"
+	ConfigRefresh     = "config.refresh"
+	CatalogRefresh    = "catalog.refresh"
+	CatalogRefreshAll = "catalog.refresh-all"
+	CatalogCreate     = "catalog.create"
+	CatalogUpdate     = "catalog.update"
+	CatalogDelete     = "catalog.delete"
+	RatingUpdate      = "rating.update"
+	ReviewCreate      = "review.create"
+	ReviewUpdate      = "review.update"
+	ReviewDelete      = "review.delete"
+)
+
+// Record adds an event of the action done by the actor on the target.
+// The payload is kept as the digest of its JSON, the token of the actor
+// must be removed from the payload before it is recorded.
+func Record(db *gorm.DB, actorID uint, action, target string, payload interface{}) error {
+
+	digest, err := Digest(payload)
+	if err != nil {
+		return err
+	}
+
+	event := model.AuditEvent{
+		ActorID: actorID,
+		Action:  action,
+		Target:  target,
+		Digest:  digest,
+	}
+	return db.Create(&event).Error
+}
+
+// Digest returns the hex encoded SHA256 of the JSON of payload
+func Digest(payload interface{}) (string, error) {
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
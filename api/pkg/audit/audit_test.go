@@ -0,0 +1,45 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigest(t *testing.T) {
+	digest, err := Digest(map[string]string{"name": "tekton"})
+	assert.NoError(t, err)
+	// sha256 of {"name":"tekton"}
+	assert.Equal(t, "307f1e7920b2264455e064a9389da8c174c0221f86b389653169fee20012ccaf", digest)
+}
+
+func TestDigest_SamePayload(t *testing.T) {
+	first, err := Digest(struct{ Name string }{"tekton"})
+	assert.NoError(t, err)
+	second, err := Digest(struct{ Name string }{"tekton"})
+	assert.NoError(t, err)
+	assert.Equal(t, first, second)
+
+	other, err := Digest(struct{ Name string }{"hub"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, other)
+}
+
+func TestDigest_InvalidPayload(t *testing.T) {
+	_, err := Digest(make(chan int))
+	assert.Error(t, err)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/markbates/goth/providers/bitbucket"
 	"github.com/markbates/goth/providers/github"
 	"github.com/markbates/goth/providers/gitlab"
+	"github.com/markbates/goth/providers/openidConnect"
 	"github.com/tektoncd/hub/api/pkg/app"
 	"github.com/tektoncd/hub/api/pkg/auth/provider"
 	auth "github.com/tektoncd/hub/api/pkg/auth/service"
@@ -103,11 +104,32 @@ func AuthProvider(r *mux.Router, api app.Config) {
 		),
 	)
 
+	oidcAuth := provider.OIDCProvider(AUTH_URL)
+	if oidcAuth.Enabled() {
+		oidc, err := openidConnect.New(
+			oidcAuth.ClientId,
+			oidcAuth.ClientSecret,
+			oidcAuth.CallbackUrl,
+			oidcAuth.DiscoveryUrl,
+			oidcAuth.Scopes...)
+
+		// The git providers keep working if the issuer can't be reached
+		if err != nil {
+			api.Logger("auth").Errorf("failed to configure %s provider: %v", provider.OIDC, err)
+		} else {
+			oidc.SetName(provider.OIDC)
+			goth.UseProviders(oidc)
+		}
+	}
+
 	authSvc := auth.New(api)
 
 	// Return name and status of the services
 	r.HandleFunc("/", auth.Status)
 
+	// Public keys to verify the tokens, empty unless JWT_KEYS_DIR is set
+	r.HandleFunc("/.well-known/jwks.json", authSvc.JWKS)
+
 	s := r.PathPrefix("/auth").Subrouter()
 
 	// Provides a list of git provider present in auth server
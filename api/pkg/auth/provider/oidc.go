@@ -0,0 +1,123 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// OIDC is the name of the generic OpenID Connect provider, it is used as
+// the provider of the accounts of the users logging in through it
+const OIDC = "oidc"
+
+type oidcProvider struct {
+	provider
+	// Url of the OpenID configuration of the issuer
+	DiscoveryUrl string
+	Scopes       []string
+}
+
+func OIDCProvider(AUTH_URL string) oidcProvider {
+	oidcAuth := oidcProvider{
+		provider: provider{
+			Url:          strings.TrimSuffix(os.Getenv("OIDC_ISSUER_URL"), "/"),
+			ClientId:     os.Getenv("OIDC_CLIENT_ID"),
+			ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+			CallbackUrl:  fmt.Sprintf(AUTH_URL, OIDC),
+		},
+		Scopes: []string{"openid", "profile", "email"},
+	}
+
+	oidcAuth.DiscoveryUrl = oidcAuth.Url + "/.well-known/openid-configuration"
+
+	if scopes := os.Getenv("OIDC_SCOPES"); scopes != "" {
+		oidcAuth.Scopes = strings.Split(scopes, ",")
+	}
+
+	return oidcAuth
+}
+
+// Enabled checks if the issuer and the client of the provider are set
+func (p oidcProvider) Enabled() bool {
+	return p.Url != "" && p.ClientId != "" && p.ClientSecret != ""
+}
+
+// ClaimedScopes returns the hub scopes mapped in OIDC_SCOPES_MAPPING to
+// the values of the claim set in OIDC_SCOPES_CLAIM from the claims of the
+// user, the values which are not mapped are ignored. nil is returned if
+// the claim is not set.
+func ClaimedScopes(claims map[string]interface{}) []string {
+
+	claim := os.Getenv("OIDC_SCOPES_CLAIM")
+	if claim == "" {
+		return nil
+	}
+
+	values := []string{}
+	switch value := claims[claim].(type) {
+	case string:
+		// a claim such as scope of OAuth holds the values separated by space
+		values = append(values, strings.Fields(value)...)
+	case []interface{}:
+		for _, v := range value {
+			if s, ok := v.(string); ok && s != "" {
+				values = append(values, s)
+			}
+		}
+	}
+
+	mapping := scopesMapping()
+	scopes := []string{}
+	for _, v := range values {
+		for _, s := range mapping[v] {
+			if !contains(scopes, s) {
+				scopes = append(scopes, s)
+			}
+		}
+	}
+	return scopes
+}
+
+// scopesMapping returns the hub scopes granted for each value of the claim,
+// read from OIDC_SCOPES_MAPPING as a comma separated list of value=scope
+// pairs eg. hub-admins=catalog:manage,hub-admins=config:refresh
+func scopesMapping() map[string][]string {
+
+	mapping := map[string][]string{}
+	for _, pair := range strings.Split(os.Getenv("OIDC_SCOPES_MAPPING"), ",") {
+		// a value of the claim may have a =, unlike the hub scopes
+		i := strings.LastIndex(pair, "=")
+		if i < 0 {
+			continue
+		}
+		value, scope := strings.TrimSpace(pair[:i]), strings.TrimSpace(pair[i+1:])
+		if value == "" || scope == "" {
+			continue
+		}
+		mapping[value] = append(mapping[value], scope)
+	}
+	return mapping
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
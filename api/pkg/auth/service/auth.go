@@ -25,7 +25,9 @@ import (
 	"github.com/markbates/goth/gothic"
 	"github.com/tektoncd/hub/api/pkg/app"
 	authApp "github.com/tektoncd/hub/api/pkg/auth/app"
+	authProvider "github.com/tektoncd/hub/api/pkg/auth/provider"
 	"github.com/tektoncd/hub/api/pkg/db/model"
+	"github.com/tektoncd/hub/api/pkg/token"
 	"gorm.io/gorm"
 )
 
@@ -59,6 +61,7 @@ var (
 type Service interface {
 	AuthCallBack(res http.ResponseWriter, req *http.Request)
 	HubAuthenticate(res http.ResponseWriter, req *http.Request)
+	JWKS(res http.ResponseWriter, req *http.Request)
 }
 
 // New returns the auth service implementation.
@@ -116,10 +119,22 @@ func (s *service) AuthCallBack(res http.ResponseWriter, req *http.Request) {
 
 	params := req.URL.Query()
 
-	if err = r.insertData(ghUser, params.Get("code"), provider); err != nil {
+	userID, err := r.insertData(ghUser, params.Get("code"), provider)
+	if err != nil {
 		r.log.Error(err)
 		res.Header().Set("Location", fmt.Sprintf("%s?status=%d", UI_URL, http.StatusBadRequest))
 		res.WriteHeader(http.StatusTemporaryRedirect)
+		return
+	}
+
+	// the login fails rather than granting the scopes claimed previously
+	if provider == authProvider.OIDC {
+		if err = r.updateClaimedScopes(ghUser, userID, provider); err != nil {
+			r.log.Error(err)
+			res.Header().Set("Location", fmt.Sprintf("%s?status=%d", UI_URL, http.StatusInternalServerError))
+			res.WriteHeader(http.StatusTemporaryRedirect)
+			return
+		}
 	}
 
 	res.Header().Set("Location", fmt.Sprintf("%s?status=%d&code=%s", UI_URL, http.StatusOK, params.Get("code")))
@@ -215,6 +230,10 @@ func List(res http.ResponseWriter, req *http.Request) {
 		providerList = append(providerList, authApp.Provider{Name: "gitlab"})
 	}
 
+	if os.Getenv("OIDC_ISSUER_URL") != "" && os.Getenv("OIDC_CLIENT_ID") != "" && os.Getenv("OIDC_CLIENT_SECRET") != "" {
+		providerList = append(providerList, authApp.Provider{Name: authProvider.OIDC})
+	}
+
 	providers := authApp.ProviderList{
 		Data: providerList,
 	}
@@ -227,3 +246,15 @@ func List(res http.ResponseWriter, req *http.Request) {
 		return
 	}
 }
+
+// Provides the public keys to verify the tokens signed by the hub
+func (s *service) JWKS(res http.ResponseWriter, req *http.Request) {
+
+	keys := token.JWKS(s.api.JWTConfig())
+
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(res).Encode(keys); err != nil {
+		s.Logger(req.Context()).Error(err)
+	}
+}
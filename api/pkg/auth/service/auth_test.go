@@ -145,7 +145,7 @@ func TestInsertData_AccountExistsButNotEmail(t *testing.T) {
 		AvatarURL: "http://bitbucketavatar",
 	}
 
-	err := req.insertData(gitUser, "code", req.provider)
+	_, err := req.insertData(gitUser, "code", req.provider)
 	assert.NoError(t, err)
 
 	userQuery := tc.DB().Model(&model.User{}).
@@ -190,7 +190,7 @@ func TestInsertData_AddNewEmailAndAccount(t *testing.T) {
 	err = accountQuery.First(&model.Account{}).Error
 	assert.Error(t, err)
 
-	err = req.insertData(gitUser, "code", req.provider)
+	_, err = req.insertData(gitUser, "code", req.provider)
 	assert.NoError(t, err)
 }
 
@@ -241,7 +241,7 @@ func TestInsertData_EmailExistsAddNewAccount(t *testing.T) {
 	assert.Error(t, err)
 
 	// Insert new account data
-	err = req.insertData(gitUser, "code", req.provider)
+	_, err = req.insertData(gitUser, "code", req.provider)
 	assert.NoError(t, err)
 
 	// Check for no of accounts associated with particular email
@@ -251,3 +251,115 @@ func TestInsertData_EmailExistsAddNewAccount(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 3, len(accounts))
 }
+
+func TestProviderList_OIDC(t *testing.T) {
+	t.Setenv("OIDC_ISSUER_URL", "https://sso.example.com")
+	t.Setenv("OIDC_CLIENT_ID", "hub")
+	t.Setenv("OIDC_CLIENT_SECRET", "secret")
+
+	req, err := http.NewRequest("GET", "/auth/providers", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := httptest.NewRecorder()
+	http.HandlerFunc(List).ServeHTTP(res, req)
+
+	var provider *authApp.ProviderList
+	err = json.Unmarshal(res.Body.Bytes(), &provider)
+	assert.NoError(t, err)
+
+	last := provider.Data[len(provider.Data)-1]
+	assert.Equal(t, "oidc", last.Name)
+}
+
+func TestUpdateClaimedScopes(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	t.Setenv("OIDC_SCOPES_CLAIM", "groups")
+	t.Setenv("OIDC_SCOPES_MAPPING", "hub-admins=catalog:manage, developers=unknown:scope")
+
+	req := request{
+		db:            tc.DB(),
+		log:           tc.Logger("svc"),
+		defaultScopes: []string{"rating:write", "rating:read"},
+		jwtConfig:     tc.JWTConfig(),
+		provider:      "oidc",
+	}
+
+	gitUser := goth.User{
+		Email:    "sso@bar.com",
+		NickName: "ssouser",
+		Name:     "ssouser",
+		RawData: map[string]interface{}{
+			"groups": []interface{}{"hub-admins", "developers", "catalog:refresh"},
+		},
+	}
+
+	userID, err := req.insertData(gitUser, "code", req.provider)
+	assert.NoError(t, err)
+
+	// only the mapped values of the claim are granted, a value having the
+	// name of a hub scope or mapped to an unknown scope is ignored
+	err = req.updateClaimedScopes(gitUser, userID, req.provider)
+	assert.NoError(t, err)
+
+	acc := model.Account{}
+	err = tc.DB().Where(&model.Account{UserName: "ssouser", Provider: "oidc"}).First(&acc).Error
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"catalog:manage"}, acc.ClaimedScopes)
+
+	scopes, err := req.userScopes(&acc)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"rating:write", "rating:read", "catalog:manage"}, scopes)
+
+	// the scope removed in the provider is not granted anymore
+	gitUser.RawData = map[string]interface{}{"groups": []interface{}{"developers"}}
+	err = req.updateClaimedScopes(gitUser, userID, req.provider)
+	assert.NoError(t, err)
+
+	err = tc.DB().Where(&model.Account{UserName: "ssouser", Provider: "oidc"}).First(&acc).Error
+	assert.NoError(t, err)
+	assert.Empty(t, acc.ClaimedScopes)
+}
+
+func TestUpdateClaimedScopes_EmptyEmail(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	t.Setenv("OIDC_SCOPES_CLAIM", "groups")
+	t.Setenv("OIDC_SCOPES_MAPPING", "hub-admins=catalog:manage, developers=unknown:scope")
+
+	req := request{
+		db:            tc.DB(),
+		log:           tc.Logger("svc"),
+		defaultScopes: []string{"rating:write", "rating:read"},
+		jwtConfig:     tc.JWTConfig(),
+		provider:      "oidc",
+	}
+
+	// users of the fixtures have an empty email as well
+	user := model.User{Type: model.NormalUserType}
+	assert.NoError(t, tc.DB().Create(&user).Error)
+	assert.NoError(t, tc.DB().Create(&model.Account{UserID: user.ID, UserName: "noemail", Provider: "oidc"}).Error)
+
+	gitUser := goth.User{
+		NickName: "noemail",
+		RawData: map[string]interface{}{
+			"groups": []interface{}{"hub-admins"},
+		},
+	}
+
+	err := req.updateClaimedScopes(gitUser, user.ID, req.provider)
+	assert.NoError(t, err)
+
+	acc := model.Account{}
+	err = tc.DB().Where(&model.Account{UserID: user.ID, Provider: "oidc"}).First(&acc).Error
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"catalog:manage"}, acc.ClaimedScopes)
+
+	// the login fails if the account is not found
+	err = req.updateClaimedScopes(gitUser, 31, req.provider)
+	assert.EqualError(t, err, "oidc account of user 31 not found")
+}
@@ -17,10 +17,12 @@ package auth
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
 	"strings"
 
 	"github.com/markbates/goth"
 	"github.com/tektoncd/hub/api/pkg/auth/app"
+	authProvider "github.com/tektoncd/hub/api/pkg/auth/provider"
 	"github.com/tektoncd/hub/api/pkg/db/model"
 	"github.com/tektoncd/hub/api/pkg/token"
 	"gorm.io/gorm"
@@ -42,9 +44,59 @@ func (r *request) userScopes(account *model.Account) ([]string, error) {
 		userScopes = append(userScopes, s.Name)
 	}
 
+	// scopes granted by the identity provider at the login
+	for _, s := range account.ClaimedScopes {
+		if !contains(userScopes, s) {
+			userScopes = append(userScopes, s)
+		}
+	}
+
 	return userScopes, nil
 }
 
+// updateClaimedScopes saves the scopes claimed for the user by the provider
+// in the account, the mapped scopes which are not hub scopes are ignored.
+// The scopes are replaced on every login so that a scope removed in the
+// provider is not granted in the next tokens.
+func (r *request) updateClaimedScopes(gitUser goth.User, userID uint, provider string) error {
+
+	claimed := authProvider.ClaimedScopes(gitUser.RawData)
+
+	scopes := []model.Scope{}
+	if len(claimed) > 0 {
+		if err := r.db.Where("name IN ?", claimed).Order("name").Find(&scopes).Error; err != nil {
+			return err
+		}
+	}
+
+	names := []string{}
+	for _, s := range scopes {
+		names = append(names, s.Name)
+	}
+
+	q := r.db.Model(&model.Account{}).
+		Where("user_id = ?", userID).
+		Where("provider = ?", provider)
+
+	res := q.Select("claimed_scopes").Updates(&model.Account{ClaimedScopes: names})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("%s account of user %d not found", provider, userID)
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *request) createTokens(user *model.User, scopes []string, provider string) (*app.AuthenticateResult, error) {
 
 	req := token.Request{
@@ -107,8 +159,9 @@ If Email is already present
 		- if the record doesn't exists(user trying to login with new provider)
 			then create a new record in accounts table with new provider and the user_id already associated with the email
 		- if record exists then update the record if there are any changes
+The id of the user of the account is returned.
 */
-func (r *request) insertData(gitUser goth.User, code, provider string) error {
+func (r *request) insertData(gitUser goth.User, code, provider string) (uint, error) {
 
 	var acc model.Account
 	var user model.User
@@ -133,29 +186,31 @@ func (r *request) insertData(gitUser goth.User, code, provider string) error {
 
 			if err = r.insertIntoAccountsTable(gitUser, provider, user.ID); err != nil {
 				r.log.Error(err)
-				return err
+				return 0, err
 			}
+			return user.ID, nil
 		} else {
 			// Account exists
 			// Update the user table with the email
 			if err := r.db.Model(&model.User{}).Where("id = ?", acc.UserID).
 				Updates(model.User{Code: code, Email: gitUser.Email, Type: model.NormalUserType}).Error; err != nil {
 				r.log.Error(err)
-				return err
+				return 0, err
 			}
 
 			// Update the AvatarUrl and Name in Accounts table
 			if err := updateAccountDetails(accountQuery, acc,
 				model.Account{AvatarURL: gitUser.AvatarURL, Name: gitUser.Name, UserName: gitUser.NickName}); err != nil {
 				r.log.Error(err)
-				return err
+				return 0, err
 			}
+			return acc.UserID, nil
 		}
 	} else { // when the email of user already exists
 		// Update the users table with the auth code
 		if err := userQuery.Update("code", code).Error; err != nil {
 			r.log.Error(err)
-			return err
+			return 0, err
 		}
 
 		// Check for the account on the basis of user_id and provider
@@ -166,24 +221,24 @@ func (r *request) insertData(gitUser goth.User, code, provider string) error {
 		if err == gorm.ErrRecordNotFound {
 			if err = r.insertIntoAccountsTable(gitUser, provider, user.ID); err != nil {
 				r.log.Error(err)
-				return err
+				return 0, err
 			}
-			return nil
+			return user.ID, nil
 		} else if err != nil {
 			r.log.Error(err)
-			return err
+			return 0, err
 		}
 
 		// If account found then update the details of the user
 		if err := updateAccountDetails(accountQuery, acc,
 			model.Account{AvatarURL: gitUser.AvatarURL, Name: gitUser.Name, UserName: gitUser.NickName}); err != nil {
 			r.log.Error(err)
-			return err
+			return 0, err
 		}
 
 	}
 
-	return nil
+	return user.ID, nil
 }
 
 func updateAccountDetails(accountQuery *gorm.DB, existingAccountDetails model.Account, newAccountDetails model.Account) error {
@@ -667,3 +667,72 @@ func TestV1UpdateAvailable_WithSkippedTasks_PipelinesUnknown(t *testing.T) {
 	golden.Assert(t, buf.String(), fmt.Sprintf("%s.golden", t.Name()))
 	assert.Equal(t, gock.IsDone(), true)
 }
+
+// resWithLatestVersion returns the resource foo with the latest version
+func resWithLatestVersion(version string) *res.ResourceData {
+	latest := *resVersion.LatestVersion
+	latest.Version = version
+	r := *resVersion
+	r.LatestVersion = &latest
+	r.Versions = []*res.ResourceVersionData{{ID: 12, Version: version}}
+	return &r
+}
+
+func runCheckUpgrade(t *testing.T, installed, latest string) string {
+	cli := test.NewCLI(hub.TektonHubType)
+
+	defer gock.Off()
+
+	resource := &res.Resource{Data: resWithLatestVersion(latest)}
+	res := res.NewViewedResource(resource, "default")
+	gock.New(test.API).
+		Get("/resource/tekton/task/foo").
+		MatchParam("pipelinesversion", "0.14").
+		Reply(200).
+		JSON(&res.Projected)
+
+	buf := new(bytes.Buffer)
+	cli.SetStream(buf, buf)
+
+	existingTask := &v1.Task{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "hub",
+			Labels: map[string]string{
+				"hub.tekton.dev/catalog":    "tekton",
+				"app.kubernetes.io/version": installed,
+			}},
+	}
+
+	version := "v1"
+	dynamic := test.DynamicClient(cb.UnstructuredV1(existingTask, version))
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{Tasks: []*v1.Task{existingTask}})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"task"})
+
+	opts := &options{
+		cs:   test.FakeClientSet(cs.Pipeline, dynamic, "hub"),
+		cli:  cli,
+		kind: "task",
+	}
+
+	err := test.CreateTektonPipelineController(dynamic, "v0.14.0")
+	if err != nil {
+		t.Errorf("%s", err.Error())
+	}
+
+	err = opts.run()
+	assert.NoError(t, err)
+	assert.Equal(t, gock.IsDone(), true)
+	return buf.String()
+}
+
+func TestV1UpdateAvailable_ComparesSemver(t *testing.T) {
+	out := runCheckUpgrade(t, "0.9", "0.10")
+	assert.Contains(t, out, "foo    Tekton    0.9               0.10")
+}
+
+func TestV1NoUpdateAvailable_ComparesSemver(t *testing.T) {
+	out := runCheckUpgrade(t, "0.10", "0.9")
+	golden.Assert(t, out, "TestV1NoUpdateAvailable.golden")
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/tektoncd/hub/api/pkg/cli/installer"
 	"github.com/tektoncd/hub/api/pkg/cli/kube"
 	"github.com/tektoncd/hub/api/pkg/cli/printer"
+	"github.com/tektoncd/hub/api/pkg/semver"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
@@ -120,6 +121,7 @@ func Command(cli app.CLI) *cobra.Command {
 	}
 	cmd.AddCommand(
 		commandForKind("task", opts),
+		commandForKind("stepaction", opts),
 	)
 
 	cmd.PersistentFlags().StringVarP(&opts.kc.Path, "kubeconfig", "k", "", "Kubectl config file (default: $HOME/.kube/config)")
@@ -205,7 +207,7 @@ func (opts *options) run() error {
 		hubResource := resourceDetails.(hub.ResourceData)
 
 		// Check if higher version available
-		if opts.version < *hubResource.LatestVersion.Version {
+		if semver.Less(opts.version, *hubResource.LatestVersion.Version) {
 			hubRes := hubRes{
 				Name:           *hubResource.Name,
 				Catalog:        cases.Title(language.English).String(resourceCatalogLabel),
@@ -158,6 +158,7 @@ func (opts *options) run() error {
 		Catalog: catalog,
 		Kind:    opts.kind,
 		Version: opts.version,
+		Install: true,
 	})
 
 	manifest, err := opts.hubRes.ResourceYaml()
@@ -51,7 +51,9 @@ const resTemplate = `{{ icon "name" }}Name: {{ .Resource.Name }}
 {{ icon "description" }}Description: {{ formatDesc .ResVersion.Description 80 16 }}
 
 {{ icon "minPipelineVersion" }}Minimum Pipeline Version: {{ .ResVersion.MinPipelinesVersion }}
-
+{{ with .ResVersion.APIVersion }}
+{{ icon "apiVersion" }}API Version: {{ . }}
+{{ end }}
 {{ icon "rating" }}Rating: {{ .Resource.Rating }}
 
 {{ $t := len .Resource.Categories }}{{ if ne $t 0 }}
@@ -74,6 +76,27 @@ const resTemplate = `{{ icon "name" }}Name: {{ .Resource.Name }}
   {{ icon "bullet" }}{{ $p.Name }}
  {{- end }}
 {{- end }}
+{{- with .ResVersion.Params }}
+
+{{ icon "params" }}Params
+ {{- range $p := . }}
+  {{ icon "bullet" }}{{ formatParam $p }}
+ {{- end }}
+{{- end }}
+{{- with .ResVersion.Workspaces }}
+
+{{ icon "workspaces" }}Workspaces
+ {{- range $w := . }}
+  {{ icon "bullet" }}{{ formatWorkspace $w }}
+ {{- end }}
+{{- end }}
+{{- with .ResVersion.Results }}
+
+{{ icon "results" }}Results
+ {{- range $r := . }}
+  {{ icon "bullet" }}{{ formatResult $r }}
+ {{- end }}
+{{- end }}
 
 {{ icon "install" }}Install Command:
   {{ formatInstallCMD .Resource .ResVersion .Latest }}
@@ -85,6 +108,9 @@ var (
 		"formatDesc":       formatter.WrapText,
 		"formatVersion":    formatter.FormatVersion,
 		"formatInstallCMD": formatter.FormatInstallCMD,
+		"formatParam":      formatter.FormatParam,
+		"formatWorkspace":  formatter.FormatWorkspace,
+		"formatResult":     formatter.FormatResult,
 		"default":          formatter.DefaultValue,
 		"lower":            strings.ToLower,
 	}
@@ -122,6 +148,7 @@ func Command(cli app.CLI) *cobra.Command {
 	}
 	cmd.AddCommand(
 		commandForKind("task", opts),
+		commandForKind("stepaction", opts),
 	)
 
 	cmd.PersistentFlags().StringVar(&opts.from, "from", "", "Name of Catalog to which resource belongs.")
@@ -127,6 +127,131 @@ var taskResWithOldVersion = &res.ResourceVersionData{
 	},
 }
 
+var stepActionAPIVersion = "tekton.dev/v1beta1"
+
+var stepActionResWithLatestVersion = &res.ResourceVersionData{
+	ID:                  21,
+	Version:             "0.1",
+	Description:         "Description for stepaction git-clone version 0.1",
+	MinPipelinesVersion: "0.56.0",
+	RawURL:              "http://raw.github.url/git-clone/",
+	WebURL:              "http://web.github.com/git-clone/",
+	UpdatedAt:           "2020-01-01 12:00:00 +0000 UTC",
+	APIVersion:          &stepActionAPIVersion,
+	Platforms: []*res.Platform{
+		{
+			ID:   3,
+			Name: "linux/amd64",
+		},
+	},
+	Resource: &res.ResourceData{
+		ID:   9,
+		Name: "git-clone",
+		Kind: "StepAction",
+		Catalog: &res.Catalog{
+			ID:   1,
+			Name: "tekton",
+			Type: "community",
+		},
+		Rating: 5,
+		Categories: []*res.Category{
+			{
+				ID:   2,
+				Name: "Git",
+			},
+		},
+		Tags: []*res.Tag{
+			{
+				ID:   4,
+				Name: "git",
+			},
+		},
+		Platforms: []*res.Platform{
+			{
+				ID:   3,
+				Name: "linux/amd64",
+			},
+		},
+	},
+}
+
+var imageDefault = "quay.io/buildah/stable:v1.31"
+var taskResWithSchema = &res.ResourceVersionData{
+	ID:                  13,
+	Version:             "0.3",
+	Description:         "Description for task foo-bar version 0.3",
+	MinPipelinesVersion: "0.50.0",
+	RawURL:              "http://raw.github.url/foo-bar/",
+	WebURL:              "http://web.github.com/foo-bar/",
+	UpdatedAt:           "2020-01-01 12:00:00 +0000 UTC",
+	Platforms: []*res.Platform{
+		{
+			ID:   3,
+			Name: "linux/amd64",
+		},
+	},
+	Params: []*res.ParamSpec{
+		{
+			Name:        "IMAGE",
+			Type:        "string",
+			Description: "Reference of the image to build",
+		},
+		{
+			Name:        "BUILDER_IMAGE",
+			Type:        "string",
+			Default:     &imageDefault,
+			Description: "The location of the buildah builder image",
+		},
+	},
+	Workspaces: []*res.WorkspaceSpec{
+		{
+			Name:        "source",
+			Description: "Source to build",
+		},
+		{
+			Name:     "dockerconfig",
+			Optional: true,
+			ReadOnly: true,
+		},
+	},
+	Results: []*res.ResultSpec{
+		{
+			Name:        "IMAGE_DIGEST",
+			Type:        "string",
+			Description: "Digest of the image just built",
+		},
+	},
+	Resource: &res.ResourceData{
+		ID:   2,
+		Name: "foo-bar",
+		Kind: "Task",
+		Catalog: &res.Catalog{
+			ID:   1,
+			Name: "tekton",
+			Type: "community",
+		},
+		Rating: 4,
+		Categories: []*res.Category{
+			{
+				ID:   1,
+				Name: "foo-bar",
+			},
+		},
+		Tags: []*res.Tag{
+			{
+				ID:   3,
+				Name: "foo",
+			},
+		},
+		Platforms: []*res.Platform{
+			{
+				ID:   3,
+				Name: "linux/amd64",
+			},
+		},
+	},
+}
+
 func mockApi(io InfoOptions, taskWithVersion *res.ResourceVersionData) {
 
 	// Get ResourceId in order to get all versions of resource
@@ -170,6 +295,36 @@ func TestInfoTask_WithLatestVersion(t *testing.T) {
 	assert.Equal(t, gock.IsDone(), true)
 }
 
+func TestInfoTask_WithSchema(t *testing.T) {
+	cli := test.NewCLI(hub.TektonHubType)
+
+	defer gock.Off()
+
+	mockApi(InfoOptions{
+		ResId:   13,
+		Name:    "foo-bar",
+		Kind:    "task",
+		Catalog: "tekton",
+		Version: "0.3",
+	}, taskResWithSchema)
+
+	buf := new(bytes.Buffer)
+	cli.SetStream(buf, buf)
+
+	opts := options{
+		cli:     cli,
+		kind:    "task",
+		args:    []string{"foo-bar"},
+		from:    "tekton",
+		version: "0.3",
+	}
+
+	err := opts.run()
+	assert.NoError(t, err)
+	golden.Assert(t, buf.String(), fmt.Sprintf("%s.golden", t.Name()))
+	assert.Equal(t, gock.IsDone(), true)
+}
+
 func TestInfoTask_WithOldVersion(t *testing.T) {
 	cli := test.NewCLI(hub.TektonHubType)
 
@@ -231,3 +386,33 @@ func TestPipelineTask_MultiLineDescription(t *testing.T) {
 	golden.Assert(t, buf.String(), fmt.Sprintf("%s.golden", t.Name()))
 	assert.Equal(t, gock.IsDone(), true)
 }
+
+func TestInfoStepAction_WithLatestVersion(t *testing.T) {
+	cli := test.NewCLI(hub.TektonHubType)
+
+	defer gock.Off()
+
+	mockApi(InfoOptions{
+		ResId:   9,
+		Name:    "git-clone",
+		Kind:    "stepaction",
+		Catalog: "tekton",
+		Version: "0.1",
+	}, stepActionResWithLatestVersion)
+
+	buf := new(bytes.Buffer)
+	cli.SetStream(buf, buf)
+
+	opts := options{
+		cli:     cli,
+		kind:    "stepaction",
+		args:    []string{"git-clone"},
+		from:    "tekton",
+		version: "0.1",
+	}
+
+	err := opts.run()
+	assert.NoError(t, err)
+	golden.Assert(t, buf.String(), fmt.Sprintf("%s.golden", t.Name()))
+	assert.Equal(t, gock.IsDone(), true)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/tektoncd/hub/api/pkg/cli/installer"
 	"github.com/tektoncd/hub/api/pkg/cli/kube"
 	"github.com/tektoncd/hub/api/pkg/cli/printer"
+	"github.com/tektoncd/hub/api/pkg/semver"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -78,6 +79,7 @@ func Command(cli app.CLI) *cobra.Command {
 	}
 	cmd.AddCommand(
 		commandForKind("task", opts),
+		commandForKind("stepaction", opts),
 	)
 
 	cmd.PersistentFlags().StringVar(&opts.from, "from", "", "Name of Catalog to which resource belongs.")
@@ -152,6 +154,7 @@ func (opts *options) run() error {
 		Catalog: opts.from,
 		Kind:    opts.kind,
 		Version: opts.version,
+		Install: true,
 	})
 
 	manifest, err := opts.hubRes.ResourceYaml()
@@ -249,25 +252,21 @@ func (opts *options) errors(pipelinesVersion string, errors []error) error {
 					resourceVersion += ".0"
 				}
 
-				switch {
-				case existingVersion == resourceVersion:
+				switch semver.Compare(existingVersion, resourceVersion) {
+				case 0:
 					return fmt.Errorf("%s %s(%s) already exists in %s namespace. Use reinstall command to overwrite existing",
 						cases.Title(language.English).String(opts.resource.GetKind()), opts.resource.GetName(), existingVersion, opts.cs.Namespace())
 
-				case existingVersion < resourceVersion:
+				case -1:
 					if opts.version == "" {
 						resourceVersion = resourceVersion + "(latest)"
 					}
 					return fmt.Errorf("%s %s(%s) already exists in %s namespace. Use upgrade command to install v%s",
 						cases.Title(language.English).String(opts.resource.GetKind()), opts.resource.GetName(), existingVersion, opts.cs.Namespace(), resourceVersion)
 
-				case existingVersion > resourceVersion:
+				default:
 					return fmt.Errorf("%s %s(%s) already exists in %s namespace. Use downgrade command to install v%s",
 						cases.Title(language.English).String(opts.resource.GetKind()), opts.resource.GetName(), existingVersion, opts.cs.Namespace(), resourceVersion)
-
-				default:
-					return fmt.Errorf("%s %s(%s) already exists in %s namespace. Use reinstall command to overwrite existing",
-						cases.Title(language.English).String(opts.resource.GetKind()), opts.resource.GetName(), existingVersion, opts.cs.Namespace())
 				}
 
 			} else {
@@ -537,6 +537,60 @@ func TestV1Install_UpgradeError(t *testing.T) {
 	assert.Equal(t, gock.IsDone(), true)
 }
 
+func TestV1Install_DowngradeError_ComparesSemver(t *testing.T) {
+	cli := test.NewCLI(hub.TektonHubType)
+
+	defer gock.Off()
+
+	rVer := &res.ResourceVersionYaml{Data: taskV1WithNewVersionYaml}
+	resWithVersion := res.NewViewedResourceVersionYaml(rVer, "default")
+
+	resInfo := fmt.Sprintf("%s/%s/%s/%s", "tekton", "task", "foo", "0.3")
+
+	gock.New(test.API).
+		Get("/resource/" + resInfo + "/yaml").
+		Reply(200).
+		JSON(&resWithVersion.Projected)
+
+	resVersion := &res.ResourceVersion{Data: resVersion}
+	resource := res.NewViewedResourceVersion(resVersion, "default")
+	gock.New(test.API).
+		Get("/resource/tekton/task/foo/0.3").
+		Reply(200).
+		JSON(&resource.Projected)
+
+	buf := new(bytes.Buffer)
+	cli.SetStream(buf, buf)
+
+	existingTask := &v1.Task{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: "hub",
+			Labels:    map[string]string{"app.kubernetes.io/version": "0.10"},
+		},
+	}
+
+	version := "v1"
+	dynamic := test.DynamicClient(cb.UnstructuredV1(existingTask, version))
+
+	cs, _ := test.SeedTestData(t, pipelinetest.Data{Tasks: []*v1.Task{existingTask}})
+	cs.Pipeline.Resources = cb.APIResourceList(version, []string{"task"})
+
+	opts := &options{
+		cs:      test.FakeClientSet(cs.Pipeline, dynamic, "hub"),
+		cli:     cli,
+		kind:    "task",
+		args:    []string{"foo"},
+		from:    "tekton",
+		version: "0.3",
+	}
+
+	err := opts.run()
+	assert.Error(t, err)
+	assert.EqualError(t, err, "Task foo(0.10.0) already exists in hub namespace. Use downgrade command to install v0.3.0")
+	assert.Equal(t, gock.IsDone(), true)
+}
+
 func TestInstall_SameVersionError(t *testing.T) {
 	cli := test.NewCLI(hub.TektonHubType)
 
@@ -139,6 +139,7 @@ func (opts *options) run() error {
 		Catalog: opts.resCatalog(),
 		Kind:    opts.kind,
 		Version: opts.resVersion(),
+		Install: true,
 	})
 
 	manifest, err := opts.hubRes.ResourceYaml()
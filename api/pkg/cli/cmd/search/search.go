@@ -62,15 +62,16 @@ var (
 )
 
 type options struct {
-	cli        app.CLI
-	limit      uint
-	match      string
-	output     string
-	tags       []string
-	categories []string
-	kinds      []string
-	platforms  []string
-	args       []string
+	cli         app.CLI
+	limit       uint
+	match       string
+	output      string
+	tags        []string
+	categories  []string
+	kinds       []string
+	platforms   []string
+	apiVersions []string
+	args        []string
 }
 
 var examples string = `
@@ -83,6 +84,13 @@ or
 Search resources using tag 'cli':
 
     tkn hub search --tags cli
+
+or
+
+Search resources whose name, description, tags or categories match 'container image',
+sorted by relevance:
+
+    tkn hub search "container image" --match fulltext
 `
 
 func Command(cli app.CLI) *cobra.Command {
@@ -106,11 +114,12 @@ func Command(cli app.CLI) *cobra.Command {
 	}
 
 	cmd.Flags().UintVarP(&opts.limit, "limit", "l", 0, "Max number of resources to fetch")
-	cmd.Flags().StringVar(&opts.match, "match", "contains", "Accept type of search. 'exact' or 'contains'.")
+	cmd.Flags().StringVar(&opts.match, "match", "contains", "Accept type of search. 'exact', 'contains' or 'fulltext'.")
 	cmd.Flags().StringArrayVar(&opts.kinds, "kinds", nil, "Accepts a comma separated list of kinds")
 	cmd.Flags().StringArrayVar(&opts.tags, "tags", nil, "Accepts a comma separated list of tags")
 	cmd.Flags().StringArrayVar(&opts.categories, "categories", nil, "Accepts a comma separated list of categories")
 	cmd.Flags().StringArrayVar(&opts.platforms, "platforms", nil, "Accepts a comma separated list of platforms")
+	cmd.Flags().StringArrayVar(&opts.apiVersions, "api-versions", nil, "Accepts a comma separated list of apiVersions e.g. tekton.dev/v1")
 	cmd.Flags().StringVarP(&opts.output, "output", "o", "table", "Accepts output format: [table, json, wide]")
 
 	return cmd
@@ -125,13 +134,14 @@ func (opts *options) run() error {
 	hubClient := opts.cli.Hub()
 
 	result := hubClient.Search(hub.SearchOption{
-		Name:       opts.name(),
-		Kinds:      opts.kinds,
-		Tags:       opts.tags,
-		Categories: opts.categories,
-		Platforms:  opts.platforms,
-		Match:      opts.match,
-		Limit:      opts.limit,
+		Name:        opts.name(),
+		Kinds:       opts.kinds,
+		Tags:        opts.tags,
+		Categories:  opts.categories,
+		Platforms:   opts.platforms,
+		APIVersions: opts.apiVersions,
+		Match:       opts.match,
+		Limit:       opts.limit,
 	})
 
 	out := opts.cli.Stream().Out
@@ -163,11 +173,11 @@ func (opts *options) validate() error {
 		return fmt.Errorf("search sub command is not supported for artifact type")
 	}
 
-	if flag.AllEmpty(opts.args, opts.kinds, opts.tags, opts.categories, opts.platforms) {
-		return fmt.Errorf("please specify a resource name, --tags, --platforms, --categories or --kinds flag to search")
+	if flag.AllEmpty(opts.args, opts.kinds, opts.tags, opts.categories, opts.platforms, opts.apiVersions) {
+		return fmt.Errorf("please specify a resource name, --tags, --platforms, --categories, --api-versions or --kinds flag to search")
 	}
 
-	if err := flag.InList("match", opts.match, []string{"contains", "exact"}); err != nil {
+	if err := flag.InList("match", opts.match, []string{"contains", "exact", "fulltext"}); err != nil {
 		return err
 	}
 
@@ -179,6 +189,7 @@ func (opts *options) validate() error {
 	opts.tags = flag.TrimArray(opts.tags)
 	opts.categories = flag.TrimArray(opts.categories)
 	opts.platforms = flag.TrimArray(opts.platforms)
+	opts.apiVersions = flag.TrimArray(opts.apiVersions)
 
 	for _, k := range opts.kinds {
 		if !parser.IsSupportedKind(k) {
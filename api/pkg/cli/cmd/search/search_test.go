@@ -118,6 +118,26 @@ func TestValidate(t *testing.T) {
 
 	err = opt.validate()
 	assert.NoError(t, err)
+
+	opt = options{
+		args:   []string{"container image"},
+		match:  "fulltext",
+		output: "table",
+		cli:    cli,
+	}
+
+	err = opt.validate()
+	assert.NoError(t, err)
+
+	opt = options{
+		apiVersions: []string{"tekton.dev/v1"},
+		match:       "contains",
+		output:      "table",
+		cli:         cli,
+	}
+
+	err = opt.validate()
+	assert.NoError(t, err)
 }
 
 func TestValidate_ErrorCases(t *testing.T) {
@@ -130,7 +150,7 @@ func TestValidate_ErrorCases(t *testing.T) {
 	opt := options{cli: cli}
 	err := opt.validate()
 	assert.Error(t, err)
-	assert.EqualError(t, err, "please specify a resource name, --tags, --platforms, --categories or --kinds flag to search")
+	assert.EqualError(t, err, "please specify a resource name, --tags, --platforms, --categories, --api-versions or --kinds flag to search")
 
 	opt = options{
 		kinds:  []string{"abc"},
@@ -140,7 +160,7 @@ func TestValidate_ErrorCases(t *testing.T) {
 	}
 	err = opt.validate()
 	assert.Error(t, err)
-	assert.EqualError(t, err, "invalid value \"abc\" set for option kinds. supported kinds: [task, pipeline]")
+	assert.EqualError(t, err, "invalid value \"abc\" set for option kinds. supported kinds: [task, pipeline, stepaction]")
 
 	opt = options{
 		kinds:  []string{"task"},
@@ -150,7 +170,7 @@ func TestValidate_ErrorCases(t *testing.T) {
 	}
 	err = opt.validate()
 	assert.Error(t, err)
-	assert.EqualError(t, err, "invalid value \"abc\" set for option match. Valid options: [contains, exact]")
+	assert.EqualError(t, err, "invalid value \"abc\" set for option match. Valid options: [contains, exact, fulltext]")
 
 	opt = options{
 		kinds:  []string{"task"},
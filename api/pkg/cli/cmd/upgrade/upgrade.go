@@ -74,6 +74,7 @@ func Command(cli app.CLI) *cobra.Command {
 	}
 	cmd.AddCommand(
 		commandForKind("task", opts),
+		commandForKind("stepaction", opts),
 	)
 
 	cmd.PersistentFlags().StringVar(&opts.version, "to", "", "Version of Resource")
@@ -152,6 +153,7 @@ func (opts *options) run() error {
 		Catalog: catalog,
 		Kind:    opts.kind,
 		Version: opts.version,
+		Install: true,
 	})
 
 	manifest, err := opts.hubRes.ResourceYaml()
@@ -33,9 +33,13 @@ var icons = map[string]string{
 	"version":            "📌 ",
 	"description":        "📖 ",
 	"minPipelineVersion": "🗒  ",
+	"apiVersion":         "🔖 ",
 	"rating":             "⭐ ️",
 	"tags":               "🏷 ",
 	"platforms":          "💻 ",
+	"params":             "⚙ ",
+	"workspaces":         "📂 ",
+	"results":            "📤 ",
 	"install":            "⚒ ",
 	"categories":         "🏷️  ️",
 }
@@ -109,6 +113,53 @@ func FormatPlatforms(platforms []*client.PlatformResponseBody) string {
 	return sb.String()
 }
 
+// FormatParam returns name of param with its type, default and
+// description e.g. IMAGE (string, default: alpine): Image to run
+func FormatParam(p *client.ParamSpecResponseBody) string {
+	var sb strings.Builder
+	sb.WriteString(*p.Name + " (" + *p.Type)
+	if p.Default != nil {
+		sb.WriteString(", default: " + *p.Default)
+	}
+	sb.WriteString(")")
+	if p.Description != nil && *p.Description != "" {
+		sb.WriteString(": " + *p.Description)
+	}
+	return sb.String()
+}
+
+// FormatWorkspace returns name of workspace with its description and
+// whether it is optional or read only
+func FormatWorkspace(w *client.WorkspaceSpecResponseBody) string {
+	var attrs []string
+	if w.Optional != nil && *w.Optional {
+		attrs = append(attrs, "optional")
+	}
+	if w.ReadOnly != nil && *w.ReadOnly {
+		attrs = append(attrs, "read only")
+	}
+
+	var sb strings.Builder
+	sb.WriteString(*w.Name)
+	if len(attrs) != 0 {
+		sb.WriteString(" (" + strings.Join(attrs, ", ") + ")")
+	}
+	if w.Description != nil && *w.Description != "" {
+		sb.WriteString(": " + *w.Description)
+	}
+	return sb.String()
+}
+
+// FormatResult returns name of result with its type and description
+func FormatResult(r *client.ResultSpecResponseBody) string {
+	var sb strings.Builder
+	sb.WriteString(*r.Name + " (" + *r.Type + ")")
+	if r.Description != nil && *r.Description != "" {
+		sb.WriteString(": " + *r.Description)
+	}
+	return sb.String()
+}
+
 // WrapText returns description broken down in multiple lines with
 // max width passed to it
 // titleLength would be the length of title on left hand side before the
@@ -112,6 +112,38 @@ func TestFormatPlatforms(t *testing.T) {
 	assert.Equal(t, "---", platforms)
 }
 
+func TestFormatParam(t *testing.T) {
+
+	name, typ, def, desc := "ARGS", "array", `["--help"]`, "Arguments of tkn"
+	empty := ""
+
+	param := FormatParam(&client.ParamSpecResponseBody{Name: &name, Type: &typ, Default: &def, Description: &desc})
+	assert.Equal(t, `ARGS (array, default: ["--help"]): Arguments of tkn`, param)
+
+	param = FormatParam(&client.ParamSpecResponseBody{Name: &name, Type: &typ, Description: &empty})
+	assert.Equal(t, "ARGS (array)", param)
+}
+
+func TestFormatWorkspace(t *testing.T) {
+
+	name, desc := "source", "Source to build"
+	optional, readOnly := true, false
+
+	ws := FormatWorkspace(&client.WorkspaceSpecResponseBody{Name: &name, Description: &desc, Optional: &optional, ReadOnly: &readOnly})
+	assert.Equal(t, "source (optional): Source to build", ws)
+
+	ws = FormatWorkspace(&client.WorkspaceSpecResponseBody{Name: &name, Description: &desc, Optional: &readOnly, ReadOnly: &optional})
+	assert.Equal(t, "source (read only): Source to build", ws)
+}
+
+func TestFormatResult(t *testing.T) {
+
+	name, typ, desc := "IMAGE_DIGEST", "string", "Digest of the image"
+
+	result := FormatResult(&client.ResultSpecResponseBody{Name: &name, Type: &typ, Description: &desc})
+	assert.Equal(t, "IMAGE_DIGEST (string): Digest of the image", result)
+}
+
 func TestWrapText(t *testing.T) {
 
 	// Description of resource with just summa
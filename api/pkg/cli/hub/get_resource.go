@@ -21,7 +21,7 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/Masterminds/semver/v3"
+	"github.com/tektoncd/hub/api/pkg/semver"
 	rclient "github.com/tektoncd/hub/api/v1/gen/http/resource/client"
 )
 
@@ -33,6 +33,9 @@ type ResourceOption struct {
 	Version         string
 	Kind            string
 	PipelineVersion string
+	// Install is set when the resource is fetched to be installed, the
+	// installs are counted as downloads of the resource by the hub
+	Install bool
 }
 
 // ResourceResult defines API response
@@ -149,7 +152,11 @@ func (a *artifactHubClient) GetResourceYaml(opt ResourceOption) ResourceResult {
 // GetResourceYaml queries the resource yaml using Tekton Hub Endpoint
 func (t *tektonHubClient) GetResourceYaml(opt ResourceOption) ResourceResult {
 
-	yaml, yamlStatus, yamlErr := t.Get(fmt.Sprintf("/v1/resource/%s/%s/%s/%s/yaml", opt.Catalog, opt.Kind, opt.Name, opt.Version))
+	yamlPath := fmt.Sprintf("/v1/resource/%s/%s/%s/%s/yaml", opt.Catalog, opt.Kind, opt.Name, opt.Version)
+	if opt.Install {
+		yamlPath += "?install=true"
+	}
+	yaml, yamlStatus, yamlErr := t.Get(yamlPath)
 	data, status, err := t.Get(opt.Endpoint())
 
 	return &TektonHubResourceResult{
@@ -407,7 +414,7 @@ func (rr *ArtifactHubResourceResult) ResourceVersion() (string, error) {
 		if err != nil {
 			return "", err
 		}
-		return versions[0], nil
+		return semver.Latest(versions, false), nil
 	}
 	return rr.version, nil
 }
@@ -495,47 +502,9 @@ func sortVersionsSemanticaly(versions []string) []string {
 		return versions
 	}
 
-	// Create a struct to hold both original string and parsed version
-	type versionPair struct {
-		original string
-		semver   *semver.Version
-	}
-
-	var validVersions []versionPair
-	var invalidVersions []string
-
-	// Parse versions, keeping original strings and invalid ones separate
-	for _, v := range versions {
-		// Try to parse as semantic version
-		version, err := semver.NewVersion(v)
-		if err != nil {
-			// If it's not a valid semver, keep it as string for fallback sorting
-			invalidVersions = append(invalidVersions, v)
-		} else {
-			validVersions = append(validVersions, versionPair{
-				original: v,
-				semver:   version,
-			})
-		}
-	}
-
-	// Sort valid versions by their semver objects in descending order (latest first)
-	sort.Slice(validVersions, func(i, j int) bool {
-		return validVersions[i].semver.GreaterThan(validVersions[j].semver)
+	sorted := append([]string{}, versions...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return semver.Less(sorted[j], sorted[i])
 	})
-
-	// Sort invalid versions alphabetically in descending order as fallback
-	sort.Sort(sort.Reverse(sort.StringSlice(invalidVersions)))
-
-	// Convert back to original strings and combine results
-	var result []string
-
-	// Add properly sorted semantic versions first (in original format)
-	for _, vp := range validVersions {
-		result = append(result, vp.original)
-	}
-
-	result = append(result, invalidVersions...)
-
-	return result
+	return sorted
 }
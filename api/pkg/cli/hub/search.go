@@ -25,14 +25,15 @@ import (
 
 // SearchOption defines option associated with query API
 type SearchOption struct {
-	Name       string
-	Kinds      []string
-	Tags       []string
-	Categories []string
-	Platforms  []string
-	Match      string
-	Limit      uint
-	Catalog    string
+	Name        string
+	Kinds       []string
+	Tags        []string
+	Categories  []string
+	Platforms   []string
+	APIVersions []string
+	Match       string
+	Limit       uint
+	Catalog     string
 }
 
 // SearchResponse is the data object which is the search result
@@ -105,6 +106,9 @@ func (so SearchOption) Endpoint() string {
 	if len(so.Platforms) != 0 {
 		addArraytoURL("platforms", so.Platforms, v)
 	}
+	if len(so.APIVersions) != 0 {
+		addArraytoURL("apiVersions", so.APIVersions, v)
+	}
 	if so.Limit != 0 {
 		v.Set("limit", strconv.FormatUint(uint64(so.Limit), 10))
 	}
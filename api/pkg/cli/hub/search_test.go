@@ -30,6 +30,13 @@ func TestEndpoint(t *testing.T) {
 	url := opt.Endpoint()
 	assert.Equal(t, "/v1/query?limit=100&match=contains", url)
 
+	opt = SearchOption{
+		APIVersions: []string{"tekton.dev/v1"},
+		Match:       "contains",
+	}
+	url = opt.Endpoint()
+	assert.Equal(t, "/v1/query?apiVersions=tekton.dev%2Fv1&match=contains", url)
+
 	opt = SearchOption{
 		Name:  "res",
 		Kinds: []string{"k1", "k2", "k3"},
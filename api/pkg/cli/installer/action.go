@@ -21,6 +21,7 @@ import (
 
 	"github.com/tektoncd/hub/api/pkg/cli/hub"
 	tknVer "github.com/tektoncd/hub/api/pkg/cli/version"
+	"github.com/tektoncd/hub/api/pkg/semver"
 	kErr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -81,7 +82,7 @@ func (i *Installer) checkVersion(resPipMinVersion string) error {
 		return ErrWarnVersionNotFound
 	}
 
-	if i.GetPipelineVersion() < resPipMinVersion && i.GetPipelineVersion() != "devel" {
+	if semver.Less(i.GetPipelineVersion(), resPipMinVersion) && i.GetPipelineVersion() != "devel" {
 		return ErrVersionIncompatible
 	}
 
@@ -238,10 +239,10 @@ func (i *Installer) updateByAction(data []byte, catalog, namespace string, actio
 
 func isUpgradable(existingVersion, newVersion string) error {
 
-	if newVersion == existingVersion {
+	switch semver.Compare(newVersion, existingVersion) {
+	case 0:
 		return ErrSameVersion
-	}
-	if newVersion < existingVersion {
+	case -1:
 		return ErrLowerVersion
 	}
 	return nil
@@ -249,10 +250,10 @@ func isUpgradable(existingVersion, newVersion string) error {
 
 func isDowngradable(existingVersion, newVersion string) error {
 
-	if newVersion == existingVersion {
+	switch semver.Compare(newVersion, existingVersion) {
+	case 0:
 		return ErrSameVersion
-	}
-	if newVersion > existingVersion {
+	case 1:
 		return ErrHigherVersion
 	}
 	return nil
@@ -127,3 +127,17 @@ func TestListInstalled(t *testing.T) {
 
 	assert.Equal(t, len(list), 1)
 }
+
+func TestIsUpgradable(t *testing.T) {
+	assert.NoError(t, isUpgradable("0.9", "0.10"))
+	assert.NoError(t, isUpgradable("0.3.0-rc1", "0.3"))
+	assert.Equal(t, ErrSameVersion, isUpgradable("0.1", "0.1.0"))
+	assert.Equal(t, ErrLowerVersion, isUpgradable("0.10", "0.9"))
+}
+
+func TestIsDowngradable(t *testing.T) {
+	assert.NoError(t, isDowngradable("0.10", "0.9"))
+	assert.NoError(t, isDowngradable("0.3", "0.3.0-rc1"))
+	assert.Equal(t, ErrSameVersion, isDowngradable("0.2", "0.2"))
+	assert.Equal(t, ErrHigherVersion, isDowngradable("0.9", "0.10"))
+}
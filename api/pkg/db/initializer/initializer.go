@@ -16,6 +16,7 @@ package initializer
 
 import (
 	"context"
+	"os"
 	"strings"
 
 	"github.com/tektoncd/hub/api/pkg/app"
@@ -142,7 +143,33 @@ func addCatalogs(db *gorm.DB, log *app.Logger, data *app.Data) error {
 			Revision:   c.Revision,
 			ContextDir: c.ContextDir,
 		}
-		if err := db.Where(&model.Catalog{Name: c.Name, Org: c.Org}).FirstOrCreate(&cat).Error; err != nil {
+		// the webhook secret, the credentials, the visibility and the public
+		// keys are assigned to existing catalogs as well so that they can be
+		// updated in the config
+		keys := strings.Join(c.PublicKeys, "\n")
+		secret := map[string]interface{}{
+			"webhook_secret":           os.ExpandEnv(c.WebhookSecret),
+			"credential":               c.Credential,
+			"insecure_skip_tls_verify": c.InsecureSkipTLSVerify,
+			"private":                  c.Private,
+			"scope":                    c.Scope,
+			"public_keys":              keys,
+		}
+
+		// the verification status of all the versions of the catalog has
+		// to be computed again with the new keys, so the sha synced last
+		// is cleared for the next sync to be a full one
+		existing := model.Catalog{}
+		err := db.Where(&model.Catalog{Name: c.Name, Org: c.Org}).First(&existing).Error
+		if err != nil && err != gorm.ErrRecordNotFound {
+			log.Error(err)
+			return err
+		}
+		if err == nil && existing.PublicKeys != keys {
+			secret["sha"] = ""
+		}
+
+		if err := db.Where(&model.Catalog{Name: c.Name, Org: c.Org}).Assign(secret).FirstOrCreate(&cat).Error; err != nil {
 			log.Error(err)
 			return err
 		}
@@ -0,0 +1,49 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/tektoncd/hub/api/pkg/app"
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"github.com/tektoncd/hub/api/pkg/token"
+	"gorm.io/gorm"
+)
+
+// Agent tokens created before the column is added don't carry a jti, the
+// existing agents get the legacy token id so that their tokens are valid
+// until the agents are updated or revoked
+func addTokenIDColumnInUsersTable(log *app.Logger) *gormigrate.Migration {
+
+	return &gormigrate.Migration{
+		ID: "202610171010_add_token_id_column_in_users_table",
+		Migrate: func(db *gorm.DB) error {
+			// older migrations auto migrate the users table with the latest model
+			if !db.Migrator().HasColumn(&model.User{}, "token_id") {
+				if err := db.Migrator().AddColumn(&model.User{}, "token_id"); err != nil {
+					log.Error(err)
+					return err
+				}
+			}
+			if err := db.Model(&model.User{}).Where(&model.User{Type: model.AgentUserType}).
+				Where("token_id IS NULL OR token_id = ''").
+				UpdateColumn("token_id", token.LegacyID).Error; err != nil {
+				log.Error(err)
+				return err
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/tektoncd/hub/api/pkg/app"
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// Adds a unique index on the catalog of queued and running sync jobs so
+// that a catalog is synced by one worker at a time. The jobs queued twice
+// for a catalog before the index are cancelled except the oldest one.
+func addActiveJobIndexInSyncJobsTable(log *app.Logger) *gormigrate.Migration {
+
+	return &gormigrate.Migration{
+		ID: "202610171019_add_active_job_index_in_sync_jobs_table",
+		Migrate: func(db *gorm.DB) error {
+			if db.Migrator().HasIndex(&model.SyncJob{}, "idx_sync_jobs_active_catalog") {
+				return nil
+			}
+
+			active := []string{model.JobQueued.String(), model.JobRunning.String()}
+			oldest := db.Unscoped().Model(&model.SyncJob{}).Select("MIN(id)").
+				Where("status IN ?", active).Group("catalog_id")
+
+			if err := db.Unscoped().Model(&model.SyncJob{}).
+				Where("status IN ? AND id NOT IN (?)", active, oldest).
+				Updates(map[string]interface{}{
+					"status": model.JobCancelled.String(),
+					"reason": "cancelled as another job of the catalog is queued",
+				}).Error; err != nil {
+				log.Error(err)
+				return err
+			}
+
+			if err := db.Migrator().CreateIndex(&model.SyncJob{}, "idx_sync_jobs_active_catalog"); err != nil {
+				log.Error(err)
+				return err
+			}
+			return nil
+		},
+	}
+}
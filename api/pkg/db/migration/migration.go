@@ -45,6 +45,27 @@ func Migrate(api *app.APIBase) error {
 			addCodeColInUserTable(log),
 			addUsersDetailsInAccountTable(log),
 			addSSHURLColumnInCatalogsTable(log),
+			addBundleColumnInResourceVersionTable(log),
+			addAPIVersionColumnInResourceVersionTable(log),
+			addSearchVectorColumnInResourcesTable(log),
+			addWebhookSecretColumnInCatalogsTable(log),
+			addStatusColsInSyncJobsTable(log),
+			addRetryColsInSyncJobsTable(log),
+			addIncrementalSyncCols(log),
+			addCredentialColumnInCatalogsTable(log),
+			addVisibilityColumnsInCatalogsTable(log),
+			createAuditEventsTable(log),
+			addTokenIDColumnInUsersTable(log),
+			addClaimedScopesColumnInAccountsTable(log),
+			createReviewsTable(log),
+			createVersionDownloadsTable(log),
+			createDependenciesTable(log),
+			createLintResultsTable(log),
+			createImagesTable(log),
+			addVersionVerification(log),
+			createVersionSchemaTables(log),
+			addActiveJobIndexInSyncJobsTable(log),
+			addInsecureSkipTLSVerifyColumnInCatalogsTable(log),
 		},
 	)
 
@@ -65,6 +86,15 @@ func Migrate(api *app.APIBase) error {
 			&model.VersionPlatform{},
 			&model.ResourcePlatform{},
 			&model.Account{},
+			&model.AuditEvent{},
+			&model.Review{},
+			&model.VersionDownload{},
+			&model.Dependency{},
+			&model.LintResult{},
+			&model.Image{},
+			&model.VersionParam{},
+			&model.VersionWorkspace{},
+			&model.VersionResult{},
 		); err != nil {
 			log.Error(err)
 			return err
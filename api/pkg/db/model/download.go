@@ -0,0 +1,56 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Today returns the day downloads are counted in, it can be replaced
+// in tests
+var Today = func() time.Time {
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
+// CountDownload adds a download of the version to the count of the day
+// and to the downloads of its resource
+func CountDownload(db *gorm.DB, version ResourceVersion) error {
+
+	return db.Transaction(func(txn *gorm.DB) error {
+
+		download := VersionDownload{VersionID: version.ID, Day: Today(), Count: 1}
+		if err := txn.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "version_id"}, {Name: "day"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{"count": gorm.Expr("version_downloads.count + 1")}),
+		}).Omit(clause.Associations).Create(&download).Error; err != nil {
+			return err
+		}
+
+		return txn.Model(&Resource{}).Where("id = ?", version.ResourceID).
+			UpdateColumn("downloads", gorm.Expr("downloads + 1")).Error
+	})
+}
+
+// DownloadsSince defines a subquery of the downloads of a resource from
+// the day, to be selected along with the resources
+func DownloadsSince(db *gorm.DB, day time.Time) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true}).Table("version_downloads AS vd").
+		Select("COALESCE(SUM(vd.count), 0)").
+		Joins("JOIN resource_versions AS dv ON dv.id = vd.version_id").
+		Where("dv.resource_id = resources.id AND vd.day >= ?", day)
+}
@@ -51,6 +51,21 @@ type (
 		Revision   string `gorm:"not null;default:null"`
 		ContextDir string
 		SHA        string
+		// WebhookSecret verifies the push events of the catalog repository
+		WebhookSecret string
+		// Credential is the name of the credential used to fetch the
+		// catalog repository if it is private
+		Credential string
+		// InsecureSkipTLSVerify fetches the catalog repository without
+		// verifying its TLS certificate
+		InsecureSkipTLSVerify bool
+		// Private catalogs are visible only to the users having the
+		// Scope of the catalog, catalog:private if it is not set
+		Private bool
+		Scope   string
+		// PublicKeys are the PEM encoded keys verifying the signatures
+		// of the resources of the catalog
+		PublicKeys string
 		Resources  []Resource
 		Errors     []CatalogError
 	}
@@ -61,6 +76,7 @@ type (
 		CatalogID uint
 		Type      string
 		Detail    string
+		Resource  string
 	}
 
 	Resource struct {
@@ -68,12 +84,24 @@ type (
 		Name       string `gorm:"not null;default:null"`
 		Kind       string `gorm:"not null;default:null"`
 		Rating     float64
+		Downloads  uint `gorm:"not null;default:0"`
 		Catalog    Catalog
 		Categories []*Category `gorm:"many2many:resource_categories;constraint:OnDelete:CASCADE;"`
 		CatalogID  uint
 		Platforms  []*Platform       `gorm:"many2many:resource_platforms;constraint:OnDelete:CASCADE;"`
 		Versions   []ResourceVersion `gorm:"constraint:OnDelete:CASCADE;"`
 		Tags       []*Tag            `gorm:"many2many:resource_tags;constraint:OnDelete:CASCADE;"`
+		// SearchVector is maintained using RefreshSearchVector
+		SearchVector string `gorm:"type:tsvector;index:idx_resources_search_vector,type:gin;->:false;<-:false"`
+	}
+
+	// VersionDownload is the number of downloads of a version in a day,
+	// nothing identifying who downloaded the version is kept
+	VersionDownload struct {
+		VersionID uint            `gorm:"primaryKey;autoIncrement:false"`
+		Version   ResourceVersion `gorm:"constraint:OnDelete:CASCADE;"`
+		Day       time.Time       `gorm:"primaryKey;type:date"`
+		Count     uint            `gorm:"not null;default:0"`
 	}
 
 	ResourceVersion struct {
@@ -88,6 +116,11 @@ type (
 		ResourceID          uint
 		Platforms           []*Platform `gorm:"many2many:version_platforms;constraint:OnDelete:CASCADE;"`
 		ModifiedAt          time.Time
+		Bundle              string
+		APIVersion          string
+		// Verification is the result of the verification of the
+		// signature of the version: verified, unsigned or invalid
+		Verification string `gorm:"not null;default:unsigned"`
 	}
 
 	ResourceTag struct {
@@ -128,6 +161,7 @@ type (
 		Type                 UserType
 		AgentName            string
 		RefreshTokenChecksum string
+		TokenID              string
 		Code                 string
 		Scopes               []*Scope  `gorm:"many2many:user_scopes;"`
 		Accounts             []Account `gorm:"constraint:OnDelete:CASCADE;"`
@@ -147,6 +181,90 @@ type (
 		Rating     uint `gorm:"not null;default:null"`
 	}
 
+	// Dependency is a task referenced by a version of a pipeline. Catalog
+	// is the name of the catalog of the task if known and TaskVersion is
+	// set only if the reference pins the version of the task
+	Dependency struct {
+		ID          uint            `gorm:"primarykey"`
+		VersionID   uint            `gorm:"index"`
+		Version     ResourceVersion `gorm:"constraint:OnDelete:CASCADE;"`
+		Name        string          `gorm:"index;not null;default:null"`
+		Kind        string          `gorm:"not null;default:null"`
+		Catalog     string
+		TaskVersion string
+		Bundle      string
+		URL         string
+		Via         string `gorm:"not null;default:null"`
+	}
+
+	// LintResult is a problem found by a lint rule in a version of a
+	// resource, Severity is one of critical, warning and info
+	LintResult struct {
+		ID        uint            `gorm:"primarykey"`
+		VersionID uint            `gorm:"index"`
+		Version   ResourceVersion `gorm:"constraint:OnDelete:CASCADE;"`
+		Rule      string          `gorm:"not null;default:null"`
+		Severity  string          `gorm:"not null;default:null"`
+		Message   string
+	}
+
+	// Image is an image of a step or a sidecar of a version of a
+	// resource, Repository is the Ref without tag and digest
+	Image struct {
+		ID         uint            `gorm:"primarykey"`
+		VersionID  uint            `gorm:"index"`
+		Version    ResourceVersion `gorm:"constraint:OnDelete:CASCADE;"`
+		Ref        string          `gorm:"index;not null;default:null"`
+		Repository string          `gorm:"index;not null;default:null"`
+	}
+
+	// VersionParam is a param of a version of a resource, Default is
+	// JSON for array and object params and null if it has no default
+	VersionParam struct {
+		ID          uint            `gorm:"primarykey"`
+		VersionID   uint            `gorm:"index"`
+		Version     ResourceVersion `gorm:"constraint:OnDelete:CASCADE;"`
+		Name        string          `gorm:"not null;default:null"`
+		Type        string          `gorm:"not null;default:string"`
+		Default     *string
+		Description string
+	}
+
+	// VersionWorkspace is a workspace of a version of a task or a
+	// pipeline
+	VersionWorkspace struct {
+		ID          uint            `gorm:"primarykey"`
+		VersionID   uint            `gorm:"index"`
+		Version     ResourceVersion `gorm:"constraint:OnDelete:CASCADE;"`
+		Name        string          `gorm:"not null;default:null"`
+		Description string
+		Optional    bool `gorm:"default:false"`
+		ReadOnly    bool `gorm:"default:false"`
+	}
+
+	// VersionResult is a result of a version of a resource
+	VersionResult struct {
+		ID          uint            `gorm:"primarykey"`
+		VersionID   uint            `gorm:"index"`
+		Version     ResourceVersion `gorm:"constraint:OnDelete:CASCADE;"`
+		Name        string          `gorm:"not null;default:null"`
+		Type        string          `gorm:"not null;default:string"`
+		Description string
+	}
+
+	// Review is a text written by a user on a version of a resource, next
+	// to the rating of the resource
+	Review struct {
+		gorm.Model
+		UserID     uint `gorm:"index"`
+		User       User
+		ResourceID uint     `gorm:"index"`
+		Resource   Resource `gorm:"constraint:OnDelete:CASCADE;"`
+		VersionID  uint
+		Version    ResourceVersion `gorm:"constraint:OnDelete:CASCADE;"`
+		Text       string          `gorm:"not null;default:null"`
+	}
+
 	Account struct {
 		gorm.Model
 		UserID    uint
@@ -154,6 +272,8 @@ type (
 		Name      string
 		AvatarURL string
 		Provider  string
+		// Scopes granted by the provider in the claims of the last login
+		ClaimedScopes []string `gorm:"serializer:json"`
 	}
 
 	UserScope struct {
@@ -165,6 +285,17 @@ type (
 		gorm.Model
 		Checksum string
 	}
+
+	// AuditEvent records a write operation done by a user or an agent,
+	// the payload of the operation is kept as its digest only
+	AuditEvent struct {
+		ID        uint      `gorm:"primarykey"`
+		ActorID   uint      `gorm:"index"`
+		Action    string    `gorm:"index;not null;default:null"`
+		Target    string    `gorm:"not null;default:null"`
+		Digest    string    `gorm:"not null;default:null"`
+		CreatedAt time.Time `gorm:"index"`
+	}
 )
 
 type UserType string
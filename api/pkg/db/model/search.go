@@ -0,0 +1,48 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"gorm.io/gorm"
+)
+
+// searchVectorQuery computes the full text search document of a resource.
+// Name and display names weigh the most, followed by tags and categories
+// and then descriptions of all versions of the resource.
+const searchVectorQuery = `UPDATE resources AS r SET search_vector =
+	setweight(to_tsvector('english', r.name), 'A') ||
+	setweight(to_tsvector('english', coalesce((
+		SELECT string_agg(rv.display_name, ' ') FROM resource_versions rv
+		WHERE rv.resource_id = r.id AND rv.deleted_at IS NULL), '')), 'A') ||
+	setweight(to_tsvector('english', coalesce((
+		SELECT string_agg(t.name, ' ') FROM resource_tags rt
+		JOIN tags t ON t.id = rt.tag_id
+		WHERE rt.resource_id = r.id), '')), 'B') ||
+	setweight(to_tsvector('english', coalesce((
+		SELECT string_agg(c.name, ' ') FROM resource_categories rc
+		JOIN categories c ON c.id = rc.category_id
+		WHERE rc.resource_id = r.id), '')), 'B') ||
+	setweight(to_tsvector('english', coalesce((
+		SELECT string_agg(rv.description, ' ') FROM resource_versions rv
+		WHERE rv.resource_id = r.id AND rv.deleted_at IS NULL), '')), 'C')`
+
+// RefreshSearchVector recomputes the full text search document of the
+// resources with given ids, or of all resources if no id is passed
+func RefreshSearchVector(db *gorm.DB, ids ...uint) error {
+	if len(ids) == 0 {
+		return db.Exec(searchVectorQuery).Error
+	}
+	return db.Exec(searchVectorQuery+" WHERE r.id IN (?)", ids).Error
+}
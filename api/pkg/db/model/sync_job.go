@@ -15,6 +15,8 @@
 package model
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -27,19 +29,29 @@ const (
 	JobRunning
 	JobDone
 	JobError
+	JobCancelled
 )
 
 func (s JobState) String() string {
-	return [...]string{"queued", "running", "done", "error"}[s]
+	return [...]string{"queued", "running", "done", "error", "cancelled"}[s]
 }
 
 type SyncJob struct {
 	gorm.Model
-	Catalog   Catalog
-	CatalogID uint
-	Status    string
-	UserID    uint
-	User      User
+	Catalog Catalog
+	// a catalog has at most one queued or running job so that two
+	// workers never sync the same clone
+	CatalogID     uint `gorm:"uniqueIndex:idx_sync_jobs_active_catalog,where:status IN ('queued'\\,'running')"`
+	Status        string
+	UserID        uint
+	User          User
+	StartedAt     *time.Time
+	FinishedAt    *time.Time
+	SHA           string
+	Reason        string
+	Attempts      uint
+	NextAttemptAt *time.Time
+	Full          bool
 }
 
 func (j *SyncJob) SetState(s JobState) {
@@ -49,3 +61,8 @@ func (j *SyncJob) SetState(s JobState) {
 func (j *SyncJob) IsRunning() bool {
 	return j.Status == JobRunning.String()
 }
+
+// IsFinished reports whether the job is done, failed or cancelled
+func (j *SyncJob) IsFinished() bool {
+	return j.Status != JobQueued.String() && j.Status != JobRunning.String()
+}
@@ -0,0 +1,46 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"gorm.io/gorm"
+)
+
+// PrivateScope is the scope required to see a private catalog which
+// has no scope of its own
+const PrivateScope = "catalog:private"
+
+// VisibleCatalogs defines a gorm scope on catalogs filtering out the
+// private catalogs whose scope is not one of the scopes of the caller.
+// Anonymous callers have no scopes and see the public catalogs only.
+func VisibleCatalogs(scopes []string) func(db *gorm.DB) *gorm.DB {
+	if len(scopes) == 0 {
+		return func(db *gorm.DB) *gorm.DB {
+			return db.Where("catalogs.private IS NOT TRUE")
+		}
+	}
+
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("(catalogs.private IS NOT TRUE OR COALESCE(NULLIF(catalogs.scope, ''), ?) IN (?))",
+			PrivateScope, scopes)
+	}
+}
+
+// VisibleCatalogIDs returns a sub query selecting the ids of the catalogs
+// visible to the caller having the scopes
+func VisibleCatalogIDs(db *gorm.DB, scopes []string) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true}).
+		Model(&Catalog{}).Select("catalogs.id").Scopes(VisibleCatalogs(scopes))
+}
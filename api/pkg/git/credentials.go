@@ -0,0 +1,150 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Files of a credential in its directory, named after the keys of the
+// kubernetes basic-auth and ssh-auth secrets so that a secret can be
+// mounted as a credential
+const (
+	usernameFile   = "username"
+	tokenFile      = "token"
+	passwordFile   = "password"
+	sshKeyFile     = "ssh-privatekey"
+	knownHostsFile = "known_hosts"
+)
+
+// defaultUsername is used along with a token if the credential has no
+// username, GitHub and GitLab accept any username for a token
+const defaultUsername = "git"
+
+var credentialNameRe = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+
+// Credentials authenticate the fetch of a private repository, using the
+// token for an https url and the ssh key for an ssh url
+type Credentials struct {
+	Username string
+	Token    string
+	// SSHKey and KnownHosts are paths to the files
+	SSHKey     string
+	KnownHosts string
+}
+
+// LoadCredentials reads the credential with the name from its directory
+// in dir. The directory has a token with an optional username, or an ssh
+// key with optional known hosts.
+func LoadCredentials(dir, name string) (*Credentials, error) {
+	if !credentialNameRe.MatchString(name) {
+		return nil, fmt.Errorf("invalid credential name %q", name)
+	}
+
+	path := filepath.Join(dir, name)
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("credential %s not found in %s", name, dir)
+	}
+
+	c := &Credentials{}
+	for _, f := range []string{tokenFile, passwordFile} {
+		if token, err := readFile(path, f); err != nil {
+			return nil, err
+		} else if token != "" {
+			c.Token = token
+			break
+		}
+	}
+
+	username, err := readFile(path, usernameFile)
+	if err != nil {
+		return nil, err
+	}
+	c.Username = username
+
+	if _, err := os.Stat(filepath.Join(path, sshKeyFile)); err == nil {
+		c.SSHKey = filepath.Join(path, sshKeyFile)
+	}
+	if _, err := os.Stat(filepath.Join(path, knownHostsFile)); err == nil {
+		c.KnownHosts = filepath.Join(path, knownHostsFile)
+	}
+
+	if c.Token == "" && c.SSHKey == "" {
+		return nil, fmt.Errorf("credential %s has neither a %s nor an %s", name, tokenFile, sshKeyFile)
+	}
+	return c, nil
+}
+
+// check verifies the credentials have a key for the ssh url of the
+// repository or a token for its https url
+func (c *Credentials) check(spec FetchSpec) error {
+	if spec.SSHUrl != "" && c.SSHKey == "" {
+		return fmt.Errorf("credential of catalog %s has no ssh key for %s", spec.CatalogName, spec.SSHUrl)
+	}
+	if spec.SSHUrl == "" && c.Token == "" {
+		return fmt.Errorf("credential of catalog %s has no token for %s", spec.CatalogName, spec.URL)
+	}
+	return nil
+}
+
+// user returns the username to authenticate with the token
+func (c *Credentials) user() string {
+	if c.Username != "" {
+		return c.Username
+	}
+	return defaultUsername
+}
+
+// env returns the environment of git commands fetching the repository
+// with the credentials. The token is passed as an extra header through
+// env so that it is neither stored in the config of the clone nor shown
+// in the arguments of the process. The header is scoped to the url of the
+// catalog so that it isn't sent to the hosts of its submodules.
+func (c *Credentials) env(spec FetchSpec) []string {
+	if c == nil {
+		return nil
+	}
+
+	if spec.SSHUrl != "" {
+		cmd := fmt.Sprintf("ssh -i '%s' -o IdentitiesOnly=yes", c.SSHKey)
+		if c.KnownHosts != "" {
+			cmd += fmt.Sprintf(" -o UserKnownHostsFile='%s'", c.KnownHosts)
+		}
+		return []string{"GIT_SSH_COMMAND=" + cmd}
+	}
+
+	auth := base64.StdEncoding.EncodeToString([]byte(c.user() + ":" + c.Token))
+	return []string{
+		"GIT_CONFIG_COUNT=1",
+		"GIT_CONFIG_KEY_0=http." + spec.URL + ".extraHeader",
+		"GIT_CONFIG_VALUE_0=Authorization: Basic " + auth,
+	}
+}
+
+func readFile(dir, name string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
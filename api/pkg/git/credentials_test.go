@@ -0,0 +1,93 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeCredential(t *testing.T, dir, name string, files map[string]string) {
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, name), 0700))
+	for f, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name, f), []byte(content), 0600))
+	}
+}
+
+func TestLoadCredentials(t *testing.T) {
+	dir := t.TempDir()
+	writeCredential(t, dir, "team-a", map[string]string{"token": "abc\n"})
+	writeCredential(t, dir, "team-b", map[string]string{"username": "bot", "password": "xyz"})
+	writeCredential(t, dir, "team-c", map[string]string{"ssh-privatekey": "key", "known_hosts": "hosts"})
+	writeCredential(t, dir, "empty", map[string]string{"username": "bot"})
+
+	c, err := LoadCredentials(dir, "team-a")
+	assert.NoError(t, err)
+	assert.Equal(t, &Credentials{Token: "abc"}, c)
+	assert.Equal(t, "git", c.user())
+
+	c, err = LoadCredentials(dir, "team-b")
+	assert.NoError(t, err)
+	assert.Equal(t, &Credentials{Username: "bot", Token: "xyz"}, c)
+
+	c, err = LoadCredentials(dir, "team-c")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "team-c", "ssh-privatekey"), c.SSHKey)
+	assert.Equal(t, filepath.Join(dir, "team-c", "known_hosts"), c.KnownHosts)
+
+	_, err = LoadCredentials(dir, "empty")
+	assert.Error(t, err)
+
+	_, err = LoadCredentials(dir, "unknown")
+	assert.Error(t, err)
+
+	_, err = LoadCredentials(dir, "../team-a")
+	assert.Error(t, err)
+}
+
+func TestCredentialsEnv(t *testing.T) {
+	https := FetchSpec{URL: "https://github.com/tektoncd/catalog"}
+	ssh := FetchSpec{URL: "https://github.com/tektoncd/catalog", SSHUrl: "git@github.com:tektoncd/catalog.git"}
+
+	var none *Credentials
+	assert.Nil(t, none.env(https))
+
+	c := &Credentials{Username: "bot", Token: "xyz", SSHKey: "/creds/key", KnownHosts: "/creds/hosts"}
+	assert.Equal(t, []string{
+		"GIT_CONFIG_COUNT=1",
+		"GIT_CONFIG_KEY_0=http.https://github.com/tektoncd/catalog.extraHeader",
+		"GIT_CONFIG_VALUE_0=Authorization: Basic Ym90Onh5eg==",
+	}, c.env(https))
+	assert.Equal(t, []string{
+		"GIT_SSH_COMMAND=ssh -i '/creds/key' -o IdentitiesOnly=yes -o UserKnownHostsFile='/creds/hosts'",
+	}, c.env(ssh))
+}
+
+func TestCredentialsCheck(t *testing.T) {
+	https := FetchSpec{URL: "https://github.com/tektoncd/catalog", CatalogName: "tekton"}
+	ssh := FetchSpec{URL: "https://github.com/tektoncd/catalog", SSHUrl: "git@github.com:tektoncd/catalog.git", CatalogName: "tekton"}
+
+	token := &Credentials{Token: "xyz"}
+	assert.NoError(t, token.check(https))
+	assert.Error(t, token.check(ssh))
+
+	key := &Credentials{SSHKey: "/creds/key"}
+	assert.NoError(t, key.check(ssh))
+	assert.Error(t, key.check(https))
+}
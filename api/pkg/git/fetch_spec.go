@@ -28,6 +28,9 @@ type FetchSpec struct {
 	Depth       uint
 	SSLVerify   bool
 	CatalogName string
+	// Credentials of a private repository, nil to use the ssh keys
+	// in the home directory
+	Credentials *Credentials
 }
 
 func (f *FetchSpec) sanitize() {
@@ -38,6 +41,14 @@ func (f *FetchSpec) sanitize() {
 	f.CatalogName = strings.TrimSpace(f.CatalogName)
 }
 
+// cloneURL returns the ssh url of the repository if it has one
+func (f *FetchSpec) cloneURL() string {
+	if f.SSHUrl != "" {
+		return f.SSHUrl
+	}
+	return f.URL
+}
+
 func (f *FetchSpec) clonePath() string {
 	f.sanitize()
 	return filepath.Join(f.Path, f.CatalogName)
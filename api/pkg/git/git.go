@@ -16,6 +16,7 @@ package git
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
@@ -27,9 +28,17 @@ import (
 )
 
 type Client interface {
-	Fetch(spec FetchSpec) (Repo, error)
+	Fetch(ctx context.Context, spec FetchSpec) (Repo, error)
 }
 
+// Names of the implementations of Client
+const (
+	// ExecClient runs the git binary
+	ExecClient = "exec"
+	// GoGitClient fetches repositories in process using go-git
+	GoGitClient = "go-git"
+)
+
 type client struct {
 	log *zap.SugaredLogger
 }
@@ -38,34 +47,68 @@ func New(log *zap.SugaredLogger) Client {
 	return &client{log: log}
 }
 
+// NewClient returns the implementation of Client with the name
+func NewClient(name string, log *zap.SugaredLogger) (Client, error) {
+	switch name {
+	case ExecClient:
+		return New(log), nil
+	case GoGitClient:
+		return NewGoGit(log), nil
+	}
+	return nil, fmt.Errorf("git client %q not supported, must be one of %s, %s", name, ExecClient, GoGitClient)
+}
+
 // Fetch fetches the specified git repository at the revision into path.
-func (c *client) Fetch(spec FetchSpec) (Repo, error) {
+// All git commands are run in the clone path of the catalog so that
+// catalogs can be fetched concurrently, and are killed once ctx is done.
+func (c *client) Fetch(ctx context.Context, spec FetchSpec) (Repo, error) {
 	spec.sanitize()
 	log := c.log.With("name", "git")
 	if err := ensureHomeEnv(log); err != nil {
 		return nil, err
 	}
+	if spec.Credentials != nil {
+		if err := spec.Credentials.check(spec); err != nil {
+			return nil, err
+		}
+	}
 
 	log.With("path", spec.clonePath()).Info("cloning")
 
-	repo, err := c.initRepo(spec)
+	repo, err := c.initRepo(ctx, spec)
 	if err != nil {
 		os.RemoveAll(spec.clonePath())
 		return nil, err
 	}
 
-	fetchArgs := []string{"fetch", "--recurse-submodules=yes", "origin", spec.Revision}
+	// set on every fetch as the verification can be changed for an
+	// existing clone
+	if _, err := git(ctx, log, repo.path, nil, "config", "http.sslVerify", strconv.FormatBool(spec.SSLVerify)); err != nil {
+		log.Error(err, "failed to set http.sslVerify in git configs")
+		return nil, err
+	}
+
+	fetchArgs := []string{"fetch", "--recurse-submodules=yes"}
+	if spec.Depth > 0 {
+		fetchArgs = append(fetchArgs, "--depth", strconv.FormatUint(uint64(spec.Depth), 10))
+	}
+	fetchArgs = append(fetchArgs, "origin", spec.Revision)
+
+	env := spec.Credentials.env(spec)
 
-	if _, err := git(log, "", fetchArgs...); err != nil {
+	if _, err := git(ctx, log, repo.path, env, fetchArgs...); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 		// Fetch can fail if an old commit id was used so try git pull, performing regardless of error
 		// as no guarantee that the same error is returned by all git servers gitlab, github etc...
-		if _, err := git(log, "", "pull", "--recurse-submodules=yes", "origin"); err != nil {
+		if _, err := git(ctx, log, repo.path, env, "pull", "--recurse-submodules=yes", "origin"); err != nil {
 			log.Info("Failed to pull origin", "err", err)
 		}
-		if _, err := git(log, "", "checkout", spec.Revision); err != nil {
+		if _, err := git(ctx, log, repo.path, nil, "checkout", spec.Revision); err != nil {
 			return nil, err
 		}
-	} else if _, err := git(log, "", "reset", "--hard", "FETCH_HEAD"); err != nil {
+	} else if _, err := git(ctx, log, repo.path, nil, "reset", "--hard", "FETCH_HEAD"); err != nil {
 		return nil, err
 	}
 	log.With("url", spec.URL, "revision", spec.Revision, "path", repo.path).Info("successfully cloned")
@@ -73,41 +116,25 @@ func (c *client) Fetch(spec FetchSpec) (Repo, error) {
 	return repo, nil
 }
 
-func (c *client) initRepo(spec FetchSpec) (*LocalRepo, error) {
-
-	cloneUrl := spec.URL
+func (c *client) initRepo(ctx context.Context, spec FetchSpec) (*LocalRepo, error) {
 
-	if spec.SSHUrl != "" {
-		cloneUrl = spec.SSHUrl
-	}
+	cloneUrl := spec.cloneURL()
 
 	log := c.log.With("name", "repo").With("url", cloneUrl)
 
 	clonePath := spec.clonePath()
 	repo := &LocalRepo{path: clonePath}
 
-	// if already cloned, cd to the cloned path
+	// already cloned
 	if _, err := os.Stat(clonePath); err == nil {
-		if err := os.Chdir(clonePath); err != nil {
-			return nil, fmt.Errorf("failed to change directory with path %s; err: %w", clonePath, err)
-		}
 		return repo, nil
 	}
 
-	if _, err := git(log, "", "init", clonePath); err != nil {
+	if _, err := git(ctx, log, "", nil, "init", clonePath); err != nil {
 		return nil, err
 	}
 
-	if err := os.Chdir(clonePath); err != nil {
-		return nil, fmt.Errorf("failed to change directory with path %s; err: %w", spec.Path, err)
-	}
-
-	if _, err := git(log, "", "remote", "add", "origin", cloneUrl); err != nil {
-		return nil, err
-	}
-
-	if _, err := git(log, "", "config", "http.sslVerify", strconv.FormatBool(spec.SSLVerify)); err != nil {
-		log.Error(err, "failed to set http.sslVerify in git configs")
+	if _, err := git(ctx, log, clonePath, nil, "remote", "add", "origin", cloneUrl); err != nil {
 		return nil, err
 	}
 	return repo, nil
@@ -142,8 +169,8 @@ func ensureHomeEnv(log *zap.SugaredLogger) error {
 	return nil
 }
 
-func git(log *zap.SugaredLogger, kind string, args ...string) (string, error) {
-	output, err := rawGit(kind, args...)
+func git(ctx context.Context, log *zap.SugaredLogger, dir string, env []string, args ...string) (string, error) {
+	output, err := runGit(ctx, dir, env, args...)
 
 	if err != nil {
 		log.Errorw(
@@ -157,7 +184,15 @@ func git(log *zap.SugaredLogger, kind string, args ...string) (string, error) {
 }
 
 func rawGit(dir string, args ...string) (string, error) {
-	c := exec.Command("git", args...)
+	return runGit(context.Background(), dir, nil, args...)
+}
+
+// runGit runs git with the env added to the environment of the process
+func runGit(ctx context.Context, dir string, env []string, args ...string) (string, error) {
+	c := exec.CommandContext(ctx, "git", args...)
+	if len(env) > 0 {
+		c.Env = append(os.Environ(), env...)
+	}
 	var output bytes.Buffer
 	c.Stderr = &output
 	c.Stdout = &output
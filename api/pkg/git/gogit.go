@@ -0,0 +1,446 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/mitchellh/go-homedir"
+	"go.uber.org/zap"
+)
+
+type goGitClient struct {
+	log *zap.SugaredLogger
+}
+
+// NewGoGit returns a Client which fetches repositories in process
+// using go-git instead of running the git binary
+func NewGoGit(log *zap.SugaredLogger) Client {
+	return &goGitClient{log: log}
+}
+
+// Fetch fetches the specified git repository at the revision into path
+// and hard resets the worktree to the fetched commit.
+func (c *goGitClient) Fetch(ctx context.Context, spec FetchSpec) (Repo, error) {
+	spec.sanitize()
+	log := c.log.With("name", "go-git")
+
+	clonePath := spec.clonePath()
+	log.With("path", clonePath).Info("cloning")
+
+	r, err := c.initRepo(spec)
+	if err != nil {
+		os.RemoveAll(clonePath)
+		return nil, err
+	}
+
+	auth, err := c.auth(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := c.fetch(ctx, r, spec, auth)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		log.Errorw("failed to fetch", "url", spec.URL, "revision", spec.Revision, "error", err)
+		return nil, err
+	}
+
+	// HEAD is detached at the fetched commit as the branch of a new
+	// repository doesn't exist yet
+	if err := r.Storer.SetReference(plumbing.NewHashReference(plumbing.HEAD, hash)); err != nil {
+		return nil, err
+	}
+
+	wt, err := r.Worktree()
+	if err != nil {
+		return nil, err
+	}
+	if err := wt.Reset(&gogit.ResetOptions{Commit: hash, Mode: gogit.HardReset}); err != nil {
+		return nil, err
+	}
+
+	catalogURL, err := transport.NewEndpoint(spec.cloneURL())
+	if err != nil {
+		return nil, err
+	}
+	if err := updateSubmodules(ctx, r, catalogURL, auth, gogit.DefaultSubmoduleRecursionDepth); err != nil {
+		return nil, fmt.Errorf("failed to update submodules: %w", err)
+	}
+
+	log.With("url", spec.URL, "revision", spec.Revision, "path", clonePath).Info("successfully cloned")
+	return &goGitRepo{path: clonePath, repo: r}, nil
+}
+
+// initRepo opens the repository in the clone path, initializing it with
+// the url of the catalog as origin if it hasn't been cloned yet
+func (c *goGitClient) initRepo(spec FetchSpec) (*gogit.Repository, error) {
+
+	r, err := gogit.PlainOpen(spec.clonePath())
+	if err == nil {
+		return r, nil
+	}
+	if !errors.Is(err, gogit.ErrRepositoryNotExists) {
+		return nil, err
+	}
+
+	cloneUrl := spec.cloneURL()
+
+	if r, err = gogit.PlainInit(spec.clonePath(), false); err != nil {
+		return nil, err
+	}
+	if _, err = r.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{cloneUrl}}); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// auth returns the credentials of the catalog if it has any, otherwise
+// the ssh key of the user to fetch a catalog using its ssh url. nil is
+// returned for https urls without a token.
+func (c *goGitClient) auth(spec FetchSpec) (transport.AuthMethod, error) {
+	if spec.Credentials != nil {
+		return credentialsAuth(spec)
+	}
+	if spec.SSHUrl == "" {
+		return nil, nil
+	}
+
+	home, err := homedir.Dir()
+	if err != nil {
+		return nil, err
+	}
+
+	key := filepath.Join(home, ".ssh", "id_rsa")
+	if _, err := os.Stat(key); err != nil {
+		// go-git falls back to the ssh agent
+		return nil, nil
+	}
+
+	knownHosts := filepath.Join(home, ".ssh", "known_hosts")
+	if _, err := os.Stat(knownHosts); err != nil {
+		knownHosts = ""
+	}
+	return sshAuth(key, knownHosts)
+}
+
+func credentialsAuth(spec FetchSpec) (transport.AuthMethod, error) {
+	creds := spec.Credentials
+	if err := creds.check(spec); err != nil {
+		return nil, err
+	}
+
+	if spec.SSHUrl != "" {
+		return sshAuth(creds.SSHKey, creds.KnownHosts)
+	}
+	return &http.BasicAuth{Username: creds.user(), Password: creds.Token}, nil
+}
+
+func sshAuth(key, knownHosts string) (transport.AuthMethod, error) {
+	auth, err := ssh.NewPublicKeysFromFile("git", key, "")
+	if err != nil {
+		return nil, err
+	}
+	if knownHosts != "" {
+		if auth.HostKeyCallback, err = ssh.NewKnownHostsCallback(knownHosts); err != nil {
+			return nil, err
+		}
+	}
+	return auth, nil
+}
+
+// updateSubmodules initializes and updates the submodules of the repository
+// recursively. The credentials of the catalog are only used for submodules
+// under its url, the same way the exec client scopes the token, so that
+// they are never sent to the hosts of other submodules.
+func updateSubmodules(ctx context.Context, r *gogit.Repository, catalogURL *transport.Endpoint, auth transport.AuthMethod, depth gogit.SubmoduleRescursivity) error {
+	if depth == gogit.NoRecurseSubmodules {
+		return nil
+	}
+
+	wt, err := r.Worktree()
+	if err != nil {
+		return err
+	}
+	subs, err := wt.Submodules()
+	if err != nil {
+		return err
+	}
+
+	for _, sub := range subs {
+		url, err := submoduleURL(r, sub.Config().URL)
+		if err != nil {
+			return err
+		}
+
+		var subAuth transport.AuthMethod
+		if underURL(catalogURL, url) {
+			subAuth = auth
+		}
+		if err := sub.UpdateContext(ctx, &gogit.SubmoduleUpdateOptions{Init: true, Auth: subAuth}); err != nil {
+			return err
+		}
+
+		subRepo, err := sub.Repository()
+		if err != nil {
+			return err
+		}
+		if err := updateSubmodules(ctx, subRepo, catalogURL, auth, depth-1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// submoduleURL returns the url of a submodule, resolving a relative url
+// against the url of the repository as go-git does
+func submoduleURL(r *gogit.Repository, url string) (*transport.Endpoint, error) {
+	ep, err := transport.NewEndpoint(url)
+	if err != nil {
+		return nil, err
+	}
+	if ep.Protocol != "file" || path.IsAbs(ep.Path) {
+		return ep, nil
+	}
+
+	remote, err := r.Remote("origin")
+	if err != nil {
+		return nil, err
+	}
+	root, err := transport.NewEndpoint(remote.Config().URLs[0])
+	if err != nil {
+		return nil, err
+	}
+	root.Path = path.Join(root.Path, ep.Path)
+	return root, nil
+}
+
+// underURL reports whether url is the url of the catalog or a path under
+// it on the same host, as git matches the url of a http.<url>.* config
+func underURL(catalog, url *transport.Endpoint) bool {
+	if catalog.Protocol != url.Protocol || catalog.Host != url.Host || catalog.Port != url.Port {
+		return false
+	}
+	base := strings.TrimSuffix(catalog.Path, "/")
+	return url.Path == base || strings.HasPrefix(url.Path, base+"/")
+}
+
+// fetch fetches the revision of the catalog which can be a branch, a tag
+// or a commit and returns the hash of the fetched commit
+func (c *goGitClient) fetch(ctx context.Context, r *gogit.Repository, spec FetchSpec, auth transport.AuthMethod) (plumbing.Hash, error) {
+
+	refs := []struct {
+		spec string
+		name plumbing.ReferenceName
+	}{
+		{"+refs/heads/%[1]s:refs/remotes/origin/%[1]s", plumbing.NewRemoteReferenceName("origin", spec.Revision)},
+		{"+refs/tags/%[1]s:refs/tags/%[1]s", plumbing.NewTagReferenceName(spec.Revision)},
+	}
+
+	for _, ref := range refs {
+		err := r.FetchContext(ctx, &gogit.FetchOptions{
+			RemoteName:      "origin",
+			RefSpecs:        []config.RefSpec{config.RefSpec(fmt.Sprintf(ref.spec, spec.Revision))},
+			Depth:           int(spec.Depth),
+			Auth:            auth,
+			Tags:            gogit.NoTags,
+			Force:           true,
+			InsecureSkipTLS: !spec.SSLVerify,
+		})
+		if errors.Is(err, gogit.NoMatchingRefSpecError{}) {
+			continue
+		}
+		if err != nil && err != gogit.NoErrAlreadyUpToDate {
+			return plumbing.ZeroHash, err
+		}
+		return resolve(r, ref.name)
+	}
+
+	if !plumbing.IsHash(spec.Revision) {
+		return plumbing.ZeroHash, fmt.Errorf("revision %s not found in %s", spec.Revision, spec.URL)
+	}
+
+	// the revision is a commit which may be older than the depth so the
+	// whole history of the branches is fetched
+	err := r.FetchContext(ctx, &gogit.FetchOptions{
+		RemoteName:      "origin",
+		RefSpecs:        []config.RefSpec{"+refs/heads/*:refs/remotes/origin/*"},
+		Auth:            auth,
+		Tags:            gogit.NoTags,
+		Force:           true,
+		InsecureSkipTLS: !spec.SSLVerify,
+	})
+	if err != nil && err != gogit.NoErrAlreadyUpToDate {
+		return plumbing.ZeroHash, err
+	}
+
+	hash := plumbing.NewHash(spec.Revision)
+	if _, err := r.CommitObject(hash); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("revision %s not found in %s: %w", spec.Revision, spec.URL, err)
+	}
+	return hash, nil
+}
+
+// resolve returns the commit a branch or a tag points to
+func resolve(r *gogit.Repository, name plumbing.ReferenceName) (plumbing.Hash, error) {
+	hash, err := r.ResolveRevision(plumbing.Revision(name))
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	return *hash, nil
+}
+
+// goGitRepo is a repository fetched by the go-git client
+type goGitRepo struct {
+	path string
+	repo *gogit.Repository
+}
+
+func (r *goGitRepo) Path() string {
+	return r.path
+}
+
+func (r *goGitRepo) Head() string {
+	head, err := r.repo.Head()
+	if err != nil {
+		return ""
+	}
+	return head.Hash().String()
+}
+
+// ModifiedTime returns the commit time of the last commit changing the
+// file. For a shallow clone, it is the time of the oldest commit fetched
+// if the file hasn't been changed since.
+func (r *goGitRepo) ModifiedTime(path string) (time.Time, error) {
+	gitPath, err := r.RelPath(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	gitPath = filepath.ToSlash(gitPath)
+
+	head, err := r.repo.Head()
+	if err != nil {
+		return time.Time{}, err
+	}
+	commit, err := r.repo.CommitObject(head.Hash())
+	if err != nil {
+		return time.Time{}, err
+	}
+	hash, err := blobHash(commit, gitPath)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to find %s at HEAD: %w", gitPath, err)
+	}
+
+	// walk the history following a parent having the same version of the
+	// file until reaching the commit which changed it
+	for {
+		var next *object.Commit
+		for _, h := range commit.ParentHashes {
+			parent, err := r.repo.CommitObject(h)
+			if err != nil {
+				// parents of the oldest commit of a shallow clone are not fetched
+				break
+			}
+			if ph, err := blobHash(parent, gitPath); err == nil && ph == hash {
+				next = parent
+				break
+			}
+		}
+		if next == nil {
+			return commit.Committer.When, nil
+		}
+		commit = next
+	}
+}
+
+func blobHash(c *object.Commit, path string) (plumbing.Hash, error) {
+	tree, err := c.Tree()
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	entry, err := tree.FindEntry(path)
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+	return entry.Hash, nil
+}
+
+// RelPath returns the path of file relative to repo's path
+func (r *goGitRepo) RelPath(file string) (string, error) {
+	return filepath.Rel(r.path, file)
+}
+
+// ChangedFiles returns the files added, modified or deleted between the
+// commit from and the head of the repo, relative to the repo's path
+func (r *goGitRepo) ChangedFiles(from string) ([]string, error) {
+	if !plumbing.IsHash(from) {
+		return nil, fmt.Errorf("invalid commit %q", from)
+	}
+
+	fromTree, err := r.tree(plumbing.NewHash(from))
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %s..HEAD: %w", from, err)
+	}
+
+	head, err := r.repo.Head()
+	if err != nil {
+		return nil, err
+	}
+	headTree, err := r.tree(head.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := object.DiffTree(fromTree, headTree)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %s..HEAD: %w", from, err)
+	}
+
+	files := []string{}
+	for _, ch := range changes {
+		// a rename is reported as the deletion and the addition of a file
+		if ch.From.Name != "" {
+			files = append(files, ch.From.Name)
+		}
+		if ch.To.Name != "" && ch.To.Name != ch.From.Name {
+			files = append(files, ch.To.Name)
+		}
+	}
+	return files, nil
+}
+
+func (r *goGitRepo) tree(hash plumbing.Hash) (*object.Tree, error) {
+	commit, err := r.repo.CommitObject(hash)
+	if err != nil {
+		return nil, err
+	}
+	return commit.Tree()
+}
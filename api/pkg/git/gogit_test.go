@@ -0,0 +1,189 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// origin is a repository with a main branch acting as the remote of a catalog
+type origin struct {
+	t    *testing.T
+	path string
+	repo *gogit.Repository
+}
+
+func newOrigin(t *testing.T) *origin {
+	path := t.TempDir()
+	r, err := gogit.PlainInitWithOptions(path, &gogit.PlainInitOptions{
+		InitOptions: gogit.InitOptions{DefaultBranch: plumbing.NewBranchReferenceName("main")},
+	})
+	require.NoError(t, err)
+	return &origin{t: t, path: path, repo: r}
+}
+
+// commit writes the files, removing the ones with empty content, and commits them
+func (o *origin) commit(when time.Time, files map[string]string) string {
+	wt, err := o.repo.Worktree()
+	require.NoError(o.t, err)
+
+	for name, content := range files {
+		path := filepath.Join(o.path, name)
+		if content == "" {
+			_, err = wt.Remove(name)
+			require.NoError(o.t, err)
+			continue
+		}
+		require.NoError(o.t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(o.t, os.WriteFile(path, []byte(content), 0644))
+		_, err = wt.Add(name)
+		require.NoError(o.t, err)
+	}
+
+	sig := &object.Signature{Name: "hub", Email: "hub@tekton.dev", When: when}
+	hash, err := wt.Commit("update", &gogit.CommitOptions{Author: sig, Committer: sig})
+	require.NoError(o.t, err)
+	return hash.String()
+}
+
+func TestNewClient(t *testing.T) {
+	log := zap.NewNop().Sugar()
+
+	c, err := NewClient(ExecClient, log)
+	assert.NoError(t, err)
+	assert.IsType(t, &client{}, c)
+
+	c, err = NewClient(GoGitClient, log)
+	assert.NoError(t, err)
+	assert.IsType(t, &goGitClient{}, c)
+
+	_, err = NewClient("libgit2", log)
+	assert.Error(t, err)
+}
+
+func TestGoGitFetch(t *testing.T) {
+	o := newOrigin(t)
+	t1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+
+	first := o.commit(t1, map[string]string{
+		"task/buildah/0.1/buildah.yaml": "v1",
+		"task/kaniko/0.1/kaniko.yaml":   "v1",
+	})
+
+	spec := FetchSpec{URL: o.path, Revision: "main", Path: t.TempDir(), CatalogName: "tekton"}
+	c := NewGoGit(zap.NewNop().Sugar())
+
+	repo, err := c.Fetch(context.Background(), spec)
+	require.NoError(t, err)
+	assert.Equal(t, first, repo.Head())
+	assert.Equal(t, filepath.Join(spec.Path, "tekton"), repo.Path())
+
+	second := o.commit(t2, map[string]string{
+		"task/buildah/0.1/buildah.yaml": "v2",
+		"task/kaniko/0.1/kaniko.yaml":   "",
+	})
+
+	// fetching again updates the existing clone
+	repo, err = c.Fetch(context.Background(), spec)
+	require.NoError(t, err)
+	assert.Equal(t, second, repo.Head())
+
+	content, err := os.ReadFile(filepath.Join(repo.Path(), "task/buildah/0.1/buildah.yaml"))
+	assert.NoError(t, err)
+	assert.Equal(t, "v2", string(content))
+	assert.NoFileExists(t, filepath.Join(repo.Path(), "task/kaniko/0.1/kaniko.yaml"))
+
+	modified, err := repo.ModifiedTime(filepath.Join(repo.Path(), "task/buildah/0.1/buildah.yaml"))
+	assert.NoError(t, err)
+	assert.True(t, t2.Equal(modified))
+
+	files, err := repo.ChangedFiles(first)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"task/buildah/0.1/buildah.yaml", "task/kaniko/0.1/kaniko.yaml"}, files)
+
+	_, err = repo.ChangedFiles("unknown")
+	assert.Error(t, err)
+}
+
+func TestGoGitFetch_Revision(t *testing.T) {
+	o := newOrigin(t)
+	first := o.commit(time.Now(), map[string]string{"README.md": "v1"})
+	_, err := o.repo.CreateTag("v0.1", plumbing.NewHash(first), nil)
+	require.NoError(t, err)
+	o.commit(time.Now(), map[string]string{"README.md": "v2"})
+
+	c := NewGoGit(zap.NewNop().Sugar())
+
+	repo, err := c.Fetch(context.Background(), FetchSpec{URL: o.path, Revision: "v0.1", Path: t.TempDir(), CatalogName: "tag"})
+	require.NoError(t, err)
+	assert.Equal(t, first, repo.Head())
+
+	repo, err = c.Fetch(context.Background(), FetchSpec{URL: o.path, Revision: first, Path: t.TempDir(), CatalogName: "commit"})
+	require.NoError(t, err)
+	assert.Equal(t, first, repo.Head())
+
+	_, err = c.Fetch(context.Background(), FetchSpec{URL: o.path, Revision: "unknown", Path: t.TempDir(), CatalogName: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestGoGitFetch_Shallow(t *testing.T) {
+	o := newOrigin(t)
+	t1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	first := o.commit(t1, map[string]string{"README.md": "v1"})
+	second := o.commit(t1.Add(time.Hour), map[string]string{"task/buildah/0.1/buildah.yaml": "v1"})
+
+	c := NewGoGit(zap.NewNop().Sugar())
+	repo, err := c.Fetch(context.Background(), FetchSpec{URL: "file://" + o.path, Revision: "main", Depth: 1, Path: t.TempDir(), CatalogName: "shallow"})
+	require.NoError(t, err)
+	assert.Equal(t, second, repo.Head())
+
+	// the history before the fetched commit is unknown
+	modified, err := repo.ModifiedTime(filepath.Join(repo.Path(), "README.md"))
+	assert.NoError(t, err)
+	assert.True(t, t1.Add(time.Hour).Equal(modified))
+
+	_, err = repo.ChangedFiles(first)
+	assert.Error(t, err)
+}
+
+func TestUnderURL(t *testing.T) {
+	endpoint := func(url string) *transport.Endpoint {
+		ep, err := transport.NewEndpoint(url)
+		require.NoError(t, err)
+		return ep
+	}
+	catalog := endpoint("https://github.com/tektoncd/catalog")
+
+	assert.True(t, underURL(catalog, endpoint("https://github.com/tektoncd/catalog")))
+	assert.True(t, underURL(catalog, endpoint("https://github.com/tektoncd/catalog/tasks")))
+	assert.False(t, underURL(catalog, endpoint("https://github.com/tektoncd/catalog-private")))
+	assert.False(t, underURL(catalog, endpoint("https://github.com/tektoncd/pipeline")))
+	assert.False(t, underURL(catalog, endpoint("https://gitlab.com/tektoncd/catalog")))
+	assert.False(t, underURL(catalog, endpoint("http://github.com/tektoncd/catalog")))
+	assert.False(t, underURL(catalog, endpoint("git@github.com:tektoncd/catalog.git")))
+}
@@ -15,6 +15,7 @@
 package git
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
 	"time"
@@ -25,6 +26,7 @@ type Repo interface {
 	Head() string
 	ModifiedTime(path string) (time.Time, error)
 	RelPath(path string) (string, error)
+	ChangedFiles(from string) ([]string, error)
 }
 
 type LocalRepo struct {
@@ -38,7 +40,7 @@ func (r LocalRepo) Path() string {
 
 func (r LocalRepo) Head() string {
 	if r.head == "" {
-		head, _ := rawGit("", "rev-parse", "HEAD")
+		head, _ := rawGit(r.path, "rev-parse", "HEAD")
 		r.head = strings.TrimSuffix(head, "\n")
 	}
 	return r.head
@@ -64,3 +66,20 @@ func (r LocalRepo) ModifiedTime(path string) (time.Time, error) {
 func (r LocalRepo) RelPath(file string) (string, error) {
 	return filepath.Rel(r.path, file)
 }
+
+// ChangedFiles returns the files added, modified or deleted between the
+// commit from and the head of the repo, relative to the repo's path
+func (r LocalRepo) ChangedFiles(from string) ([]string, error) {
+	out, err := rawGit(r.path, "diff", "--name-only", "--no-renames", from, "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %s..HEAD: %s", from, strings.TrimSpace(out))
+	}
+
+	files := []string{}
+	for _, f := range strings.Split(out, "\n") {
+		if f = strings.TrimSpace(f); f != "" {
+			files = append(files, f)
+		}
+	}
+	return files, nil
+}
@@ -45,6 +45,7 @@ const (
 	PlatformsAnnotation           = "tekton.dev/platforms"
 	DefaultPlatform               = "linux/amd64"
 	DeprecatedAnnotation          = "tekton.dev/deprecated"
+	BundleAnnotation              = "tekton.dev/bundle"
 )
 
 type (
@@ -66,9 +67,37 @@ type (
 		Path                string
 		ModifiedAt          time.Time
 		Platforms           []string
+		Bundle              string
+		APIVersion          string
+		// TaskRefs are the tasks referenced by a pipeline
+		TaskRefs []TaskRef
+		// Lint are the problems found by the lint rules
+		Lint []LintResult
+		// Images are the images of the steps and sidecars
+		Images []string
+		// Signature is the trusted resource signature of the version and
+		// Checksum the checksum of the resource it signs
+		Signature string
+		Checksum  []byte
+		// Params, Workspaces and Results are the interface of the version
+		Params     []ParamSpec
+		Workspaces []WorkspaceSpec
+		Results    []ResultSpec
 	}
 )
 
+// ResourceKey identifies a resource of a catalog
+type ResourceKey struct {
+	Kind string
+	Name string
+}
+
+// String returns the path of the resource relative to the context dir
+// of the catalog e.g. task/buildah
+func (k ResourceKey) String() string {
+	return strings.ToLower(k.Kind) + "/" + k.Name
+}
+
 type CatalogParser struct {
 	logger      *zap.SugaredLogger
 	repo        git.Repo
@@ -90,6 +119,71 @@ func (c *CatalogParser) Parse() ([]Resource, Result) {
 	return resources, result
 }
 
+// ChangedResources returns the resources having at least one of the files,
+// given relative to the root of the repository, in their directory
+func (c *CatalogParser) ChangedResources(files []string) []ResourceKey {
+
+	prefix := filepath.ToSlash(filepath.Clean(c.contextPath)) + "/"
+	if prefix == "./" {
+		prefix = ""
+	}
+
+	seen := map[ResourceKey]bool{}
+	changed := []ResourceKey{}
+	for _, f := range files {
+		f = filepath.ToSlash(f)
+		if !strings.HasPrefix(f, prefix) {
+			continue
+		}
+
+		// <kind>/<name>/<version>/<name>.yaml
+		parts := strings.Split(strings.TrimPrefix(f, prefix), "/")
+		if len(parts) < 3 {
+			continue
+		}
+		for _, k := range kinds {
+			key := ResourceKey{Kind: k, Name: parts[1]}
+			if strings.ToLower(k) == parts[0] && !seen[key] {
+				seen[key] = true
+				changed = append(changed, key)
+			}
+		}
+	}
+	return changed
+}
+
+// ParseResources parses only the resources given by keys. Resources which
+// no longer exist or are no longer valid are returned as removed.
+func (c *CatalogParser) ParseResources(keys []ResourceKey) ([]Resource, []ResourceKey, Result) {
+	found := []Resource{}
+	removed := []ResourceKey{}
+	result := Result{}
+
+	for _, key := range keys {
+		log := c.logger.With("kind", key.Kind)
+		kindPath := filepath.Join(c.repo.Path(), c.contextPath, strings.ToLower(key.Kind))
+
+		info, err := os.Stat(filepath.Join(kindPath, key.Name))
+		if err != nil || !info.IsDir() {
+			log.Infof("resource %s has been removed", key)
+			removed = append(removed, key)
+			continue
+		}
+
+		res, r := c.parseResource(key.Kind, kindPath, info)
+		r = r.ForResource(key)
+		result.Combine(r)
+		if r.Errors != nil || res == nil {
+			log.Warn(r.Error())
+			removed = append(removed, key)
+			continue
+		}
+		found = append(found, *res)
+	}
+
+	return found, removed, result
+}
+
 func ignoreNotExists(err error) error {
 	if os.IsNotExist(err) {
 		return nil
@@ -127,6 +221,7 @@ func (c CatalogParser) findResourcesByKind(kind string) ([]Resource, Result) {
 			continue
 		}
 		res, r := c.parseResource(kind, kindPath, resDirInfo)
+		r = r.ForResource(ResourceKey{Kind: kind, Name: resDirInfo.Name()})
 		result.Combine(r)
 		if r.Errors != nil {
 			log.Warn(r.Error())
@@ -294,6 +389,10 @@ func (c CatalogParser) appendVersion(res *Resource, filePath string) Result {
 		return result
 	}
 
+	// optional check, bundle reference of the version e.g.
+	// gcr.io/tekton-releases/catalog/upstream/git-clone:0.9
+	bundle := strings.TrimSpace(annotations[BundleAnnotation])
+
 	tags := annotations[TagsAnnotation]
 	tagList := strings.FieldsFunc(tags, func(c rune) bool { return c == ',' || c == ' ' })
 	res.Tags = append(res.Tags, tagList...)
@@ -314,6 +413,22 @@ func (c CatalogParser) appendVersion(res *Resource, filePath string) Result {
 		versionPlatforms = append(versionPlatforms, DefaultPlatform)
 	}
 
+	var refs []TaskRef
+	if kind == "Pipeline" {
+		refs = taskRefs(u)
+	}
+
+	// optional check, signature of the trusted resource
+	signature := strings.TrimSpace(annotations[SignatureAnnotation])
+	var sum []byte
+	if signature != "" {
+		if sum, err = checksum(u); err != nil {
+			issue := fmt.Sprintf("Resource %s - %s checksum of the signed resource failed: %s", tkn.GVK, tkn.Name, err)
+			log.With("action", "ignore").Warn(issue)
+			result.Warn("%s", issue)
+		}
+	}
+
 	res.Versions = append(res.Versions,
 		VersionInfo{
 			Version:             version,
@@ -324,6 +439,16 @@ func (c CatalogParser) appendVersion(res *Resource, filePath string) Result {
 			Path:                relPath,
 			ModifiedAt:          modified,
 			Platforms:           versionPlatforms,
+			Bundle:              bundle,
+			APIVersion:          u.GetAPIVersion(),
+			TaskRefs:            refs,
+			Lint:                lint(u),
+			Images:              images(u),
+			Signature:           signature,
+			Checksum:            sum,
+			Params:              params(u),
+			Workspaces:          workspaces(u),
+			Results:             results(u),
 		},
 	)
 
@@ -385,7 +510,7 @@ func decodeResource(reader io.Reader, kind string) (*TektonResource, error) {
 }
 
 func convertToTyped(u *unstructured.Unstructured) (interface{}, error) {
-	t, err := typeForKind(u.GetKind())
+	t, err := typeForKind(u.GroupVersionKind())
 	if err != nil {
 		return nil, err
 	}
@@ -414,15 +539,29 @@ type tektonKind interface {
 	apis.Defaultable
 }
 
-func typeForKind(kind string) (tektonKind, error) {
-	switch kind {
-	case "Task":
-		return &v1beta1.Task{}, nil
-	case "Pipeline":
-		return &v1beta1.Pipeline{}, nil
+// typeForKind returns the typed resource matching both the kind and the
+// apiVersion so that v1 resources are validated against the v1 types
+func typeForKind(gvk schema.GroupVersionKind) (tektonKind, error) {
+	switch gvk.GroupVersion().Identifier() {
+	case v1.SchemeGroupVersion.Identifier():
+		switch gvk.Kind {
+		case "Task":
+			return &v1.Task{}, nil
+		case "Pipeline":
+			return &v1.Pipeline{}, nil
+		}
+	case v1beta1.SchemeGroupVersion.Identifier():
+		switch gvk.Kind {
+		case "Task":
+			return &v1beta1.Task{}, nil
+		case "Pipeline":
+			return &v1beta1.Pipeline{}, nil
+		case "StepAction":
+			return &v1beta1.StepAction{}, nil
+		}
 	}
 
-	return nil, fmt.Errorf("unknown kind %s", kind)
+	return nil, fmt.Errorf("unknown kind %s for apiVersion %s", gvk.Kind, gvk.GroupVersion())
 }
 
 func isTektonKind(gvk *schema.GroupVersionKind) bool {
@@ -15,11 +15,14 @@
 package parser
 
 import (
+	"errors"
+	"os"
 	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/tektoncd/hub/api/pkg/git"
+	v1 "github.com/tektoncd/pipeline/pkg/apis/pipeline/v1"
 	"go.uber.org/zap"
 	"gotest.tools/v3/assert"
 	"gotest.tools/v3/assert/cmp"
@@ -51,6 +54,10 @@ func (r fakeRepo) RelPath(f string) (string, error) {
 	return filepath.Rel(r.path, f)
 }
 
+func (r fakeRepo) ChangedFiles(from string) ([]string, error) {
+	return nil, nil
+}
+
 func TestParse_NonExistentRepo(t *testing.T) {
 	repo := fakeRepo{
 		path: "./testdata/catalogs/non-existent",
@@ -78,7 +85,7 @@ func TestParse_ValidRepo(t *testing.T) {
 	assert.Equal(t, 0, len(result.Errors))
 	assert.Equal(t, "", result.Error())
 
-	assert.Equal(t, 2, len(res))
+	assert.Equal(t, 4, len(res))
 	gitCLI := res[0]
 	assert.Equal(t, "git-cli", gitCLI.Name)
 	assert.Equal(t, 1, len(gitCLI.Versions))
@@ -91,6 +98,22 @@ func TestParse_ValidRepo(t *testing.T) {
 	assert.Equal(t, "linux/amd64", maven.Versions[0].Platforms[0])
 	assert.Equal(t, "linux/ppc64le", maven.Versions[1].Platforms[0])
 	assert.Equal(t, "linux/s390x", maven.Versions[1].Platforms[1])
+	assert.Equal(t, "", maven.Versions[0].Bundle)
+	assert.Equal(t, "gcr.io/tekton-releases/catalog/upstream/maven:0.2", maven.Versions[1].Bundle)
+	assert.Equal(t, "tekton.dev/v1beta1", maven.Versions[1].APIVersion)
+
+	yq := res[2]
+	assert.Equal(t, "yq", yq.Name)
+	assert.Equal(t, 1, len(yq.Versions))
+	assert.Equal(t, "tekton.dev/v1", yq.Versions[0].APIVersion)
+
+	gitClone := res[3]
+	assert.Equal(t, "git-clone", gitClone.Name)
+	assert.Equal(t, "StepAction", gitClone.Kind)
+	assert.Equal(t, 1, len(gitClone.Versions))
+	assert.Equal(t, "0.56.0", gitClone.Versions[0].MinPipelinesVersion)
+	assert.Equal(t, 2, len(gitClone.Versions[0].Platforms))
+	assert.Equal(t, "tekton.dev/v1beta1", gitClone.Versions[0].APIVersion)
 }
 
 func TestParse_InvalidTask(t *testing.T) {
@@ -135,3 +158,108 @@ func TestParse_InvalidFilename(t *testing.T) {
 	assert.Assert(t, cmp.Contains(gitClone.Message, "expected to find 2 versions but found only 1"))
 	assert.Equal(t, Critical, gitClone.Type)
 }
+
+func TestChangedResources(t *testing.T) {
+	repo := fakeRepo{path: "./testdata/catalogs/valid"}
+
+	p := ForCatalog(zap.NewNop().Sugar(), repo, "")
+	changed := p.ChangedResources([]string{
+		"task/maven/0.1/maven.yaml",
+		"task/maven/0.2/README.md",
+		"pipeline/build/0.1/build.yaml",
+		"task/README.md",
+		"docs/task/yq/0.1/yq.yaml",
+	})
+	assert.DeepEqual(t, []ResourceKey{{Kind: "Task", Name: "maven"}, {Kind: "Pipeline", Name: "build"}}, changed)
+
+	p = ForCatalog(zap.NewNop().Sugar(), repo, "catalog/")
+	changed = p.ChangedResources([]string{"catalog/task/yq/0.1/yq.yaml", "task/maven/0.1/maven.yaml"})
+	assert.DeepEqual(t, []ResourceKey{{Kind: "Task", Name: "yq"}}, changed)
+}
+
+func TestParseResources(t *testing.T) {
+	repo := fakeRepo{path: "./testdata/catalogs/valid"}
+
+	p := ForCatalog(zap.NewNop().Sugar(), repo, "")
+	res, removed, result := p.ParseResources([]ResourceKey{
+		{Kind: "Task", Name: "maven"},
+		{Kind: "Task", Name: "removed"},
+	})
+
+	assert.Equal(t, 0, len(result.Errors))
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, "maven", res[0].Name)
+	assert.Equal(t, 2, len(res[0].Versions))
+	assert.DeepEqual(t, []ResourceKey{{Kind: "Task", Name: "removed"}}, removed)
+}
+
+func TestParseResources_Invalid(t *testing.T) {
+	repo := fakeRepo{path: "./testdata/catalogs/invalid-taskname"}
+
+	p := ForCatalog(zap.NewNop().Sugar(), repo, "")
+	res, removed, result := p.ParseResources([]ResourceKey{{Kind: "Task", Name: "git-cli"}})
+
+	assert.Equal(t, 0, len(res))
+	assert.DeepEqual(t, []ResourceKey{{Kind: "Task", Name: "git-cli"}}, removed)
+
+	assert.Equal(t, 1, len(result.Issues))
+	assert.Equal(t, "task/git-cli", result.Issues[0].Resource)
+	assert.Assert(t, cmp.Contains(result.Issues[0].Message, "failed to find any resource matching"))
+}
+
+func TestResourceError(t *testing.T) {
+	r := Result{}
+	r.AddError(errors.New("invalid yaml"))
+
+	tagged := r.ForResource(ResourceKey{Kind: "Pipeline", Name: "build"})
+	assert.Equal(t, "invalid yaml", tagged.Error())
+
+	var resErr *ResourceError
+	assert.Assert(t, errors.As(tagged.Errors[0], &resErr))
+	assert.Equal(t, "pipeline/build", resErr.Resource)
+}
+
+func TestDecodeResource_V1Task(t *testing.T) {
+	once.Do(registerSchema)
+
+	f, err := os.Open("./testdata/catalogs/valid/task/yq/0.1/yq.yaml")
+	assert.NilError(t, err)
+	defer f.Close()
+
+	res, err := decodeResource(f, "Task")
+	assert.NilError(t, err)
+	assert.Equal(t, "tekton.dev/v1", res.GVK.GroupVersion().String())
+
+	typed, err := res.ToType()
+	assert.NilError(t, err)
+
+	task, ok := typed.(*v1.Task)
+	assert.Assert(t, ok)
+	assert.Equal(t, "100m", task.Spec.Steps[0].ComputeResources.Requests.Cpu().String())
+}
+
+func TestParse_PipelineTaskRefs(t *testing.T) {
+	repo := fakeRepo{
+		path: "./testdata/catalogs/pipelines",
+	}
+
+	p := ForCatalog(zap.NewNop().Sugar(), repo, "")
+	res, result := p.Parse()
+	assert.Equal(t, "", result.Error())
+	assert.Equal(t, 1, len(res))
+
+	refs := res[0].Versions[0].TaskRefs
+	assert.DeepEqual(t, []TaskRef{
+		{Name: "git-clone", Kind: "Task", Via: ViaName},
+		{Name: "buildah", Kind: "Task", Version: "0.6", Bundle: "gcr.io/tekton-releases/catalog/upstream/buildah:0.6", Via: ViaBundle},
+		{Name: "yq", Kind: "Task", Version: "0.1", Catalog: "tekton", Via: ViaHub},
+		{Name: "kubernetes-actions", Kind: "Task", Version: "0.2", URL: "https://github.com/tektoncd/catalog.git", Via: ViaGit},
+		{Name: "send-to-webhook-slack", Kind: "Task", Via: ViaName},
+	}, refs)
+}
+
+func TestImageTag(t *testing.T) {
+	assert.Equal(t, "0.9", imageTag("gcr.io/tekton-releases/catalog/upstream/git-clone:0.9"))
+	assert.Equal(t, "", imageTag("localhost:5000/git-clone"))
+	assert.Equal(t, "", imageTag("gcr.io/catalog/git-clone@sha256:4f8b"))
+}
@@ -0,0 +1,74 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// paramRef matches the references to params e.g. $(params.image) or
+// $(params["image"])
+var paramRef = regexp.MustCompile(`\$\(params(?:\.([\w.-]+)|\[['"]([^'"]+)['"]\])\)`)
+
+// images returns the images of the steps and sidecars of a resource, a
+// param in an image is replaced by its default. Images which can't be
+// resolved e.g. given by a param without default are skipped
+func images(u *unstructured.Unstructured) []string {
+
+	list := []string{}
+	seen := map[string]bool{}
+	for _, c := range containers(u) {
+		image, _ := c.Fields["image"].(string)
+		image, ok := resolveParams(strings.TrimSpace(image), c.Params)
+		if !ok || image == "" || seen[image] {
+			continue
+		}
+		seen[image] = true
+		list = append(list, image)
+	}
+	return list
+}
+
+// resolveParams replaces the params in the value by their defaults, ok is
+// false if a param has no default
+func resolveParams(value string, defaults map[string]string) (string, bool) {
+
+	ok := true
+	resolved := paramRef.ReplaceAllStringFunc(value, func(ref string) string {
+		m := paramRef.FindStringSubmatch(ref)
+		name := m[1] + m[2]
+		d, found := defaults[name]
+		if !found {
+			ok = false
+		}
+		return d
+	})
+	return resolved, ok && !strings.Contains(resolved, "$(")
+}
+
+// ImageRepository returns the image without its tag and digest e.g.
+// alpine for alpine:3.18
+func ImageRepository(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	if tag := imageTag(image); tag != "" {
+		image = strings.TrimSuffix(image, ":"+tag)
+	}
+	return image
+}
@@ -0,0 +1,95 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestImages(t *testing.T) {
+	task := `
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: images
+spec:
+  params:
+    - name: builder
+      default: quay.io/buildah/stable:v1.31
+    - name: registry
+      default: gcr.io
+    - name: tag
+  steps:
+    - name: build
+      image: $(params.builder)
+    - name: push
+      image: $(params["registry"])/tekton-releases/push:v0.1
+    - name: again
+      image: quay.io/buildah/stable:v1.31
+    - name: tagged
+      image: alpine:$(params.tag)
+  sidecars:
+    - name: registry
+      image: registry:2
+`
+	tkn, err := decodeResource(strings.NewReader(task), "Task")
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, []string{
+		"quay.io/buildah/stable:v1.31",
+		"gcr.io/tekton-releases/push:v0.1",
+		"registry:2",
+	}, images(tkn.Unstructured))
+}
+
+func TestImages_PipelineTaskSpec(t *testing.T) {
+	pipeline := `
+apiVersion: tekton.dev/v1
+kind: Pipeline
+metadata:
+  name: images
+spec:
+  params:
+    - name: image
+      default: busybox
+  tasks:
+    - name: echo
+      taskRef:
+        name: echo
+    - name: inline
+      taskSpec:
+        params:
+          - name: image
+            default: alpine:3.18
+        steps:
+          - name: echo
+            image: $(params.image)
+`
+	tkn, err := decodeResource(strings.NewReader(pipeline), "Pipeline")
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, []string{"alpine:3.18"}, images(tkn.Unstructured))
+}
+
+func TestImageRepository(t *testing.T) {
+	assert.Equal(t, "alpine", ImageRepository("alpine"))
+	assert.Equal(t, "alpine", ImageRepository("alpine:3.18"))
+	assert.Equal(t, "localhost:5000/alpine", ImageRepository("localhost:5000/alpine:3.18"))
+	assert.Equal(t, "gcr.io/distroless/base", ImageRepository("gcr.io/distroless/base@sha256:4f8b"))
+	assert.Equal(t, "gcr.io/distroless/base", ImageRepository("gcr.io/distroless/base:debug@sha256:4f8b"))
+}
@@ -22,6 +22,7 @@ import (
 var kinds = []string{
 	"Task",
 	"Pipeline",
+	"StepAction",
 }
 
 // SupportedKinds returns list of supported Tekton kind
@@ -0,0 +1,282 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Rule is a lint check run on every version of the resources of a
+// catalog. Check returns a message for each problem found, none if the
+// resource follows the rule
+type Rule struct {
+	ID          string
+	Severity    IssueType
+	Description string
+	Check       func(u *unstructured.Unstructured) []string
+}
+
+// LintResult is a problem found by a rule in a version of a resource
+type LintResult struct {
+	Rule     string
+	Severity IssueType
+	Message  string
+}
+
+var rules = []Rule{
+	{
+		ID:          "image-digest",
+		Severity:    Info,
+		Description: "Images of steps should be pinned by digest",
+		Check:       checkImageDigest,
+	},
+	{
+		ID:          "image-latest-tag",
+		Severity:    Warning,
+		Description: "Images of steps should not use the latest tag",
+		Check:       checkImageLatestTag,
+	},
+	{
+		ID:          "script-shebang",
+		Severity:    Warning,
+		Description: "Scripts of steps should start with a shebang",
+		Check:       checkScriptShebang,
+	},
+	{
+		ID:          "param-description",
+		Severity:    Warning,
+		Description: "Params should have a description",
+		Check:       checkParamDescription,
+	},
+	{
+		ID:          "run-as-non-root",
+		Severity:    Warning,
+		Description: "Steps should run as a non root user",
+		Check:       checkRunAsNonRoot,
+	},
+}
+
+// Rules returns the rules run on the resources
+func Rules() []Rule {
+	return rules
+}
+
+// RegisterRule adds a rule to the rules run on the resources, a rule
+// with the ID of an existing rule replaces it
+func RegisterRule(rule Rule) {
+	for i, r := range rules {
+		if r.ID == rule.ID {
+			rules[i] = rule
+			return
+		}
+	}
+	rules = append(rules, rule)
+}
+
+// lint runs the rules on a resource
+func lint(u *unstructured.Unstructured) []LintResult {
+
+	results := []LintResult{}
+	for _, rule := range rules {
+		for _, msg := range rule.Check(u) {
+			results = append(results, LintResult{Rule: rule.ID, Severity: rule.Severity, Message: msg})
+		}
+	}
+	return results
+}
+
+// container is a step or a sidecar of a resource, Name identifies it in
+// the messages of the rules. Template is the step template and Params
+// are the defaults of the params of the task of the container
+type container struct {
+	Name     string
+	Sidecar  bool
+	Fields   map[string]interface{}
+	Template map[string]interface{}
+	Params   map[string]string
+}
+
+// containers returns the steps and sidecars of a task, of the tasks
+// embedded in a pipeline and the step of a step action
+func containers(u *unstructured.Unstructured) []container {
+
+	if u.GetKind() == "StepAction" {
+		spec, _, _ := unstructured.NestedMap(u.Object, "spec")
+		return []container{{Name: "step " + u.GetName(), Fields: spec, Params: paramDefaults(u.Object)}}
+	}
+
+	list := taskContainers(u.Object, "")
+	for _, field := range []string{"tasks", "finally"} {
+		tasks, _, _ := unstructured.NestedSlice(u.Object, "spec", field)
+		for _, t := range tasks {
+			task, ok := t.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := task["name"].(string)
+			if spec, ok := task["taskSpec"].(map[string]interface{}); ok {
+				list = append(list, taskContainers(map[string]interface{}{"spec": spec}, "task "+name+" ")...)
+			}
+		}
+	}
+	return list
+}
+
+func taskContainers(obj map[string]interface{}, prefix string) []container {
+
+	template, _, _ := unstructured.NestedMap(obj, "spec", "stepTemplate")
+	params := paramDefaults(obj)
+
+	list := []container{}
+	for _, field := range []string{"steps", "sidecars"} {
+		items, _, _ := unstructured.NestedSlice(obj, "spec", field)
+		for i, item := range items {
+			fields, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := fields["name"].(string)
+			if name == "" {
+				name = fmt.Sprint(i)
+			}
+			list = append(list, container{
+				Name:     prefix + strings.TrimSuffix(field, "s") + " " + name,
+				Sidecar:  field == "sidecars",
+				Fields:   fields,
+				Template: template,
+				Params:   params,
+			})
+		}
+	}
+	return list
+}
+
+// paramDefaults returns the string defaults of the params in the spec
+func paramDefaults(obj map[string]interface{}) map[string]string {
+
+	defaults := map[string]string{}
+	params, _, _ := unstructured.NestedSlice(obj, "spec", "params")
+	for _, p := range params {
+		param, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := param["name"].(string)
+		if value, ok := param["default"].(string); ok && name != "" {
+			defaults[name] = value
+		}
+	}
+	return defaults
+}
+
+func checkImageDigest(u *unstructured.Unstructured) []string {
+	msgs := []string{}
+	for _, c := range containers(u) {
+		image, _ := c.Fields["image"].(string)
+		if image != "" && !strings.Contains(image, "@sha256:") && !isParam(image) {
+			msgs = append(msgs, fmt.Sprintf("%s uses image %s which is not pinned by digest", c.Name, image))
+		}
+	}
+	return msgs
+}
+
+func checkImageLatestTag(u *unstructured.Unstructured) []string {
+	msgs := []string{}
+	for _, c := range containers(u) {
+		image, _ := c.Fields["image"].(string)
+		if image == "" || strings.Contains(image, "@") || isParam(image) {
+			continue
+		}
+		if tag := imageTag(image); tag == "" || tag == "latest" {
+			msgs = append(msgs, fmt.Sprintf("%s uses image %s with the latest tag", c.Name, image))
+		}
+	}
+	return msgs
+}
+
+func checkScriptShebang(u *unstructured.Unstructured) []string {
+	msgs := []string{}
+	for _, c := range containers(u) {
+		script, _ := c.Fields["script"].(string)
+		if script != "" && !strings.HasPrefix(strings.TrimSpace(script), "#!") {
+			msgs = append(msgs, fmt.Sprintf("%s has a script without shebang", c.Name))
+		}
+	}
+	return msgs
+}
+
+func checkParamDescription(u *unstructured.Unstructured) []string {
+	msgs := []string{}
+	params, _, _ := unstructured.NestedSlice(u.Object, "spec", "params")
+	for _, p := range params {
+		param, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if desc, _ := param["description"].(string); strings.TrimSpace(desc) == "" {
+			msgs = append(msgs, fmt.Sprintf("param %v has no description", param["name"]))
+		}
+	}
+	return msgs
+}
+
+// checkRunAsNonRoot reports the steps whose security context, merged with
+// the one of the step template, neither sets runAsNonRoot nor a non root user
+func checkRunAsNonRoot(u *unstructured.Unstructured) []string {
+	msgs := []string{}
+	for _, c := range containers(u) {
+		if c.Sidecar {
+			continue
+		}
+		ctx, _ := c.Fields["securityContext"].(map[string]interface{})
+		template, _ := c.Template["securityContext"].(map[string]interface{})
+		if !nonRoot(ctx, template) {
+			msgs = append(msgs, fmt.Sprintf("%s may run as root", c.Name))
+		}
+	}
+	return msgs
+}
+
+// nonRoot resolves each field of the security context of a step over the
+// one of the step template, as Tekton merges them. A user set explicitly
+// decides, otherwise runAsNonRoot does.
+func nonRoot(step, template map[string]interface{}) bool {
+	switch uid := field(step, template, "runAsUser").(type) {
+	case int64:
+		return uid > 0
+	case float64:
+		return uid > 0
+	}
+	nonRoot, _ := field(step, template, "runAsNonRoot").(bool)
+	return nonRoot
+}
+
+// field returns the field of the step if it is set, otherwise the one of
+// the step template
+func field(step, template map[string]interface{}, name string) interface{} {
+	if v, ok := step[name]; ok {
+		return v
+	}
+	return template[name]
+}
+
+// isParam is true for an image given by a param e.g. $(params.image)
+// which can't be checked
+func isParam(image string) bool {
+	return strings.HasPrefix(image, "$(")
+}
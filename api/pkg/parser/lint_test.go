@@ -0,0 +1,193 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const lintTask = `
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: lint-me
+spec:
+  params:
+    - name: url
+      description: The url to fetch
+    - name: revision
+  steps:
+    - name: fetch
+      image: alpine/git:v2.40.1@sha256:4f8b
+      securityContext:
+        runAsNonRoot: true
+      script: |
+        #!/bin/sh
+        git clone $(params.url)
+    - name: build
+      image: golang
+      script: go build ./...
+    - name: push
+      image: $(params.image)
+      securityContext:
+        runAsUser: 0
+  sidecars:
+    - name: registry
+      image: registry:latest
+`
+
+func decodeLintTask(t *testing.T, yaml string) *unstructured.Unstructured {
+	t.Helper()
+	tkn, err := decodeResource(strings.NewReader(yaml), "Task")
+	assert.NilError(t, err)
+	return tkn.Unstructured
+}
+
+func TestLint(t *testing.T) {
+	results := lint(decodeLintTask(t, lintTask))
+
+	assert.DeepEqual(t, []LintResult{
+		{Rule: "image-digest", Severity: Info, Message: "step build uses image golang which is not pinned by digest"},
+		{Rule: "image-digest", Severity: Info, Message: "sidecar registry uses image registry:latest which is not pinned by digest"},
+		{Rule: "image-latest-tag", Severity: Warning, Message: "step build uses image golang with the latest tag"},
+		{Rule: "image-latest-tag", Severity: Warning, Message: "sidecar registry uses image registry:latest with the latest tag"},
+		{Rule: "script-shebang", Severity: Warning, Message: "step build has a script without shebang"},
+		{Rule: "param-description", Severity: Warning, Message: "param revision has no description"},
+		{Rule: "run-as-non-root", Severity: Warning, Message: "step build may run as root"},
+		{Rule: "run-as-non-root", Severity: Warning, Message: "step push may run as root"},
+	}, results)
+}
+
+func TestLint_StepTemplate(t *testing.T) {
+	task := `
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: lint-me
+spec:
+  stepTemplate:
+    securityContext:
+      runAsUser: 1001
+  steps:
+    - name: build
+      image: golang:1.22@sha256:4f8b
+`
+	assert.Equal(t, 0, len(lint(decodeLintTask(t, task))))
+}
+
+func TestLint_StepOverridesTemplate(t *testing.T) {
+	// the user of a step overrides the one of the template
+	userTask := `
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: lint-me
+spec:
+  stepTemplate:
+    securityContext:
+      runAsNonRoot: true
+      runAsUser: 1001
+  steps:
+    - name: build
+      image: golang:1.22@sha256:4f8b
+    - name: root
+      image: golang:1.22@sha256:4f8b
+      securityContext:
+        runAsUser: 0
+    - name: any
+      image: golang:1.22@sha256:4f8b
+      securityContext:
+        runAsNonRoot: false
+`
+	// runAsNonRoot of a step overrides the one of the template
+	nonRootTask := `
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: lint-me
+spec:
+  stepTemplate:
+    securityContext:
+      runAsNonRoot: true
+  steps:
+    - name: build
+      image: golang:1.22@sha256:4f8b
+    - name: any
+      image: golang:1.22@sha256:4f8b
+      securityContext:
+        runAsNonRoot: false
+`
+	assert.DeepEqual(t, []LintResult{
+		{Rule: "run-as-non-root", Severity: Warning, Message: "step root may run as root"},
+	}, lint(decodeLintTask(t, userTask)))
+
+	assert.DeepEqual(t, []LintResult{
+		{Rule: "run-as-non-root", Severity: Warning, Message: "step any may run as root"},
+	}, lint(decodeLintTask(t, nonRootTask)))
+}
+
+func TestLint_PipelineTaskSpec(t *testing.T) {
+	pipeline := `
+apiVersion: tekton.dev/v1
+kind: Pipeline
+metadata:
+  name: lint-me
+spec:
+  tasks:
+    - name: echo
+      taskSpec:
+        steps:
+          - name: echo
+            image: alpine@sha256:4f8b
+            script: echo done
+            securityContext:
+              runAsNonRoot: true
+`
+	tkn, err := decodeResource(strings.NewReader(pipeline), "Pipeline")
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, []LintResult{
+		{Rule: "script-shebang", Severity: Warning, Message: "task echo step echo has a script without shebang"},
+	}, lint(tkn.Unstructured))
+}
+
+func TestRegisterRule(t *testing.T) {
+	saved := append([]Rule{}, rules...)
+	defer func() { rules = saved }()
+
+	RegisterRule(Rule{
+		ID:       "no-sidecars",
+		Severity: Critical,
+		Check: func(u *unstructured.Unstructured) []string {
+			sidecars, _, _ := unstructured.NestedSlice(u.Object, "spec", "sidecars")
+			if len(sidecars) > 0 {
+				return []string{"task has sidecars"}
+			}
+			return nil
+		},
+	})
+	// replaces the rule having the same id
+	RegisterRule(Rule{ID: "image-digest", Check: func(*unstructured.Unstructured) []string { return nil }})
+
+	assert.Equal(t, len(saved)+1, len(Rules()))
+
+	results := lint(decodeLintTask(t, lintTask))
+	assert.Equal(t, "image-latest-tag", results[0].Rule)
+	assert.DeepEqual(t, LintResult{Rule: "no-sidecars", Severity: Critical, Message: "task has sidecars"}, results[len(results)-1])
+}
@@ -34,6 +34,22 @@ func (t IssueType) String() string {
 type Issue struct {
 	Type    IssueType
 	Message string
+	// Resource is the kind/name of the resource having the issue, if any
+	Resource string
+}
+
+// ResourceError is an error found parsing a resource
+type ResourceError struct {
+	Resource string
+	Err      error
+}
+
+func (e *ResourceError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ResourceError) Unwrap() error {
+	return e.Err
 }
 
 type Result struct {
@@ -90,3 +106,17 @@ func (r *Result) Combine(other Result) {
 	r.Issues = append(r.Issues, other.Issues...)
 	r.Errors = append(r.Errors, other.Errors...)
 }
+
+// ForResource returns the result with its issues and errors marked as
+// the ones of the resource
+func (r Result) ForResource(key ResourceKey) Result {
+	tagged := Result{}
+	for _, i := range r.Issues {
+		i.Resource = key.String()
+		tagged.Issues = append(tagged.Issues, i)
+	}
+	for _, err := range r.Errors {
+		tagged.AddError(&ResourceError{Resource: key.String(), Err: err})
+	}
+	return tagged
+}
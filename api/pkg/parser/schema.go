@@ -0,0 +1,152 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"encoding/json"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ParamSpec is a param of a resource, Default is set only if the param
+// has a default and is JSON for array and object params
+type ParamSpec struct {
+	Name        string
+	Type        string
+	Default     *string
+	Description string
+}
+
+// WorkspaceSpec is a workspace of a task or a pipeline, ReadOnly is
+// never set for a pipeline
+type WorkspaceSpec struct {
+	Name        string
+	Description string
+	Optional    bool
+	ReadOnly    bool
+}
+
+// ResultSpec is a result of a resource
+type ResultSpec struct {
+	Name        string
+	Type        string
+	Description string
+}
+
+// params returns the params declared in the spec of a resource, the type
+// of a param without type is the one of its default or string
+func params(u *unstructured.Unstructured) []ParamSpec {
+
+	list := []ParamSpec{}
+	items, _, _ := unstructured.NestedSlice(u.Object, "spec", "params")
+	for _, item := range items {
+		p, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := p["name"].(string)
+		if name == "" {
+			continue
+		}
+
+		param := ParamSpec{Name: name, Description: description(p)}
+		param.Type, _ = p["type"].(string)
+
+		switch def := p["default"].(type) {
+		case nil:
+		case string:
+			param.Default = &def
+		default:
+			if b, err := json.Marshal(def); err == nil {
+				value := string(b)
+				param.Default = &value
+			}
+		}
+
+		if param.Type == "" {
+			param.Type = valueType(p["default"])
+		}
+		list = append(list, param)
+	}
+	return list
+}
+
+// workspaces returns the workspaces declared in the spec of a task or a
+// pipeline
+func workspaces(u *unstructured.Unstructured) []WorkspaceSpec {
+
+	list := []WorkspaceSpec{}
+	items, _, _ := unstructured.NestedSlice(u.Object, "spec", "workspaces")
+	for _, item := range items {
+		w, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := w["name"].(string)
+		if name == "" {
+			continue
+		}
+
+		ws := WorkspaceSpec{Name: name, Description: description(w)}
+		ws.Optional, _ = w["optional"].(bool)
+		ws.ReadOnly, _ = w["readOnly"].(bool)
+		list = append(list, ws)
+	}
+	return list
+}
+
+// results returns the results declared in the spec of a resource
+func results(u *unstructured.Unstructured) []ResultSpec {
+
+	list := []ResultSpec{}
+	items, _, _ := unstructured.NestedSlice(u.Object, "spec", "results")
+	for _, item := range items {
+		r, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := r["name"].(string)
+		if name == "" {
+			continue
+		}
+
+		result := ResultSpec{Name: name, Description: description(r)}
+		result.Type, _ = r["type"].(string)
+		if result.Type == "" {
+			result.Type = valueType(r["value"])
+		}
+		list = append(list, result)
+	}
+	return list
+}
+
+func description(fields map[string]interface{}) string {
+	desc, _ := fields["description"].(string)
+	return strings.TrimSpace(desc)
+}
+
+// valueType returns the type of a param or a result given its default
+// or value as done by Tekton
+func valueType(value interface{}) string {
+	switch value.(type) {
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return "string"
+	}
+}
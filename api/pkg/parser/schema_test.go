@@ -0,0 +1,111 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestSchema(t *testing.T) {
+	task := `
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: schema
+spec:
+  params:
+    - name: IMAGE
+      description: |
+        Reference of the image to build
+    - name: ARGS
+      type: array
+      default: ["--verbose"]
+    - name: ENV
+      default:
+        FOO: bar
+    - name: TLSVERIFY
+      type: string
+      default: "true"
+      description: Verify the TLS on the registry endpoint
+  workspaces:
+    - name: source
+    - name: dockerconfig
+      description: Credentials of the registry
+      optional: true
+      readOnly: true
+  results:
+    - name: IMAGE_DIGEST
+      description: Digest of the image just built
+    - name: IMAGES
+      type: array
+  steps:
+    - name: build
+      image: quay.io/buildah/stable:v1.31
+`
+	tkn, err := decodeResource(strings.NewReader(task), "Task")
+	assert.NilError(t, err)
+
+	args, env, tls := `["--verbose"]`, `{"FOO":"bar"}`, "true"
+	assert.DeepEqual(t, []ParamSpec{
+		{Name: "IMAGE", Type: "string", Description: "Reference of the image to build"},
+		{Name: "ARGS", Type: "array", Default: &args},
+		{Name: "ENV", Type: "object", Default: &env},
+		{Name: "TLSVERIFY", Type: "string", Default: &tls, Description: "Verify the TLS on the registry endpoint"},
+	}, params(tkn.Unstructured))
+
+	assert.DeepEqual(t, []WorkspaceSpec{
+		{Name: "source"},
+		{Name: "dockerconfig", Description: "Credentials of the registry", Optional: true, ReadOnly: true},
+	}, workspaces(tkn.Unstructured))
+
+	assert.DeepEqual(t, []ResultSpec{
+		{Name: "IMAGE_DIGEST", Type: "string", Description: "Digest of the image just built"},
+		{Name: "IMAGES", Type: "array"},
+	}, results(tkn.Unstructured))
+}
+
+func TestSchema_PipelineResults(t *testing.T) {
+	pipeline := `
+apiVersion: tekton.dev/v1
+kind: Pipeline
+metadata:
+  name: schema
+spec:
+  workspaces:
+    - name: shared
+      optional: true
+  results:
+    - name: digest
+      value: $(tasks.build.results.IMAGE_DIGEST)
+    - name: images
+      value: ["$(tasks.build.results.IMAGE_URL)"]
+  tasks:
+    - name: build
+      taskRef:
+        name: buildah
+`
+	tkn, err := decodeResource(strings.NewReader(pipeline), "Pipeline")
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, []ParamSpec{}, params(tkn.Unstructured))
+	assert.DeepEqual(t, []WorkspaceSpec{{Name: "shared", Optional: true}}, workspaces(tkn.Unstructured))
+	assert.DeepEqual(t, []ResultSpec{
+		{Name: "digest", Type: "string"},
+		{Name: "images", Type: "array"},
+	}, results(tkn.Unstructured))
+}
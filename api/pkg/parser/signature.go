@@ -0,0 +1,116 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// SignatureAnnotation is the annotation of the signature of a Tekton
+// trusted resource
+const SignatureAnnotation = "tekton.dev/signature"
+
+// The results of the verification of the signature of a version
+const (
+	Verified         = "verified"
+	Unsigned         = "unsigned"
+	InvalidSignature = "invalid"
+)
+
+// hashable is a typed Tekton resource which can be signed
+type hashable interface {
+	Checksum() ([]byte, error)
+}
+
+// checksum returns the sha256 checksum of the resource signed by Tekton
+// trusted resources, computed on the typed resource without defaults as
+// the resource is verified by the pipelines controller before defaulting
+func checksum(u *unstructured.Unstructured) ([]byte, error) {
+
+	t, err := typeForKind(u.GroupVersionKind())
+	if err != nil {
+		return nil, err
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, t); err != nil {
+		return nil, err
+	}
+
+	h, ok := t.(hashable)
+	if !ok {
+		return nil, fmt.Errorf("kind %s can't be signed", u.GetKind())
+	}
+	return h.Checksum()
+}
+
+// LoadVerifiers returns the verifiers of the public keys, given as PEM
+// blocks, of a catalog
+func LoadVerifiers(keys string) ([]signature.Verifier, error) {
+
+	verifiers := []signature.Verifier{}
+	rest := []byte(keys)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		key, err := cryptoutils.UnmarshalPEMToPublicKey(pem.EncodeToMemory(block))
+		if err != nil {
+			return nil, err
+		}
+		verifier, err := signature.LoadVerifier(key, crypto.SHA256)
+		if err != nil {
+			return nil, err
+		}
+		verifiers = append(verifiers, verifier)
+	}
+
+	if len(bytes.TrimSpace(rest)) != 0 {
+		return nil, fmt.Errorf("invalid public key: not a PEM block")
+	}
+	return verifiers, nil
+}
+
+// Verify verifies the signature of the version with the verifiers of
+// the keys of its catalog. A version signed with none of the keys, or
+// signed when the catalog has no keys, has an invalid signature
+func (v VersionInfo) Verify(verifiers []signature.Verifier) string {
+
+	if v.Signature == "" {
+		return Unsigned
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(v.Signature)
+	if err != nil || len(v.Checksum) == 0 {
+		return InvalidSignature
+	}
+
+	for _, verifier := range verifiers {
+		if err := verifier.VerifySignature(bytes.NewReader(sig), bytes.NewReader(v.Checksum)); err == nil {
+			return Verified
+		}
+	}
+	return InvalidSignature
+}
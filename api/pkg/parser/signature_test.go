@@ -0,0 +1,145 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"go.uber.org/zap"
+	"gotest.tools/v3/assert"
+)
+
+const signedTask = `
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: signed
+  annotations:
+    tekton.dev/pipelines.minVersion: "0.50.0"
+spec:
+  steps:
+    - name: echo
+      image: alpine:3.18
+      script: echo hello
+`
+
+func signer(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NilError(t, err)
+	pub, err := cryptoutils.MarshalPublicKeyToPEM(key.Public())
+	assert.NilError(t, err)
+	return key, string(pub)
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, sum []byte) string {
+	t.Helper()
+
+	s, err := signature.LoadECDSASigner(key, crypto.SHA256)
+	assert.NilError(t, err)
+	sig, err := s.SignMessage(bytes.NewReader(sum))
+	assert.NilError(t, err)
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+func TestChecksum_IgnoresSignature(t *testing.T) {
+	tkn, err := decodeResource(strings.NewReader(signedTask), "Task")
+	assert.NilError(t, err)
+
+	sum, err := checksum(tkn.Unstructured)
+	assert.NilError(t, err)
+
+	annotations := tkn.Unstructured.GetAnnotations()
+	annotations[SignatureAnnotation] = "c2lnbmF0dXJl"
+	tkn.Unstructured.SetAnnotations(annotations)
+
+	signed, err := checksum(tkn.Unstructured)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sum, signed)
+}
+
+func TestVerify(t *testing.T) {
+	tkn, err := decodeResource(strings.NewReader(signedTask), "Task")
+	assert.NilError(t, err)
+	sum, err := checksum(tkn.Unstructured)
+	assert.NilError(t, err)
+
+	key, pub := signer(t)
+	other, otherPub := signer(t)
+
+	verifiers, err := LoadVerifiers(otherPub + pub)
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(verifiers))
+
+	none, err := LoadVerifiers("")
+	assert.NilError(t, err)
+
+	signed := VersionInfo{Signature: sign(t, key, sum), Checksum: sum}
+	assert.Equal(t, Verified, signed.Verify(verifiers))
+	assert.Equal(t, InvalidSignature, signed.Verify(none))
+
+	unknown := VersionInfo{Signature: sign(t, other, []byte("modified")), Checksum: sum}
+	assert.Equal(t, InvalidSignature, unknown.Verify(verifiers))
+
+	garbled := VersionInfo{Signature: "not base64!", Checksum: sum}
+	assert.Equal(t, InvalidSignature, garbled.Verify(verifiers))
+
+	assert.Equal(t, Unsigned, VersionInfo{}.Verify(verifiers))
+}
+
+// the task of the signed catalog has been signed with the signer of the
+// trusted resources tests of Tekton Pipelines and verified by its
+// VerifyResource, cosign.pub is the public key of the signer
+func TestVerify_SignedByTekton(t *testing.T) {
+	repo := fakeRepo{path: "./testdata/catalogs/signed"}
+	p := ForCatalog(zap.NewNop().Sugar(), repo, "")
+	res, result := p.Parse()
+	assert.Equal(t, 0, len(result.Errors))
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, 1, len(res[0].Versions))
+
+	pub, err := os.ReadFile("./testdata/catalogs/signed/cosign.pub")
+	assert.NilError(t, err)
+	verifiers, err := LoadVerifiers(string(pub))
+	assert.NilError(t, err)
+
+	hello := res[0].Versions[0]
+	assert.Equal(t, Verified, hello.Verify(verifiers))
+
+	// the signature doesn't match another key or a modified task
+	_, other := signer(t)
+	unknown, err := LoadVerifiers(other)
+	assert.NilError(t, err)
+	assert.Equal(t, InvalidSignature, hello.Verify(unknown))
+	hello.Checksum = append([]byte{}, hello.Checksum...)
+	hello.Checksum[0] ^= 0xff
+	assert.Equal(t, InvalidSignature, hello.Verify(verifiers))
+}
+
+func TestLoadVerifiers_Invalid(t *testing.T) {
+	_, err := LoadVerifiers("not a key")
+	assert.ErrorContains(t, err, "not a PEM block")
+}
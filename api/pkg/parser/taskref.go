@@ -0,0 +1,172 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"path"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// The ways a pipeline references a task
+const (
+	ViaName   = "name"
+	ViaBundle = "bundle"
+	ViaHub    = "hub"
+	ViaGit    = "git"
+)
+
+// TaskRef is a reference of a pipeline to a task. Version is known only
+// if the reference pins it e.g. through the tag of a bundle
+type TaskRef struct {
+	Name    string
+	Kind    string
+	Version string
+	Catalog string
+	Bundle  string
+	URL     string
+	Via     string
+}
+
+// taskRefs returns the references to the tasks of the pipeline in
+// spec.tasks and spec.finally, a task run more than once by the pipeline
+// is referenced once. Embedded task specs and references through other
+// resolvers e.g. cluster are ignored
+func taskRefs(u *unstructured.Unstructured) []TaskRef {
+
+	var refs []TaskRef
+	seen := map[TaskRef]bool{}
+	for _, field := range []string{"tasks", "finally"} {
+		tasks, _, _ := unstructured.NestedSlice(u.Object, "spec", field)
+		for _, t := range tasks {
+			task, ok := t.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			taskRef, ok := task["taskRef"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if ref, ok := parseTaskRef(taskRef); ok && !seen[ref] {
+				seen[ref] = true
+				refs = append(refs, ref)
+			}
+		}
+	}
+	return refs
+}
+
+func parseTaskRef(taskRef map[string]interface{}) (TaskRef, bool) {
+
+	name, _ := taskRef["name"].(string)
+	kind, _ := taskRef["kind"].(string)
+	resolver, _ := taskRef["resolver"].(string)
+	params := resolverParams(taskRef)
+
+	ref := TaskRef{Name: name, Kind: kind}
+	switch resolver {
+	case "":
+		// the deprecated bundle field of v1beta1 pipelines
+		if bundle, _ := taskRef["bundle"].(string); bundle != "" {
+			ref.Via = ViaBundle
+			ref.Bundle = bundle
+			ref.Version = imageTag(bundle)
+		} else {
+			ref.Via = ViaName
+		}
+
+	case "bundles":
+		ref.Via = ViaBundle
+		ref.Name = params["name"]
+		ref.Kind = params["kind"]
+		ref.Bundle = params["bundle"]
+		ref.Version = imageTag(ref.Bundle)
+
+	case "hub":
+		ref.Via = ViaHub
+		ref.Name = params["name"]
+		ref.Kind = params["kind"]
+		ref.Catalog = params["catalog"]
+		ref.Version = params["version"]
+
+	case "git":
+		// the catalog layout is <kind>/<name>/<version>/<name>.yaml
+		ref.Via = ViaGit
+		ref.URL = params["url"]
+		parts := strings.Split(strings.Trim(params["pathInRepo"], "/"), "/")
+		if len(parts) == 4 {
+			ref.Kind = parts[0]
+			ref.Name = parts[1]
+			ref.Version = parts[2]
+		} else {
+			ref.Name = strings.TrimSuffix(path.Base(params["pathInRepo"]), ".yaml")
+		}
+
+	default:
+		return TaskRef{}, false
+	}
+
+	if ref.Name == "" {
+		return TaskRef{}, false
+	}
+	ref.Kind = taskKind(ref.Kind)
+	return ref, true
+}
+
+// resolverParams returns the string params of a resolver
+func resolverParams(taskRef map[string]interface{}) map[string]string {
+
+	params := map[string]string{}
+	list, _ := taskRef["params"].([]interface{})
+	for _, p := range list {
+		param, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := param["name"].(string)
+		value, _ := param["value"].(string)
+		params[name] = value
+	}
+	return params
+}
+
+// imageTag returns the tag of an image e.g. of a bundle reference which
+// is the version of the task in the bundles of the catalog, images
+// without tag or referenced by digest have no tag
+func imageTag(image string) string {
+	if strings.Contains(image, "@") {
+		return ""
+	}
+	i := strings.LastIndex(image, ":")
+	if i < 0 || strings.Contains(image[i:], "/") {
+		return ""
+	}
+	return image[i+1:]
+}
+
+// taskKind returns the kind of the task as saved for the resources e.g.
+// Task, a reference without kind is to a Task
+func taskKind(kind string) string {
+	for _, k := range SupportedKinds() {
+		if strings.EqualFold(k, kind) {
+			return k
+		}
+	}
+	if kind == "" {
+		return "Task"
+	}
+	return kind
+}
@@ -0,0 +1,83 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package semver orders and compares versions of resources and of
+// Tekton Pipelines. Versions such as 0.1 or v0.21.0 are accepted and
+// versions which are not valid semver sort below all valid versions.
+package semver
+
+import (
+	"sort"
+	"strings"
+
+	mv "github.com/Masterminds/semver/v3"
+)
+
+// Compare returns -1, 0 or 1 if version a is lower than, equal to or
+// higher than version b. Invalid versions are compared as strings.
+func Compare(a, b string) int {
+	va, errA := mv.NewVersion(a)
+	vb, errB := mv.NewVersion(b)
+
+	switch {
+	case errA == nil && errB == nil:
+		return va.Compare(vb)
+	case errA == nil:
+		return 1
+	case errB == nil:
+		return -1
+	}
+	return strings.Compare(a, b)
+}
+
+// Less reports whether version a is lower than version b
+func Less(a, b string) bool {
+	return Compare(a, b) < 0
+}
+
+// Sort sorts versions in ascending order
+func Sort(versions []string) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		return Less(versions[i], versions[j])
+	})
+}
+
+// IsPrerelease reports whether version has a pre-release label
+// e.g. 0.3.0-rc1
+func IsPrerelease(version string) bool {
+	v, err := mv.NewVersion(version)
+	if err != nil {
+		return false
+	}
+	return v.Prerelease() != ""
+}
+
+// Latest returns the highest of the versions. Pre-release versions are
+// skipped unless prerelease is true or there is no other version.
+func Latest(versions []string, prerelease bool) string {
+	latest := ""
+	for _, v := range versions {
+		if !prerelease && IsPrerelease(v) {
+			continue
+		}
+		if latest == "" || Less(latest, v) {
+			latest = v
+		}
+	}
+
+	if latest == "" && !prerelease {
+		return Latest(versions, true)
+	}
+	return latest
+}
@@ -0,0 +1,54 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompare(t *testing.T) {
+	assert.Equal(t, -1, Compare("0.9", "0.10"))
+	assert.Equal(t, 1, Compare("0.10.0", "0.9.1"))
+	assert.Equal(t, 0, Compare("0.1", "0.1.0"))
+	assert.Equal(t, 0, Compare("v0.21.0", "0.21.0"))
+	assert.Equal(t, -1, Compare("0.3.0-rc1", "0.3.0"))
+	assert.Equal(t, -1, Compare("0.3.0-rc1", "0.3.0-rc2"))
+	assert.Equal(t, -1, Compare("", "0.1"))
+	assert.Equal(t, -1, Compare("alpha", "0.1"))
+	assert.Equal(t, 1, Compare("beta", "alpha"))
+}
+
+func TestSort(t *testing.T) {
+	versions := []string{"0.10", "0.3.0-rc1", "0.9", "0.3", "0.1", "devel"}
+	Sort(versions)
+	assert.Equal(t, []string{"devel", "0.1", "0.3.0-rc1", "0.3", "0.9", "0.10"}, versions)
+}
+
+func TestIsPrerelease(t *testing.T) {
+	assert.True(t, IsPrerelease("0.3.0-rc1"))
+	assert.True(t, IsPrerelease("v1.0.0-beta.1"))
+	assert.False(t, IsPrerelease("0.3"))
+	assert.False(t, IsPrerelease("devel"))
+}
+
+func TestLatest(t *testing.T) {
+	assert.Equal(t, "0.10", Latest([]string{"0.9", "0.10", "0.2"}, false))
+	assert.Equal(t, "0.3", Latest([]string{"0.3", "0.4.0-rc1", "0.2"}, false))
+	assert.Equal(t, "0.4.0-rc1", Latest([]string{"0.3", "0.4.0-rc1", "0.2"}, true))
+	assert.Equal(t, "0.4.0-rc2", Latest([]string{"0.4.0-rc1", "0.4.0-rc2"}, false))
+	assert.Equal(t, "", Latest(nil, false))
+}
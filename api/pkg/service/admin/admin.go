@@ -17,9 +17,11 @@ package admin
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/tektoncd/hub/api/gen/admin"
 	"github.com/tektoncd/hub/api/pkg/app"
+	"github.com/tektoncd/hub/api/pkg/audit"
 	"github.com/tektoncd/hub/api/pkg/db/initializer"
 	"github.com/tektoncd/hub/api/pkg/db/model"
 	"github.com/tektoncd/hub/api/pkg/service/validator"
@@ -36,17 +38,23 @@ type agentRequest struct {
 	db        *gorm.DB
 	log       *app.Logger
 	jwtConfig *app.JWTConfig
+	actorID   uint
 }
 
 type refreshRequest struct {
-	db  *gorm.DB
-	log *app.Logger
-	api app.BaseConfig
+	db      *gorm.DB
+	log     *app.Logger
+	api     app.BaseConfig
+	actorID uint
 }
 
 var (
 	internalError = admin.MakeInternalError(fmt.Errorf("failed to create agent"))
 	refreshError  = admin.MakeInternalError(fmt.Errorf("failed to refresh config"))
+	auditError    = admin.MakeInternalError(fmt.Errorf("failed to list audit events"))
+	agentsError   = admin.MakeInternalError(fmt.Errorf("failed to list agents"))
+	revokeError   = admin.MakeInternalError(fmt.Errorf("failed to revoke agent token"))
+	tokenError    = admin.MakeInternalError(fmt.Errorf("failed to revoke refresh token of user"))
 )
 
 // New returns the admin service implementation.
@@ -63,6 +71,7 @@ func (s *service) UpdateAgent(ctx context.Context, p *admin.UpdateAgentPayload)
 		db:        s.DB(ctx),
 		log:       s.Logger(ctx),
 		jwtConfig: s.api.JWTConfig(),
+		actorID:   validator.UserID(ctx),
 	}
 
 	return req.run(p.Name, p.Scopes)
@@ -81,6 +90,17 @@ func (r *agentRequest) run(name string, scopes []string) (*admin.UpdateAgentResu
 		return nil, err
 	}
 
+	// the event is recorded in the same transaction so that scopes of an
+	// agent are not changed without a trace
+	payload := map[string]interface{}{"name": name, "scopes": scopes}
+	if err := audit.Record(r.db, r.actorID, audit.AgentUpdate, "agent:"+name, payload); err != nil {
+		r.log.Error(err)
+		if err := r.db.Rollback().Error; err != nil {
+			r.log.Error(err)
+		}
+		return nil, internalError
+	}
+
 	if err := r.db.Commit().Error; err != nil {
 		r.log.Error(err)
 		return nil, internalError
@@ -119,6 +139,7 @@ func (r *agentRequest) addNewAgent(name string, scopes []string) (string, error)
 	agent := model.User{
 		AgentName: name,
 		Type:      model.AgentUserType,
+		TokenID:   token.NewID(),
 	}
 
 	// User ID is by default set to zero so we need to update the value with (last inserted user_id+1)
@@ -160,6 +181,13 @@ func (r *agentRequest) updateExistingAgent(agent *model.User, scopes []string) (
 		return "", err
 	}
 
+	// A new token id revokes the token having the previous scopes
+	agent.TokenID = token.NewID()
+	if err := r.db.Model(agent).UpdateColumn("token_id", agent.TokenID).Error; err != nil {
+		r.log.Error(err)
+		return "", internalError
+	}
+
 	req := token.Request{User: agent, Scopes: scopes, JWTConfig: r.jwtConfig}
 	token, err := req.AgentJWT()
 	if err != nil {
@@ -211,13 +239,108 @@ func (r *agentRequest) userExistWithAgentName(name string) error {
 	return admin.MakeInvalidPayload(fmt.Errorf("user exists with name: %s", name))
 }
 
+// List the agents along with the id of their valid token
+func (s *service) ListAgents(ctx context.Context, p *admin.ListAgentsPayload) ([]*admin.Agent, error) {
+
+	log := s.Logger(ctx)
+	db := s.DB(ctx)
+
+	agents := []model.User{}
+	q := db.Preload("Scopes").Where(&model.User{Type: model.AgentUserType}).Order("id")
+	if err := q.Find(&agents).Error; err != nil {
+		log.Error(err)
+		return nil, agentsError
+	}
+
+	res := []*admin.Agent{}
+	for _, a := range agents {
+		agent := &admin.Agent{
+			ID:     a.ID,
+			Name:   a.AgentName,
+			Scopes: []string{},
+		}
+		for _, sc := range a.Scopes {
+			agent.Scopes = append(agent.Scopes, sc.Name)
+		}
+		if a.TokenID != "" {
+			tokenID := a.TokenID
+			agent.TokenID = &tokenID
+		}
+		res = append(res, agent)
+	}
+	return res, nil
+}
+
+// Revoke the token of an agent, a new token can be created by updating the agent
+func (s *service) RevokeAgent(ctx context.Context, p *admin.RevokeAgentPayload) error {
+
+	log := s.Logger(ctx)
+	db := s.DB(ctx)
+
+	agent := model.User{}
+	q := db.Where(&model.User{AgentName: p.Name, Type: model.AgentUserType})
+	if err := q.First(&agent).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return admin.MakeNotFound(fmt.Errorf("agent not found: %s", p.Name))
+		}
+		log.Error(err)
+		return revokeError
+	}
+
+	// tokens are verified against the token id of the agent, so clearing
+	// it revokes the token without affecting the tokens of other users
+	if err := db.Model(&agent).UpdateColumn("token_id", "").Error; err != nil {
+		log.Error(err)
+		return revokeError
+	}
+
+	payload := map[string]interface{}{"name": p.Name}
+	if err := audit.Record(db, validator.UserID(ctx), audit.AgentRevoke, "agent:"+p.Name, payload); err != nil {
+		log.Error(err)
+	}
+
+	return nil
+}
+
+// Revoke the refresh token of a user
+func (s *service) RevokeUserToken(ctx context.Context, p *admin.RevokeUserTokenPayload) error {
+
+	log := s.Logger(ctx)
+	db := s.DB(ctx)
+
+	user := model.User{}
+	q := db.Where(&model.User{Type: model.NormalUserType})
+	if err := q.First(&user, p.ID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return admin.MakeNotFound(fmt.Errorf("user not found: %d", p.ID))
+		}
+		log.Error(err)
+		return tokenError
+	}
+
+	// the refresh token is verified against its checksum saved for the
+	// user, so clearing it revokes the token until the user logs in again
+	if err := db.Model(&user).UpdateColumn("refresh_token_checksum", "").Error; err != nil {
+		log.Error(err)
+		return tokenError
+	}
+
+	payload := map[string]interface{}{"id": p.ID}
+	if err := audit.Record(db, validator.UserID(ctx), audit.UserTokenRevoke, fmt.Sprintf("user:%d", p.ID), payload); err != nil {
+		log.Error(err)
+	}
+
+	return nil
+}
+
 // Refresh the changes in config file
 func (s *service) RefreshConfig(ctx context.Context, p *admin.RefreshConfigPayload) (*admin.RefreshConfigResult, error) {
 
 	req := refreshRequest{
-		db:  s.DB(ctx),
-		log: s.Logger(ctx),
-		api: s.api,
+		db:      s.DB(ctx),
+		log:     s.Logger(ctx),
+		api:     s.api,
+		actorID: validator.UserID(ctx),
 	}
 
 	return req.run(ctx)
@@ -239,5 +362,57 @@ func (r *refreshRequest) run(ctx context.Context) (*admin.RefreshConfigResult, e
 		return nil, refreshError
 	}
 
+	payload := map[string]interface{}{"checksum": config.Checksum}
+	if err := audit.Record(r.db, r.actorID, audit.ConfigRefresh, "config", payload); err != nil {
+		r.log.Error(err)
+	}
+
 	return &admin.RefreshConfigResult{Checksum: config.Checksum}, nil
 }
+
+// List the audit events of write operations, latest first
+func (s *service) Audit(ctx context.Context, p *admin.AuditPayload) ([]*admin.AuditEvent, error) {
+
+	log := s.Logger(ctx)
+	q := s.DB(ctx).Model(&model.AuditEvent{})
+
+	if p.Actor != nil {
+		q = q.Where("actor_id = ?", *p.Actor)
+	}
+	if p.Action != nil {
+		q = q.Where("action = ?", *p.Action)
+	}
+	if p.Since != nil {
+		since, err := time.Parse(time.RFC3339, *p.Since)
+		if err != nil {
+			return nil, admin.MakeInvalidPayload(fmt.Errorf("invalid since: %w", err))
+		}
+		q = q.Where("created_at >= ?", since)
+	}
+	if p.Until != nil {
+		until, err := time.Parse(time.RFC3339, *p.Until)
+		if err != nil {
+			return nil, admin.MakeInvalidPayload(fmt.Errorf("invalid until: %w", err))
+		}
+		q = q.Where("created_at < ?", until)
+	}
+
+	events := []model.AuditEvent{}
+	if err := q.Order("created_at DESC, id DESC").Limit(int(p.Limit)).Find(&events).Error; err != nil {
+		log.Error(err)
+		return nil, auditError
+	}
+
+	res := []*admin.AuditEvent{}
+	for _, e := range events {
+		res = append(res, &admin.AuditEvent{
+			ID:        e.ID,
+			ActorID:   e.ActorID,
+			Action:    e.Action,
+			Target:    e.Target,
+			Digest:    e.Digest,
+			CreatedAt: e.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return res, nil
+}
@@ -238,3 +238,231 @@ func computeChecksum() (string, error) {
 	hash := sha256.Sum256(data)
 	return hex.EncodeToString(hash[:]), nil
 }
+
+func AuditChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(server.NewAuditHandler,
+		server.MountAuditHandler,
+		admin.NewAuditEndpoint(New(tc), New(tc).(admin.Auther).JWTAuth))
+	return checker
+}
+
+func TestAudit_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// user with audit:read scope
+	user, token, err := tc.UserWithScopes("foo", "foo@bar.com", "audit:read")
+	assert.Equal(t, user.Email, "foo@bar.com")
+	assert.NoError(t, err)
+
+	// Mocks the time
+	jwt.TimeFunc = testutils.Now
+
+	AuditChecker(tc).Test(t, http.MethodGet, "/system/audit?action=catalog.refresh").
+		WithHeader("Authorization", token).Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := []*admin.AuditEvent{}
+		marshallErr := json.Unmarshal([]byte(b), &res)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, 1, len(res))
+		assert.Equal(t, uint(11), res[0].ActorID)
+		assert.Equal(t, "catalog:catalog-official", res[0].Target)
+	})
+}
+
+func TestAudit_HttpInvalidSince(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// user with audit:read scope
+	_, token, err := tc.UserWithScopes("foo", "foo@bar.com", "audit:read")
+	assert.NoError(t, err)
+
+	// Mocks the time
+	jwt.TimeFunc = testutils.Now
+
+	AuditChecker(tc).Test(t, http.MethodGet, "/system/audit?since=yesterday").
+		WithHeader("Authorization", token).Check().
+		HasStatus(400)
+}
+
+func TestAudit_HttpInsufficientScope(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// user without audit:read scope
+	_, token, err := tc.UserWithScopes("foo", "foo@bar.com", "config:refresh")
+	assert.NoError(t, err)
+
+	// Mocks the time
+	jwt.TimeFunc = testutils.Now
+
+	AuditChecker(tc).Test(t, http.MethodGet, "/system/audit").
+		WithHeader("Authorization", token).Check().
+		HasStatus(403)
+}
+
+func ListAgentsChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(server.NewListAgentsHandler,
+		server.MountListAgentsHandler,
+		admin.NewListAgentsEndpoint(New(tc), New(tc).(admin.Auther).JWTAuth))
+	return checker
+}
+
+func TestListAgents_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// user with agent:create scope
+	_, token, err := tc.UserWithScopes("foo", "foo@bar.com", "agent:create")
+	assert.NoError(t, err)
+
+	// Mocks the time
+	jwt.TimeFunc = testutils.Now
+
+	ListAgentsChecker(tc).Test(t, http.MethodGet, "/system/user/agents").
+		WithHeader("Authorization", token).Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := []*admin.Agent{}
+		marshallErr := json.Unmarshal([]byte(b), &res)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, 1, len(res))
+		assert.Equal(t, "agent-001", res[0].Name)
+	})
+}
+
+func RevokeAgentChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(server.NewRevokeAgentHandler,
+		server.MountRevokeAgentHandler,
+		admin.NewRevokeAgentEndpoint(New(tc), New(tc).(admin.Auther).JWTAuth))
+	return checker
+}
+
+func TestRevokeAgent_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// user with agent:create scope
+	_, userToken, err := tc.UserWithScopes("foo", "foo@bar.com", "agent:create")
+	assert.NoError(t, err)
+
+	// agent which will create other agents until its token is revoked
+	_, agentToken, err := tc.AgentWithScopes("agent-007", "agent:create")
+	assert.NoError(t, err)
+
+	// Mocks the time
+	jwt.TimeFunc = testutils.Now
+
+	ListAgentsChecker(tc).Test(t, http.MethodGet, "/system/user/agents").
+		WithHeader("Authorization", agentToken).Check().
+		HasStatus(200)
+
+	RevokeAgentChecker(tc).Test(t, http.MethodDelete, "/system/user/agent/agent-007").
+		WithHeader("Authorization", userToken).Check().
+		HasStatus(200)
+
+	ListAgentsChecker(tc).Test(t, http.MethodGet, "/system/user/agents").
+		WithHeader("Authorization", agentToken).Check().
+		HasStatus(401)
+
+	// tokens of users are not affected by revoking an agent
+	ListAgentsChecker(tc).Test(t, http.MethodGet, "/system/user/agents").
+		WithHeader("Authorization", userToken).Check().
+		HasStatus(200)
+}
+
+func TestRevokeAgent_HttpLegacyToken(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// user with agent:create scope
+	_, userToken, err := tc.UserWithScopes("foo", "foo@bar.com", "agent:create")
+	assert.NoError(t, err)
+
+	// agent created before tokens had a jti
+	agent, _, err := tc.AgentWithScopes("agent-legacy", "agent:create")
+	assert.NoError(t, err)
+	assert.NoError(t, tc.DB().Model(agent).UpdateColumn("token_id", token.LegacyID).Error)
+
+	legacyToken, err := token.Create(jwt.MapClaims{
+		"iss":    "Tekton Hub",
+		"id":     agent.ID,
+		"name":   agent.AgentName,
+		"type":   "agent-token",
+		"scopes": []string{"agent:create"},
+		"iat":    testutils.Now().Unix(),
+	}, tc.JWTConfig())
+	assert.NoError(t, err)
+
+	// Mocks the time
+	jwt.TimeFunc = testutils.Now
+
+	ListAgentsChecker(tc).Test(t, http.MethodGet, "/system/user/agents").
+		WithHeader("Authorization", legacyToken).Check().
+		HasStatus(200)
+
+	RevokeAgentChecker(tc).Test(t, http.MethodDelete, "/system/user/agent/agent-legacy").
+		WithHeader("Authorization", userToken).Check().
+		HasStatus(200)
+
+	ListAgentsChecker(tc).Test(t, http.MethodGet, "/system/user/agents").
+		WithHeader("Authorization", legacyToken).Check().
+		HasStatus(401)
+}
+
+func TestRevokeAgent_HttpNotFound(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// user with agent:create scope
+	_, token, err := tc.UserWithScopes("foo", "foo@bar.com", "agent:create")
+	assert.NoError(t, err)
+
+	// Mocks the time
+	jwt.TimeFunc = testutils.Now
+
+	RevokeAgentChecker(tc).Test(t, http.MethodDelete, "/system/user/agent/agent-404").
+		WithHeader("Authorization", token).Check().
+		HasStatus(404)
+}
+
+func RevokeUserTokenChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(server.NewRevokeUserTokenHandler,
+		server.MountRevokeUserTokenHandler,
+		admin.NewRevokeUserTokenEndpoint(New(tc), New(tc).(admin.Auther).JWTAuth))
+	return checker
+}
+
+func TestRevokeUserToken_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// user with agent:create scope
+	_, token, err := tc.UserWithScopes("foo", "foo@bar.com", "agent:create")
+	assert.NoError(t, err)
+
+	// Mocks the time
+	jwt.TimeFunc = testutils.Now
+
+	RevokeUserTokenChecker(tc).Test(t, http.MethodDelete, "/system/user/13/refresh-token").
+		WithHeader("Authorization", token).Check().
+		HasStatus(200)
+
+	RevokeUserTokenChecker(tc).Test(t, http.MethodDelete, "/system/user/404/refresh-token").
+		WithHeader("Authorization", token).Check().
+		HasStatus(404)
+}
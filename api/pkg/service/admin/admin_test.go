@@ -20,6 +20,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tektoncd/hub/api/gen/admin"
+	"github.com/tektoncd/hub/api/pkg/audit"
+	"github.com/tektoncd/hub/api/pkg/db/model"
 	"github.com/tektoncd/hub/api/pkg/service/validator"
 	"github.com/tektoncd/hub/api/pkg/testutils"
 	"github.com/tektoncd/hub/api/pkg/token"
@@ -110,3 +112,178 @@ func TestUpdateAgent_UpdateScopesCase(t *testing.T) {
 
 	assert.Equal(t, agentToken, res.Token)
 }
+
+func TestUpdateAgent_RecordsAuditEvent(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// user with agent:create scope
+	user, _, err := tc.UserWithScopes("foo", "foo@bar.com", "agent:create")
+	assert.Equal(t, user.Email, "foo@bar.com")
+	assert.NoError(t, err)
+
+	// Mocks the time
+	token.Now = testutils.Now
+
+	adminSvc := New(tc)
+	ctx := validator.WithUserID(context.Background(), user.ID)
+	payload := &admin.UpdateAgentPayload{Name: "agent-007", Scopes: []string{"config:refresh"}}
+	_, err = adminSvc.UpdateAgent(ctx, payload)
+	assert.NoError(t, err)
+
+	event := model.AuditEvent{}
+	err = tc.DB().Where(&model.AuditEvent{Action: audit.AgentUpdate}).First(&event).Error
+	assert.NoError(t, err)
+	assert.Equal(t, user.ID, event.ActorID)
+	assert.Equal(t, "agent:agent-007", event.Target)
+	assert.Len(t, event.Digest, 64)
+}
+
+func TestAudit(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	adminSvc := New(tc)
+	res, err := adminSvc.Audit(context.Background(), &admin.AuditPayload{Limit: 100})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(res))
+	assert.Equal(t, "rating.update", res[0].Action)
+	assert.Equal(t, "2016-01-03T12:30:12Z", res[0].CreatedAt)
+}
+
+func TestAudit_Filters(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	actor := uint(11)
+	since := "2016-01-02T00:00:00Z"
+	adminSvc := New(tc)
+	res, err := adminSvc.Audit(context.Background(), &admin.AuditPayload{Actor: &actor, Since: &since, Limit: 100})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, "config.refresh", res[0].Action)
+	assert.Equal(t, "config", res[0].Target)
+}
+
+func TestAudit_Limit(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	adminSvc := New(tc)
+	res, err := adminSvc.Audit(context.Background(), &admin.AuditPayload{Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(res))
+	assert.Equal(t, uint(3), res[0].ID)
+	assert.Equal(t, uint(2), res[1].ID)
+}
+
+func TestUpdateAgent_RevokesPreviousToken(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// user with agent:create scope
+	user, _, err := tc.UserWithScopes("foo", "foo@bar.com", "agent:create")
+	assert.NoError(t, err)
+
+	// Mocks the time
+	token.Now = testutils.Now
+
+	adminSvc := New(tc)
+	ctx := validator.WithUserID(context.Background(), user.ID)
+	payload := &admin.UpdateAgentPayload{Name: "agent-001", Scopes: []string{"config:refresh"}}
+	_, err = adminSvc.UpdateAgent(ctx, payload)
+	assert.NoError(t, err)
+
+	agent := model.User{}
+	err = tc.DB().Where(&model.User{AgentName: "agent-001"}).First(&agent).Error
+	assert.NoError(t, err)
+	assert.NotEqual(t, "6f1c2b9e-3d4a-4c8e-9f21-0b7d5e6a8c13", agent.TokenID)
+}
+
+func TestListAgents(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	adminSvc := New(tc)
+	res, err := adminSvc.ListAgents(context.Background(), &admin.ListAgentsPayload{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, "agent-001", res[0].Name)
+	assert.Equal(t, "6f1c2b9e-3d4a-4c8e-9f21-0b7d5e6a8c13", *res[0].TokenID)
+}
+
+func TestRevokeAgent(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// user with agent:create scope
+	user, _, err := tc.UserWithScopes("foo", "foo@bar.com", "agent:create")
+	assert.NoError(t, err)
+
+	adminSvc := New(tc)
+	ctx := validator.WithUserID(context.Background(), user.ID)
+	err = adminSvc.RevokeAgent(ctx, &admin.RevokeAgentPayload{Name: "agent-001"})
+	assert.NoError(t, err)
+
+	res, err := adminSvc.ListAgents(ctx, &admin.ListAgentsPayload{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res))
+	assert.Nil(t, res[0].TokenID)
+
+	event := model.AuditEvent{}
+	err = tc.DB().Where(&model.AuditEvent{Action: audit.AgentRevoke}).First(&event).Error
+	assert.NoError(t, err)
+	assert.Equal(t, "agent:agent-001", event.Target)
+}
+
+func TestRevokeUserToken(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// user with agent:create scope
+	user, _, err := tc.UserWithScopes("foo", "foo@bar.com", "agent:create")
+	assert.NoError(t, err)
+
+	adminSvc := New(tc)
+	ctx := validator.WithUserID(context.Background(), user.ID)
+	err = adminSvc.RevokeUserToken(ctx, &admin.RevokeUserTokenPayload{ID: 13})
+	assert.NoError(t, err)
+
+	revoked := model.User{}
+	assert.NoError(t, tc.DB().First(&revoked, 13).Error)
+	assert.Equal(t, "", revoked.RefreshTokenChecksum)
+
+	// refresh tokens of other users are not affected
+	other := model.User{}
+	assert.NoError(t, tc.DB().First(&other, 31).Error)
+	assert.NotEqual(t, "", other.RefreshTokenChecksum)
+
+	event := model.AuditEvent{}
+	err = tc.DB().Where(&model.AuditEvent{Action: audit.UserTokenRevoke}).First(&event).Error
+	assert.NoError(t, err)
+	assert.Equal(t, "user:13", event.Target)
+}
+
+func TestRevokeUserToken_NotFound(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	adminSvc := New(tc)
+	ctx := validator.WithUserID(context.Background(), 11)
+
+	// agents don't have refresh tokens
+	err := adminSvc.RevokeUserToken(ctx, &admin.RevokeUserTokenPayload{ID: 21})
+	assert.Error(t, err)
+	assert.EqualError(t, err, "user not found: 21")
+}
+
+func TestRevokeAgent_NotFound(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	adminSvc := New(tc)
+	ctx := validator.WithUserID(context.Background(), 11)
+	err := adminSvc.RevokeAgent(ctx, &admin.RevokeAgentPayload{Name: "agent-404"})
+	assert.Error(t, err)
+	assert.EqualError(t, err, "agent not found: agent-404")
+}
@@ -17,10 +17,14 @@ package catalog
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/tektoncd/hub/api/gen/catalog"
 	"github.com/tektoncd/hub/api/pkg/app"
+	"github.com/tektoncd/hub/api/pkg/audit"
 	"github.com/tektoncd/hub/api/pkg/db/model"
 	"github.com/tektoncd/hub/api/pkg/parser"
 	"github.com/tektoncd/hub/api/pkg/service/validator"
@@ -75,7 +79,7 @@ func (s *service) Refresh(ctx context.Context, p *catalog.RefreshPayload) (*cata
 
 	log.Infof("going to enqueue")
 
-	job, err := s.wq.Enqueue(validator.UserID(ctx), ctg.ID)
+	job, err := s.wq.Enqueue(validator.UserID(ctx), ctg.ID, p.Full)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +87,8 @@ func (s *service) Refresh(ctx context.Context, p *catalog.RefreshPayload) (*cata
 	ret := &catalog.Job{ID: job.ID, CatalogName: ctg.Name, Status: job.Status}
 	log.Infof("job %d queued for refresh", job.ID)
 
+	s.record(ctx, audit.CatalogRefresh, ctg.Name, map[string]interface{}{"catalogName": p.CatalogName, "full": p.Full})
+
 	return ret, nil
 }
 
@@ -100,7 +106,7 @@ func (s *service) RefreshAll(ctx context.Context, p *catalog.RefreshAllPayload)
 
 	var res []*catalog.Job
 	for _, ctg := range ctgs {
-		job, err := s.wq.Enqueue(validator.UserID(ctx), ctg.ID)
+		job, err := s.wq.Enqueue(validator.UserID(ctx), ctg.ID, p.Full)
 		if err != nil {
 			return nil, err
 		}
@@ -111,9 +117,236 @@ func (s *service) RefreshAll(ctx context.Context, p *catalog.RefreshAllPayload)
 		res = append(res, ret)
 	}
 
+	s.record(ctx, audit.CatalogRefreshAll, "*", map[string]interface{}{"full": p.Full})
+
 	return res, nil
 }
 
+// add a catalog and queue its refresh
+func (s *service) Create(ctx context.Context, p *catalog.CreatePayload) (*catalog.Job, error) {
+
+	log := s.Logger(ctx)
+	db := s.DB(ctx)
+
+	if err := validateURL(p.URL); err != nil {
+		return nil, catalog.MakeInvalidPayload(err)
+	}
+	if p.ContextDir != nil {
+		if err := validateContextDir(*p.ContextDir); err != nil {
+			return nil, catalog.MakeInvalidPayload(err)
+		}
+	}
+
+	var count int64
+	if err := db.Model(&model.Catalog{}).Where("LOWER(name) = ?", strings.ToLower(p.Name)).Count(&count).Error; err != nil {
+		log.Error(err)
+		return nil, internalError
+	}
+	if count > 0 {
+		return nil, catalog.MakeAlreadyExists(fmt.Errorf("%s catalog already exists", p.Name))
+	}
+
+	ctg := model.Catalog{
+		Name:     p.Name,
+		Org:      p.Org,
+		Type:     p.Type,
+		Provider: p.Provider,
+		URL:      p.URL,
+		Revision: p.Revision,
+	}
+	if p.SSHURL != nil {
+		ctg.SSHURL = *p.SSHURL
+	}
+	if p.ContextDir != nil {
+		ctg.ContextDir = *p.ContextDir
+	}
+	if p.WebhookSecret != nil {
+		ctg.WebhookSecret = *p.WebhookSecret
+	}
+	if p.Credential != nil {
+		ctg.Credential = *p.Credential
+	}
+	if p.InsecureSkipTLSVerify != nil {
+		ctg.InsecureSkipTLSVerify = *p.InsecureSkipTLSVerify
+	}
+	if p.Private != nil {
+		ctg.Private = *p.Private
+	}
+	if p.Scope != nil {
+		ctg.Scope = *p.Scope
+	}
+
+	if err := db.Create(&ctg).Error; err != nil {
+		log.Error(err)
+		return nil, internalError
+	}
+	log.Infof("catalog %s added", ctg.Name)
+
+	payload := *p
+	payload.Token = ""
+	s.record(ctx, audit.CatalogCreate, ctg.Name, payload)
+
+	return s.enqueue(ctx, ctg)
+}
+
+// update the repository of a catalog and queue its refresh
+func (s *service) Update(ctx context.Context, p *catalog.UpdatePayload) (*catalog.Job, error) {
+
+	log := s.Logger(ctx)
+	db := s.DB(ctx)
+
+	ctg := model.Catalog{}
+	if err := db.Where(&model.Catalog{Name: p.CatalogName}).First(&ctg).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, catalogNotFoundErr(p.CatalogName)
+		}
+		log.Error(err)
+		return nil, internalError
+	}
+
+	repo := map[string]*string{
+		"provider":    p.Provider,
+		"url":         p.URL,
+		"ssh_url":     p.SSHURL,
+		"revision":    p.Revision,
+		"context_dir": p.ContextDir,
+	}
+
+	updates := map[string]interface{}{}
+	for column, value := range repo {
+		if value != nil {
+			updates[column] = *value
+		}
+	}
+	if p.URL != nil {
+		if err := validateURL(*p.URL); err != nil {
+			return nil, catalog.MakeInvalidPayload(err)
+		}
+	}
+	if p.ContextDir != nil {
+		if err := validateContextDir(*p.ContextDir); err != nil {
+			return nil, catalog.MakeInvalidPayload(err)
+		}
+	}
+
+	// the catalog is parsed again after a change of its repository
+	if len(updates) > 0 {
+		updates["sha"] = ""
+	}
+	if p.WebhookSecret != nil {
+		updates["webhook_secret"] = *p.WebhookSecret
+	}
+	if p.Credential != nil {
+		updates["credential"] = *p.Credential
+	}
+	if p.InsecureSkipTLSVerify != nil {
+		updates["insecure_skip_tls_verify"] = *p.InsecureSkipTLSVerify
+	}
+	if p.Private != nil {
+		updates["private"] = *p.Private
+	}
+	if p.Scope != nil {
+		updates["scope"] = *p.Scope
+	}
+	if len(updates) == 0 {
+		return nil, catalog.MakeInvalidPayload(fmt.Errorf("nothing to update in catalog %s", ctg.Name))
+	}
+
+	// a running sync would fetch into the clone removed below and save
+	// the commit of the previous repository
+	if _, ok := updates["sha"]; ok {
+		defer s.wq.lock(ctg.ID)()
+	}
+
+	if err := db.Model(&ctg).Updates(updates).Error; err != nil {
+		log.Error(err)
+		return nil, internalError
+	}
+	log.Infof("catalog %s updated", ctg.Name)
+
+	// the clone has the previous url as its remote
+	if p.URL != nil || p.SSHURL != nil {
+		if err := s.wq.removeClone(ctg.Name); err != nil {
+			log.Error(err)
+		}
+	}
+
+	payload := *p
+	payload.Token = ""
+	s.record(ctx, audit.CatalogUpdate, ctg.Name, payload)
+
+	return s.enqueue(ctx, ctg)
+}
+
+// delete a catalog along with its resources
+func (s *service) Delete(ctx context.Context, p *catalog.DeletePayload) error {
+
+	log := s.Logger(ctx)
+	db := s.DB(ctx)
+
+	ctg := model.Catalog{}
+	if err := db.Where(&model.Catalog{Name: p.CatalogName}).First(&ctg).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return catalogNotFoundErr(p.CatalogName)
+		}
+		log.Error(err)
+		return internalError
+	}
+
+	// a running sync would fill the catalog again and use its clone
+	defer s.wq.lock(ctg.ID)()
+
+	txn := db.Begin()
+
+	resources := txn.Model(&model.Resource{}).Select("id").Where("catalog_id = ?", ctg.ID)
+
+	var tags []model.ResourceTag
+	var platforms []model.ResourcePlatform
+	if err := txn.Where("resource_id IN (?)", resources).Find(&tags).Error; err != nil {
+		log.Error(err)
+		txn.Rollback()
+		return internalError
+	}
+	if err := txn.Where("resource_id IN (?)", resources).Find(&platforms).Error; err != nil {
+		log.Error(err)
+		txn.Rollback()
+		return internalError
+	}
+
+	// versions, ratings, tags and categories of the resources
+	// are deleted on cascade
+	for _, m := range []interface{}{&model.Resource{}, &model.CatalogError{}, &model.SyncJob{}} {
+		if err := txn.Unscoped().Where("catalog_id = ?", ctg.ID).Delete(m).Error; err != nil {
+			log.Error(err)
+			txn.Rollback()
+			return internalError
+		}
+	}
+
+	if err := txn.Unscoped().Delete(&ctg).Error; err != nil {
+		log.Error(err)
+		txn.Rollback()
+		return internalError
+	}
+
+	s.wq.deleteTag(txn, log.SugaredLogger, tags)
+	s.wq.deletePlatform(txn, log.SugaredLogger, platforms)
+
+	if err := txn.Commit().Error; err != nil {
+		log.Error(err)
+		return internalError
+	}
+	log.Infof("catalog %s deleted", ctg.Name)
+
+	s.record(ctx, audit.CatalogDelete, ctg.Name, map[string]interface{}{"catalogName": p.CatalogName})
+
+	if err := s.wq.removeClone(ctg.Name); err != nil {
+		log.Error(err)
+	}
+
+	return nil
+}
+
 // List all errors occurred refreshing a catalog
 func (s *service) CatalogError(ctx context.Context, p *catalog.CatalogErrorPayload) (*catalog.CatalogErrorResult, error) {
 
@@ -152,6 +385,49 @@ func (s *service) CatalogError(ctx context.Context, p *catalog.CatalogErrorPaylo
 	return &catalog.CatalogErrorResult{Data: allError}, nil
 }
 
+// record adds an audit event of the action done by the user on the
+// catalog, a failure is only logged as the action is already done
+func (s *service) record(ctx context.Context, action, name string, payload interface{}) {
+	if err := audit.Record(s.DB(ctx), validator.UserID(ctx), action, "catalog:"+name, payload); err != nil {
+		s.Logger(ctx).Error(err)
+	}
+}
+
+// enqueue queues the refresh of a catalog on behalf of the user
+func (s *service) enqueue(ctx context.Context, ctg model.Catalog) (*catalog.Job, error) {
+
+	job, err := s.wq.Enqueue(validator.UserID(ctx), ctg.ID, false)
+	if err != nil {
+		return nil, err
+	}
+	s.Logger(ctx).Infof("job %d queued to refresh catalog %s", job.ID, ctg.Name)
+
+	return &catalog.Job{ID: job.ID, CatalogName: ctg.Name, Status: job.Status}, nil
+}
+
+// validateURL checks the url of a catalog repository is a http(s) url
+func validateURL(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("invalid repository url %q", u)
+	}
+	return nil
+}
+
+// validateContextDir checks the context dir of a catalog is a relative path
+// inside its repository
+func validateContextDir(dir string) error {
+	if path.IsAbs(dir) {
+		return fmt.Errorf("invalid context dir %q, must be relative to the repository", dir)
+	}
+	for _, segment := range strings.Split(dir, "/") {
+		if segment == ".." {
+			return fmt.Errorf("invalid context dir %q, must be inside the repository", dir)
+		}
+	}
+	return nil
+}
+
 func catalogNotFoundErr(name string) error {
 	return catalog.MakeNotFound(fmt.Errorf("%s catalog not found", name))
 }
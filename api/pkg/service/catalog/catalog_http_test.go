@@ -19,12 +19,14 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/ikawaha/goahttpcheck"
 	"github.com/stretchr/testify/assert"
 	"github.com/tektoncd/hub/api/gen/catalog"
 	"github.com/tektoncd/hub/api/gen/http/catalog/server"
+	"github.com/tektoncd/hub/api/pkg/db/model"
 	"github.com/tektoncd/hub/api/pkg/service/validator"
 	"github.com/tektoncd/hub/api/pkg/testutils"
 	"gotest.tools/v3/golden"
@@ -152,3 +154,467 @@ func TestCatalogError_HttpHavingNoError(t *testing.T) {
 	})
 
 }
+
+func WebhookChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(server.NewWebhookHandler,
+		server.MountWebhookHandler,
+		catalog.NewWebhookEndpoint(NewServiceTest(tc)))
+	return checker
+}
+
+// webhookBot creates the apiserver-bot account used to enqueue the jobs
+func webhookBot(t *testing.T, tc *testutils.TestConfig) {
+	user := model.User{Type: model.NormalUserType}
+	assert.NoError(t, tc.DB().Create(&user).Error)
+	assert.NoError(t, tc.DB().Create(&model.Account{UserID: user.ID, UserName: "apiserver-bot", Provider: "github"}).Error)
+}
+
+const officialCatalogPush = `{
+  "ref": "refs/heads/master",
+  "repository": {
+    "html_url": "https://github.com/tektoncd/catalog",
+    "clone_url": "https://github.com/tektoncd/catalog.git",
+    "ssh_url": "git@github.com:tektoncd/catalog.git"
+  }
+}`
+
+func TestWebhook_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	webhookBot(t, tc)
+
+	WebhookChecker(tc).Test(t, http.MethodPost, "/catalog/webhook/github").
+		WithHeader("X-GitHub-Event", "push").
+		WithHeader("X-Hub-Signature-256", sign(officialCatalogPush, "webhook-secret")).
+		WithBody([]byte(officialCatalogPush)).Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := []*catalog.Job{}
+		marshallErr := json.Unmarshal([]byte(b), &res)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, 1, len(res))
+		assert.Equal(t, "catalog-official", res[0].CatalogName)
+		assert.Equal(t, "queued", res[0].Status)
+	})
+}
+
+func TestWebhook_HttpInvalidSignature(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	webhookBot(t, tc)
+
+	WebhookChecker(tc).Test(t, http.MethodPost, "/catalog/webhook/github").
+		WithHeader("X-GitHub-Event", "push").
+		WithHeader("X-Hub-Signature-256", sign(officialCatalogPush, "not-the-secret")).
+		WithBody([]byte(officialCatalogPush)).Check().
+		HasStatus(401)
+}
+
+func TestWebhook_HttpNoCatalogForBranch(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	webhookBot(t, tc)
+
+	body := strings.Replace(officialCatalogPush, "refs/heads/master", "refs/heads/feature", 1)
+	WebhookChecker(tc).Test(t, http.MethodPost, "/catalog/webhook/github").
+		WithHeader("X-GitHub-Event", "push").
+		WithHeader("X-Hub-Signature-256", sign(body, "webhook-secret")).
+		WithBody([]byte(body)).Check().
+		HasStatus(200).HasString("[]\n")
+}
+
+func TestWebhook_HttpUnknownRepositoryInvalidSignature(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	webhookBot(t, tc)
+
+	body := strings.Replace(officialCatalogPush, "tektoncd/catalog", "foo/bar", -1)
+	WebhookChecker(tc).Test(t, http.MethodPost, "/catalog/webhook/github").
+		WithHeader("X-GitHub-Event", "push").
+		WithHeader("X-Hub-Signature-256", sign(body, "not-the-secret")).
+		WithBody([]byte(body)).Check().
+		HasStatus(401)
+}
+
+func TestWebhook_HttpPingEvent(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	WebhookChecker(tc).Test(t, http.MethodPost, "/catalog/webhook/github").
+		WithHeader("X-GitHub-Event", "ping").
+		WithBody([]byte(`{"zen": "Keep it logically awesome."}`)).Check().
+		HasStatus(200).HasString("[]\n")
+}
+
+func CreateChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	service := validator.NewService(tc.APIConfig, "catalog")
+	checker := goahttpcheck.New()
+	checker.Mount(server.NewCreateHandler,
+		server.MountCreateHandler,
+		catalog.NewCreateEndpoint(NewServiceTest(tc), service.JWTAuth))
+	return checker
+}
+
+func TestCreate_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// user with catalog:manage scope
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:manage")
+	assert.NoError(t, err)
+
+	data := []byte(`{"name": "catalog-new", "org": "tektoncd", "type": "community", "url": "https://github.com/tektoncd/new", "revision": "main"}`)
+
+	CreateChecker(tc).Test(t, http.MethodPost, "/catalog").
+		WithHeader("Authorization", token).WithBody(data).Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := catalog.Job{}
+		marshallErr := json.Unmarshal([]byte(b), &res)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, "catalog-new", res.CatalogName)
+		assert.Equal(t, "queued", res.Status)
+	})
+
+	ctg := model.Catalog{}
+	assert.NoError(t, tc.DB().Where("name = ?", "catalog-new").First(&ctg).Error)
+	assert.Equal(t, "github", ctg.Provider)
+}
+
+func TestCreate_HttpAlreadyExists(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:manage")
+	assert.NoError(t, err)
+
+	data := []byte(`{"name": "Catalog-Official", "org": "tektoncd", "type": "official", "url": "https://github.com/tektoncd/catalog", "revision": "main"}`)
+
+	CreateChecker(tc).Test(t, http.MethodPost, "/catalog").
+		WithHeader("Authorization", token).WithBody(data).Check().
+		HasStatus(409)
+}
+
+func TestCreate_HttpInvalidURL(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:manage")
+	assert.NoError(t, err)
+
+	data := []byte(`{"name": "catalog-new", "org": "tektoncd", "type": "community", "url": "file:///tmp/catalog", "revision": "main"}`)
+
+	CreateChecker(tc).Test(t, http.MethodPost, "/catalog").
+		WithHeader("Authorization", token).WithBody(data).Check().
+		HasStatus(400)
+}
+
+func TestCreate_HttpInvalidContextDir(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:manage")
+	assert.NoError(t, err)
+
+	data := []byte(`{"name": "catalog-new", "org": "tektoncd", "type": "community", "url": "https://github.com/tektoncd/catalog", "revision": "main", "contextDir": "/etc"}`)
+
+	CreateChecker(tc).Test(t, http.MethodPost, "/catalog").
+		WithHeader("Authorization", token).WithBody(data).Check().
+		HasStatus(400)
+}
+
+func UpdateChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	service := validator.NewService(tc.APIConfig, "catalog")
+	checker := goahttpcheck.New()
+	checker.Mount(server.NewUpdateHandler,
+		server.MountUpdateHandler,
+		catalog.NewUpdateEndpoint(NewServiceTest(tc), service.JWTAuth))
+	return checker
+}
+
+func TestUpdate_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:manage")
+	assert.NoError(t, err)
+
+	data := []byte(`{"revision": "release"}`)
+
+	UpdateChecker(tc).Test(t, http.MethodPut, "/catalog/catalog-official").
+		WithHeader("Authorization", token).WithBody(data).Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := catalog.Job{}
+		marshallErr := json.Unmarshal([]byte(b), &res)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, "catalog-official", res.CatalogName)
+	})
+
+	ctg := model.Catalog{}
+	assert.NoError(t, tc.DB().Where("name = ?", "catalog-official").First(&ctg).Error)
+	assert.Equal(t, "release", ctg.Revision)
+	assert.Equal(t, "", ctg.SHA)
+}
+
+func TestUpdate_HttpCredential(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:manage")
+	assert.NoError(t, err)
+
+	UpdateChecker(tc).Test(t, http.MethodPut, "/catalog/catalog-official").
+		WithHeader("Authorization", token).WithBody([]byte(`{"credential": "team-a"}`)).Check().
+		HasStatus(200)
+
+	ctg := model.Catalog{}
+	assert.NoError(t, tc.DB().Where("name = ?", "catalog-official").First(&ctg).Error)
+	assert.Equal(t, "team-a", ctg.Credential)
+
+	// a credential outside the credentials directory
+	UpdateChecker(tc).Test(t, http.MethodPut, "/catalog/catalog-official").
+		WithHeader("Authorization", token).WithBody([]byte(`{"credential": "../team-a"}`)).Check().
+		HasStatus(400)
+}
+
+func TestUpdate_HttpContextDir(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:manage")
+	assert.NoError(t, err)
+
+	UpdateChecker(tc).Test(t, http.MethodPut, "/catalog/catalog-official").
+		WithHeader("Authorization", token).WithBody([]byte(`{"contextDir": "tasks/v1"}`)).Check().
+		HasStatus(200)
+
+	ctg := model.Catalog{}
+	assert.NoError(t, tc.DB().Where("name = ?", "catalog-official").First(&ctg).Error)
+	assert.Equal(t, "tasks/v1", ctg.ContextDir)
+
+	// paths outside the repository
+	for _, dir := range []string{"/etc", "..", "tasks/../../etc"} {
+		UpdateChecker(tc).Test(t, http.MethodPut, "/catalog/catalog-official").
+			WithHeader("Authorization", token).WithBody([]byte(`{"contextDir": "` + dir + `"}`)).Check().
+			HasStatus(400)
+	}
+}
+
+func TestUpdate_HttpPrivate(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:manage")
+	assert.NoError(t, err)
+
+	UpdateChecker(tc).Test(t, http.MethodPut, "/catalog/catalog-official").
+		WithHeader("Authorization", token).WithBody([]byte(`{"private": true, "scope": "catalog:internal"}`)).Check().
+		HasStatus(200)
+
+	ctg := model.Catalog{}
+	assert.NoError(t, tc.DB().Where("name = ?", "catalog-official").First(&ctg).Error)
+	assert.True(t, ctg.Private)
+	assert.Equal(t, "catalog:internal", ctg.Scope)
+}
+
+func TestUpdate_HttpNotFound(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:manage")
+	assert.NoError(t, err)
+
+	UpdateChecker(tc).Test(t, http.MethodPut, "/catalog/catalog-unknown").
+		WithHeader("Authorization", token).WithBody([]byte(`{"revision": "release"}`)).Check().
+		HasStatus(404)
+}
+
+func DeleteChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	service := validator.NewService(tc.APIConfig, "catalog")
+	checker := goahttpcheck.New()
+	checker.Mount(server.NewDeleteHandler,
+		server.MountDeleteHandler,
+		catalog.NewDeleteEndpoint(NewServiceTest(tc), service.JWTAuth))
+	return checker
+}
+
+func TestDelete_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:manage")
+	assert.NoError(t, err)
+
+	DeleteChecker(tc).Test(t, http.MethodDelete, "/catalog/catalog-official").
+		WithHeader("Authorization", token).Check().
+		HasStatus(200)
+
+	var count int64
+	tc.DB().Model(&model.Catalog{}).Where("name = ?", "catalog-official").Count(&count)
+	assert.Equal(t, int64(0), count)
+
+	tc.DB().Model(&model.Resource{}).Where("catalog_id = ?", 1).Count(&count)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestDelete_HttpNotFound(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:manage")
+	assert.NoError(t, err)
+
+	DeleteChecker(tc).Test(t, http.MethodDelete, "/catalog/catalog-unknown").
+		WithHeader("Authorization", token).Check().
+		HasStatus(404)
+}
+
+func GetJobChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	service := validator.NewService(tc.APIConfig, "catalog")
+	checker := goahttpcheck.New()
+	checker.Mount(server.NewGetJobHandler,
+		server.MountGetJobHandler,
+		catalog.NewGetJobEndpoint(NewServiceTest(tc), service.JWTAuth))
+	return checker
+}
+
+func TestGetJob_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:refresh")
+	assert.NoError(t, err)
+
+	GetJobChecker(tc).Test(t, http.MethodGet, "/catalog/job/2").
+		WithHeader("Authorization", token).Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := catalog.Job{}
+		marshallErr := json.Unmarshal([]byte(b), &res)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, "catalog-official", res.CatalogName)
+		assert.Equal(t, "error", res.Status)
+		assert.Equal(t, "failed to fetch catalog: repository not found", *res.Reason)
+		assert.Equal(t, "2016-01-02T12:31:12Z", *res.FinishedAt)
+	})
+}
+
+func TestGetJob_HttpNotFound(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:refresh")
+	assert.NoError(t, err)
+
+	GetJobChecker(tc).Test(t, http.MethodGet, "/catalog/job/404").
+		WithHeader("Authorization", token).Check().
+		HasStatus(404)
+}
+
+func ListJobsChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	service := validator.NewService(tc.APIConfig, "catalog")
+	checker := goahttpcheck.New()
+	checker.Mount(server.NewListJobsHandler,
+		server.MountListJobsHandler,
+		catalog.NewListJobsEndpoint(NewServiceTest(tc), service.JWTAuth))
+	return checker
+}
+
+func TestListJobs_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:refresh")
+	assert.NoError(t, err)
+
+	ListJobsChecker(tc).Test(t, http.MethodGet, "/catalog/catalog-official/jobs").
+		WithHeader("Authorization", token).Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := []*catalog.Job{}
+		marshallErr := json.Unmarshal([]byte(b), &res)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, 2, len(res))
+		assert.Equal(t, uint(2), res[0].ID)
+		assert.Equal(t, uint(1), res[1].ID)
+	})
+}
+
+func TestListJobs_HttpNotFound(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:refresh")
+	assert.NoError(t, err)
+
+	ListJobsChecker(tc).Test(t, http.MethodGet, "/catalog/catalog-unknown/jobs").
+		WithHeader("Authorization", token).Check().
+		HasStatus(404)
+}
+
+func CancelJobChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	service := validator.NewService(tc.APIConfig, "catalog")
+	checker := goahttpcheck.New()
+	checker.Mount(server.NewCancelJobHandler,
+		server.MountCancelJobHandler,
+		catalog.NewCancelJobEndpoint(NewServiceTest(tc), service.JWTAuth))
+	return checker
+}
+
+func TestCancelJob_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:refresh")
+	assert.NoError(t, err)
+
+	CancelJobChecker(tc).Test(t, http.MethodDelete, "/catalog/job/3").
+		WithHeader("Authorization", token).Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := catalog.Job{}
+		marshallErr := json.Unmarshal([]byte(b), &res)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, "catalog-community", res.CatalogName)
+		assert.Equal(t, "cancelled", res.Status)
+		assert.NotNil(t, res.FinishedAt)
+	})
+}
+
+func TestCancelJob_HttpFinished(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.AgentWithScopes("agent-001", "catalog:refresh")
+	assert.NoError(t, err)
+
+	CancelJobChecker(tc).Test(t, http.MethodDelete, "/catalog/job/1").
+		WithHeader("Authorization", token).Check().
+		HasStatus(409)
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/tektoncd/hub/api/gen/catalog"
 	"github.com/tektoncd/hub/api/pkg/app"
+	"github.com/tektoncd/hub/api/pkg/db/model"
 	"github.com/tektoncd/hub/api/pkg/service/validator"
 	"github.com/tektoncd/hub/api/pkg/testutils"
 )
@@ -56,6 +57,29 @@ func TestRefresh(t *testing.T) {
 	assert.Equal(t, "queued", job.Status)
 }
 
+func TestRefresh_Full(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// user with catalog:refresh scope
+	user, _, err := tc.UserWithScopes("foo", "foo@bar.com", "catalog:refresh")
+	assert.NoError(t, err)
+
+	catalogSvc := NewServiceTest(tc)
+	ctx := validator.WithUserID(context.Background(), user.ID)
+
+	res, err := catalogSvc.Refresh(ctx, &catalog.RefreshPayload{CatalogName: "catalog-official"})
+	assert.NoError(t, err)
+
+	// full refresh is done by the job already queued
+	_, err = catalogSvc.Refresh(ctx, &catalog.RefreshPayload{CatalogName: "catalog-official", Full: true})
+	assert.NoError(t, err)
+
+	job := model.SyncJob{}
+	assert.NoError(t, tc.DB().First(&job, res.ID).Error)
+	assert.True(t, job.Full)
+}
+
 func TestRefresh_CatalogNotFound(t *testing.T) {
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
@@ -0,0 +1,150 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tektoncd/hub/api/gen/catalog"
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+var jobFetchError = catalog.MakeInternalError(fmt.Errorf("failed to fetch catalog jobs"))
+
+// get a refresh job by its id
+func (s *service) GetJob(ctx context.Context, p *catalog.GetJobPayload) (*catalog.Job, error) {
+
+	log := s.Logger(ctx)
+	db := s.DB(ctx)
+
+	job := model.SyncJob{}
+	if err := db.Preload("Catalog").First(&job, p.ID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, jobNotFoundErr(p.ID)
+		}
+		log.Error(err)
+		return nil, jobFetchError
+	}
+
+	return jobResult(job), nil
+}
+
+// list the refresh jobs of a catalog, latest first
+func (s *service) ListJobs(ctx context.Context, p *catalog.ListJobsPayload) ([]*catalog.Job, error) {
+
+	log := s.Logger(ctx)
+	db := s.DB(ctx)
+
+	ctg := model.Catalog{}
+	if err := db.Where(&model.Catalog{Name: p.CatalogName}).First(&ctg).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, catalogNotFoundErr(p.CatalogName)
+		}
+		log.Error(err)
+		return nil, jobFetchError
+	}
+
+	jobs := []model.SyncJob{}
+	if err := db.Where(&model.SyncJob{CatalogID: ctg.ID}).
+		Order("id desc").Limit(int(p.Limit)).Find(&jobs).Error; err != nil {
+		log.Error(err)
+		return nil, jobFetchError
+	}
+
+	res := []*catalog.Job{}
+	for _, job := range jobs {
+		job.Catalog = ctg
+		res = append(res, jobResult(job))
+	}
+
+	return res, nil
+}
+
+// cancel a queued or running refresh job, the fetch or parse of a
+// running job is aborted and the catalog is not synced again until it
+// has stopped
+func (s *service) CancelJob(ctx context.Context, p *catalog.CancelJobPayload) (*catalog.Job, error) {
+
+	log := s.Logger(ctx)
+	db := s.DB(ctx)
+
+	job := model.SyncJob{}
+	if err := db.Preload("Catalog").First(&job, p.ID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, jobNotFoundErr(p.ID)
+		}
+		log.Error(err)
+		return nil, jobFetchError
+	}
+
+	now := time.Now()
+	cancel := db.Model(&model.SyncJob{}).
+		Where("id = ? AND status IN ?", job.ID, []string{model.JobQueued.String(), model.JobRunning.String()}).
+		Updates(map[string]interface{}{
+			"status":      model.JobCancelled.String(),
+			"finished_at": &now,
+			"reason":      "cancelled by user",
+		})
+	if cancel.Error != nil {
+		log.Error(cancel.Error)
+		return nil, internalError
+	}
+	if cancel.RowsAffected == 0 {
+		return nil, catalog.MakeInvalidState(fmt.Errorf("job %d has already finished with status %s", job.ID, job.Status))
+	}
+	s.wq.Cancel(job.ID)
+	log.Infof("job %d of catalog %s cancelled", job.ID, job.Catalog.Name)
+
+	if err := db.Preload("Catalog").First(&job, p.ID).Error; err != nil {
+		log.Error(err)
+		return nil, jobFetchError
+	}
+
+	return jobResult(job), nil
+}
+
+func jobResult(job model.SyncJob) *catalog.Job {
+
+	res := &catalog.Job{
+		ID:          job.ID,
+		CatalogName: job.Catalog.Name,
+		Status:      job.Status,
+		CreatedAt:   formatTime(&job.CreatedAt),
+		StartedAt:   formatTime(job.StartedAt),
+		FinishedAt:  formatTime(job.FinishedAt),
+	}
+	if job.SHA != "" {
+		res.Sha = &job.SHA
+	}
+	if job.Reason != "" {
+		res.Reason = &job.Reason
+	}
+	return res
+}
+
+func formatTime(t *time.Time) *string {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	f := t.UTC().Format(time.RFC3339)
+	return &f
+}
+
+func jobNotFoundErr(id uint) error {
+	return catalog.MakeNotFound(fmt.Errorf("job %d not found", id))
+}
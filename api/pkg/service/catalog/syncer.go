@@ -15,56 +15,181 @@
 package catalog
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-co-op/gocron"
+	"github.com/sigstore/sigstore/pkg/signature"
 	"github.com/tektoncd/hub/api/pkg/app"
 	"github.com/tektoncd/hub/api/pkg/db/model"
 	"github.com/tektoncd/hub/api/pkg/git"
 	"github.com/tektoncd/hub/api/pkg/parser"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type syncer struct {
 	db        *gorm.DB
 	logger    *zap.SugaredLogger
 	running   bool
-	limit     chan bool
+	wake      chan bool
 	stop      chan bool
 	git       git.Client
 	clonePath string
+	workers   int
+	timeout   time.Duration
+	retries   uint
+	depth     uint
+	// credentialsDir has a directory for each credential of catalogs
+	credentialsDir string
+
+	// catalogs being synced by the workers, a catalog stays busy until
+	// the worker is done with its clone even if its job is cancelled
+	mu   sync.Mutex
+	busy map[uint]activeJob
 }
 
+// activeJob is the job a worker is running for a catalog, done is
+// closed once the worker is done with the clone of the catalog
+type activeJob struct {
+	id     uint
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Defaults of the syncer which can be overridden through GIT_CLIENT,
+// CATALOG_SYNC_WORKERS, CATALOG_SYNC_TIMEOUT, CATALOG_SYNC_RETRIES and
+// CATALOG_CREDENTIALS_DIR
+const (
+	defaultGitClient      = git.ExecClient
+	defaultSyncWorkers    = 4
+	defaultSyncTimeout    = 10 * time.Minute
+	defaultSyncRetries    = 3
+	defaultCredentialsDir = "/etc/hub/credentials"
+)
+
+const (
+	// interval at which idle workers look for jobs due for a retry
+	pollInterval = 30 * time.Second
+	// backoff before the first retry of a job, doubled on every retry
+	retryBackoff = 30 * time.Second
+	maxBackoff   = 30 * time.Minute
+)
+
 var (
 	queued  = &model.SyncJob{Status: model.JobQueued.String()}
 	running = &model.SyncJob{Status: model.JobRunning.String()}
 )
 
+var errJobCancelled = fmt.Errorf("job has been cancelled")
+
 func NewSyncer(api app.BaseConfig, clonePath string) *syncer {
 	logger := api.Logger("syncer")
-	return &syncer{
+	s := &syncer{
 		db:        app.DBWithLogger(api.Environment(), api.DB(), logger),
 		logger:    logger.SugaredLogger,
-		limit:     make(chan bool, 1),
 		stop:      make(chan bool),
-		git:       git.New(api.Logger("git").SugaredLogger),
 		clonePath: clonePath,
+		busy:      map[uint]activeJob{},
+	}
+	s.configure()
+	s.git = s.gitClient(api.Logger("git").SugaredLogger)
+	s.wake = make(chan bool, s.workers)
+	return s
+}
+
+// gitClient returns the git client named by GIT_CLIENT, falling back to
+// the default on an invalid name
+func (s *syncer) gitClient(log *zap.SugaredLogger) git.Client {
+	name := os.Getenv("GIT_CLIENT")
+	if name == "" {
+		name = defaultGitClient
+	}
+	client, err := git.NewClient(name, log)
+	if err != nil {
+		s.logger.Errorf("invalid GIT_CLIENT: %v, using %s", err, defaultGitClient)
+		client, _ = git.NewClient(defaultGitClient, log)
+	}
+	return client
+}
+
+// configure reads the number of workers, the timeout and the retries
+// of a job and the depth of fetches from env, falling back to the
+// defaults on invalid values
+func (s *syncer) configure() {
+	s.workers = defaultSyncWorkers
+	s.timeout = defaultSyncTimeout
+	s.retries = defaultSyncRetries
+	s.depth = 0
+	s.credentialsDir = defaultCredentialsDir
+
+	if v := os.Getenv("CATALOG_CREDENTIALS_DIR"); v != "" {
+		s.credentialsDir = v
+	}
+
+	if v := os.Getenv("CATALOG_SYNC_WORKERS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			s.workers = n
+		} else {
+			s.logger.Errorf("invalid CATALOG_SYNC_WORKERS %q, using %d workers", v, s.workers)
+		}
+	}
+	if v := os.Getenv("CATALOG_SYNC_TIMEOUT"); v != "" {
+		if d, err := app.ComputeDuration(v); err == nil && d > 0 {
+			s.timeout = d
+		} else {
+			s.logger.Errorf("invalid CATALOG_SYNC_TIMEOUT %q, using %s", v, s.timeout)
+		}
+	}
+	if v := os.Getenv("CATALOG_SYNC_RETRIES"); v != "" {
+		if n, err := strconv.ParseUint(v, 10, 32); err == nil {
+			s.retries = uint(n)
+		} else {
+			s.logger.Errorf("invalid CATALOG_SYNC_RETRIES %q, using %d retries", v, s.retries)
+		}
+	}
+	if v := os.Getenv("CATALOG_SYNC_DEPTH"); v != "" {
+		if n, err := strconv.ParseUint(v, 10, 32); err == nil {
+			s.depth = uint(n)
+		} else {
+			s.logger.Errorf("invalid CATALOG_SYNC_DEPTH %q, fetching the whole history", v)
+		}
 	}
 }
 
-func (s *syncer) Enqueue(userID, catalogID uint) (*model.SyncJob, error) {
+// Enqueue queues a job to sync the catalog unless one is already queued
+// or running. A full job parses all resources of the catalog instead of
+// the ones changed since the last sync.
+func (s *syncer) Enqueue(userID, catalogID uint, full bool) (*model.SyncJob, error) {
 
 	s.logger.Infof("Enqueueing User: %d catalogID %d", userID, catalogID)
 	queued := &model.SyncJob{CatalogID: catalogID, Status: "queued"}
 	running := &model.SyncJob{CatalogID: catalogID, Status: "running"}
-	newJob := model.SyncJob{CatalogID: catalogID, Status: "queued", UserID: userID}
+	newJob := model.SyncJob{CatalogID: catalogID, Status: "queued", UserID: userID, Full: full}
 
 	if err := s.db.Where(queued).Or(running).FirstOrCreate(&newJob).Error; err != nil {
-		return nil, internalError
+		// the unique index on the catalog of queued and running jobs
+		// rejects the job when another one has been queued meanwhile
+		newJob = model.SyncJob{}
+		if err := s.db.Where(queued).Or(running).First(&newJob).Error; err != nil {
+			s.logger.Error(err)
+			return nil, internalError
+		}
+	}
+
+	// the job already queued does the full sync
+	if full && !newJob.Full && newJob.Status == model.JobQueued.String() {
+		if err := s.db.Model(&newJob).Update("full", true).Error; err != nil {
+			return nil, internalError
+		}
 	}
 
 	s.wakeUp()
@@ -72,11 +197,14 @@ func (s *syncer) Enqueue(userID, catalogID uint) (*model.SyncJob, error) {
 	return &newJob, nil
 }
 
+// wakeUp notifies the idle workers that there are jobs in the queue
 func (s *syncer) wakeUp() {
-	// start by freeing up limit if it occupied already
-	select {
-	case <-s.limit:
-	default:
+	for i := 0; i < s.workers; i++ {
+		select {
+		case s.wake <- true:
+		default:
+			return
+		}
 	}
 }
 
@@ -87,29 +215,16 @@ func (s *syncer) Run() {
 	}
 
 	log := s.logger.With("action", "run")
-	log.Info("running catalog syncer ....")
+	log.Infof("running catalog syncer with %d workers ....", s.workers)
 
 	// all running jobs should be queued so that they can be retried
 	if err := s.db.Model(model.SyncJob{}).Where(running).Updates(queued).Error; ignoreNotFound(err) != nil {
 		log.Error(err, "failed to update running -> queued")
 	}
 
-	go func() {
-		defer log.Info("exiting job runner")
-		for {
-			select {
-			case <-s.stop:
-				return
-			case s.limit <- true:
-				log.Info("processing the queue")
-				if err := s.Process(); err != nil {
-					time.AfterFunc(30*time.Second, s.Next)
-					return
-				}
-				s.Next()
-			}
-		}
-	}()
+	for i := 0; i < s.workers; i++ {
+		go s.work(i)
+	}
 
 	if err := s.SyncCatalogs(); err != nil {
 		s.logger.Error(err)
@@ -119,9 +234,45 @@ func (s *syncer) Run() {
 	s.running = true
 }
 
+// work processes the queued jobs one after the other until the queue is
+// empty and then waits to be woken up, or for the poll interval to pick
+// up the jobs due for a retry
+func (s *syncer) work(id int) {
+	log := s.logger.With("action", "work", "worker", id)
+	defer log.Info("exiting job runner")
+
+	poll := time.NewTicker(pollInterval)
+	defer poll.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-s.wake:
+		case <-poll.C:
+		}
+
+		for {
+			processed, err := s.Process()
+			if err != nil {
+				log.Error(err)
+				break
+			}
+			if !processed {
+				break
+			}
+			select {
+			case <-s.stop:
+				return
+			default:
+			}
+		}
+	}
+}
+
 func (s *syncer) enqueueCatalog(catalogs []model.Catalog, apiServerBot model.Account) error {
 	for _, c := range catalogs {
-		_, err := s.Enqueue(apiServerBot.UserID, c.ID)
+		_, err := s.Enqueue(apiServerBot.UserID, c.ID, false)
 		if err != nil {
 			s.logger.Error(err)
 			return err
@@ -154,6 +305,12 @@ func (s *syncer) SyncCatalogs() error {
 		// creates cron job
 		cron := gocron.NewScheduler(time.UTC)
 		_, err := cron.Every(getCatalogRefreshInterval).Do(func() error {
+			// catalogs may have been added or deleted since the last run
+			catalogs := []model.Catalog{}
+			if err := s.db.Find(&catalogs).Error; err != nil {
+				s.logger.Error(err)
+				return err
+			}
 			return s.enqueueCatalog(catalogs, apiserverBot)
 		})
 
@@ -173,26 +330,100 @@ func (s *syncer) SyncCatalogs() error {
 	return nil
 }
 
+// removeClone removes the clone of a catalog so that it is cloned
+// again by the next sync
+func (s *syncer) removeClone(name string) error {
+	if s.clonePath == "" {
+		return nil
+	}
+	return os.RemoveAll(filepath.Join(s.clonePath, name))
+}
+
+// acquire marks the catalog as busy with the job unless another job
+// of the catalog is still running
+func (s *syncer) acquire(catalogID, jobID uint, cancel context.CancelFunc) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.busy[catalogID]; ok {
+		return false
+	}
+	s.busy[catalogID] = activeJob{id: jobID, cancel: cancel, done: make(chan struct{})}
+	return true
+}
+
+// release marks the catalog as free and wakes up the workers as the
+// catalog may have a job queued meanwhile
+func (s *syncer) release(catalogID uint) {
+	s.mu.Lock()
+	if job, ok := s.busy[catalogID]; ok {
+		close(job.done)
+		delete(s.busy, catalogID)
+	}
+	s.mu.Unlock()
+	s.wakeUp()
+}
+
+// lock cancels the running job of the catalog, waits for its worker to
+// stop and keeps the catalog busy until the returned func is called, so
+// that the catalog can be changed without a sync using its clone
+func (s *syncer) lock(catalogID uint) func() {
+	for {
+		s.mu.Lock()
+		job, ok := s.busy[catalogID]
+		if !ok {
+			s.busy[catalogID] = activeJob{cancel: func() {}, done: make(chan struct{})}
+			s.mu.Unlock()
+			return func() { s.release(catalogID) }
+		}
+		s.mu.Unlock()
+
+		job.cancel()
+		<-job.done
+	}
+}
+
+// busyCatalogs returns the catalogs being synced
+func (s *syncer) busyCatalogs() []uint {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids := []uint{}
+	for id := range s.busy {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Cancel aborts the fetch or parse of the job if it is running. The
+// catalog stays busy until its worker stops.
+func (s *syncer) Cancel(jobID uint) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, job := range s.busy {
+		if job.id == jobID {
+			job.cancel()
+		}
+	}
+}
+
 func (s *syncer) Stop() {
 	close(s.stop)
-	close(s.limit)
 	s.running = false
 }
 
-func (s *syncer) Next() {
-	log := s.logger.With("action", "next")
-
-	var count int64
-	if err := s.db.Model(&model.SyncJob{}).Where(queued).Count(&count).Error; err != nil {
-		log.Error(err)
-		return
+// backoff returns the delay before the next attempt of a job which
+// has failed attempts times
+func backoff(attempts uint) time.Duration {
+	if attempts == 0 {
+		return retryBackoff
 	}
-
-	log.Info("queued job count: ", count)
-	if count == 0 {
-		return
+	if attempts > 16 {
+		return maxBackoff
 	}
-	s.wakeUp()
+	d := retryBackoff << (attempts - 1)
+	if d > maxBackoff {
+		return maxBackoff
+	}
+	return d
 }
 
 func ignoreNotFound(err error) error {
@@ -202,74 +433,212 @@ func ignoreNotFound(err error) error {
 	return err
 }
 
-func (s *syncer) Process() error {
+// Process runs the oldest queued job, it returns false once there is
+// no job to run
+func (s *syncer) Process() (bool, error) {
 	log := s.logger.With("action", "process")
 	db := s.db
 
 	syncJob := model.SyncJob{}
 
-	// helper to update job state
-	setJobState := func(s model.JobState) {
+	// helper to finish the running job, a job cancelled meanwhile
+	// is left as it is
+	setJobState := func(s model.JobState, reason string) {
 		syncJob.SetState(s)
-		db.Model(&syncJob).Update("status", syncJob.Status)
+		db.Model(&model.SyncJob{}).Where("id = ?", syncJob.ID).Where(running).
+			Updates(map[string]interface{}{"status": syncJob.Status, "reason": reason, "finished_at": time.Now()})
+	}
+
+	// helper to queue the job again after a backoff, or to fail it
+	// once it runs out of retries
+	retry := func(reason string) {
+		if syncJob.Attempts > s.retries {
+			log.Infof("job %d failed after %d attempts", syncJob.ID, syncJob.Attempts)
+			setJobState(model.JobError, reason)
+			return
+		}
+		next := time.Now().Add(backoff(syncJob.Attempts))
+		log.Infof("job %d failed, retrying at %s", syncJob.ID, next.Format(time.RFC3339))
+		db.Model(&model.SyncJob{}).Where("id = ?", syncJob.ID).Where(running).
+			Updates(map[string]interface{}{"status": model.JobQueued.String(), "reason": reason, "next_attempt_at": next})
 	}
 
-	if err := db.Model(&model.SyncJob{}).Where(queued).Order("created_at").First(&syncJob).Error; err != nil {
+	now := time.Now()
+	due := db.Where("next_attempt_at IS NULL OR next_attempt_at <= ?", now)
+
+	// the job of a catalog may be queued again while its cancelled job
+	// is still running
+	pending := db.Model(&model.SyncJob{}).Where(queued).Where(due)
+	if busy := s.busyCatalogs(); len(busy) > 0 {
+		pending = pending.Where("catalog_id NOT IN ?", busy)
+	}
+
+	if err := pending.Order("created_at").First(&syncJob).Error; err != nil {
 		if ignoreNotFound(err) != nil {
-			log.Error(err)
-			return err
+			return false, err
 		}
 		log.Info("nothing to sync")
-		return nil
+		return false, nil
 	}
 
-	setJobState(model.JobRunning)
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	if !s.acquire(syncJob.CatalogID, syncJob.ID, cancel) {
+		return true, nil
+	}
+	defer s.release(syncJob.CatalogID)
+
+	// another worker may have picked up the job, and even queued it
+	// again for a retry, or it may have been cancelled meanwhile, in
+	// which case move on to the next job
+	started := db.Model(&model.SyncJob{}).Where("id = ?", syncJob.ID).Where(queued).Where(due).
+		Updates(map[string]interface{}{
+			"status":     model.JobRunning.String(),
+			"started_at": now,
+			"attempts":   gorm.Expr("attempts + 1"),
+		})
+	if started.Error != nil {
+		return false, started.Error
+	}
+	if started.RowsAffected == 0 {
+		return true, nil
+	}
+	syncJob.SetState(model.JobRunning)
+	syncJob.Attempts++
 
 	catalog := model.Catalog{}
 	if err := db.Model(&syncJob).Association("Catalog").Find(&catalog); err != nil {
-		log.Error(err)
-		return err
+		retry(fmt.Sprintf("failed to find catalog: %v", err))
+		return false, err
 	}
 
-	fetchSpec := git.FetchSpec{URL: catalog.URL, Revision: catalog.Revision, Path: s.clonePath, SSHUrl: catalog.SSHURL, CatalogName: catalog.Name}
-	repo, err := s.git.Fetch(fetchSpec)
+	fetchSpec := git.FetchSpec{
+		URL:         catalog.URL,
+		Revision:    catalog.Revision,
+		Path:        s.clonePath,
+		SSHUrl:      catalog.SSHURL,
+		SSLVerify:   !catalog.InsecureSkipTLSVerify,
+		CatalogName: catalog.Name,
+		Depth:       s.depth,
+	}
+	if catalog.Credential != "" {
+		// the credential is read on every sync so that it can be rotated
+		// by updating its secret
+		creds, err := git.LoadCredentials(s.credentialsDir, catalog.Credential)
+		if err != nil {
+			log.Error(err)
+			retry(fmt.Sprintf("failed to load credential: %v", err))
+			return true, nil
+		}
+		fetchSpec.Credentials = creds
+	}
+	repo, err := s.git.Fetch(ctx, fetchSpec)
+	if ctx.Err() == context.Canceled {
+		log.Infof("job %d has been cancelled, aborted fetch of catalog %s", syncJob.ID, catalog.Name)
+		setJobState(model.JobCancelled, "cancelled by a change of the catalog")
+		return true, nil
+	}
 	if err != nil {
 		log.Error(err, "clone failed")
-		setJobState(model.JobError)
-		return nil
+		if ctx.Err() == context.DeadlineExceeded {
+			err = fmt.Errorf("timed out after %s", s.timeout)
+		}
+		retry(fmt.Sprintf("failed to fetch catalog: %v", err))
+		return true, nil
 	}
 
-	if repo.Head() == catalog.SHA {
+	if repo.Head() == catalog.SHA && !syncJob.Full {
 		log.Infof("skipping already cloned catalog - %s | sha: %s", catalog.URL, catalog.SHA)
-		setJobState(model.JobDone)
-		return nil
+		db.Model(&syncJob).Where(running).Update("sha", catalog.SHA)
+		setJobState(model.JobDone, "")
+		return true, nil
 	}
 
 	// parse the catalog and fill the db
-	parser := parser.ForCatalog(s.logger, repo, catalog.ContextDir)
+	catalogParser := parser.ForCatalog(s.logger, repo, catalog.ContextDir)
 
-	res, result := parser.Parse()
-	if err = s.updateJob(syncJob, repo.Head(), res, result); err != nil {
+	var res []parser.Resource
+	var result parser.Result
+	changes := s.changes(log, syncJob, catalog, repo, catalogParser)
+	if changes == nil {
+		res, result = catalogParser.Parse()
+	} else {
+		log.Infof("parsing %d resources changed since %s in catalog %s", len(changes.changed), catalog.SHA, catalog.Name)
+		res, changes.removed, result = catalogParser.ParseResources(changes.changed)
+	}
+	switch ctx.Err() {
+	case context.Canceled:
+		log.Infof("job %d has been cancelled, skipping update of catalog %s", syncJob.ID, catalog.Name)
+		setJobState(model.JobCancelled, "cancelled by a change of the catalog")
+		return true, nil
+	case context.DeadlineExceeded:
+		retry(fmt.Sprintf("failed to parse catalog: timed out after %s", s.timeout))
+		return true, nil
+	}
+
+	if err = s.updateJob(syncJob, repo.Head(), res, result, changes); err != nil {
+		if err == errJobCancelled {
+			log.Infof("job %d has been cancelled, skipping update of catalog %s", syncJob.ID, catalog.Name)
+			return true, nil
+		}
 		log.Error(err, "updation of db failed")
-		setJobState(model.JobQueued)
-		return err
+		retry(fmt.Sprintf("failed to update catalog: %v", err))
+		return false, err
 	}
-	setJobState(model.JobDone)
-	return nil
+	return true, nil
 }
 
-func (s *syncer) updateJob(syncJob model.SyncJob, sha string, res []parser.Resource, result parser.Result) error {
+// changeSet holds the resources changed since the last sync of a catalog
+// and the ones among them which have been removed
+type changeSet struct {
+	changed []parser.ResourceKey
+	removed []parser.ResourceKey
+}
+
+// changes diffs the commit synced last with the head of the repo to find
+// the resources to parse. It returns nil when all the resources of the
+// catalog have to be parsed i.e. for a full sync, the first sync of the
+// catalog or when the commit synced last is no longer in the history.
+func (s *syncer) changes(log *zap.SugaredLogger, job model.SyncJob, catalog model.Catalog,
+	repo git.Repo, p *parser.CatalogParser) *changeSet {
+
+	if job.Full || catalog.SHA == "" {
+		return nil
+	}
+
+	files, err := repo.ChangedFiles(catalog.SHA)
+	if err != nil {
+		log.Warnf("doing a full sync of catalog %s: %s", catalog.Name, err)
+		return nil
+	}
+	return &changeSet{changed: p.ChangedResources(files)}
+}
+
+func (s *syncer) updateJob(syncJob model.SyncJob, sha string, res []parser.Resource, result parser.Result, changes *changeSet) error {
 	log := s.logger.With("action", "update-job", "job-id", syncJob.ID)
 
 	txn := s.db.Begin()
 
+	// lock the job so that it can't be cancelled while the catalog is updated
+	job := model.SyncJob{}
+	if err := txn.Clauses(clause.Locking{Strength: "UPDATE"}).First(&job, syncJob.ID).Error; err != nil {
+		txn.Rollback()
+		return err
+	}
+	if !job.IsRunning() {
+		txn.Rollback()
+		return errJobCancelled
+	}
+
 	catalog := model.Catalog{}
 	if err := txn.Model(&syncJob).Association("Catalog").Find(&catalog); err != nil {
+		txn.Rollback()
 		return err
 	}
 	catalog.SHA = sha
 
-	rerr, err := s.updateResources(txn, log, &catalog, res)
+	rerr, err := s.updateResources(txn, log, &catalog, res, changes)
 	if err != nil {
 		txn.Rollback()
 		return err
@@ -277,7 +646,7 @@ func (s *syncer) updateJob(syncJob model.SyncJob, sha string, res []parser.Resou
 
 	result.Combine(rerr)
 
-	if err := s.updateCatalogResult(txn, log, &catalog, result); err != nil {
+	if err := s.updateCatalogResult(txn, log, &catalog, result, changes); err != nil {
 		txn.Rollback()
 		return err
 	}
@@ -287,20 +656,35 @@ func (s *syncer) updateJob(syncJob model.SyncJob, sha string, res []parser.Resou
 		return err
 	}
 
+	done := map[string]interface{}{"status": model.JobDone.String(), "finished_at": time.Now(), "sha": sha, "reason": ""}
+	if err := txn.Model(&job).Updates(done).Error; err != nil {
+		txn.Rollback()
+		return err
+	}
+
 	txn.Commit()
 	return nil
 }
 
 func (s *syncer) updateResources(
 	txn *gorm.DB, log *zap.SugaredLogger,
-	catalog *model.Catalog, res []parser.Resource) (parser.Result, error) {
+	catalog *model.Catalog, res []parser.Resource, changes *changeSet) (parser.Result, error) {
 
-	if len(res) == 0 {
+	if len(res) == 0 && changes == nil {
 		return parser.Result{}, nil
 	}
 
 	var syncResourceID []uint
 	rerr := parser.Result{}
+
+	// signatures of the versions can't be verified if the public keys of the
+	// catalog are invalid, such versions are reported as invalid
+	verifiers, err := parser.LoadVerifiers(catalog.PublicKeys)
+	if err != nil {
+		log.Error(err)
+		rerr.Warn("Public keys of catalog %s are invalid: %s", catalog.Name, err)
+	}
+
 	for _, r := range res {
 
 		log.Infof("Res: %s | Name: %s ", r.Kind, r.Name)
@@ -316,26 +700,46 @@ func (s *syncer) updateResources(
 		}
 
 		txn.Model(&model.Resource{}).Where(dbRes).FirstOrCreate(&dbRes)
-		if err := txn.Save(&dbRes).Error; err != nil {
+		// the downloads and the rating are counted while the catalog is
+		// synced, so they are not written back
+		if err := txn.Omit("downloads", "rating").Save(&dbRes).Error; err != nil {
 			return parser.Result{}, err
 		}
 		syncResourceID = append(syncResourceID, dbRes.ID)
 
 		log.Infof("Resource: %s  ID: %d stored", r.Name, dbRes.ID)
 
-		rerr.Combine(s.updateResourceCategory(txn, log, &dbRes, r.Categories))
+		key := parser.ResourceKey{Kind: r.Kind, Name: r.Name}
+		rerr.Combine(s.updateResourceCategory(txn, log, &dbRes, r.Categories).ForResource(key))
 		s.updateResourceTags(txn, log, &dbRes, r.Tags)
 		// platform ids on resource version level
 		verPlatformIds := map[uint]bool{}
-		s.updateResourceVersions(txn, log, catalog, dbRes.ID, r.Versions, &verPlatformIds)
+		s.updateResourceVersions(txn, log, catalog, dbRes.ID, r.Versions, verifiers, &verPlatformIds)
 		s.updateResourcePlatforms(txn, log, &dbRes, verPlatformIds)
 	}
 
+	if len(syncResourceID) != 0 {
+		if err := model.RefreshSearchVector(txn, syncResourceID...); err != nil {
+			log.Error(err)
+			return rerr, err
+		}
+	}
+
 	var dltRes []model.Resource
 	// Finds db resources which are deleted
-	if err := txn.Model(&model.Resource{}).Where(&model.Resource{CatalogID: catalog.ID}).Not(map[string]interface{}{"id": syncResourceID}).Find(&dltRes).Error; err != nil {
-		log.Error(err)
-		return rerr, err
+	if changes == nil {
+		if err := txn.Model(&model.Resource{}).Where(&model.Resource{CatalogID: catalog.ID}).Not(map[string]interface{}{"id": syncResourceID}).Find(&dltRes).Error; err != nil {
+			log.Error(err)
+			return rerr, err
+		}
+	}
+	for _, k := range removedResources(changes) {
+		var removed []model.Resource
+		if err := txn.Where(&model.Resource{CatalogID: catalog.ID, Kind: k.Kind, Name: k.Name}).Find(&removed).Error; err != nil {
+			log.Error(err)
+			return rerr, err
+		}
+		dltRes = append(dltRes, removed...)
 	}
 
 	for _, r := range dltRes {
@@ -420,6 +824,7 @@ func (s *syncer) updateResourceVersions(
 	catalog *model.Catalog,
 	resourceID uint,
 	versions []parser.VersionInfo,
+	verifiers []signature.Verifier,
 	verPlatformIds *map[uint]bool) {
 
 	for _, v := range versions {
@@ -436,6 +841,9 @@ func (s *syncer) updateResourceVersions(
 		ver.Description = v.Description
 		ver.ModifiedAt = v.ModifiedAt
 		ver.MinPipelinesVersion = v.MinPipelinesVersion
+		ver.Bundle = v.Bundle
+		ver.APIVersion = v.APIVersion
+		ver.Verification = v.Verify(verifiers)
 		switch catalog.Provider {
 		case "github":
 			ver.URL = fmt.Sprintf("%s/tree/%s/%s", catalog.URL, catalog.Revision, v.Path)
@@ -448,6 +856,11 @@ func (s *syncer) updateResourceVersions(
 		txn.Save(&ver)
 		log.Infof(" Version: %d -> %s | Path: %s ", ver.ID, ver.Version, v.Path)
 
+		s.updateVersionDependencies(txn, log, catalog, ver.ID, v.TaskRefs)
+		s.updateVersionLintResults(txn, log, ver.ID, v.Lint)
+		s.updateVersionImages(txn, log, ver.ID, v.Images)
+		s.updateVersionSchema(txn, log, ver.ID, v)
+
 		platforms := v.Platforms
 		if len(platforms) == 0 {
 			return
@@ -471,17 +884,166 @@ func (s *syncer) updateResourceVersions(
 	}
 }
 
+// updateVersionDependencies replaces the tasks referenced by a version of
+// a pipeline. The catalog of a task referenced by name is the catalog of
+// the pipeline and the catalog of a task fetched through git is found by
+// the url of the repository. A task of the hub resolver without a catalog
+// param is left without catalog, as its default catalog is set in the
+// config of the resolver of the cluster.
+func (s *syncer) updateVersionDependencies(
+	txn *gorm.DB, log *zap.SugaredLogger,
+	catalog *model.Catalog, versionID uint, refs []parser.TaskRef) {
+
+	if err := txn.Where(&model.Dependency{VersionID: versionID}).Delete(&model.Dependency{}).Error; err != nil {
+		log.Error(err)
+		return
+	}
+
+	for _, ref := range refs {
+		dep := model.Dependency{
+			VersionID:   versionID,
+			Name:        ref.Name,
+			Kind:        ref.Kind,
+			Catalog:     ref.Catalog,
+			TaskVersion: ref.Version,
+			Bundle:      ref.Bundle,
+			URL:         ref.URL,
+			Via:         ref.Via,
+		}
+
+		switch {
+		case ref.Via == parser.ViaName:
+			dep.Catalog = catalog.Name
+		case ref.Via == parser.ViaGit && ref.URL != "":
+			var names []string
+			url := strings.ToLower(strings.TrimSuffix(strings.TrimRight(ref.URL, "/"), ".git"))
+			txn.Model(&model.Catalog{}).Where(`LOWER(REGEXP_REPLACE(url, '\.git/*$|/+$', '')) = ?`, url).Limit(1).Pluck("name", &names)
+			if len(names) == 1 {
+				dep.Catalog = names[0]
+			}
+		}
+
+		if err := txn.Create(&dep).Error; err != nil {
+			log.Error(err)
+			return
+		}
+		log.Infof("Resource version: %d -> task: %s %s (%s)", versionID, dep.Name, dep.TaskVersion, dep.Via)
+	}
+}
+
+// updateVersionLintResults replaces the problems found by the lint rules
+// in a version of a resource
+func (s *syncer) updateVersionLintResults(
+	txn *gorm.DB, log *zap.SugaredLogger,
+	versionID uint, results []parser.LintResult) {
+
+	if err := txn.Where(&model.LintResult{VersionID: versionID}).Delete(&model.LintResult{}).Error; err != nil {
+		log.Error(err)
+		return
+	}
+
+	for _, r := range results {
+		lint := model.LintResult{
+			VersionID: versionID,
+			Rule:      r.Rule,
+			Severity:  r.Severity.String(),
+			Message:   r.Message,
+		}
+		if err := txn.Create(&lint).Error; err != nil {
+			log.Error(err)
+			return
+		}
+	}
+	log.Infof("Resource version: %d -> %d lint results", versionID, len(results))
+}
+
+// updateVersionImages replaces the images of the steps and sidecars of a
+// version of a resource
+func (s *syncer) updateVersionImages(
+	txn *gorm.DB, log *zap.SugaredLogger,
+	versionID uint, images []string) {
+
+	if err := txn.Where(&model.Image{VersionID: versionID}).Delete(&model.Image{}).Error; err != nil {
+		log.Error(err)
+		return
+	}
+
+	for _, ref := range images {
+		image := model.Image{VersionID: versionID, Ref: ref, Repository: parser.ImageRepository(ref)}
+		if err := txn.Create(&image).Error; err != nil {
+			log.Error(err)
+			return
+		}
+		log.Infof("Resource version: %d -> image: %s", versionID, ref)
+	}
+}
+
+// updateVersionSchema replaces the params, workspaces and results of a
+// version of a resource
+func (s *syncer) updateVersionSchema(
+	txn *gorm.DB, log *zap.SugaredLogger,
+	versionID uint, v parser.VersionInfo) {
+
+	for _, m := range []interface{}{&model.VersionParam{}, &model.VersionWorkspace{}, &model.VersionResult{}} {
+		if err := txn.Where("version_id = ?", versionID).Delete(m).Error; err != nil {
+			log.Error(err)
+			return
+		}
+	}
+
+	for _, p := range v.Params {
+		param := model.VersionParam{VersionID: versionID, Name: p.Name, Type: p.Type, Default: p.Default, Description: p.Description}
+		if err := txn.Create(&param).Error; err != nil {
+			log.Error(err)
+			return
+		}
+	}
+
+	for _, w := range v.Workspaces {
+		ws := model.VersionWorkspace{VersionID: versionID, Name: w.Name, Description: w.Description, Optional: w.Optional, ReadOnly: w.ReadOnly}
+		if err := txn.Create(&ws).Error; err != nil {
+			log.Error(err)
+			return
+		}
+	}
+
+	for _, r := range v.Results {
+		result := model.VersionResult{VersionID: versionID, Name: r.Name, Type: r.Type, Description: r.Description}
+		if err := txn.Create(&result).Error; err != nil {
+			log.Error(err)
+			return
+		}
+	}
+
+	log.Infof("Resource version: %d -> %d params, %d workspaces, %d results",
+		versionID, len(v.Params), len(v.Workspaces), len(v.Results))
+}
+
 func (s *syncer) updateCatalogResult(
 	txn *gorm.DB, log *zap.SugaredLogger,
-	catalog *model.Catalog, result parser.Result) error {
+	catalog *model.Catalog, result parser.Result, changes *changeSet) error {
+
+	// delete all old records, or only the ones of the changed resources
+	// and of the catalog for an incremental sync
+	old := txn.Unscoped().Where(&model.CatalogError{CatalogID: catalog.ID})
+	if changes != nil {
+		resources := []string{""}
+		for _, k := range changes.changed {
+			resources = append(resources, k.String())
+		}
+		old = old.Where("resource IN ?", resources)
+	}
+	if err := old.Delete(&model.CatalogError{}).Error; err != nil {
+		return err
+	}
 
-	// delete all old records
-	txn.Unscoped().Where(&model.CatalogError{CatalogID: catalog.ID}).Delete(&model.CatalogError{})
 	for _, err := range result.Errors {
-		if err := txn.Create(&model.CatalogError{
-			CatalogID: catalog.ID, Type: "error",
-			Detail: err.Error(),
-		}).Error; err != nil {
+		ctgErr := model.CatalogError{CatalogID: catalog.ID, Type: "error", Detail: err.Error()}
+		var resErr *parser.ResourceError
+		if errors.As(err, &resErr) {
+			ctgErr.Resource = resErr.Resource
+		}
+		if err := txn.Create(&ctgErr).Error; err != nil {
 			return err
 		}
 	}
@@ -491,6 +1053,7 @@ func (s *syncer) updateCatalogResult(
 			CatalogID: catalog.ID,
 			Type:      issue.Type.String(),
 			Detail:    issue.Message,
+			Resource:  issue.Resource,
 		}).Error; err != nil {
 			return err
 		}
@@ -498,6 +1061,13 @@ func (s *syncer) updateCatalogResult(
 	return nil
 }
 
+func removedResources(changes *changeSet) []parser.ResourceKey {
+	if changes == nil {
+		return nil
+	}
+	return changes.removed
+}
+
 // Delete the resource by id
 func (s *syncer) deleteResource(txn *gorm.DB, log *zap.SugaredLogger, res model.Resource) {
 
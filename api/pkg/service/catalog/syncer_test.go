@@ -0,0 +1,493 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"github.com/tektoncd/hub/api/pkg/git"
+	"github.com/tektoncd/hub/api/pkg/parser"
+	"github.com/tektoncd/hub/api/pkg/testutils"
+	"go.uber.org/zap"
+)
+
+type fakeRepo struct {
+	changed []string
+	err     error
+}
+
+var _ git.Repo = (*fakeRepo)(nil)
+
+func (r fakeRepo) Path() string {
+	return "."
+}
+
+func (r fakeRepo) Head() string {
+	return "b"
+}
+
+func (r fakeRepo) ModifiedTime(path string) (time.Time, error) {
+	return time.Time{}, nil
+}
+
+func (r fakeRepo) RelPath(f string) (string, error) {
+	return filepath.Rel(".", f)
+}
+
+func (r fakeRepo) ChangedFiles(from string) ([]string, error) {
+	return r.changed, r.err
+}
+
+// fakeClient fails every fetch after calling block, which may wait for
+// the context of the job to end
+type fakeClient struct {
+	mu    sync.Mutex
+	specs []git.FetchSpec
+	block func(ctx context.Context)
+}
+
+var _ git.Client = (*fakeClient)(nil)
+
+func (c *fakeClient) Fetch(ctx context.Context, spec git.FetchSpec) (git.Repo, error) {
+	c.mu.Lock()
+	c.specs = append(c.specs, spec)
+	c.mu.Unlock()
+
+	if c.block != nil {
+		c.block(ctx)
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return nil, fmt.Errorf("repository not found")
+}
+
+func (c *fakeClient) Fetched() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	fetched := []string{}
+	for _, spec := range c.specs {
+		fetched = append(fetched, spec.CatalogName)
+	}
+	return fetched
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, backoff(0))
+	assert.Equal(t, 30*time.Second, backoff(1))
+	assert.Equal(t, 1*time.Minute, backoff(2))
+	assert.Equal(t, 2*time.Minute, backoff(3))
+	assert.Equal(t, 30*time.Minute, backoff(7))
+	assert.Equal(t, 30*time.Minute, backoff(100))
+}
+
+func TestConfigure(t *testing.T) {
+	s := &syncer{logger: zap.NewNop().Sugar()}
+	s.configure()
+	assert.Equal(t, defaultSyncWorkers, s.workers)
+	assert.Equal(t, defaultSyncTimeout, s.timeout)
+	assert.Equal(t, uint(defaultSyncRetries), s.retries)
+	assert.Equal(t, defaultCredentialsDir, s.credentialsDir)
+
+	t.Setenv("CATALOG_SYNC_WORKERS", "8")
+	t.Setenv("CATALOG_SYNC_TIMEOUT", "2m")
+	t.Setenv("CATALOG_SYNC_RETRIES", "0")
+	t.Setenv("CATALOG_SYNC_DEPTH", "1")
+	t.Setenv("CATALOG_CREDENTIALS_DIR", "/tmp/credentials")
+	s.configure()
+	assert.Equal(t, 8, s.workers)
+	assert.Equal(t, 2*time.Minute, s.timeout)
+	assert.Equal(t, uint(0), s.retries)
+	assert.Equal(t, uint(1), s.depth)
+	assert.Equal(t, "/tmp/credentials", s.credentialsDir)
+
+	t.Setenv("CATALOG_SYNC_WORKERS", "-1")
+	t.Setenv("CATALOG_SYNC_TIMEOUT", "soon")
+	t.Setenv("CATALOG_SYNC_DEPTH", "shallow")
+	s.configure()
+	assert.Equal(t, defaultSyncWorkers, s.workers)
+	assert.Equal(t, defaultSyncTimeout, s.timeout)
+	assert.Equal(t, uint(0), s.depth)
+}
+
+func TestChanges(t *testing.T) {
+	log := zap.NewNop().Sugar()
+	s := &syncer{logger: log}
+	repo := fakeRepo{changed: []string{"task/buildah/0.1/buildah.yaml", "README.md"}}
+	p := parser.ForCatalog(log, repo, "")
+	ctg := model.Catalog{Name: "tekton", SHA: "a"}
+
+	changes := s.changes(log, model.SyncJob{}, ctg, repo, p)
+	assert.NotNil(t, changes)
+	assert.Equal(t, []parser.ResourceKey{{Kind: "Task", Name: "buildah"}}, changes.changed)
+
+	// full sync
+	assert.Nil(t, s.changes(log, model.SyncJob{Full: true}, ctg, repo, p))
+	assert.Nil(t, s.changes(log, model.SyncJob{}, model.Catalog{Name: "tekton"}, repo, p))
+
+	// commit synced last is unknown
+	repo = fakeRepo{err: fmt.Errorf("bad revision")}
+	assert.Nil(t, s.changes(log, model.SyncJob{}, ctg, repo, p))
+}
+
+func TestEnqueue_OneActiveJobPerCatalog(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	s := NewSyncer(tc, "")
+
+	// a cron refresh racing webhooks must not queue a second job
+	ids := make([]uint, 8)
+	var wg sync.WaitGroup
+	for i := range ids {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			job, err := s.Enqueue(11, 1, false)
+			assert.NoError(t, err)
+			if job != nil {
+				ids[i] = job.ID
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	for _, id := range ids {
+		assert.Equal(t, ids[0], id)
+	}
+
+	var count int64
+	assert.NoError(t, tc.DB().Model(&model.SyncJob{}).
+		Where("catalog_id = ? AND status IN ?", 1, []string{"queued", "running"}).
+		Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestProcess_RetriesFailedFetch(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	client := &fakeClient{}
+	s := NewSyncer(tc, "")
+	s.git = client
+	s.retries = 1
+
+	// job 3 of catalog-community is queued in the fixtures
+	processed, err := s.Process()
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	job := model.SyncJob{}
+	assert.NoError(t, tc.DB().First(&job, 3).Error)
+	assert.Equal(t, "queued", job.Status)
+	assert.Equal(t, uint(1), job.Attempts)
+	assert.Equal(t, "failed to fetch catalog: repository not found", job.Reason)
+	assert.NotNil(t, job.NextAttemptAt)
+	assert.True(t, job.NextAttemptAt.After(time.Now()))
+
+	// the job is not retried before its backoff
+	processed, err = s.Process()
+	assert.NoError(t, err)
+	assert.False(t, processed)
+	assert.Equal(t, []string{"catalog-community"}, client.Fetched())
+
+	assert.NoError(t, tc.DB().Model(&job).Update("next_attempt_at", time.Now().Add(-time.Second)).Error)
+
+	processed, err = s.Process()
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	// the job fails once it runs out of retries
+	job = model.SyncJob{}
+	assert.NoError(t, tc.DB().First(&job, 3).Error)
+	assert.Equal(t, "error", job.Status)
+	assert.Equal(t, uint(2), job.Attempts)
+	assert.Equal(t, "failed to fetch catalog: repository not found", job.Reason)
+	assert.NotNil(t, job.FinishedAt)
+	assert.Equal(t, []string{"catalog-community", "catalog-community"}, client.Fetched())
+}
+
+func TestProcess_VerifiesTLS(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	client := &fakeClient{}
+	s := NewSyncer(tc, "")
+	s.git = client
+
+	processed, err := s.Process()
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	// the verification is skipped only for the catalogs opting out of it
+	assert.NoError(t, tc.DB().Model(&model.Catalog{}).Where("id = ?", 2).Update("insecure_skip_tls_verify", true).Error)
+	assert.NoError(t, tc.DB().Model(&model.SyncJob{}).Where("id = ?", 3).Update("next_attempt_at", time.Now().Add(-time.Second)).Error)
+
+	processed, err = s.Process()
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	assert.Len(t, client.specs, 2)
+	assert.True(t, client.specs[0].SSLVerify)
+	assert.False(t, client.specs[1].SSLVerify)
+}
+
+func TestProcess_FetchTimeout(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	s := NewSyncer(tc, "")
+	s.git = &fakeClient{block: func(ctx context.Context) { <-ctx.Done() }}
+	s.timeout = 50 * time.Millisecond
+
+	processed, err := s.Process()
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	job := model.SyncJob{}
+	assert.NoError(t, tc.DB().First(&job, 3).Error)
+	assert.Equal(t, "queued", job.Status)
+	assert.Equal(t, uint(1), job.Attempts)
+	assert.Equal(t, "failed to fetch catalog: timed out after 50ms", job.Reason)
+}
+
+func TestProcess_ClaimsJobOnce(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	client := &fakeClient{}
+	s := NewSyncer(tc, "")
+	s.git = client
+
+	// all workers look for a job at once while only job 3 is queued
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.Process()
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, []string{"catalog-community"}, client.Fetched())
+
+	job := model.SyncJob{}
+	assert.NoError(t, tc.DB().First(&job, 3).Error)
+	assert.Equal(t, uint(1), job.Attempts)
+}
+
+func TestProcess_Concurrent(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// each fetch waits until the jobs of all catalogs are running
+	all := make(chan bool)
+	var fetching sync.WaitGroup
+	fetching.Add(4)
+	go func() {
+		fetching.Wait()
+		close(all)
+	}()
+
+	client := &fakeClient{block: func(ctx context.Context) {
+		fetching.Done()
+		select {
+		case <-all:
+		case <-ctx.Done():
+		}
+	}}
+	s := NewSyncer(tc, "")
+	s.git = client
+	s.timeout = 10 * time.Second
+
+	for _, id := range []uint{1, 3, 4} {
+		_, err := s.Enqueue(11, id, false)
+		assert.NoError(t, err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				processed, err := s.Process()
+				assert.NoError(t, err)
+				if !processed || err != nil {
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	select {
+	case <-all:
+	default:
+		t.Fatal("catalogs have not been fetched concurrently")
+	}
+
+	// every catalog is fetched once and its job is queued for a retry
+	assert.ElementsMatch(t, []string{"catalog-official", "catalog-community", "catalog-enterprise", "catalog-private"}, client.Fetched())
+
+	jobs := []model.SyncJob{}
+	assert.NoError(t, tc.DB().Where("status = ?", "queued").Find(&jobs).Error)
+	assert.Equal(t, 4, len(jobs))
+	for _, job := range jobs {
+		assert.Equal(t, uint(1), job.Attempts)
+		assert.Equal(t, "failed to fetch catalog: repository not found", job.Reason)
+	}
+}
+
+func TestProcess_CancelRunningJob(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// the first fetch runs until its job is cancelled
+	started := make(chan bool)
+	var once sync.Once
+	client := &fakeClient{block: func(ctx context.Context) {
+		once.Do(func() {
+			close(started)
+			<-ctx.Done()
+		})
+	}}
+	s := NewSyncer(tc, "")
+	s.git = client
+
+	done := make(chan bool)
+	go func() {
+		_, err := s.Process()
+		assert.NoError(t, err)
+		close(done)
+	}()
+	<-started
+
+	assert.NoError(t, tc.DB().Model(&model.SyncJob{}).Where("id = ?", 3).
+		Update("status", "cancelled").Error)
+
+	// the catalog is refreshed again while its cancelled job is running
+	job, err := s.Enqueue(11, 2, false)
+	assert.NoError(t, err)
+	assert.NotEqual(t, uint(3), job.ID)
+
+	processed, err := s.Process()
+	assert.NoError(t, err)
+	assert.False(t, processed)
+
+	s.Cancel(3)
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("fetch of the cancelled job has not been aborted")
+	}
+
+	cancelled := model.SyncJob{}
+	assert.NoError(t, tc.DB().First(&cancelled, 3).Error)
+	assert.Equal(t, "cancelled", cancelled.Status)
+	assert.Equal(t, uint(1), cancelled.Attempts)
+
+	// the new job runs once the cancelled one has stopped
+	processed, err = s.Process()
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	assert.Equal(t, []string{"catalog-community", "catalog-community"}, client.Fetched())
+}
+
+func TestLock_CancelsRunningJob(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// the first fetch runs until its job is cancelled
+	started := make(chan bool)
+	var once sync.Once
+	client := &fakeClient{block: func(ctx context.Context) {
+		once.Do(func() {
+			close(started)
+			<-ctx.Done()
+		})
+	}}
+	s := NewSyncer(tc, "")
+	s.git = client
+
+	go func() {
+		_, err := s.Process()
+		assert.NoError(t, err)
+	}()
+	<-started
+
+	// the catalog is locked once the running job has stopped
+	locked := make(chan func())
+	go func() { locked <- s.lock(2) }()
+
+	var unlock func()
+	select {
+	case unlock = <-locked:
+	case <-time.After(5 * time.Second):
+		t.Fatal("running job of the catalog has not been cancelled")
+	}
+
+	job := model.SyncJob{}
+	assert.NoError(t, tc.DB().First(&job, 3).Error)
+	assert.Equal(t, "cancelled", job.Status)
+	assert.Equal(t, "cancelled by a change of the catalog", job.Reason)
+
+	// no job of the catalog runs until it is unlocked
+	_, err := s.Enqueue(11, 2, false)
+	assert.NoError(t, err)
+
+	processed, err := s.Process()
+	assert.NoError(t, err)
+	assert.False(t, processed)
+
+	unlock()
+
+	processed, err = s.Process()
+	assert.NoError(t, err)
+	assert.True(t, processed)
+	assert.Equal(t, []string{"catalog-community", "catalog-community"}, client.Fetched())
+}
+
+func TestUpdateVersionDependencies(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	s := NewSyncer(tc, "")
+	catalog := model.Catalog{}
+	assert.NoError(t, tc.DB().First(&catalog, 1).Error)
+
+	s.updateVersionDependencies(tc.DB(), zap.NewNop().Sugar(), &catalog, 1, []parser.TaskRef{
+		{Name: "git-clone", Kind: "Task", Via: parser.ViaName},
+		{Name: "kaniko", Kind: "Task", Version: "0.1", Via: parser.ViaHub},
+		{Name: "yq", Kind: "Task", Catalog: "tekton", Via: parser.ViaHub},
+	})
+
+	deps := []model.Dependency{}
+	assert.NoError(t, tc.DB().Where("version_id = ?", 1).Order("id").Find(&deps).Error)
+	assert.Equal(t, 3, len(deps))
+	assert.Equal(t, "catalog-official", deps[0].Catalog)
+	// the default catalog of the hub resolver is unknown to the hub
+	assert.Equal(t, "", deps[1].Catalog)
+	assert.Equal(t, "tekton", deps[2].Catalog)
+}
@@ -0,0 +1,280 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/tektoncd/hub/api/gen/catalog"
+	"github.com/tektoncd/hub/api/pkg/db/model"
+)
+
+// maxEventSize is the maximum size of the body of a push event
+const maxEventSize = 25 << 20
+
+var (
+	invalidPayload   = catalog.MakeInvalidPayload(fmt.Errorf("invalid push event"))
+	invalidSignature = catalog.MakeInvalidSignature(fmt.Errorf("invalid webhook signature"))
+)
+
+// pushEvent is the repository and the branches updated by a push
+type pushEvent struct {
+	urls     []string
+	branches []string
+}
+
+// refresh the catalogs matching the repository and branch of a push event
+func (s *service) Webhook(ctx context.Context, p *catalog.WebhookPayload, body io.ReadCloser) ([]*catalog.Job, error) {
+
+	log := s.Logger(ctx)
+	db := s.DB(ctx)
+
+	defer body.Close()
+	data, err := io.ReadAll(io.LimitReader(body, maxEventSize))
+	if err != nil {
+		log.Error(err)
+		return nil, invalidPayload
+	}
+
+	// events other than push e.g. ping are acknowledged but ignored
+	if !isPushEvent(p) {
+		log.Infof("ignoring %s event which is not a push", p.Provider)
+		return []*catalog.Job{}, nil
+	}
+
+	event, err := parsePushEvent(p.Provider, data)
+	if err != nil {
+		log.Error(err)
+		return nil, invalidPayload
+	}
+
+	ctgs := []model.Catalog{}
+	if err := db.Where("LOWER(provider) = ? AND webhook_secret <> ''", p.Provider).Find(&ctgs).Error; err != nil {
+		log.Error(err)
+		return nil, internalError
+	}
+
+	// the signature is verified before matching the repository and branch
+	// so that callers not knowing a secret can't find out which
+	// repositories and branches are synced
+	verified := []model.Catalog{}
+	for _, ctg := range ctgs {
+		if verifySignature(p, data, ctg.WebhookSecret) {
+			verified = append(verified, ctg)
+		}
+	}
+	if len(verified) == 0 {
+		log.Infof("signature of %s event does not match the secret of any catalog", p.Provider)
+		return nil, invalidSignature
+	}
+
+	bot := model.Account{}
+	if err := db.Where("user_name = ?", "apiserver-bot").First(&bot).Error; err != nil {
+		log.Error(err)
+		return nil, internalError
+	}
+
+	// pushes to other branches of a repository are acknowledged but ignored
+	res := []*catalog.Job{}
+	for _, ctg := range verified {
+		if !event.matches(ctg) {
+			continue
+		}
+
+		job, err := s.wq.Enqueue(bot.UserID, ctg.ID, false)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("job %d queued to refresh catalog %s on push", job.ID, ctg.Name)
+		res = append(res, &catalog.Job{ID: job.ID, CatalogName: ctg.Name, Status: job.Status})
+	}
+
+	return res, nil
+}
+
+func isPushEvent(p *catalog.WebhookPayload) bool {
+	switch p.Provider {
+	case "github":
+		return p.GithubEvent != nil && *p.GithubEvent == "push"
+	case "gitlab":
+		return p.GitlabEvent != nil && *p.GitlabEvent == "Push Hook"
+	case "bitbucket":
+		return p.BitbucketEvent != nil && *p.BitbucketEvent == "repo:push"
+	}
+	return false
+}
+
+// verifySignature checks the HMAC SHA256 signature of GitHub and Bitbucket
+// events or the token of GitLab events using the secret of the catalog
+func verifySignature(p *catalog.WebhookPayload, body []byte, secret string) bool {
+
+	if p.Provider == "gitlab" {
+		return p.GitlabToken != nil &&
+			subtle.ConstantTimeCompare([]byte(*p.GitlabToken), []byte(secret)) == 1
+	}
+
+	signature := p.GithubSignature
+	if p.Provider == "bitbucket" {
+		signature = p.BitbucketSignature
+	}
+	if signature == nil || !strings.HasPrefix(*signature, "sha256=") {
+		return false
+	}
+
+	expected, err := hex.DecodeString(strings.TrimPrefix(*signature, "sha256="))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// parsePushEvent finds the urls of the repository and the pushed branches
+// from the body of a push event
+func parsePushEvent(provider string, body []byte) (*pushEvent, error) {
+
+	switch provider {
+	case "github":
+		e := struct {
+			Ref        string `json:"ref"`
+			Repository struct {
+				HTMLURL  string `json:"html_url"`
+				CloneURL string `json:"clone_url"`
+				SSHURL   string `json:"ssh_url"`
+			} `json:"repository"`
+		}{}
+		if err := json.Unmarshal(body, &e); err != nil {
+			return nil, err
+		}
+		return &pushEvent{
+			urls:     []string{e.Repository.HTMLURL, e.Repository.CloneURL, e.Repository.SSHURL},
+			branches: []string{strings.TrimPrefix(e.Ref, "refs/heads/")},
+		}, nil
+
+	case "gitlab":
+		e := struct {
+			Ref     string `json:"ref"`
+			Project struct {
+				WebURL     string `json:"web_url"`
+				GitHTTPURL string `json:"git_http_url"`
+				GitSSHURL  string `json:"git_ssh_url"`
+			} `json:"project"`
+		}{}
+		if err := json.Unmarshal(body, &e); err != nil {
+			return nil, err
+		}
+		return &pushEvent{
+			urls:     []string{e.Project.WebURL, e.Project.GitHTTPURL, e.Project.GitSSHURL},
+			branches: []string{strings.TrimPrefix(e.Ref, "refs/heads/")},
+		}, nil
+
+	case "bitbucket":
+		e := struct {
+			Push struct {
+				Changes []struct {
+					New *struct {
+						Type string `json:"type"`
+						Name string `json:"name"`
+					} `json:"new"`
+				} `json:"changes"`
+			} `json:"push"`
+			Repository struct {
+				Links struct {
+					HTML struct {
+						Href string `json:"href"`
+					} `json:"html"`
+				} `json:"links"`
+			} `json:"repository"`
+		}{}
+		if err := json.Unmarshal(body, &e); err != nil {
+			return nil, err
+		}
+		event := &pushEvent{urls: []string{e.Repository.Links.HTML.Href}}
+		for _, c := range e.Push.Changes {
+			// a deleted branch has no new state
+			if c.New != nil && c.New.Type == "branch" {
+				event.branches = append(event.branches, c.New.Name)
+			}
+		}
+		return event, nil
+	}
+
+	return nil, fmt.Errorf("provider %s not supported", provider)
+}
+
+// matches checks if the catalog is the repository and one of the
+// branches updated by the push
+func (e *pushEvent) matches(ctg model.Catalog) bool {
+
+	branch := false
+	for _, b := range e.branches {
+		if b == ctg.Revision {
+			branch = true
+		}
+	}
+	if !branch {
+		return false
+	}
+
+	for _, u := range e.urls {
+		if u == "" {
+			continue
+		}
+		if repoPath(u) == repoPath(ctg.URL) || (ctg.SSHURL != "" && repoPath(u) == repoPath(ctg.SSHURL)) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	schemeRe = regexp.MustCompile(`^[a-z+]+:/+`)
+	scpRe    = regexp.MustCompile(`^([^/@]+@)?([^/:]+):`)
+)
+
+// repoPath normalizes https and ssh urls of a repository to host/org/repo
+// e.g. git@github.com:tektoncd/catalog.git to github.com/tektoncd/catalog
+func repoPath(u string) string {
+	u = strings.ToLower(strings.TrimSpace(u))
+
+	if schemeRe.MatchString(u) {
+		u = schemeRe.ReplaceAllString(u, "")
+		// drop the user and port of the url
+		if i := strings.Index(u, "@"); i != -1 && i < strings.Index(u+"/", "/") {
+			u = u[i+1:]
+		}
+		if host, path, ok := strings.Cut(u, "/"); ok {
+			host, _, _ = strings.Cut(host, ":")
+			u = host + "/" + path
+		}
+	} else {
+		u = scpRe.ReplaceAllString(u, "$2/")
+	}
+
+	u = strings.TrimSuffix(u, "/")
+	return strings.TrimSuffix(u, ".git")
+}
@@ -0,0 +1,111 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/hub/api/gen/catalog"
+	"github.com/tektoncd/hub/api/pkg/db/model"
+)
+
+const githubPush = `{
+  "ref": "refs/heads/main",
+  "repository": {
+    "html_url": "https://github.com/tektoncd/catalog",
+    "clone_url": "https://github.com/tektoncd/catalog.git",
+    "ssh_url": "git@github.com:tektoncd/catalog.git"
+  }
+}`
+
+const gitlabPush = `{
+  "ref": "refs/heads/main",
+  "project": {
+    "web_url": "https://gitlab.com/tekton/catalog",
+    "git_http_url": "https://gitlab.com/tekton/catalog.git",
+    "git_ssh_url": "git@gitlab.com:tekton/catalog.git"
+  }
+}`
+
+const bitbucketPush = `{
+  "push": {
+    "changes": [
+      {"new": {"type": "tag", "name": "v0.1"}},
+      {"new": null},
+      {"new": {"type": "branch", "name": "main"}}
+    ]
+  },
+  "repository": {
+    "links": {"html": {"href": "https://bitbucket.org/tekton/catalog"}}
+  }
+}`
+
+func sign(body, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestRepoPath(t *testing.T) {
+	for _, u := range []string{
+		"https://github.com/tektoncd/catalog",
+		"https://github.com/tektoncd/catalog.git",
+		"https://GitHub.com/tektoncd/catalog/",
+		"https:/github.com/tektoncd/catalog",
+		"https://user@github.com:443/tektoncd/catalog",
+		"ssh://git@github.com/tektoncd/catalog.git",
+		"git@github.com:tektoncd/catalog.git",
+	} {
+		assert.Equal(t, "github.com/tektoncd/catalog", repoPath(u), u)
+	}
+}
+
+func TestParsePushEvent(t *testing.T) {
+	e, err := parsePushEvent("github", []byte(githubPush))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"main"}, e.branches)
+	assert.True(t, e.matches(model.Catalog{URL: "https://github.com/tektoncd/catalog", Revision: "main"}))
+	assert.False(t, e.matches(model.Catalog{URL: "https://github.com/tektoncd/catalog", Revision: "release"}))
+	assert.False(t, e.matches(model.Catalog{URL: "https://github.com/tektoncd/hub", Revision: "main"}))
+
+	e, err = parsePushEvent("gitlab", []byte(gitlabPush))
+	assert.NoError(t, err)
+	assert.True(t, e.matches(model.Catalog{URL: "https://example.com/other", SSHURL: "git@gitlab.com:tekton/catalog", Revision: "main"}))
+
+	e, err = parsePushEvent("bitbucket", []byte(bitbucketPush))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"main"}, e.branches)
+	assert.True(t, e.matches(model.Catalog{URL: "https://bitbucket.org/tekton/catalog.git", Revision: "main"}))
+
+	_, err = parsePushEvent("github", []byte("not json"))
+	assert.Error(t, err)
+}
+
+func TestVerifySignature(t *testing.T) {
+	signature := sign(githubPush, "secret")
+	invalid := sign(githubPush, "other")
+	token := "secret"
+
+	assert.True(t, verifySignature(&catalog.WebhookPayload{Provider: "github", GithubSignature: &signature}, []byte(githubPush), "secret"))
+	assert.False(t, verifySignature(&catalog.WebhookPayload{Provider: "github", GithubSignature: &invalid}, []byte(githubPush), "secret"))
+	assert.False(t, verifySignature(&catalog.WebhookPayload{Provider: "github"}, []byte(githubPush), "secret"))
+	assert.True(t, verifySignature(&catalog.WebhookPayload{Provider: "bitbucket", BitbucketSignature: &signature}, []byte(githubPush), "secret"))
+	assert.True(t, verifySignature(&catalog.WebhookPayload{Provider: "gitlab", GitlabToken: &token}, nil, "secret"))
+	assert.False(t, verifySignature(&catalog.WebhookPayload{Provider: "gitlab", GitlabToken: &token}, nil, "other"))
+}
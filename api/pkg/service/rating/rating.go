@@ -20,6 +20,7 @@ import (
 
 	"github.com/tektoncd/hub/api/gen/rating"
 	"github.com/tektoncd/hub/api/pkg/app"
+	"github.com/tektoncd/hub/api/pkg/audit"
 	"github.com/tektoncd/hub/api/pkg/db/model"
 	"github.com/tektoncd/hub/api/pkg/service/validator"
 	"gorm.io/gorm"
@@ -102,7 +103,17 @@ func (r *request) updateRating(resID, userRating uint) error {
 		return err
 	}
 
-	return r.updateResourceRating(res)
+	if err := r.updateResourceRating(res); err != nil {
+		return err
+	}
+
+	// a failure is only logged as the rating is already updated
+	payload := map[string]interface{}{"id": resID, "rating": userRating}
+	target := fmt.Sprintf("resource:%d", resID)
+	if err := audit.Record(r.db, r.userID, audit.RatingUpdate, target, payload); err != nil {
+		r.log.Error(err)
+	}
+	return nil
 }
 
 func (r *request) updateUserRating(resID, rating uint) error {
@@ -136,8 +147,9 @@ func (r *request) updateResourceRating(res *model.Resource) error {
 		return updateError
 	}
 
-	res.Rating = avg
-	if err := r.db.Save(&res).Error; err != nil {
+	// only the rating is written so that the downloads counted meanwhile
+	// are not overwritten
+	if err := r.db.Model(res).Update("rating", avg).Error; err != nil {
 		r.log.Error(err)
 		return updateError
 	}
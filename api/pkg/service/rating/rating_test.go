@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/tektoncd/hub/api/gen/rating"
 	"github.com/tektoncd/hub/api/pkg/service/validator"
+	res "github.com/tektoncd/hub/api/pkg/shared/resource"
 	"github.com/tektoncd/hub/api/pkg/testutils"
 )
 
@@ -91,6 +92,34 @@ func TestUpdate(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestUpdate_KeepsSearchDocument(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// user with rating:write scope
+	user, _, err := tc.UserWithScopes("foo", "foo@bar.com", "rating:write")
+	assert.NoError(t, err)
+
+	ratingSvc := New(tc)
+	ctx := validator.WithUserID(context.Background(), user.ID)
+	err = ratingSvc.Update(ctx, &rating.UpdatePayload{ID: 4, Rating: 3})
+	assert.NoError(t, err)
+
+	// the full text document of buildah is not overwritten by the rating
+	req := res.Request{
+		Db:    tc.DB(),
+		Log:   tc.Logger("resource"),
+		Name:  "desc4",
+		Match: "fulltext",
+		Kinds: []string{},
+		Limit: 100,
+	}
+	found, err := req.Query()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(found))
+	assert.Equal(t, "buildah", found[0].Name)
+}
+
 func TestUpdate_ResourceNotFound(t *testing.T) {
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
@@ -31,6 +31,7 @@ type contextKey string
 
 var (
 	userIDKey = contextKey("user-id")
+	scopesKey = contextKey("scopes")
 )
 
 var (
@@ -54,7 +55,7 @@ func NewService(api app.Config, name string) *Service {
 // JWTAuth implements the authorization logic for services for the "jwt" security scheme.
 func (s *Service) JWTAuth(ctx context.Context, jwt string, scheme *security.JWTScheme) (context.Context, error) {
 
-	claims, err := token.Verify(jwt, s.jwtConfig.SigningKey)
+	claims, err := token.Verify(jwt, s.jwtConfig)
 	if err != nil {
 		return ctx, tokenError
 	}
@@ -74,6 +75,15 @@ func (s *Service) JWTAuth(ctx context.Context, jwt string, scheme *security.JWTS
 		return ctx, err
 	}
 
+	revoked, err := token.Revoked(s.DB(ctx), claims)
+	if err != nil {
+		s.Logger(ctx).Error(err)
+		return ctx, internalError
+	}
+	if revoked {
+		return ctx, tokenError
+	}
+
 	return ctx, nil
 }
 
@@ -112,3 +122,15 @@ func WithUserID(ctx context.Context, id uint) context.Context {
 func UserID(ctx context.Context) uint {
 	return ctx.Value(userIDKey).(uint)
 }
+
+// WithScopes adds the scopes of the user in context passed to it
+func WithScopes(ctx context.Context, scopes []string) context.Context {
+	return context.WithValue(ctx, scopesKey, scopes)
+}
+
+// Scopes fetch the scopes of the user from passed context, nil is
+// returned for an anonymous user
+func Scopes(ctx context.Context) []string {
+	scopes, _ := ctx.Value(scopesKey).([]string)
+	return scopes
+}
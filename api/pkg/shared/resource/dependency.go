@@ -0,0 +1,171 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"strings"
+
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"github.com/tektoncd/hub/api/pkg/semver"
+	"gorm.io/gorm"
+)
+
+// Dependency is a task referenced by a version of a pipeline, ResourceID
+// is the id of the resource the task resolves to or zero if the task is
+// not in the hub
+type Dependency struct {
+	model.Dependency
+	ResourceID uint
+}
+
+// Dependent is a version of a pipeline referencing a task
+type Dependent struct {
+	ResourceID  uint
+	Name        string
+	Catalog     string
+	Version     string
+	TaskVersion string
+}
+
+// Dependencies returns the tasks referenced by a version of a pipeline,
+// by default the latest version. NotFoundError is returned if the
+// resource is not visible to the caller and VersionNotFoundError if the
+// resource has no such version
+// Fields: ID (Resource ID), Version, Prerelease, Scopes
+func (r *Request) Dependencies() (model.ResourceVersion, []Dependency, error) {
+
+	res, err := r.visibleResource()
+	if err != nil {
+		return model.ResourceVersion{}, nil, err
+	}
+
+	version := r.Version
+	if version == "" {
+		var all []string
+		if err := r.Db.Model(&model.ResourceVersion{}).
+			Where("resource_id = ?", res.ID).Pluck("version", &all).Error; err != nil {
+			r.Log.Error(err)
+			return model.ResourceVersion{}, nil, FetchError
+		}
+		version = semver.Latest(all, r.Prerelease)
+	}
+
+	q := r.Db.Where("resource_id = ? AND version = ?", res.ID, version)
+
+	var ver model.ResourceVersion
+	if err := findOne(q, r.Log, &ver); err != nil {
+		if err == NotFoundError {
+			return model.ResourceVersion{}, nil, VersionNotFoundError
+		}
+		return model.ResourceVersion{}, nil, err
+	}
+	ver.Resource = res
+
+	var refs []model.Dependency
+	if err := r.Db.Where("version_id = ?", ver.ID).Order("id").Find(&refs).Error; err != nil {
+		r.Log.Error(err)
+		return model.ResourceVersion{}, nil, FetchError
+	}
+
+	deps := make([]Dependency, 0, len(refs))
+	for _, ref := range refs {
+		id, err := r.resolve(ref)
+		if err != nil {
+			return model.ResourceVersion{}, nil, err
+		}
+		deps = append(deps, Dependency{Dependency: ref, ResourceID: id})
+	}
+
+	return ver, deps, nil
+}
+
+// Dependents returns the versions of the pipelines referencing a task,
+// only the references pinning the Version of the task if it is set
+// Fields: ID (Resource ID), Version, Scopes
+func (r *Request) Dependents() ([]Dependent, error) {
+
+	res, err := r.visibleResource()
+	if err != nil {
+		return nil, err
+	}
+
+	var catalog model.Catalog
+	if err := findOne(r.Db.Where("id = ?", res.CatalogID), r.Log, &catalog); err != nil {
+		return nil, err
+	}
+
+	bundles := r.Db.Session(&gorm.Session{NewDB: true}).
+		Model(&model.ResourceVersion{}).Select("bundle").
+		Where("resource_id = ? AND bundle <> ''", res.ID)
+
+	q := r.Db.Table("dependencies AS d").
+		Select("r.id AS resource_id, r.name, c.name AS catalog, v.version, d.task_version").
+		Joins("JOIN resource_versions AS v ON v.id = d.version_id AND v.deleted_at IS NULL").
+		Joins("JOIN resources AS r ON r.id = v.resource_id").
+		Joins("JOIN catalogs AS c ON c.id = r.catalog_id").
+		Where("r.catalog_id IN (?)", model.VisibleCatalogIDs(r.Db, r.Scopes)).
+		Where("(d.bundle <> '' AND d.bundle IN (?)) OR "+
+			"(d.bundle = '' AND d.name = ? AND LOWER(d.kind) = ? AND LOWER(d.catalog) = ?)",
+			bundles, res.Name, strings.ToLower(res.Kind), strings.ToLower(catalog.Name)).
+		Order("r.name, v.id")
+
+	if r.Version != "" {
+		q = q.Where("d.task_version = ?", r.Version)
+	}
+
+	var dependents []Dependent
+	if err := q.Scan(&dependents).Error; err != nil {
+		r.Log.Error(err)
+		return nil, FetchError
+	}
+
+	return dependents, nil
+}
+
+// resolve finds the id of the resource visible to the caller which a
+// task referenced by a pipeline resolves to, a task referenced through
+// a bundle is found by the bundle and other tasks by the catalog, kind
+// and name
+func (r *Request) resolve(ref model.Dependency) (uint, error) {
+
+	var q *gorm.DB
+	var column string
+	switch {
+	case ref.Bundle != "":
+		q = r.Db.Model(&model.ResourceVersion{}).
+			Scopes(r.visibleVersions).
+			Where("bundle = ?", ref.Bundle)
+		column = "resource_versions.resource_id"
+	case ref.Catalog != "":
+		q = r.Db.Scopes(
+			r.visibleResources,
+			filterByCatalog(ref.Catalog),
+			filterByKind(ref.Kind),
+			filterResourceName("exact", ref.Name))
+		column = "resources.id"
+	default:
+		return 0, nil
+	}
+
+	var ids []uint
+	if err := q.Limit(1).Pluck(column, &ids).Error; err != nil {
+		r.Log.Error(err)
+		return 0, FetchError
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	return ids[0], nil
+}
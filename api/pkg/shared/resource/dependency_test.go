@@ -0,0 +1,155 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/hub/api/pkg/testutils"
+)
+
+func TestDependencies(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 2}
+
+	version, deps, err := req.Dependencies()
+	assert.NoError(t, err)
+	assert.Equal(t, "0.2", version.Version)
+	assert.Equal(t, 4, len(deps))
+
+	// by name in the catalog of the pipeline
+	assert.Equal(t, "tekton", deps[0].Name)
+	assert.Equal(t, uint(1), deps[0].ResourceID)
+	// by the bundle of a version
+	assert.Equal(t, "tkn", deps[1].Name)
+	assert.Equal(t, uint(5), deps[1].ResourceID)
+	// the catalog tekton is not in the hub
+	assert.Equal(t, "yq", deps[2].Name)
+	assert.Equal(t, uint(0), deps[2].ResourceID)
+	assert.Equal(t, "tkn-enterprise", deps[3].Name)
+	assert.Equal(t, uint(8), deps[3].ResourceID)
+}
+
+func TestDependencies_Version(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 2, Version: "0.1"}
+
+	version, deps, err := req.Dependencies()
+	assert.NoError(t, err)
+	assert.Equal(t, "0.1", version.Version)
+	assert.Equal(t, 1, len(deps))
+	assert.Equal(t, "0.1", deps[0].TaskVersion)
+	assert.Equal(t, uint(1), deps[0].ResourceID)
+}
+
+func TestDependencies_PrivateCatalog(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	makePrivate(t, tc)
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 2}
+
+	_, deps, err := req.Dependencies()
+	assert.NoError(t, err)
+	assert.Equal(t, "tkn-enterprise", deps[3].Name)
+	assert.Equal(t, uint(0), deps[3].ResourceID)
+
+	req = Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 2, Scopes: []string{"catalog:enterprise"}}
+
+	_, deps, err = req.Dependencies()
+	assert.NoError(t, err)
+	assert.Equal(t, uint(8), deps[3].ResourceID)
+}
+
+func TestDependencies_NoDependencies(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 3}
+
+	_, deps, err := req.Dependencies()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(deps))
+}
+
+func TestDependencies_NotFound(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 111}
+	_, _, err := req.Dependencies()
+	assert.Equal(t, NotFoundError, err)
+
+	req = Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 2, Version: "0.9"}
+	_, _, err = req.Dependencies()
+	assert.Equal(t, VersionNotFoundError, err)
+}
+
+func TestDependents(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 1}
+
+	dependents, err := req.Dependents()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(dependents))
+	assert.Equal(t, "build-pipeline", dependents[0].Name)
+	assert.Equal(t, "catalog-official", dependents[0].Catalog)
+	assert.Equal(t, "0.1", dependents[0].Version)
+	assert.Equal(t, "0.1", dependents[0].TaskVersion)
+	assert.Equal(t, "0.2", dependents[1].Version)
+	assert.Equal(t, "", dependents[1].TaskVersion)
+}
+
+func TestDependents_Version(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 1, Version: "0.1"}
+
+	dependents, err := req.Dependents()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(dependents))
+	assert.Equal(t, "0.1", dependents[0].Version)
+}
+
+func TestDependents_Bundle(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 5}
+
+	dependents, err := req.Dependents()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(dependents))
+	assert.Equal(t, uint(2), dependents[0].ResourceID)
+	assert.Equal(t, "0.1", dependents[0].TaskVersion)
+}
+
+func TestDependents_NotFound(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 111}
+
+	_, err := req.Dependents()
+	assert.Equal(t, NotFoundError, err)
+}
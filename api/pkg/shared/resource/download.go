@@ -0,0 +1,40 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"strings"
+
+	"github.com/tektoncd/hub/api/pkg/db/model"
+)
+
+// CountDownload counts a download of the version of the resource
+// Fields: Catalog, Kind, Name, Version
+func (r *Request) CountDownload() error {
+
+	q := r.Db.Model(&model.ResourceVersion{}).
+		Joins("JOIN resources AS r ON r.id = resource_versions.resource_id").
+		Joins("JOIN catalogs AS c ON c.id = r.catalog_id").
+		Where("LOWER(c.name) = ? AND LOWER(r.kind) = ? AND r.name = ?",
+			strings.ToLower(r.Catalog), strings.ToLower(r.Kind), r.Name).
+		Where("resource_versions.version = ?", r.Version)
+
+	var version model.ResourceVersion
+	if err := findOne(q, r.Log, &version); err != nil {
+		return err
+	}
+
+	return model.CountDownload(r.Db, version)
+}
@@ -0,0 +1,90 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"github.com/tektoncd/hub/api/pkg/testutils"
+)
+
+func TestCountDownload(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{
+		Db:      tc.DB(),
+		Log:     tc.Logger("resource"),
+		Catalog: "catalog-official",
+		Kind:    "Task",
+		Name:    "tkn",
+		Version: "0.1",
+	}
+
+	assert.NoError(t, req.CountDownload())
+	assert.NoError(t, req.CountDownload())
+
+	var day model.VersionDownload
+	assert.NoError(t, tc.DB().Where("version_id = ?", 8).First(&day).Error)
+	assert.Equal(t, uint(2), day.Count)
+
+	var res model.Resource
+	assert.NoError(t, tc.DB().First(&res, 5).Error)
+	assert.Equal(t, uint(2), res.Downloads)
+}
+
+func TestCountDownload_NotFound(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{
+		Db:      tc.DB(),
+		Log:     tc.Logger("resource"),
+		Catalog: "catalog-official",
+		Kind:    "task",
+		Name:    "tkn",
+		Version: "9.9",
+	}
+
+	assert.Equal(t, NotFoundError, req.CountDownload())
+}
+
+func TestAllResources_SortByPopular(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// tekton was downloaded the most but not in the last 30 days
+	downloads := []model.VersionDownload{
+		{VersionID: 1, Day: model.Today().AddDate(0, 0, -60), Count: 50},
+		{VersionID: 8, Day: model.Today(), Count: 5},
+	}
+	assert.NoError(t, tc.DB().Create(&downloads).Error)
+	assert.NoError(t, tc.DB().Model(&model.Resource{}).Where("id = ?", 1).Update("downloads", 50).Error)
+
+	req := Request{
+		Db:    tc.DB(),
+		Log:   tc.Logger("resource"),
+		Limit: 100,
+		Sort:  "popular",
+	}
+
+	res, err := req.AllResources()
+	assert.NoError(t, err)
+	assert.Equal(t, "tkn", res[0].Name)
+	assert.Equal(t, "tekton", res[1].Name)
+	assert.Equal(t, uint(50), res[1].Downloads)
+}
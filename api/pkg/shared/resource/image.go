@@ -0,0 +1,80 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"github.com/tektoncd/hub/api/pkg/parser"
+)
+
+// ImageUser is a version of a resource using an image
+type ImageUser struct {
+	ResourceID uint
+	Catalog    string
+	Kind       string
+	Name       string
+	Version    string
+	Image      string
+}
+
+// Images returns the images of the steps and sidecars of the version of
+// the resource
+// Fields: Catalog, Kind, Name, Version, Scopes
+func (r *Request) Images() ([]model.Image, error) {
+
+	version, err := r.visibleVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	var images []model.Image
+	if err := r.Db.Where("version_id = ?", version.ID).Order("id").Find(&images).Error; err != nil {
+		r.Log.Error(err)
+		return nil, FetchError
+	}
+
+	return images, nil
+}
+
+// ByImage returns the versions of the resources visible to the caller
+// which use the image. An image without tag and digest e.g. alpine
+// matches all the tags and digests of the image and an image with a tag
+// also matches the image pinned by digest e.g. alpine:3.18@sha256:...
+// Fields: Limit, Scopes
+func (r *Request) ByImage(ref string) ([]ImageUser, error) {
+
+	q := r.Db.Table("images AS i").
+		Select("r.id AS resource_id, c.name AS catalog, r.kind, r.name, v.version, i.ref AS image").
+		Joins("JOIN resource_versions AS v ON v.id = i.version_id AND v.deleted_at IS NULL").
+		Joins("JOIN resources AS r ON r.id = v.resource_id").
+		Joins("JOIN catalogs AS c ON c.id = r.catalog_id").
+		Where("r.catalog_id IN (?)", model.VisibleCatalogIDs(r.Db, r.Scopes)).
+		Order("c.name, r.name, v.id, i.id").
+		Limit(int(r.Limit))
+
+	if parser.ImageRepository(ref) == ref {
+		q = q.Where("i.repository = ?", ref)
+	} else {
+		q = q.Where("i.ref = ? OR i.ref LIKE ?", ref, ref+"@%")
+	}
+
+	var users []ImageUser
+	if err := q.Scan(&users).Error; err != nil {
+		r.Log.Error(err)
+		return nil, FetchError
+	}
+
+	return users, nil
+}
@@ -0,0 +1,122 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/hub/api/pkg/testutils"
+)
+
+func TestImages(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{
+		Db:      tc.DB(),
+		Log:     tc.Logger("resource"),
+		Catalog: "catalog-official",
+		Kind:    "task",
+		Name:    "tkn",
+		Version: "0.1",
+	}
+
+	images, err := req.Images()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(images))
+	assert.Equal(t, "alpine:3.18", images[0].Ref)
+	assert.Equal(t, "gcr.io/tekton-releases/dogfooding/tkn", images[1].Repository)
+}
+
+func TestImages_NotFound(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{
+		Db:      tc.DB(),
+		Log:     tc.Logger("resource"),
+		Catalog: "catalog-official",
+		Kind:    "task",
+		Name:    "tkn",
+		Version: "0.9",
+	}
+
+	_, err := req.Images()
+	assert.Equal(t, NotFoundError, err)
+}
+
+func TestByImage(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), Limit: 100}
+
+	users, err := req.ByImage("alpine:3.18")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(users))
+	assert.Equal(t, "catalog-enterprise", users[0].Catalog)
+	assert.Equal(t, "tkn-enterprise", users[0].Name)
+	// the image pinned by digest is matched by its tag
+	assert.Equal(t, "tekton", users[1].Name)
+	assert.Equal(t, "alpine:3.18@sha256:4f8b", users[1].Image)
+	assert.Equal(t, "tkn", users[2].Name)
+	assert.Equal(t, "0.1", users[2].Version)
+}
+
+func TestByImage_Repository(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), Limit: 100}
+
+	users, err := req.ByImage("alpine")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(users))
+	assert.Equal(t, "alpine:3.19", users[2].Image)
+
+	req.Limit = 2
+	users, err = req.ByImage("alpine")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(users))
+}
+
+func TestByImage_PrivateCatalog(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	makePrivate(t, tc)
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), Limit: 100}
+
+	users, err := req.ByImage("alpine:3.18")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(users))
+
+	req.Scopes = []string{"catalog:enterprise"}
+	users, err = req.ByImage("alpine:3.18")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(users))
+}
+
+func TestByImage_Unknown(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), Limit: 100}
+
+	users, err := req.ByImage("alpine:3.1")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(users))
+}
@@ -0,0 +1,39 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import "github.com/tektoncd/hub/api/pkg/db/model"
+
+// LintResults returns the problems found by the lint rules in the
+// version of the resource, the most severe first
+// Fields: Catalog, Kind, Name, Version, Scopes
+func (r *Request) LintResults() ([]model.LintResult, error) {
+
+	version, err := r.visibleVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	var results []model.LintResult
+	err = r.Db.Where("version_id = ?", version.ID).
+		Order("CASE severity WHEN 'critical' THEN 0 WHEN 'warning' THEN 1 ELSE 2 END, id").
+		Find(&results).Error
+	if err != nil {
+		r.Log.Error(err)
+		return nil, FetchError
+	}
+
+	return results, nil
+}
@@ -0,0 +1,100 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/hub/api/pkg/testutils"
+)
+
+func TestLintResults(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{
+		Db:      tc.DB(),
+		Log:     tc.Logger("resource"),
+		Catalog: "catalog-official",
+		Kind:    "task",
+		Name:    "tkn",
+		Version: "0.1",
+	}
+
+	results, err := req.LintResults()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(results))
+	assert.Equal(t, "image-latest-tag", results[0].Rule)
+	assert.Equal(t, "run-as-non-root", results[1].Rule)
+	assert.Equal(t, "info", results[2].Severity)
+}
+
+func TestLintResults_NoResults(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{
+		Db:      tc.DB(),
+		Log:     tc.Logger("resource"),
+		Catalog: "catalog-official",
+		Kind:    "task",
+		Name:    "tekton",
+		Version: "0.1",
+	}
+
+	results, err := req.LintResults()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(results))
+}
+
+func TestLintResults_NotFound(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{
+		Db:      tc.DB(),
+		Log:     tc.Logger("resource"),
+		Catalog: "catalog-official",
+		Kind:    "task",
+		Name:    "tkn",
+		Version: "0.9",
+	}
+
+	_, err := req.LintResults()
+	assert.Equal(t, NotFoundError, err)
+}
+
+func TestLintResults_PrivateCatalog(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	makePrivate(t, tc)
+
+	req := Request{
+		Db:      tc.DB(),
+		Log:     tc.Logger("resource"),
+		Catalog: "catalog-enterprise",
+		Kind:    "task",
+		Name:    "tkn-enterprise",
+		Version: "0.1",
+	}
+
+	_, err := req.LintResults()
+	assert.Equal(t, NotFoundError, err)
+
+	req.Scopes = []string{"catalog:enterprise"}
+	_, err = req.LintResults()
+	assert.NoError(t, err)
+}
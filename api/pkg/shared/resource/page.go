@@ -0,0 +1,127 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"gorm.io/gorm"
+)
+
+// popularDays is the number of days of downloads popular resources are
+// sorted by
+const popularDays = 30
+
+// cursor is the position of a page in the list of resources sorted by
+// Sort, clients receive it as an opaque base64 encoded token
+type cursor struct {
+	Sort   string `json:"sort"`
+	Offset int    `json:"offset"`
+}
+
+func encodeCursor(sort string, offset int) string {
+	b, _ := json.Marshal(cursor{Sort: sort, Offset: offset})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeCursor returns the offset of the page, a cursor is valid only for
+// the sort it was created with
+func decodeCursor(token, sort string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, InvalidCursorError
+	}
+
+	var c cursor
+	if err := json.Unmarshal(b, &c); err != nil || c.Offset < 0 || c.Sort != sort {
+		return 0, InvalidCursorError
+	}
+
+	return c.Offset, nil
+}
+
+// byRelevance is true when resources are to be sorted by their rank of
+// the fulltext search
+func (r *Request) byRelevance() bool {
+	return r.Match == "fulltext" && r.Name != "" && r.Sort == ""
+}
+
+// selection returns the columns to select along with the columns that
+// resources are sorted by, as with DISTINCT Postgres requires the ORDER BY
+// expressions to be selected
+func (r *Request) selection(columns string) (string, []interface{}) {
+
+	var args []interface{}
+	if r.byRelevance() {
+		columns += ", ts_rank(resources.search_vector, plainto_tsquery('english', ?)) AS rank"
+		args = append(args, r.Name)
+	}
+
+	switch r.Sort {
+	case "updated":
+		columns += ", (SELECT MAX(srv.modified_at) FROM resource_versions AS srv WHERE srv.resource_id = resources.id) AS last_modified"
+	case "versions":
+		columns += ", (SELECT COUNT(srv.id) FROM resource_versions AS srv WHERE srv.resource_id = resources.id) AS version_count"
+	case "popular":
+		columns += ", (?) AS recent_downloads"
+		args = append(args, model.DownloadsSince(r.Db, model.Today().AddDate(0, 0, -popularDays)))
+	}
+
+	return columns, args
+}
+
+// orderResources defines a gorm scope to sort resources, resources.id is
+// always the last key so that the order is stable across pages
+func orderResources(sort string, byRelevance bool) func(db *gorm.DB) *gorm.DB {
+
+	var order string
+	switch sort {
+	case "name":
+		order = "resources.name"
+	case "updated":
+		order = "last_modified DESC, resources.name"
+	case "versions":
+		order = "version_count DESC, resources.name"
+	case "popular":
+		order = "recent_downloads DESC, resources.downloads DESC, rating DESC, resources.name"
+	default:
+		order = "rating DESC, resources.name"
+		if byRelevance {
+			order = "rank DESC, " + order
+		}
+	}
+
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Order(order + ", resources.id")
+	}
+}
+
+// paginate defines a gorm scope to fetch the page of resources at offset,
+// one more resource than the limit is fetched to find if there is a next page
+func paginate(offset int, limit uint) func(db *gorm.DB) *gorm.DB {
+	if limit != 0 {
+		limit++
+	}
+
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Offset(offset).Limit(int(limit))
+	}
+}
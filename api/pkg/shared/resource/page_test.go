@@ -0,0 +1,42 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor(t *testing.T) {
+	offset, err := decodeCursor(encodeCursor("updated", 20), "updated")
+	assert.NoError(t, err)
+	assert.Equal(t, 20, offset)
+
+	offset, err = decodeCursor("", "updated")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, offset)
+}
+
+func TestCursor_Invalid(t *testing.T) {
+	_, err := decodeCursor("not a cursor", "")
+	assert.Equal(t, InvalidCursorError, err)
+
+	_, err = decodeCursor(encodeCursor("name", 20), "rating")
+	assert.Equal(t, InvalidCursorError, err)
+
+	_, err = decodeCursor(encodeCursor("", -1), "")
+	assert.Equal(t, InvalidCursorError, err)
+}
@@ -16,40 +16,59 @@ package resource
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/tektoncd/hub/api/pkg/app"
 	"github.com/tektoncd/hub/api/pkg/db/model"
 	"github.com/tektoncd/hub/api/pkg/parser"
+	"github.com/tektoncd/hub/api/pkg/semver"
 	"gorm.io/gorm"
 )
 
 type Request struct {
-	Db         *gorm.DB
-	Log        *app.Logger
-	ID         uint
-	Name       string
-	Match      string
-	Kinds      []string
-	Catalogs   []string
-	Categories []string
-	Tags       []string
-	Platforms  []string
-	Limit      uint
-	Version    string
-	Kind       string
-	Catalog    string
-	VersionID  uint
+	Db          *gorm.DB
+	Log         *app.Logger
+	ID          uint
+	Name        string
+	Match       string
+	Kinds       []string
+	Catalogs    []string
+	Categories  []string
+	Tags        []string
+	Platforms   []string
+	APIVersions []string
+	Limit       uint
+	Version     string
+	Kind        string
+	Catalog     string
+	VersionID   uint
+	Sort        string
+	Cursor      string
+	// Prerelease includes pre-release versions e.g. 0.3.0-rc1 which
+	// are hidden by default
+	Prerelease bool
+	// Verifications are the results of the verification of the
+	// signatures of the versions to filter by e.g. verified
+	Verifications []string
+	// Scopes of the caller, the resources of private catalogs are
+	// returned only if one of the scopes is the scope of the catalog
+	Scopes []string
+	// Next is set by Query and AllResources to the cursor of the
+	// next page when there are more resources than the Limit
+	Next string
 }
 
 var (
-	FetchError    = fmt.Errorf("failed to fetch resources")
-	NotFoundError = fmt.Errorf("resource not found")
+	FetchError         = fmt.Errorf("failed to fetch resources")
+	NotFoundError      = fmt.Errorf("resource not found")
+	InvalidCursorError = fmt.Errorf("invalid cursor")
 )
 
 // Query resources based on name, kind, tags.
-// Match is the type of search: 'exact' or 'contains'
-// Fields: name, []kinds, []Catalogs, []Categories, []Tags, Match, Limit
+// Match is the type of search: 'exact', 'contains' or 'fulltext'
+// Sort is the order of resources: 'rating', 'name', 'updated', 'versions' or 'popular'
+// Fields: name, []kinds, []Catalogs, []Categories, []Tags, []APIVersions, []Verifications, Match, Limit, Sort, Cursor
 func (r *Request) Query() ([]model.Resource, error) {
 
 	// Validate the kinds passed are supported by Hub
@@ -59,40 +78,77 @@ func (r *Request) Query() ([]model.Resource, error) {
 		}
 	}
 
+	offset, err := decodeCursor(r.Cursor, r.Sort)
+	if err != nil {
+		return nil, err
+	}
+
 	// DISTINCT(resources.id) and resources.id is required as the
 	// INNER JOIN of tags and resources returns duplicate records of
 	// resources as a resource may have multiple tags, thus we have to
 	// find DISTINCT on resource.id
 
-	r.Db = r.Db.Select("DISTINCT(resources.id), resources.*").Scopes(
+	selection, args := r.selection("DISTINCT(resources.id), resources.*")
+	r.Db = r.Db.Select(selection, args...).Scopes(
+		r.visibleResources,
 		filterByTags(r.Tags),
 		filterByPlatforms(r.Platforms),
+		filterByAPIVersions(r.APIVersions),
+		filterByVerifications(r.Verifications),
 		filterByKinds(r.Kinds),
 		filterByCatalogs(r.Catalogs),
 		filterByCategories(r.Categories),
 		filterResourceName(r.Match, r.Name),
 		withResourceDetails,
-	).Limit(int(r.Limit))
+		orderResources(r.Sort, r.byRelevance()),
+		paginate(offset, r.Limit),
+	)
+
+	rs, err := r.findPage(offset)
+	if err != nil {
+		return nil, err
+	}
 
-	return r.findAllResources()
+	for i := range rs {
+		rs[i].Versions = r.releases(rs[i].Versions)
+	}
+	return rs, nil
 }
 
 // AllResources returns all resources in db sorted by rating and name
 // Limit can be passed for limiting number of resources
-// Field: Limit
+// Field: Limit, Sort, Cursor
 func (r *Request) AllResources() ([]model.Resource, error) {
 
-	r.Db = r.Db.Scopes(withResourceDetails).
-		Limit(int(r.Limit))
+	offset, err := decodeCursor(r.Cursor, r.Sort)
+	if err != nil {
+		return nil, err
+	}
 
-	return r.findAllResources()
+	selection, args := r.selection("resources.*")
+	r.Db = r.Db.Select(selection, args...).Scopes(
+		r.visibleResources,
+		withResourceDetails,
+		orderResources(r.Sort, false),
+		paginate(offset, r.Limit),
+	)
+
+	rs, err := r.findPage(offset)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rs {
+		rs[i].Versions = r.releases(rs[i].Versions)
+	}
+	return rs, nil
 }
 
-// AllVersions returns all versions of a resource
-// Fields: ID (Resource ID)
+// AllVersions returns all versions of a resource sorted by semver
+// Fields: ID (Resource ID), Prerelease
 func (r *Request) AllVersions() ([]model.ResourceVersion, error) {
 
-	q := r.Db.Scopes(orderByVersion, filterByResourceID(r.ID)).Preload("Platforms", orderByPlatforms).Preload("Resource").Preload("Resource.Catalog")
+	q := r.Db.Scopes(r.visibleVersions, filterByResourceID(r.ID)).Preload("Platforms", orderByPlatforms).Preload("Resource").Preload("Resource.Catalog")
 
 	var all []model.ResourceVersion
 	if err := q.Find(&all).Error; err != nil {
@@ -104,7 +160,7 @@ func (r *Request) AllVersions() ([]model.ResourceVersion, error) {
 		return nil, NotFoundError
 	}
 
-	return all, nil
+	return r.releases(all), nil
 }
 
 // ByCatalogKindNameVersion searches resource by catalog name, kind, resource name, and version
@@ -112,6 +168,7 @@ func (r *Request) AllVersions() ([]model.ResourceVersion, error) {
 func (r *Request) ByCatalogKindNameVersion() (model.Resource, error) {
 
 	q := r.Db.Scopes(
+		r.visibleResources,
 		withVersionInfo(r.Version),
 		filterByCatalog(r.Catalog),
 		filterByKind(r.Kind),
@@ -125,11 +182,30 @@ func (r *Request) ByCatalogKindNameVersion() (model.Resource, error) {
 	return res, nil
 }
 
+// visibleVersion finds the version of a resource by catalog name, kind,
+// resource name and version among the versions visible to the caller
+func (r *Request) visibleVersion() (model.ResourceVersion, error) {
+
+	q := r.Db.Model(&model.ResourceVersion{}).
+		Scopes(r.visibleVersions).
+		Joins("JOIN resources AS r ON r.id = resource_versions.resource_id").
+		Joins("JOIN catalogs AS c ON c.id = r.catalog_id").
+		Where("LOWER(c.name) = ? AND LOWER(r.kind) = ? AND r.name = ?",
+			strings.ToLower(r.Catalog), strings.ToLower(r.Kind), r.Name).
+		Where("resource_versions.version = ?", r.Version)
+
+	var version model.ResourceVersion
+	if err := findOne(q, r.Log, &version); err != nil {
+		return model.ResourceVersion{}, err
+	}
+	return version, nil
+}
+
 // ByVersionID searches resource version by its ID
 // Field: VersionID
 func (r *Request) ByVersionID() (model.ResourceVersion, error) {
 
-	q := r.Db.Scopes(withResourceVersionDetails, filterByVersionID(r.VersionID)).Preload("Platforms", orderByPlatforms)
+	q := r.Db.Scopes(r.visibleVersions, withResourceVersionDetails, filterByVersionID(r.VersionID)).Preload("Platforms", orderByPlatforms)
 
 	var v model.ResourceVersion
 	if err := findOne(q, r.Log, &v); err != nil {
@@ -140,10 +216,11 @@ func (r *Request) ByVersionID() (model.ResourceVersion, error) {
 }
 
 // ByCatalogKindName searches resource by catalog name, kind and resource name
-// Fields: Catalog, Kind, Name
+// Fields: Catalog, Kind, Name, Prerelease
 func (r *Request) ByCatalogKindName() (model.Resource, error) {
 
 	q := r.Db.Scopes(
+		r.visibleResources,
 		withResourceDetails,
 		filterByCatalog(r.Catalog),
 		filterByKind(r.Kind),
@@ -154,6 +231,7 @@ func (r *Request) ByCatalogKindName() (model.Resource, error) {
 		return model.Resource{}, err
 	}
 
+	res.Versions = r.releases(res.Versions)
 	return res, nil
 }
 
@@ -161,16 +239,34 @@ func (r *Request) ByCatalogKindName() (model.Resource, error) {
 // Field: ID
 func (r *Request) ByID() (model.Resource, error) {
 
-	q := r.Db.Scopes(withResourceDetails, filterByID(r.ID))
+	q := r.Db.Scopes(r.visibleResources, withResourceDetails, filterByID(r.ID))
 
 	var res model.Resource
 	if err := findOne(q, r.Log, &res); err != nil {
 		return model.Resource{}, err
 	}
 
+	res.Versions = r.releases(res.Versions)
 	return res, nil
 }
 
+// findPage finds a page of resources and sets Next if there are more
+// resources than the limit
+func (r *Request) findPage(offset int) ([]model.Resource, error) {
+
+	rs, err := r.findAllResources()
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Limit != 0 && uint(len(rs)) > r.Limit {
+		rs = rs[:r.Limit]
+		r.Next = encodeCursor(r.Sort, offset+int(r.Limit))
+	}
+
+	return rs, nil
+}
+
 func (r *Request) findAllResources() ([]model.Resource, error) {
 
 	var rs []model.Resource
@@ -186,6 +282,41 @@ func (r *Request) findAllResources() ([]model.Resource, error) {
 	return rs, nil
 }
 
+// CatalogVisible checks if the Catalog exists and is visible to the
+// caller, NotFoundError is returned for a private catalog the caller
+// is not allowed to see so that its existence is not disclosed
+// Fields: Catalog, Scopes
+func (r *Request) CatalogVisible() error {
+
+	q := r.Db.Model(&model.Catalog{}).
+		Scopes(model.VisibleCatalogs(r.Scopes)).
+		Where("LOWER(catalogs.name) = ?", strings.ToLower(r.Catalog))
+
+	var count int64
+	if err := q.Count(&count).Error; err != nil {
+		r.Log.Error(err)
+		return FetchError
+	}
+	if count == 0 {
+		return NotFoundError
+	}
+	return nil
+}
+
+// visibleResources defines a gorm scope filtering out the resources of
+// catalogs not visible to the caller
+func (r *Request) visibleResources(db *gorm.DB) *gorm.DB {
+	return db.Where("resources.catalog_id IN (?)", model.VisibleCatalogIDs(db, r.Scopes))
+}
+
+// visibleVersions defines a gorm scope filtering out the resource versions
+// of catalogs not visible to the caller
+func (r *Request) visibleVersions(db *gorm.DB) *gorm.DB {
+	resources := db.Session(&gorm.Session{NewDB: true}).Model(&model.Resource{}).
+		Select("resources.id").Scopes(r.visibleResources)
+	return db.Where("resource_versions.resource_id IN (?)", resources)
+}
+
 func withCatalogAndTags(db *gorm.DB) *gorm.DB {
 	return db.
 		Preload("Catalog").
@@ -195,10 +326,9 @@ func withCatalogAndTags(db *gorm.DB) *gorm.DB {
 // withResourceDetails defines a gorm scope to include all details of resource.
 func withResourceDetails(db *gorm.DB) *gorm.DB {
 	return db.
-		Order("rating DESC, resources.name").
 		Preload("Categories", orderByCategories).
 		Scopes(withCatalogAndTags).
-		Preload("Versions", orderByVersion).
+		Preload("Versions").
 		Preload("Versions.Platforms", orderByPlatforms).
 		Preload("Platforms", orderByPlatforms)
 }
@@ -247,8 +377,30 @@ func orderByPlatforms(db *gorm.DB) *gorm.DB {
 	return db.Order("platforms.name ASC")
 }
 
-func orderByVersion(db *gorm.DB) *gorm.DB {
-	return db.Order("string_to_array(version, '.')::int[]")
+// releases sorts versions by semver and drops the pre-release versions
+// unless Prerelease is set. The versions are kept as is if there are only
+// pre-release versions so that the resource still has a latest version.
+func (r *Request) releases(versions []model.ResourceVersion) []model.ResourceVersion {
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		return semver.Less(versions[i].Version, versions[j].Version)
+	})
+
+	if r.Prerelease {
+		return versions
+	}
+
+	var stable []model.ResourceVersion
+	for _, v := range versions {
+		if !semver.IsPrerelease(v.Version) {
+			stable = append(stable, v)
+		}
+	}
+
+	if len(stable) == 0 {
+		return versions
+	}
+	return stable
 }
 
 func findOne(db *gorm.DB, log *app.Logger, result interface{}) error {
@@ -328,6 +480,36 @@ func filterByPlatforms(platforms []string) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
+// filterByAPIVersions matches resources having at least one version
+// with one of the given apiVersions e.g. tekton.dev/v1
+func filterByAPIVersions(apiVersions []string) func(db *gorm.DB) *gorm.DB {
+	if len(apiVersions) == 0 {
+		return noop
+	}
+
+	apiVersions = lower(apiVersions)
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Model(&model.Resource{}).
+			Joins("JOIN resource_versions as rv on rv.resource_id = resources.id").
+			Where("lower(rv.api_version) in (?)", apiVersions)
+	}
+}
+
+// filterByVerifications matches resources having at least one version
+// with one of the given verification results e.g. verified
+func filterByVerifications(verifications []string) func(db *gorm.DB) *gorm.DB {
+	if len(verifications) == 0 {
+		return noop
+	}
+
+	verifications = lower(verifications)
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Model(&model.Resource{}).
+			Joins("JOIN resource_versions as vv on vv.resource_id = resources.id").
+			Where("vv.verification in (?)", verifications)
+	}
+}
+
 func filterByCategories(categories []string) func(db *gorm.DB) *gorm.DB {
 	if categories == nil {
 		return noop
@@ -364,6 +546,11 @@ func filterResourceName(match, name string) func(db *gorm.DB) *gorm.DB {
 		return func(db *gorm.DB) *gorm.DB {
 			return db.Where("LOWER(resources.name) = ?", name)
 		}
+	case "fulltext":
+		// matches name, display name, description, tags and categories
+		return func(db *gorm.DB) *gorm.DB {
+			return db.Where("resources.search_vector @@ plainto_tsquery('english', ?)", name)
+		}
 	default:
 		likeName := "%" + name + "%"
 		return func(db *gorm.DB) *gorm.DB {
@@ -402,18 +589,19 @@ func invalidKindError(kind string) error {
 		kind, parser.SupportedKinds())
 }
 
-// Fetch the latest verion of a resource
+// Fetch the latest verion of a resource, pre-release versions are
+// skipped unless Prerelease is set
 func (r *Request) GetLatestVersion() (string, error) {
 
 	var catalogInfo *model.Catalog
-	if err := r.Db.Where(&model.Catalog{Name: r.Catalog}).First(&catalogInfo).Error; err != nil {
+	if err := r.Db.Scopes(model.VisibleCatalogs(r.Scopes)).Where(&model.Catalog{Name: r.Catalog}).First(&catalogInfo).Error; err != nil {
 		return "", err
 	}
 
-	fmt.Println(catalogInfo.Name)
-
+	// kind is matched case insensitively as strings.Title would not
+	// map a kind like stepaction to StepAction
 	var resInfo *model.Resource
-	if err := r.Db.Where(&model.Resource{Name: r.Name, Kind: strings.Title(r.Kind), CatalogID: catalogInfo.ID}).Find(&resInfo).Error; err != nil {
+	if err := r.Db.Where(&model.Resource{Name: r.Name, CatalogID: catalogInfo.ID}).Scopes(filterByKind(r.Kind)).Find(&resInfo).Error; err != nil {
 		return "", err
 	}
 
@@ -422,5 +610,8 @@ func (r *Request) GetLatestVersion() (string, error) {
 	if err := r.Db.Model(&model.ResourceVersion{}).Select("version").Where(&model.ResourceVersion{ResourceID: resInfo.ID}).Find(&allVersions).Error; err != nil {
 		return "", err
 	}
-	return allVersions[len(allVersions)-1], nil
+	if len(allVersions) == 0 {
+		return "", NotFoundError
+	}
+	return semver.Latest(allVersions, r.Prerelease), nil
 }
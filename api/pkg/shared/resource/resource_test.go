@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/hub/api/pkg/db/model"
 	"github.com/tektoncd/hub/api/pkg/testutils"
 )
 
@@ -55,6 +56,86 @@ func TestQuery_ByLimit(t *testing.T) {
 	assert.Equal(t, "tekton", res[0].Name)
 }
 
+func TestQuery_NextPage(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{
+		Db:    tc.DB(),
+		Log:   tc.Logger("resource"),
+		Limit: 6,
+	}
+
+	res, err := req.Query()
+	assert.NoError(t, err)
+	assert.Equal(t, 6, len(res))
+	assert.NotEmpty(t, req.Next)
+
+	req = Request{
+		Db:     tc.DB(),
+		Log:    tc.Logger("resource"),
+		Limit:  6,
+		Cursor: req.Next,
+	}
+
+	res, err = req.Query()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(res))
+	assert.Empty(t, req.Next)
+}
+
+func TestQuery_SortByName(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{
+		Db:    tc.DB(),
+		Log:   tc.Logger("resource"),
+		Limit: 2,
+		Sort:  "name",
+	}
+
+	res, err := req.Query()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(res))
+	assert.Equal(t, "build-pipeline", res[0].Name)
+	assert.Equal(t, "buildah", res[1].Name)
+}
+
+func TestQuery_SortByVersions(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{
+		Db:    tc.DB(),
+		Log:   tc.Logger("resource"),
+		Limit: 100,
+		Sort:  "versions",
+	}
+
+	res, err := req.Query()
+	assert.NoError(t, err)
+	assert.Equal(t, 8, len(res))
+	assert.Equal(t, "tekton", res[0].Name)
+}
+
+func TestQuery_InvalidCursor(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{
+		Db:     tc.DB(),
+		Log:    tc.Logger("resource"),
+		Limit:  2,
+		Sort:   "name",
+		Cursor: encodeCursor("rating", 2),
+	}
+
+	_, err := req.Query()
+	assert.Error(t, err)
+	assert.Equal(t, InvalidCursorError, err)
+}
+
 func TestQuery_ByName(t *testing.T) {
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
@@ -90,6 +171,44 @@ func TestQuery_ByPartialName(t *testing.T) {
 	assert.Equal(t, 2, len(res))
 }
 
+func TestQuery_ByFullText(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{
+		Db:    tc.DB(),
+		Log:   tc.Logger("resource"),
+		Name:  "cli",
+		Match: "fulltext",
+		Kinds: []string{},
+		Limit: 100,
+	}
+
+	// matches display names Tekton CLI and Tkn CLI
+	res, err := req.Query()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(res))
+}
+
+func TestQuery_ByFullTextDescription(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{
+		Db:    tc.DB(),
+		Log:   tc.Logger("resource"),
+		Name:  "desc4",
+		Match: "fulltext",
+		Kinds: []string{},
+		Limit: 100,
+	}
+
+	res, err := req.Query()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, "buildah", res[0].Name)
+}
+
 func TestQuery_ByKind(t *testing.T) {
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
@@ -163,6 +282,44 @@ func TestQuery_ByPlatforms(t *testing.T) {
 	assert.Equal(t, "buildah", res[2].Name)
 }
 
+func TestQuery_ByAPIVersions(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{
+		Db:          tc.DB(),
+		Log:         tc.Logger("resource"),
+		Name:        "",
+		Kinds:       []string{},
+		APIVersions: []string{"tekton.dev/v1"},
+		Limit:       100,
+	}
+
+	res, err := req.Query()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, "tkn", res[0].Name)
+}
+
+func TestQuery_ByVerifications(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{
+		Db:            tc.DB(),
+		Log:           tc.Logger("resource"),
+		Name:          "",
+		Kinds:         []string{},
+		Verifications: []string{"verified"},
+		Limit:         100,
+	}
+
+	res, err := req.Query()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, "tkn", res[0].Name)
+}
+
 func TestQuery_ByCatalogs(t *testing.T) {
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
@@ -558,3 +715,139 @@ func TestGetLatestVersion_NotFoundError(t *testing.T) {
 	_, err := req.GetLatestVersion()
 	assert.Equal(t, err.Error(), "record not found")
 }
+
+// makePrivate marks the catalog-enterprise private, visible to the users
+// having catalog:enterprise scope
+func makePrivate(t *testing.T, tc *testutils.TestConfig) {
+	err := tc.DB().Model(&model.Catalog{}).Where("name = ?", "catalog-enterprise").
+		Updates(map[string]interface{}{"private": true, "scope": "catalog:enterprise"}).Error
+	assert.NoError(t, err)
+}
+
+func TestQuery_PrivateCatalog(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	makePrivate(t, tc)
+
+	req := Request{
+		Db:    tc.DB(),
+		Log:   tc.Logger("resource"),
+		Limit: 100,
+	}
+
+	res, err := req.Query()
+	assert.NoError(t, err)
+	assert.Equal(t, 7, len(res))
+
+	req = Request{
+		Db:     tc.DB(),
+		Log:    tc.Logger("resource"),
+		Limit:  100,
+		Scopes: []string{"catalog:enterprise"},
+	}
+
+	res, err = req.Query()
+	assert.NoError(t, err)
+	assert.Equal(t, 8, len(res))
+}
+
+func TestList_PrivateCatalog(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	makePrivate(t, tc)
+
+	req := Request{
+		Db:     tc.DB(),
+		Log:    tc.Logger("resource"),
+		Limit:  100,
+		Scopes: []string{model.PrivateScope},
+	}
+
+	res, err := req.AllResources()
+	assert.NoError(t, err)
+	assert.Equal(t, 7, len(res))
+}
+
+func TestByID_PrivateCatalog(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	makePrivate(t, tc)
+
+	req := Request{
+		Db:  tc.DB(),
+		Log: tc.Logger("resource"),
+		ID:  8,
+	}
+
+	_, err := req.ByID()
+	assert.EqualError(t, err, "resource not found")
+
+	req.Scopes = []string{"catalog:enterprise"}
+	res, err := req.ByID()
+	assert.NoError(t, err)
+	assert.Equal(t, "tkn-enterprise", res.Name)
+}
+
+func TestByVersionID_PrivateCatalog(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	makePrivate(t, tc)
+
+	req := Request{
+		Db:        tc.DB(),
+		Log:       tc.Logger("resource"),
+		VersionID: 11,
+	}
+
+	_, err := req.ByVersionID()
+	assert.EqualError(t, err, "resource not found")
+
+	req.Scopes = []string{"catalog:enterprise"}
+	res, err := req.ByVersionID()
+	assert.NoError(t, err)
+	assert.Equal(t, "0.1", res.Version)
+}
+
+func TestCatalogVisible(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	makePrivate(t, tc)
+
+	req := Request{
+		Db:      tc.DB(),
+		Log:     tc.Logger("resource"),
+		Catalog: "catalog-official",
+	}
+	assert.NoError(t, req.CatalogVisible())
+
+	req.Catalog = "Catalog-Enterprise"
+	assert.EqualError(t, req.CatalogVisible(), "resource not found")
+
+	req.Scopes = []string{"catalog:enterprise"}
+	assert.NoError(t, req.CatalogVisible())
+}
+
+func TestReleases(t *testing.T) {
+	versions := func() []model.ResourceVersion {
+		return []model.ResourceVersion{
+			{Version: "0.10"}, {Version: "0.3.0-rc1"}, {Version: "0.9"}, {Version: "0.2"},
+		}
+	}
+	names := func(vs []model.ResourceVersion) []string {
+		var n []string
+		for _, v := range vs {
+			n = append(n, v.Version)
+		}
+		return n
+	}
+
+	req := Request{}
+	assert.Equal(t, []string{"0.2", "0.9", "0.10"}, names(req.releases(versions())))
+
+	req.Prerelease = true
+	assert.Equal(t, []string{"0.2", "0.3.0-rc1", "0.9", "0.10"}, names(req.releases(versions())))
+
+	req.Prerelease = false
+	onlyRC := []model.ResourceVersion{{Version: "0.1.0-rc2"}, {Version: "0.1.0-rc1"}}
+	assert.Equal(t, []string{"0.1.0-rc1", "0.1.0-rc2"}, names(req.releases(onlyRC)))
+}
@@ -0,0 +1,115 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"fmt"
+
+	"github.com/tektoncd/hub/api/pkg/db/model"
+)
+
+// reviewSort is the sort recorded in the cursors of the pages of reviews
+const reviewSort = "reviews"
+
+var VersionNotFoundError = fmt.Errorf("version not found for resource")
+
+// Reviews returns the reviews of a resource, the latest review first.
+// A resource without reviews has an empty list of reviews
+// Fields: ID (Resource ID), Limit, Cursor, Scopes
+func (r *Request) Reviews() ([]model.Review, error) {
+
+	offset, err := decodeCursor(r.Cursor, reviewSort)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := r.visibleResource(); err != nil {
+		return nil, err
+	}
+
+	q := r.Db.Preload("Version").
+		Where("resource_id = ?", r.ID).
+		Order("created_at DESC, id DESC").
+		Scopes(paginate(offset, r.Limit))
+
+	var reviews []model.Review
+	if err := q.Find(&reviews).Error; err != nil {
+		r.Log.Error(err)
+		return nil, FetchError
+	}
+
+	if r.Limit != 0 && uint(len(reviews)) > r.Limit {
+		reviews = reviews[:r.Limit]
+		r.Next = encodeCursor(reviewSort, offset+int(r.Limit))
+	}
+
+	return reviews, nil
+}
+
+// ReviewedVersion finds the version of a resource which is reviewed,
+// NotFoundError is returned if the resource is not visible to the caller
+// and VersionNotFoundError if the resource has no such version
+// Fields: ID (Resource ID), Version, Scopes
+func (r *Request) ReviewedVersion() (model.ResourceVersion, error) {
+
+	res, err := r.visibleResource()
+	if err != nil {
+		return model.ResourceVersion{}, err
+	}
+
+	q := r.Db.Where("resource_id = ? AND version = ?", res.ID, r.Version)
+
+	var version model.ResourceVersion
+	if err := findOne(q, r.Log, &version); err != nil {
+		if err == NotFoundError {
+			return model.ResourceVersion{}, VersionNotFoundError
+		}
+		return model.ResourceVersion{}, err
+	}
+	version.Resource = res
+	return version, nil
+}
+
+// visibleResource finds the resource of ID among the resources visible
+// to the caller
+func (r *Request) visibleResource() (model.Resource, error) {
+
+	q := r.Db.Scopes(r.visibleResources, filterByID(r.ID))
+
+	var res model.Resource
+	if err := findOne(q, r.Log, &res); err != nil {
+		return model.Resource{}, err
+	}
+	return res, nil
+}
+
+// UserReview finds a review of the resource written by the user, the
+// reviews of other users are not found
+// Fields: ID (Resource ID), Scopes
+func (r *Request) UserReview(userID, reviewID uint) (model.Review, error) {
+
+	if _, err := r.visibleResource(); err != nil {
+		return model.Review{}, err
+	}
+
+	q := r.Db.Preload("Version").
+		Where("id = ? AND resource_id = ? AND user_id = ?", reviewID, r.ID, userID)
+
+	var review model.Review
+	if err := findOne(q, r.Log, &review); err != nil {
+		return model.Review{}, err
+	}
+	return review, nil
+}
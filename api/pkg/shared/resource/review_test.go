@@ -0,0 +1,118 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/hub/api/pkg/testutils"
+)
+
+func TestReviews(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 1, Limit: 100}
+
+	reviews, err := req.Reviews()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(reviews))
+	assert.Equal(t, uint(3), reviews[0].ID)
+	assert.Equal(t, "0.1.1", reviews[0].Version.Version)
+	assert.Equal(t, "", req.Next)
+}
+
+func TestReviews_NextPage(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 1, Limit: 2}
+
+	reviews, err := req.Reviews()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(reviews))
+	assert.NotEmpty(t, req.Next)
+
+	req = Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 1, Limit: 2, Cursor: req.Next}
+
+	reviews, err = req.Reviews()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(reviews))
+	assert.Equal(t, uint(1), reviews[0].ID)
+	assert.Equal(t, "", req.Next)
+}
+
+func TestReviews_InvalidCursor(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// a cursor of the list of resources is not valid for reviews
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 1, Limit: 2, Cursor: encodeCursor("name", 2)}
+
+	_, err := req.Reviews()
+	assert.Equal(t, InvalidCursorError, err)
+}
+
+func TestReviews_NoReviews(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 2, Limit: 100}
+
+	reviews, err := req.Reviews()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(reviews))
+}
+
+func TestReviews_NotFound(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 111, Limit: 100}
+
+	_, err := req.Reviews()
+	assert.Equal(t, NotFoundError, err)
+}
+
+func TestReviewedVersion(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 1, Version: "0.2"}
+
+	version, err := req.ReviewedVersion()
+	assert.NoError(t, err)
+	assert.Equal(t, uint(5), version.ID)
+
+	req.Version = "9.9"
+	_, err = req.ReviewedVersion()
+	assert.Equal(t, VersionNotFoundError, err)
+}
+
+func TestUserReview(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{Db: tc.DB(), Log: tc.Logger("resource"), ID: 1}
+
+	review, err := req.UserReview(11, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.1", review.Version.Version)
+
+	// the review is written by another user
+	_, err = req.UserReview(11, 2)
+	assert.Equal(t, NotFoundError, err)
+}
@@ -0,0 +1,43 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"github.com/tektoncd/hub/api/pkg/db/model"
+)
+
+// Schema is the interface of a version of a resource
+type Schema struct {
+	Params     []model.VersionParam
+	Workspaces []model.VersionWorkspace
+	Results    []model.VersionResult
+}
+
+// Schema returns the params, workspaces and results of a version of a
+// resource in the order they are declared. The version is expected to
+// be visible to the caller e.g. found by ByCatalogKindNameVersion
+// Field: VersionID
+func (r *Request) Schema() (Schema, error) {
+
+	var schema Schema
+	for _, list := range []interface{}{&schema.Params, &schema.Workspaces, &schema.Results} {
+		if err := r.Db.Where("version_id = ?", r.VersionID).Order("id").Find(list).Error; err != nil {
+			r.Log.Error(err)
+			return Schema{}, FetchError
+		}
+	}
+
+	return schema, nil
+}
@@ -0,0 +1,61 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/hub/api/pkg/testutils"
+)
+
+func TestSchema(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{
+		Db:        tc.DB(),
+		Log:       tc.Logger("resource"),
+		VersionID: 8,
+	}
+
+	schema, err := req.Schema()
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(schema.Params))
+	assert.Equal(t, "ARGS", schema.Params[0].Name)
+	assert.Equal(t, `["--help"]`, *schema.Params[0].Default)
+	assert.Nil(t, schema.Params[2].Default)
+	assert.Equal(t, 1, len(schema.Workspaces))
+	assert.True(t, schema.Workspaces[0].Optional)
+	assert.Equal(t, 1, len(schema.Results))
+	assert.Equal(t, "OUTPUT", schema.Results[0].Name)
+}
+
+func TestSchema_NoInterface(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	req := Request{
+		Db:        tc.DB(),
+		Log:       tc.Logger("resource"),
+		VersionID: 1,
+	}
+
+	schema, err := req.Schema()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(schema.Params))
+	assert.Equal(t, 0, len(schema.Workspaces))
+	assert.Equal(t, 0, len(schema.Results))
+}
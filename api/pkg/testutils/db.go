@@ -21,6 +21,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/tektoncd/hub/api/pkg/app"
 	"github.com/tektoncd/hub/api/pkg/db/migration"
+	"github.com/tektoncd/hub/api/pkg/db/model"
 )
 
 // LoadFixtures is called before executing each test, it clears db and
@@ -35,6 +36,10 @@ func LoadFixtures(t *testing.T, dir string) {
 		testfixtures.Directory(dir))
 	assert.NoError(t, err)
 	assert.NoError(t, fixtures.Load())
+
+	// fixtures are inserted directly and skip the syncer which
+	// maintains the full text search document of resources
+	assert.NoError(t, model.RefreshSearchVector(tc.DB()))
 }
 
 // applyMigration creates tables in test db
@@ -110,6 +110,14 @@ func (tc *TestConfig) AgentWithScopes(name string, scopes ...string) (*model.Use
 		return nil, "", err
 	}
 
+	// tokens of an agent without a token id are revoked
+	if agent.TokenID == "" {
+		agent.TokenID = token.NewID()
+		if err := tc.DB().Model(agent).UpdateColumn("token_id", agent.TokenID).Error; err != nil {
+			return nil, "", err
+		}
+	}
+
 	token.Now = Now
 
 	req := token.Request{User: agent, Scopes: scopes, JWTConfig: tc.JWTConfig()}
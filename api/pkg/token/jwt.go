@@ -15,34 +15,84 @@
 package token
 
 import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
 	"fmt"
+	"math/big"
 
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/tektoncd/hub/api/pkg/app"
 	"goa.design/goa/v3/security"
 )
 
-// Create takes claim and jwtkey and returns a signed token
-func Create(claim jwt.Claims, jwtKey string) (string, error) {
+// JWK is the public part of a signing key as described in RFC 7517
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claim)
+// JWKSet is the list of keys served at /.well-known/jwks.json
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
 
-	jwt, err := token.SignedString([]byte(jwtKey))
-	if err != nil {
-		return "", err
+// Create takes claim and jwt config and returns a signed token. If keys
+// are configured the token is signed using the key with SigningKeyID and
+// its kid is added in the header, else the SigningKey is used with HS256
+func Create(claim jwt.Claims, conf *app.JWTConfig) (string, error) {
+
+	if len(conf.Keys) == 0 {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claim)
+		return token.SignedString([]byte(conf.SigningKey))
 	}
 
-	return jwt, nil
+	key, ok := conf.Key(conf.SigningKeyID)
+	if !ok {
+		return "", fmt.Errorf("no jwt key found with id %s", conf.SigningKeyID)
+	}
+
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm()), claim)
+	token.Header["kid"] = key.ID
+
+	return token.SignedString(key.Signer)
 }
 
-// Verify takes jwt and key and verify if jwt is valid and not expired
-func Verify(token string, jwtKey string) (jwt.MapClaims, error) {
+// Verify takes jwt and config and verify if jwt is valid and not expired.
+// The key is looked up using the kid of the token so that tokens signed
+// with any of the keys are valid, tokens without kid are the ones signed
+// using the SigningKey
+func Verify(token string, conf *app.JWTConfig) (jwt.MapClaims, error) {
 
 	claims := make(jwt.MapClaims)
 
 	// Parse JWT token
 	_, err := jwt.ParseWithClaims(token, claims,
-		func(_ *jwt.Token) (interface{}, error) {
-			return []byte(jwtKey), nil
+		func(t *jwt.Token) (interface{}, error) {
+
+			kid, _ := t.Header["kid"].(string)
+			if kid == "" {
+				if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok || conf.SigningKey == "" {
+					return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+				}
+				return []byte(conf.SigningKey), nil
+			}
+
+			key, ok := conf.Key(kid)
+			if !ok {
+				return nil, fmt.Errorf("unknown kid: %s", kid)
+			}
+			if t.Method.Alg() != key.Algorithm() {
+				return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+			}
+			return key.Signer.Public(), nil
 		})
 	if err != nil {
 		return nil, err
@@ -51,6 +101,35 @@ func Verify(token string, jwtKey string) (jwt.MapClaims, error) {
 	return claims, nil
 }
 
+// JWKS returns the public keys which can be used to verify the tokens
+func JWKS(conf *app.JWTConfig) JWKSet {
+
+	set := JWKSet{Keys: []JWK{}}
+	for _, k := range conf.Keys {
+		jwk := JWK{Kid: k.ID, Use: "sig", Alg: k.Algorithm()}
+
+		switch pub := k.Signer.Public().(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = encode(pub.N.Bytes())
+			jwk.E = encode(big.NewInt(int64(pub.E)).Bytes())
+		case *ecdsa.PublicKey:
+			size := (pub.Curve.Params().BitSize + 7) / 8
+			jwk.Kty = "EC"
+			jwk.Crv = pub.Curve.Params().Name
+			jwk.X = encode(pub.X.FillBytes(make([]byte, size)))
+			jwk.Y = encode(pub.Y.FillBytes(make([]byte, size)))
+		}
+
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
 // ValidateScopes takes user scopes and checks if it has the scope which
 // is required for accessing the api
 func ValidateScopes(claims jwt.MapClaims, scheme *security.JWTScheme) error {
@@ -74,3 +153,20 @@ func ValidateScopes(claims jwt.MapClaims, scheme *security.JWTScheme) error {
 
 	return nil
 }
+
+// Scopes returns the scopes of the user in the claims of a token
+func Scopes(claims jwt.MapClaims) []string {
+
+	scopes, ok := claims["scopes"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	res := []string{}
+	for _, scp := range scopes {
+		if s, ok := scp.(string); ok {
+			res = append(res, s)
+		}
+	}
+	return res
+}
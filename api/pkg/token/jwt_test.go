@@ -0,0 +1,125 @@
+// Copyright © 2026 The Tekton Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package token
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/hub/api/pkg/app"
+)
+
+func testConfig(t *testing.T) *app.JWTConfig {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	return &app.JWTConfig{
+		SigningKey: "secret",
+		Keys: []app.JWTKey{
+			{ID: "rsa", Signer: rsaKey},
+			{ID: "ec", Signer: ecKey},
+		},
+		SigningKeyID: "rsa",
+	}
+}
+
+func TestCreateVerify_Keys(t *testing.T) {
+	conf := testConfig(t)
+
+	for _, kid := range []string{"rsa", "ec"} {
+		conf.SigningKeyID = kid
+		signed, err := Create(jwt.MapClaims{"id": 11}, conf)
+		assert.NoError(t, err)
+
+		parsed, _, err := new(jwt.Parser).ParseUnverified(signed, jwt.MapClaims{})
+		assert.NoError(t, err)
+		assert.Equal(t, kid, parsed.Header["kid"])
+
+		claims, err := Verify(signed, conf)
+		assert.NoError(t, err)
+		assert.Equal(t, float64(11), claims["id"])
+	}
+}
+
+func TestVerify_RotatedKey(t *testing.T) {
+	conf := testConfig(t)
+
+	signed, err := Create(jwt.MapClaims{"id": 11}, conf)
+	assert.NoError(t, err)
+
+	// a token signed with the previous key is valid until the key is removed
+	conf.SigningKeyID = "ec"
+	_, err = Verify(signed, conf)
+	assert.NoError(t, err)
+
+	conf.Keys = conf.Keys[1:]
+	_, err = Verify(signed, conf)
+	assert.Error(t, err)
+}
+
+func TestVerify_SigningKey(t *testing.T) {
+	conf := testConfig(t)
+
+	// tokens signed before the keys were configured
+	signed, err := Create(jwt.MapClaims{"id": 11}, &app.JWTConfig{SigningKey: "secret"})
+	assert.NoError(t, err)
+
+	_, err = Verify(signed, conf)
+	assert.NoError(t, err)
+
+	conf.SigningKey = ""
+	_, err = Verify(signed, conf)
+	assert.Error(t, err)
+}
+
+func TestVerify_UnexpectedSigningMethod(t *testing.T) {
+	conf := testConfig(t)
+
+	// HS256 token having the kid of a RSA key
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"id": 11})
+	token.Header["kid"] = "rsa"
+	signed, err := token.SignedString([]byte("secret"))
+	assert.NoError(t, err)
+
+	_, err = Verify(signed, conf)
+	assert.Error(t, err)
+}
+
+func TestJWKS(t *testing.T) {
+	conf := testConfig(t)
+
+	set := JWKS(conf)
+	assert.Equal(t, 2, len(set.Keys))
+
+	assert.Equal(t, "RSA", set.Keys[0].Kty)
+	assert.Equal(t, "rsa", set.Keys[0].Kid)
+	assert.Equal(t, "RS256", set.Keys[0].Alg)
+	assert.Equal(t, "AQAB", set.Keys[0].E)
+
+	assert.Equal(t, "EC", set.Keys[1].Kty)
+	assert.Equal(t, "P-256", set.Keys[1].Crv)
+	assert.Equal(t, "ES256", set.Keys[1].Alg)
+	assert.Len(t, set.Keys[1].X, 43)
+	assert.Len(t, set.Keys[1].Y, 43)
+
+	assert.Empty(t, JWKS(&app.JWTConfig{SigningKey: "secret"}).Keys)
+}
@@ -15,11 +15,14 @@
 package token
 
 import (
+	"errors"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 	"github.com/tektoncd/hub/api/pkg/app"
 	"github.com/tektoncd/hub/api/pkg/db/model"
+	"gorm.io/gorm"
 )
 
 const (
@@ -52,7 +55,7 @@ func (r *Request) AccessJWT() (string, int64, error) {
 		"exp":      expiresAt,
 	}
 
-	token, err := Create(claim, r.JWTConfig.SigningKey)
+	token, err := Create(claim, r.JWTConfig)
 	if err != nil {
 		return "", 0, err
 	}
@@ -73,7 +76,7 @@ func (r *Request) RefreshJWT() (string, int64, error) {
 		"exp":      expiresAt,
 	}
 
-	token, err := Create(claim, r.JWTConfig.SigningKey)
+	token, err := Create(claim, r.JWTConfig)
 	if err != nil {
 		return "", 0, err
 	}
@@ -81,21 +84,65 @@ func (r *Request) RefreshJWT() (string, int64, error) {
 	return token, expiresAt, nil
 }
 
+// AgentJWT returns a token which never expires, the TokenID of the agent
+// is added as jti so that the token can be revoked by changing it
 func (r *Request) AgentJWT() (string, error) {
 
 	claim := jwt.MapClaims{
 		"iss":    issuer,
 		"id":     r.User.ID,
+		"jti":    r.User.TokenID,
 		"name":   r.User.AgentName,
 		"type":   agentTokenType,
 		"scopes": r.Scopes,
 		"iat":    Now().Unix(),
 	}
 
-	token, err := Create(claim, r.JWTConfig.SigningKey)
+	token, err := Create(claim, r.JWTConfig)
 	if err != nil {
 		return "", err
 	}
 
 	return token, nil
 }
+
+// LegacyID is the TokenID of the agents created before their tokens had
+// a jti, the tokens without a jti are valid until the agent is updated
+// or revoked
+const LegacyID = "legacy"
+
+// NewID returns a random id to be used as the jti of an agent token
+func NewID() string {
+	return uuid.NewString()
+}
+
+// Revoked checks if the claims are of an agent token whose jti is not the
+// TokenID of the agent anymore. Refresh tokens of users are revoked by
+// clearing the checksum of the token saved for the user, which is checked
+// when the token is refreshed.
+func Revoked(db *gorm.DB, claims jwt.MapClaims) (bool, error) {
+
+	if claims["type"] != agentTokenType {
+		return false, nil
+	}
+
+	userID, ok := claims["id"].(float64)
+	if !ok {
+		return true, nil
+	}
+
+	agent := model.User{}
+	q := db.Where(&model.User{Type: model.AgentUserType})
+	if err := q.First(&agent, uint(userID)).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	jti, _ := claims["jti"].(string)
+	if jti == "" {
+		return agent.TokenID != LegacyID, nil
+	}
+	return agent.TokenID != jti, nil
+}
@@ -49,7 +49,7 @@ func (s *UserService) JWTAuth(handler http.HandlerFunc) http.HandlerFunc {
 			jwt = jwt[7:]
 		}
 
-		claims, err := token.Verify(jwt, s.JwtConfig.SigningKey)
+		claims, err := token.Verify(jwt, s.JwtConfig)
 		if err != nil {
 			http.Error(res, err.Error(), http.StatusBadRequest)
 			return
@@ -57,7 +57,7 @@ func (s *UserService) JWTAuth(handler http.HandlerFunc) http.HandlerFunc {
 
 		scheme := &JWTScheme{
 			Name:   jwt,
-			Scopes: []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "config:refresh", "refresh:token"},
+			Scopes: []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
 		}
 
 		if req.RequestURI == "/user/info" {
@@ -232,5 +232,28 @@ func (r *request) userScopes() ([]string, error) {
 		userScopes = append(userScopes, s.Name)
 	}
 
+	// scopes granted by the identity provider at the last login, the same
+	// as in the access token issued by the login
+	account := model.Account{}
+	if err := r.db.Where("user_id = ? AND provider = ?", dbUser.ID, r.provider).
+		Limit(1).Find(&account).Error; err != nil {
+		r.log.Error(err)
+		return nil, refreshError
+	}
+	for _, claimed := range account.ClaimedScopes {
+		if !contains(userScopes, claimed) {
+			userScopes = append(userScopes, claimed)
+		}
+	}
+
 	return userScopes, nil
 }
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -22,7 +22,9 @@ import (
 
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/hub/api/pkg/db/model"
 	"github.com/tektoncd/hub/api/pkg/testutils"
+	"github.com/tektoncd/hub/api/pkg/token"
 	userApp "github.com/tektoncd/hub/api/pkg/user/app"
 )
 
@@ -110,6 +112,53 @@ func TestRefreshAccessToken(t *testing.T) {
 	assert.Equal(t, accessExpiryTime, u.Data.Access.ExpiresAt)
 }
 
+func TestRefreshAccessToken_OIDCUser(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	testUser, _, err := tc.RefreshTokenForUser("abc", "abc@bar.com")
+	assert.NoError(t, err)
+
+	// the scopes claimed by the identity provider at the login
+	account := model.Account{UserID: testUser.ID, UserName: "abc", Provider: "oidc", ClaimedScopes: []string{"catalog:refresh"}}
+	assert.NoError(t, tc.DB().Create(&account).Error)
+
+	refreshReq := token.Request{User: testUser, JWTConfig: tc.JWTConfig(), Provider: "oidc"}
+	refreshToken, _, err := refreshReq.RefreshJWT()
+	assert.NoError(t, err)
+	assert.NoError(t, tc.DB().Model(testUser).UpdateColumn("refresh_token_checksum", createChecksum(refreshToken)).Error)
+
+	// Mocks the time
+	jwt.TimeFunc = testutils.Now
+
+	req, err := http.NewRequest("POST", "/user/refresh/accesstoken", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := httptest.NewRecorder()
+
+	userSvc := New(tc)
+	jwt := UserService{
+		JwtConfig: tc.JWTConfig(),
+	}
+
+	req.Header.Set("Authorization", refreshToken)
+	handler := http.HandlerFunc(jwt.JWTAuth(userSvc.RefreshAccessToken))
+	handler.ServeHTTP(res, req)
+	assert.Equal(t, http.StatusOK, res.Code)
+
+	// the claimed scopes are kept in the refreshed access token
+	accessReq := token.Request{User: testUser, Scopes: []string{"rating:read", "rating:write", "catalog:refresh"}, JWTConfig: tc.JWTConfig(), Provider: "oidc"}
+	accessToken, _, err := accessReq.AccessJWT()
+	assert.NoError(t, err)
+
+	var u *userApp.RefreshAccessTokenResult
+	err = json.Unmarshal(res.Body.Bytes(), &u)
+	assert.NoError(t, err)
+	assert.Equal(t, accessToken, u.Data.Access.Token)
+}
+
 func TestRefreshAccessToken_RefreshTokenChecksumIsDifferent(t *testing.T) {
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
@@ -24,12 +24,19 @@ var _ = Service("catalog", func() {
 
 	HTTP(func() {
 		Path("/v1")
+		Response("invalid-token", StatusUnauthorized)
 	})
 
+	Security(types.JWTAuth)
+
 	Error("internal-error", ErrorResult, "Internal Server Error")
+	Error("invalid-token", ErrorResult, "Invalid User token")
 
 	Method("List", func() {
-		Description("List all Catalogs")
+		Description("List all Catalogs, private catalogs are listed only for the users allowed to see them")
+		Payload(func() {
+			Token("token", String, "JWT of the user to see private catalogs")
+		})
 
 		Result(func() {
 			Attribute("data", ArrayOf(types.Catalog))
@@ -37,6 +44,7 @@ var _ = Service("catalog", func() {
 
 		HTTP(func() {
 			GET("/catalogs")
+			Header("token:Authorization")
 
 			Response(StatusOK)
 			Response("internal-error", StatusInternalServerError)
@@ -24,17 +24,27 @@ var _ = Service("resource", func() {
 
 	HTTP(func() {
 		Path("/v1")
+		Response("invalid-token", StatusUnauthorized)
 	})
 
+	// the token is optional, callers without a token see the resources of
+	// public catalogs only
+	Security(types.JWTAuth)
+
 	Error("internal-error", ErrorResult, "Internal Server Error")
 	Error("not-found", ErrorResult, "Resource Not Found Error")
 	Error("invalid-kind", ErrorResult, "Invalid Resource Kind")
+	Error("invalid-cursor", ErrorResult, "Invalid Cursor")
+	Error("invalid-token", ErrorResult, "Invalid User token")
+	Error("invalid-scopes", ErrorResult, "Invalid User scope")
+	Error("invalid-payload", ErrorResult, "Invalid request body")
 
 	// NOTE: Supported Tekton Resource kind by APIs are defined in /pkg/parser/kind.go
 
 	Method("Query", func() {
-		Description("Find resources by a combination of name, kind, catalog, categories, platforms and tags")
+		Description("Find resources by a combination of name, kind, catalog, categories, platforms, apiVersions, verifications and tags")
 		Payload(func() {
+			Token("token", String, "JWT of the user to see the resources of private catalogs")
 			Attribute("name", String, "Name of resource", func() {
 				Default("")
 				Example("name", "buildah")
@@ -54,20 +64,37 @@ var _ = Service("resource", func() {
 			Attribute("platforms", ArrayOf(String), "Platforms associated with a resource to filter by", func() {
 				Example([]string{"linux/s390x", "linux/amd64"})
 			})
+			Attribute("apiVersions", ArrayOf(String), "Tekton apiVersions of resource versions to filter by", func() {
+				Example([]string{"tekton.dev/v1", "tekton.dev/v1beta1"})
+			})
+			Attribute("verifications", ArrayOf(String, func() {
+				Enum("verified", "unsigned", "invalid")
+			}), "Verifications of the signatures of resource versions to filter by", func() {
+				Example([]string{"verified"})
+			})
 			Attribute("limit", UInt, "Maximum number of resources to be returned", func() {
 				Default(1000)
 				Example("limit", 100)
 			})
 
 			Attribute("match", String, "Strategy used to find matching resources", func() {
-				Enum("exact", "contains")
+				Enum("exact", "contains", "fulltext")
 				Default("contains")
 			})
+			Attribute("sort", String, "Order of the resources, by default rating and name or relevance for fulltext match. Popular resources are the most downloaded in the last 30 days", func() {
+				Enum("rating", "name", "updated", "versions", "popular")
+				Example("sort", "updated")
+			})
+			Attribute("cursor", String, "Cursor returned as next by the previous page to fetch the next page")
+			Attribute("prerelease", Boolean, "Include pre-release versions e.g. 0.3.0-rc1 of resources", func() {
+				Default(false)
+			})
 		})
 		Result(types.Resources)
 
 		HTTP(func() {
 			GET("/query")
+			Header("token:Authorization")
 
 			Param("name")
 			Param("catalogs")
@@ -75,12 +102,18 @@ var _ = Service("resource", func() {
 			Param("kinds")
 			Param("tags")
 			Param("platforms")
+			Param("apiVersions")
+			Param("verifications")
 			Param("limit")
 			Param("match")
+			Param("sort")
+			Param("cursor")
+			Param("prerelease")
 
 			Response(StatusOK)
 			Response("internal-error", StatusInternalServerError)
 			Response("invalid-kind", StatusBadRequest)
+			Response("invalid-cursor", StatusBadRequest)
 			Response("not-found", StatusNotFound)
 		})
 	})
@@ -88,20 +121,34 @@ var _ = Service("resource", func() {
 	Method("List", func() {
 		Description("List all resources sorted by rating and name")
 		Payload(func() {
+			Token("token", String, "JWT of the user to see the resources of private catalogs")
 			Attribute("limit", UInt, "Maximum number of resources to be returned", func() {
 				Default(1000)
 				Example("limit", 100)
 			})
+			Attribute("sort", String, "Order of the resources, by default rating and name. Popular resources are the most downloaded in the last 30 days", func() {
+				Enum("rating", "name", "updated", "versions", "popular")
+				Example("sort", "updated")
+			})
+			Attribute("cursor", String, "Cursor returned as next by the previous page to fetch the next page")
+			Attribute("prerelease", Boolean, "Include pre-release versions e.g. 0.3.0-rc1 of resources", func() {
+				Default(false)
+			})
 		})
 		Result(types.Resources)
 
 		HTTP(func() {
 			GET("/resources")
+			Header("token:Authorization")
 
 			Param("limit")
+			Param("sort")
+			Param("cursor")
+			Param("prerelease")
 
 			Response(StatusOK)
 			Response("internal-error", StatusInternalServerError)
+			Response("invalid-cursor", StatusBadRequest)
 			Response("not-found", StatusNotFound)
 		})
 	})
@@ -109,15 +156,198 @@ var _ = Service("resource", func() {
 	Method("VersionsByID", func() {
 		Description("Find all versions of a resource by its id")
 		Payload(func() {
+			Token("token", String, "JWT of the user to see the resources of private catalogs")
 			Attribute("id", UInt, "ID of a resource", func() {
 				Example("id", 1)
 			})
+			Attribute("prerelease", Boolean, "Include pre-release versions e.g. 0.3.0-rc1 of resources", func() {
+				Default(false)
+			})
 			Required("id")
 		})
 		Result(types.ResourceVersions)
 
 		HTTP(func() {
 			GET("/resource/{id}/versions")
+			Header("token:Authorization")
+
+			Param("prerelease")
+
+			Response(StatusOK)
+			Response("internal-error", StatusInternalServerError)
+			Response("not-found", StatusNotFound)
+		})
+	})
+
+	Method("ListReviews", func() {
+		Description("List the reviews of a resource, latest first")
+		Payload(func() {
+			Token("token", String, "JWT of the user to see the resources of private catalogs")
+			Attribute("id", UInt, "ID of a resource", func() {
+				Example("id", 1)
+			})
+			Attribute("limit", UInt, "Maximum number of reviews to be returned", func() {
+				Default(100)
+				Example("limit", 20)
+			})
+			Attribute("cursor", String, "Cursor returned as next by the previous page to fetch the next page")
+			Required("id")
+		})
+		Result(types.Reviews)
+
+		HTTP(func() {
+			GET("/resource/{id}/reviews")
+			Header("token:Authorization")
+
+			Param("limit")
+			Param("cursor")
+
+			Response(StatusOK)
+			Response("internal-error", StatusInternalServerError)
+			Response("invalid-cursor", StatusBadRequest)
+			Response("not-found", StatusNotFound)
+		})
+	})
+
+	Method("CreateReview", func() {
+		Description("Write a review on a version of a resource")
+		Security(types.JWTAuth, func() {
+			Scope("rating:write")
+		})
+		Payload(func() {
+			Token("token", String, "JWT")
+			Attribute("id", UInt, "ID of a resource", func() {
+				Example("id", 1)
+			})
+			Attribute("version", String, "Version of the resource which is reviewed", func() {
+				Example("version", "0.1")
+			})
+			Attribute("text", String, "Text of the review", func() {
+				MinLength(1)
+				MaxLength(5000)
+				Example("text", "Works well, the workspace for the credentials could be optional")
+			})
+			Required("token", "id", "version", "text")
+		})
+		Result(types.Review)
+
+		HTTP(func() {
+			POST("/resource/{id}/reviews")
+			Header("token:Authorization")
+
+			Response(StatusOK)
+			Response("internal-error", StatusInternalServerError)
+			Response("invalid-payload", StatusBadRequest)
+			Response("invalid-scopes", StatusForbidden)
+			Response("not-found", StatusNotFound)
+		})
+	})
+
+	Method("UpdateReview", func() {
+		Description("Edit a review written by the user")
+		Security(types.JWTAuth, func() {
+			Scope("rating:write")
+		})
+		Payload(func() {
+			Token("token", String, "JWT")
+			Attribute("id", UInt, "ID of a resource", func() {
+				Example("id", 1)
+			})
+			Attribute("reviewID", UInt, "ID of the review", func() {
+				Example("reviewID", 1)
+			})
+			Attribute("text", String, "Text of the review", func() {
+				MinLength(1)
+				MaxLength(5000)
+				Example("text", "Works well, the workspace for the credentials could be optional")
+			})
+			Required("token", "id", "reviewID", "text")
+		})
+		Result(types.Review)
+
+		HTTP(func() {
+			PUT("/resource/{id}/reviews/{reviewID}")
+			Header("token:Authorization")
+
+			Response(StatusOK)
+			Response("internal-error", StatusInternalServerError)
+			Response("invalid-scopes", StatusForbidden)
+			Response("not-found", StatusNotFound)
+		})
+	})
+
+	Method("DeleteReview", func() {
+		Description("Delete a review written by the user")
+		Security(types.JWTAuth, func() {
+			Scope("rating:write")
+		})
+		Payload(func() {
+			Token("token", String, "JWT")
+			Attribute("id", UInt, "ID of a resource", func() {
+				Example("id", 1)
+			})
+			Attribute("reviewID", UInt, "ID of the review", func() {
+				Example("reviewID", 1)
+			})
+			Required("token", "id", "reviewID")
+		})
+
+		HTTP(func() {
+			DELETE("/resource/{id}/reviews/{reviewID}")
+			Header("token:Authorization")
+
+			Response(StatusOK)
+			Response("internal-error", StatusInternalServerError)
+			Response("invalid-scopes", StatusForbidden)
+			Response("not-found", StatusNotFound)
+		})
+	})
+
+	Method("Dependencies", func() {
+		Description("List the tasks referenced by a version of a pipeline, by default the latest version")
+		Payload(func() {
+			Token("token", String, "JWT of the user to see the resources of private catalogs")
+			Attribute("id", UInt, "ID of a resource", func() {
+				Example("id", 1)
+			})
+			Attribute("version", String, "Version of the pipeline", func() {
+				Example("version", "0.1")
+			})
+			Required("id")
+		})
+		Result(types.PipelineDependencies)
+
+		HTTP(func() {
+			GET("/resource/{id}/dependencies")
+			Header("token:Authorization")
+
+			Param("version")
+
+			Response(StatusOK)
+			Response("internal-error", StatusInternalServerError)
+			Response("not-found", StatusNotFound)
+		})
+	})
+
+	Method("Dependents", func() {
+		Description("List the versions of the pipelines referencing a task")
+		Payload(func() {
+			Token("token", String, "JWT of the user to see the resources of private catalogs")
+			Attribute("id", UInt, "ID of a resource", func() {
+				Example("id", 1)
+			})
+			Attribute("version", String, "Version of the task, only the references pinning the version are returned", func() {
+				Example("version", "0.9")
+			})
+			Required("id")
+		})
+		Result(types.TaskDependents)
+
+		HTTP(func() {
+			GET("/resource/{id}/dependents")
+			Header("token:Authorization")
+
+			Param("version")
 
 			Response(StatusOK)
 			Response("internal-error", StatusInternalServerError)
@@ -128,11 +358,12 @@ var _ = Service("resource", func() {
 	Method("ByCatalogKindNameVersion", func() {
 		Description("Find resource using name of catalog & name, kind and version of resource")
 		Payload(func() {
+			Token("token", String, "JWT of the user to see the resources of private catalogs")
 			Attribute("catalog", String, "name of catalog", func() {
 				Example("catalog", "tekton")
 			})
 			Attribute("kind", String, "kind of resource", func() {
-				Enum("task", "pipeline")
+				Enum("task", "pipeline", "stepaction")
 			})
 			Attribute("name", String, "name of resource", func() {
 				Example("name", "buildah")
@@ -147,6 +378,7 @@ var _ = Service("resource", func() {
 
 		HTTP(func() {
 			GET("/resource/{catalog}/{kind}/{name}/{version}")
+			Header("token:Authorization")
 
 			Response(StatusOK)
 			Response("internal-error", StatusInternalServerError)
@@ -157,11 +389,12 @@ var _ = Service("resource", func() {
 	Method("ByCatalogKindNameVersionReadme", func() {
 		Description("Find resource README using name of catalog & name, kind and version of resource")
 		Payload(func() {
+			Token("token", String, "JWT of the user to see the resources of private catalogs")
 			Attribute("catalog", String, "name of catalog", func() {
 				Example("catalog", "tekton")
 			})
 			Attribute("kind", String, "kind of resource", func() {
-				Enum("task", "pipeline")
+				Enum("task", "pipeline", "stepaction")
 			})
 			Attribute("name", String, "name of resource", func() {
 				Example("name", "buildah")
@@ -176,6 +409,7 @@ var _ = Service("resource", func() {
 
 		HTTP(func() {
 			GET("/resource/{catalog}/{kind}/{name}/{version}/readme")
+			Header("token:Authorization")
 
 			Response(StatusOK)
 			Response("internal-error", StatusInternalServerError)
@@ -186,11 +420,12 @@ var _ = Service("resource", func() {
 	Method("ByCatalogKindNameVersionYaml", func() {
 		Description("Find resource YAML using name of catalog & name, kind and version of resource")
 		Payload(func() {
+			Token("token", String, "JWT of the user to see the resources of private catalogs")
 			Attribute("catalog", String, "name of catalog", func() {
 				Example("catalog", "tekton")
 			})
 			Attribute("kind", String, "kind of resource", func() {
-				Enum("task", "pipeline")
+				Enum("task", "pipeline", "stepaction")
 			})
 			Attribute("name", String, "name of resource", func() {
 				Example("name", "buildah")
@@ -198,6 +433,10 @@ var _ = Service("resource", func() {
 			Attribute("version", String, "version of resource", func() {
 				Example("version", "0.1")
 			})
+			Attribute("install", Boolean, "The YAML is fetched to install the resource, installs are counted as downloads", func() {
+				Default(false)
+				Example("install", true)
+			})
 
 			Required("catalog", "kind", "name", "version")
 		})
@@ -205,6 +444,40 @@ var _ = Service("resource", func() {
 
 		HTTP(func() {
 			GET("/resource/{catalog}/{kind}/{name}/{version}/yaml")
+			Header("token:Authorization")
+
+			Param("install")
+
+			Response(StatusOK)
+			Response("internal-error", StatusInternalServerError)
+			Response("not-found", StatusNotFound)
+		})
+	})
+
+	Method("ByCatalogKindNameVersionLint", func() {
+		Description("Find the problems found by the lint rules in a resource using name of catalog & name, kind and version of resource")
+		Payload(func() {
+			Token("token", String, "JWT of the user to see the resources of private catalogs")
+			Attribute("catalog", String, "name of catalog", func() {
+				Example("catalog", "tekton")
+			})
+			Attribute("kind", String, "kind of resource", func() {
+				Enum("task", "pipeline", "stepaction")
+			})
+			Attribute("name", String, "name of resource", func() {
+				Example("name", "buildah")
+			})
+			Attribute("version", String, "version of resource", func() {
+				Example("version", "0.1")
+			})
+
+			Required("catalog", "kind", "name", "version")
+		})
+		Result(types.VersionLint)
+
+		HTTP(func() {
+			GET("/resource/{catalog}/{kind}/{name}/{version}/lint")
+			Header("token:Authorization")
 
 			Response(StatusOK)
 			Response("internal-error", StatusInternalServerError)
@@ -212,9 +485,69 @@ var _ = Service("resource", func() {
 		})
 	})
 
+	Method("ByCatalogKindNameVersionImages", func() {
+		Description("Find the images of the steps and sidecars of a resource using name of catalog & name, kind and version of resource")
+		Payload(func() {
+			Token("token", String, "JWT of the user to see the resources of private catalogs")
+			Attribute("catalog", String, "name of catalog", func() {
+				Example("catalog", "tekton")
+			})
+			Attribute("kind", String, "kind of resource", func() {
+				Enum("task", "pipeline", "stepaction")
+			})
+			Attribute("name", String, "name of resource", func() {
+				Example("name", "buildah")
+			})
+			Attribute("version", String, "version of resource", func() {
+				Example("version", "0.1")
+			})
+
+			Required("catalog", "kind", "name", "version")
+		})
+		Result(types.VersionImages)
+
+		HTTP(func() {
+			GET("/resource/{catalog}/{kind}/{name}/{version}/images")
+			Header("token:Authorization")
+
+			Response(StatusOK)
+			Response("internal-error", StatusInternalServerError)
+			Response("not-found", StatusNotFound)
+		})
+	})
+
+	Method("ByImage", func() {
+		Description("Find the versions of the resources using an image, an image without tag and digest matches all the tags and digests of the image")
+		Payload(func() {
+			Token("token", String, "JWT of the user to see the resources of private catalogs")
+			Attribute("ref", String, "Reference of the image", func() {
+				Example("ref", "alpine:3.18")
+			})
+			Attribute("limit", UInt, "Maximum number of versions to be returned", func() {
+				Default(1000)
+				Example("limit", 100)
+			})
+
+			Required("ref")
+		})
+		Result(types.ImageUsers)
+
+		HTTP(func() {
+			GET("/images")
+			Header("token:Authorization")
+
+			Param("ref")
+			Param("limit")
+
+			Response(StatusOK)
+			Response("internal-error", StatusInternalServerError)
+		})
+	})
+
 	Method("ByVersionId", func() {
 		Description("Find a resource using its version's id")
 		Payload(func() {
+			Token("token", String, "JWT of the user to see the resources of private catalogs")
 			Attribute("versionID", UInt, "Version ID of a resource's version", func() {
 				Example("versionID", 1)
 			})
@@ -224,6 +557,7 @@ var _ = Service("resource", func() {
 
 		HTTP(func() {
 			GET("/resource/version/{versionID}")
+			Header("token:Authorization")
 
 			Response(StatusOK)
 			Response("internal-error", StatusInternalServerError)
@@ -234,11 +568,12 @@ var _ = Service("resource", func() {
 	Method("ByCatalogKindName", func() {
 		Description("Find resources using name of catalog, resource name and kind of resource")
 		Payload(func() {
+			Token("token", String, "JWT of the user to see the resources of private catalogs")
 			Attribute("catalog", String, "name of catalog", func() {
 				Example("catalog", "tekton")
 			})
 			Attribute("kind", String, "kind of resource", func() {
-				Enum("task", "pipeline")
+				Enum("task", "pipeline", "stepaction")
 			})
 			Attribute("name", String, "Name of resource", func() {
 				Example("name", "buildah")
@@ -247,14 +582,19 @@ var _ = Service("resource", func() {
 				Pattern(types.PipelinesVersionRegex)
 				Example("pipelinesversion", "0.21.0")
 			})
+			Attribute("prerelease", Boolean, "Include pre-release versions e.g. 0.3.0-rc1 of resources", func() {
+				Default(false)
+			})
 			Required("catalog", "kind", "name")
 		})
 		Result(types.Resource)
 
 		HTTP(func() {
 			GET("/resource/{catalog}/{kind}/{name}")
+			Header("token:Authorization")
 
 			Param("pipelinesversion")
+			Param("prerelease")
 
 			Response(StatusOK)
 			Response("internal-error", StatusInternalServerError)
@@ -265,6 +605,7 @@ var _ = Service("resource", func() {
 	Method("ById", func() {
 		Description("Find a resource using it's id")
 		Payload(func() {
+			Token("token", String, "JWT of the user to see the resources of private catalogs")
 			Attribute("id", UInt, "ID of a resource", func() {
 				Example("id", 1)
 			})
@@ -274,6 +615,7 @@ var _ = Service("resource", func() {
 
 		HTTP(func() {
 			GET("/resource/{id}")
+			Header("token:Authorization")
 
 			Response(StatusOK)
 			Response("internal-error", StatusInternalServerError)
@@ -285,11 +627,12 @@ var _ = Service("resource", func() {
 		Description("Fetch a raw resource yaml file using the name of catalog, resource name, kind, and version")
 
 		Payload(func() {
+			Token("token", String, "JWT of the user to see the resources of private catalogs")
 			Attribute("catalog", String, "name of catalog", func() {
 				Example("catalog", "tekton")
 			})
 			Attribute("kind", String, "kind of resource", func() {
-				Enum("task", "pipeline")
+				Enum("task", "pipeline", "stepaction")
 			})
 			Attribute("name", String, "name of resource", func() {
 				Example("name", "buildah")
@@ -303,6 +646,7 @@ var _ = Service("resource", func() {
 
 		HTTP(func() {
 			GET("/resource/{catalog}/{kind}/{name}/{version}/raw")
+			Header("token:Authorization")
 			Response(StatusOK)
 			Response("internal-error", StatusInternalServerError)
 			Response("not-found", StatusNotFound)
@@ -314,11 +658,12 @@ var _ = Service("resource", func() {
 		Description("Fetch a raw latest resource yaml file using the name of catalog, resource name, and kind")
 
 		Payload(func() {
+			Token("token", String, "JWT of the user to see the resources of private catalogs")
 			Attribute("catalog", String, "name of catalog", func() {
 				Example("catalog", "tekton")
 			})
 			Attribute("kind", String, "kind of resource", func() {
-				Enum("task", "pipeline")
+				Enum("task", "pipeline", "stepaction")
 			})
 			Attribute("name", String, "name of resource", func() {
 				Example("name", "buildah")
@@ -329,6 +674,7 @@ var _ = Service("resource", func() {
 
 		HTTP(func() {
 			GET("/resource/{catalog}/{kind}/{name}/raw")
+			Header("token:Authorization")
 			Response(StatusOK)
 			Response("internal-error", StatusInternalServerError)
 			Response("not-found", StatusNotFound)
@@ -28,10 +28,11 @@ func NewClient(list goa.Endpoint) *Client {
 // List calls the "List" endpoint of the "catalog" service.
 // List may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
 //   - error: internal error
-func (c *Client) List(ctx context.Context) (res *ListResult, err error) {
+func (c *Client) List(ctx context.Context, p *ListPayload) (res *ListResult, err error) {
 	var ires any
-	ires, err = c.ListEndpoint(ctx, nil)
+	ires, err = c.ListEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
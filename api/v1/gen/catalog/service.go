@@ -12,12 +12,20 @@ import (
 
 	catalogviews "github.com/tektoncd/hub/api/v1/gen/catalog/views"
 	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
 )
 
 // The catalog service provides details about catalogs.
 type Service interface {
-	// List all Catalogs
-	List(context.Context) (res *ListResult, err error)
+	// List all Catalogs, private catalogs are listed only for the users allowed to
+	// see them
+	List(context.Context, *ListPayload) (res *ListResult, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// JWTAuth implements the authorization logic for the JWT security scheme.
+	JWTAuth(ctx context.Context, token string, schema *security.JWTScheme) (context.Context, error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -49,6 +57,12 @@ type Catalog struct {
 	Provider string
 }
 
+// ListPayload is the payload type of the catalog service List method.
+type ListPayload struct {
+	// JWT of the user to see private catalogs
+	Token *string
+}
+
 // ListResult is the result type of the catalog service List method.
 type ListResult struct {
 	Data []*Catalog
@@ -59,6 +73,11 @@ func MakeInternalError(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "internal-error", false, false, false)
 }
 
+// MakeInvalidToken builds a goa.ServiceError from an error.
+func MakeInvalidToken(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid-token", false, false, false)
+}
+
 // newCatalogMin converts projected type Catalog to service type Catalog.
 func newCatalogMin(vres *catalogviews.CatalogView) *Catalog {
 	res := &Catalog{}
@@ -6,3 +6,22 @@
 // $ goa gen github.com/tektoncd/hub/api/v1/design
 
 package client
+
+import (
+	catalog "github.com/tektoncd/hub/api/v1/gen/catalog"
+)
+
+// BuildListPayload builds the payload for the catalog List endpoint from CLI
+// flags.
+func BuildListPayload(catalogListToken string) (*catalog.ListPayload, error) {
+	var token *string
+	{
+		if catalogListToken != "" {
+			token = &catalogListToken
+		}
+	}
+	v := &catalog.ListPayload{}
+	v.Token = token
+
+	return v, nil
+}
@@ -57,6 +57,7 @@ func NewClient(
 // List server.
 func (c *Client) List() goa.Endpoint {
 	var (
+		encodeRequest  = EncodeListRequest(c.encoder)
 		decodeResponse = DecodeListResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
@@ -64,6 +65,10 @@ func (c *Client) List() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
 		resp, err := c.ListDoer.Do(req)
 		if err != nil {
 			return nil, goahttp.ErrRequestError("catalog", "List", err)
@@ -13,6 +13,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 
 	catalog "github.com/tektoncd/hub/api/v1/gen/catalog"
 	goahttp "goa.design/goa/v3/http"
@@ -33,11 +34,32 @@ func (c *Client) BuildListRequest(ctx context.Context, v any) (*http.Request, er
 	return req, nil
 }
 
+// EncodeListRequest returns an encoder for requests sent to the catalog List
+// server.
+func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*catalog.ListPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("catalog", "List", "*catalog.ListPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
 // DecodeListResponse returns a decoder for responses returned by the catalog
 // List endpoint. restoreBody controls whether the response body should be
 // restored after having been read.
 // DecodeListResponse may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
 //   - error: internal error
 func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -83,6 +105,20 @@ func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBod
 				return nil, goahttp.ErrValidationError("catalog", "List", err)
 			}
 			return nil, NewListInternalError(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("catalog", "List", err)
+			}
+			err = ValidateListInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("catalog", "List", err)
+			}
+			return nil, NewListInvalidToken(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("catalog", "List", resp.StatusCode, string(body))
@@ -36,6 +36,24 @@ type ListInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// ListInvalidTokenResponseBody is the type of the "catalog" service "List"
+// endpoint HTTP response body for the "invalid-token" error.
+type ListInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // CatalogResponseBody is used to define fields on response body types.
 type CatalogResponseBody struct {
 	// ID is the unique id of the catalog
@@ -79,6 +97,21 @@ func NewListInternalError(body *ListInternalErrorResponseBody) *goa.ServiceError
 	return v
 }
 
+// NewListInvalidToken builds a catalog service List endpoint invalid-token
+// error.
+func NewListInvalidToken(body *ListInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
 // ValidateListResponseBody runs the validations defined on ListResponseBody
 func ValidateListResponseBody(body *ListResponseBody) (err error) {
 	for _, e := range body.Data {
@@ -115,6 +148,30 @@ func ValidateListInternalErrorResponseBody(body *ListInternalErrorResponseBody)
 	return
 }
 
+// ValidateListInvalidTokenResponseBody runs the validations defined on
+// List_invalid-token_Response_Body
+func ValidateListInvalidTokenResponseBody(body *ListInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
 // ValidateCatalogResponseBody runs the validations defined on
 // CatalogResponseBody
 func ValidateCatalogResponseBody(body *CatalogResponseBody) (err error) {
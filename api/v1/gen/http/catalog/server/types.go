@@ -36,6 +36,24 @@ type ListInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// ListInvalidTokenResponseBody is the type of the "catalog" service "List"
+// endpoint HTTP response body for the "invalid-token" error.
+type ListInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // CatalogResponseBody is used to define fields on response body types.
 type CatalogResponseBody struct {
 	// ID is the unique id of the catalog
@@ -76,3 +94,25 @@ func NewListInternalErrorResponseBody(res *goa.ServiceError) *ListInternalErrorR
 	}
 	return body
 }
+
+// NewListInvalidTokenResponseBody builds the HTTP response body from the
+// result of the "List" endpoint of the "catalog" service.
+func NewListInvalidTokenResponseBody(res *goa.ServiceError) *ListInvalidTokenResponseBody {
+	body := &ListInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListPayload builds a catalog service List endpoint payload.
+func NewListPayload(token *string) *catalog.ListPayload {
+	v := &catalog.ListPayload{}
+	v.Token = token
+
+	return v
+}
@@ -24,13 +24,13 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
 	return `catalog list
-resource (query|list|versions-by-id|by-catalog-kind-name-version|by-catalog-kind-name-version-readme|by-catalog-kind-name-version-yaml|by-version-id|by-catalog-kind-name|by-id|get-raw-yaml-by-catalog-kind-name-version|get-latest-raw-yaml-by-catalog-kind-name)
+resource (query|list|versions-by-id|list-reviews|create-review|update-review|delete-review|dependencies|dependents|by-catalog-kind-name-version|by-catalog-kind-name-version-readme|by-catalog-kind-name-version-yaml|by-catalog-kind-name-version-lint|by-catalog-kind-name-version-images|by-image|by-version-id|by-catalog-kind-name|by-id|get-raw-yaml-by-catalog-kind-name-version|get-latest-raw-yaml-by-catalog-kind-name)
 `
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + ` catalog list` + "\n" +
+	return os.Args[0] + ` catalog list --token "Non saepe maiores quia nam est."` + "\n" +
 		os.Args[0] + ` resource query --name "buildah" --catalogs '[
       "tekton",
       "openshift"
@@ -46,7 +46,12 @@ func UsageExamples() string {
    ]' --platforms '[
       "linux/s390x",
       "linux/amd64"
-   ]' --limit 100 --match "exact"` + "\n" +
+   ]' --api-versions '[
+      "tekton.dev/v1",
+      "tekton.dev/v1beta1"
+   ]' --verifications '[
+      "verified"
+   ]' --limit 100 --match "exact" --sort "updated" --cursor "Illum aut vero veniam nihil vel." --prerelease true --token "Exercitationem molestias id eum illum rerum."` + "\n" +
 		""
 }
 
@@ -62,66 +67,140 @@ func ParseEndpoint(
 	var (
 		catalogFlags = flag.NewFlagSet("catalog", flag.ContinueOnError)
 
-		catalogListFlags = flag.NewFlagSet("list", flag.ExitOnError)
+		catalogListFlags     = flag.NewFlagSet("list", flag.ExitOnError)
+		catalogListTokenFlag = catalogListFlags.String("token", "", "")
 
 		resourceFlags = flag.NewFlagSet("resource", flag.ContinueOnError)
 
-		resourceQueryFlags          = flag.NewFlagSet("query", flag.ExitOnError)
-		resourceQueryNameFlag       = resourceQueryFlags.String("name", "", "")
-		resourceQueryCatalogsFlag   = resourceQueryFlags.String("catalogs", "", "")
-		resourceQueryCategoriesFlag = resourceQueryFlags.String("categories", "", "")
-		resourceQueryKindsFlag      = resourceQueryFlags.String("kinds", "", "")
-		resourceQueryTagsFlag       = resourceQueryFlags.String("tags", "", "")
-		resourceQueryPlatformsFlag  = resourceQueryFlags.String("platforms", "", "")
-		resourceQueryLimitFlag      = resourceQueryFlags.String("limit", "1000", "")
-		resourceQueryMatchFlag      = resourceQueryFlags.String("match", "contains", "")
-
-		resourceListFlags     = flag.NewFlagSet("list", flag.ExitOnError)
-		resourceListLimitFlag = resourceListFlags.String("limit", "1000", "")
-
-		resourceVersionsByIDFlags  = flag.NewFlagSet("versions-by-id", flag.ExitOnError)
-		resourceVersionsByIDIDFlag = resourceVersionsByIDFlags.String("id", "REQUIRED", "ID of a resource")
+		resourceQueryFlags             = flag.NewFlagSet("query", flag.ExitOnError)
+		resourceQueryNameFlag          = resourceQueryFlags.String("name", "", "")
+		resourceQueryCatalogsFlag      = resourceQueryFlags.String("catalogs", "", "")
+		resourceQueryCategoriesFlag    = resourceQueryFlags.String("categories", "", "")
+		resourceQueryKindsFlag         = resourceQueryFlags.String("kinds", "", "")
+		resourceQueryTagsFlag          = resourceQueryFlags.String("tags", "", "")
+		resourceQueryPlatformsFlag     = resourceQueryFlags.String("platforms", "", "")
+		resourceQueryAPIVersionsFlag   = resourceQueryFlags.String("api-versions", "", "")
+		resourceQueryVerificationsFlag = resourceQueryFlags.String("verifications", "", "")
+		resourceQueryLimitFlag         = resourceQueryFlags.String("limit", "1000", "")
+		resourceQueryMatchFlag         = resourceQueryFlags.String("match", "contains", "")
+		resourceQuerySortFlag          = resourceQueryFlags.String("sort", "", "")
+		resourceQueryCursorFlag        = resourceQueryFlags.String("cursor", "", "")
+		resourceQueryPrereleaseFlag    = resourceQueryFlags.String("prerelease", "", "")
+		resourceQueryTokenFlag         = resourceQueryFlags.String("token", "", "")
+
+		resourceListFlags          = flag.NewFlagSet("list", flag.ExitOnError)
+		resourceListLimitFlag      = resourceListFlags.String("limit", "1000", "")
+		resourceListSortFlag       = resourceListFlags.String("sort", "", "")
+		resourceListCursorFlag     = resourceListFlags.String("cursor", "", "")
+		resourceListPrereleaseFlag = resourceListFlags.String("prerelease", "", "")
+		resourceListTokenFlag      = resourceListFlags.String("token", "", "")
+
+		resourceVersionsByIDFlags          = flag.NewFlagSet("versions-by-id", flag.ExitOnError)
+		resourceVersionsByIDIDFlag         = resourceVersionsByIDFlags.String("id", "REQUIRED", "ID of a resource")
+		resourceVersionsByIDPrereleaseFlag = resourceVersionsByIDFlags.String("prerelease", "", "")
+		resourceVersionsByIDTokenFlag      = resourceVersionsByIDFlags.String("token", "", "")
+
+		resourceListReviewsFlags      = flag.NewFlagSet("list-reviews", flag.ExitOnError)
+		resourceListReviewsIDFlag     = resourceListReviewsFlags.String("id", "REQUIRED", "ID of a resource")
+		resourceListReviewsLimitFlag  = resourceListReviewsFlags.String("limit", "100", "")
+		resourceListReviewsCursorFlag = resourceListReviewsFlags.String("cursor", "", "")
+		resourceListReviewsTokenFlag  = resourceListReviewsFlags.String("token", "", "")
+
+		resourceCreateReviewFlags     = flag.NewFlagSet("create-review", flag.ExitOnError)
+		resourceCreateReviewBodyFlag  = resourceCreateReviewFlags.String("body", "REQUIRED", "")
+		resourceCreateReviewIDFlag    = resourceCreateReviewFlags.String("id", "REQUIRED", "ID of a resource")
+		resourceCreateReviewTokenFlag = resourceCreateReviewFlags.String("token", "REQUIRED", "")
+
+		resourceUpdateReviewFlags        = flag.NewFlagSet("update-review", flag.ExitOnError)
+		resourceUpdateReviewBodyFlag     = resourceUpdateReviewFlags.String("body", "REQUIRED", "")
+		resourceUpdateReviewIDFlag       = resourceUpdateReviewFlags.String("id", "REQUIRED", "ID of a resource")
+		resourceUpdateReviewReviewIDFlag = resourceUpdateReviewFlags.String("review-id", "REQUIRED", "ID of the review")
+		resourceUpdateReviewTokenFlag    = resourceUpdateReviewFlags.String("token", "REQUIRED", "")
+
+		resourceDeleteReviewFlags        = flag.NewFlagSet("delete-review", flag.ExitOnError)
+		resourceDeleteReviewIDFlag       = resourceDeleteReviewFlags.String("id", "REQUIRED", "ID of a resource")
+		resourceDeleteReviewReviewIDFlag = resourceDeleteReviewFlags.String("review-id", "REQUIRED", "ID of the review")
+		resourceDeleteReviewTokenFlag    = resourceDeleteReviewFlags.String("token", "REQUIRED", "")
+
+		resourceDependenciesFlags       = flag.NewFlagSet("dependencies", flag.ExitOnError)
+		resourceDependenciesIDFlag      = resourceDependenciesFlags.String("id", "REQUIRED", "ID of a resource")
+		resourceDependenciesVersionFlag = resourceDependenciesFlags.String("version", "", "")
+		resourceDependenciesTokenFlag   = resourceDependenciesFlags.String("token", "", "")
+
+		resourceDependentsFlags       = flag.NewFlagSet("dependents", flag.ExitOnError)
+		resourceDependentsIDFlag      = resourceDependentsFlags.String("id", "REQUIRED", "ID of a resource")
+		resourceDependentsVersionFlag = resourceDependentsFlags.String("version", "", "")
+		resourceDependentsTokenFlag   = resourceDependentsFlags.String("token", "", "")
 
 		resourceByCatalogKindNameVersionFlags       = flag.NewFlagSet("by-catalog-kind-name-version", flag.ExitOnError)
 		resourceByCatalogKindNameVersionCatalogFlag = resourceByCatalogKindNameVersionFlags.String("catalog", "REQUIRED", "name of catalog")
 		resourceByCatalogKindNameVersionKindFlag    = resourceByCatalogKindNameVersionFlags.String("kind", "REQUIRED", "kind of resource")
 		resourceByCatalogKindNameVersionNameFlag    = resourceByCatalogKindNameVersionFlags.String("name", "REQUIRED", "name of resource")
 		resourceByCatalogKindNameVersionVersionFlag = resourceByCatalogKindNameVersionFlags.String("version", "REQUIRED", "version of resource")
+		resourceByCatalogKindNameVersionTokenFlag   = resourceByCatalogKindNameVersionFlags.String("token", "", "")
 
 		resourceByCatalogKindNameVersionReadmeFlags       = flag.NewFlagSet("by-catalog-kind-name-version-readme", flag.ExitOnError)
 		resourceByCatalogKindNameVersionReadmeCatalogFlag = resourceByCatalogKindNameVersionReadmeFlags.String("catalog", "REQUIRED", "name of catalog")
 		resourceByCatalogKindNameVersionReadmeKindFlag    = resourceByCatalogKindNameVersionReadmeFlags.String("kind", "REQUIRED", "kind of resource")
 		resourceByCatalogKindNameVersionReadmeNameFlag    = resourceByCatalogKindNameVersionReadmeFlags.String("name", "REQUIRED", "name of resource")
 		resourceByCatalogKindNameVersionReadmeVersionFlag = resourceByCatalogKindNameVersionReadmeFlags.String("version", "REQUIRED", "version of resource")
+		resourceByCatalogKindNameVersionReadmeTokenFlag   = resourceByCatalogKindNameVersionReadmeFlags.String("token", "", "")
 
 		resourceByCatalogKindNameVersionYamlFlags       = flag.NewFlagSet("by-catalog-kind-name-version-yaml", flag.ExitOnError)
 		resourceByCatalogKindNameVersionYamlCatalogFlag = resourceByCatalogKindNameVersionYamlFlags.String("catalog", "REQUIRED", "name of catalog")
 		resourceByCatalogKindNameVersionYamlKindFlag    = resourceByCatalogKindNameVersionYamlFlags.String("kind", "REQUIRED", "kind of resource")
 		resourceByCatalogKindNameVersionYamlNameFlag    = resourceByCatalogKindNameVersionYamlFlags.String("name", "REQUIRED", "name of resource")
 		resourceByCatalogKindNameVersionYamlVersionFlag = resourceByCatalogKindNameVersionYamlFlags.String("version", "REQUIRED", "version of resource")
+		resourceByCatalogKindNameVersionYamlInstallFlag = resourceByCatalogKindNameVersionYamlFlags.String("install", "", "")
+		resourceByCatalogKindNameVersionYamlTokenFlag   = resourceByCatalogKindNameVersionYamlFlags.String("token", "", "")
+
+		resourceByCatalogKindNameVersionLintFlags       = flag.NewFlagSet("by-catalog-kind-name-version-lint", flag.ExitOnError)
+		resourceByCatalogKindNameVersionLintCatalogFlag = resourceByCatalogKindNameVersionLintFlags.String("catalog", "REQUIRED", "name of catalog")
+		resourceByCatalogKindNameVersionLintKindFlag    = resourceByCatalogKindNameVersionLintFlags.String("kind", "REQUIRED", "kind of resource")
+		resourceByCatalogKindNameVersionLintNameFlag    = resourceByCatalogKindNameVersionLintFlags.String("name", "REQUIRED", "name of resource")
+		resourceByCatalogKindNameVersionLintVersionFlag = resourceByCatalogKindNameVersionLintFlags.String("version", "REQUIRED", "version of resource")
+		resourceByCatalogKindNameVersionLintTokenFlag   = resourceByCatalogKindNameVersionLintFlags.String("token", "", "")
+
+		resourceByCatalogKindNameVersionImagesFlags       = flag.NewFlagSet("by-catalog-kind-name-version-images", flag.ExitOnError)
+		resourceByCatalogKindNameVersionImagesCatalogFlag = resourceByCatalogKindNameVersionImagesFlags.String("catalog", "REQUIRED", "name of catalog")
+		resourceByCatalogKindNameVersionImagesKindFlag    = resourceByCatalogKindNameVersionImagesFlags.String("kind", "REQUIRED", "kind of resource")
+		resourceByCatalogKindNameVersionImagesNameFlag    = resourceByCatalogKindNameVersionImagesFlags.String("name", "REQUIRED", "name of resource")
+		resourceByCatalogKindNameVersionImagesVersionFlag = resourceByCatalogKindNameVersionImagesFlags.String("version", "REQUIRED", "version of resource")
+		resourceByCatalogKindNameVersionImagesTokenFlag   = resourceByCatalogKindNameVersionImagesFlags.String("token", "", "")
+
+		resourceByImageFlags     = flag.NewFlagSet("by-image", flag.ExitOnError)
+		resourceByImageRefFlag   = resourceByImageFlags.String("ref", "REQUIRED", "")
+		resourceByImageLimitFlag = resourceByImageFlags.String("limit", "1000", "")
+		resourceByImageTokenFlag = resourceByImageFlags.String("token", "", "")
 
 		resourceByVersionIDFlags         = flag.NewFlagSet("by-version-id", flag.ExitOnError)
 		resourceByVersionIDVersionIDFlag = resourceByVersionIDFlags.String("version-id", "REQUIRED", "Version ID of a resource's version")
+		resourceByVersionIDTokenFlag     = resourceByVersionIDFlags.String("token", "", "")
 
 		resourceByCatalogKindNameFlags                = flag.NewFlagSet("by-catalog-kind-name", flag.ExitOnError)
 		resourceByCatalogKindNameCatalogFlag          = resourceByCatalogKindNameFlags.String("catalog", "REQUIRED", "name of catalog")
 		resourceByCatalogKindNameKindFlag             = resourceByCatalogKindNameFlags.String("kind", "REQUIRED", "kind of resource")
 		resourceByCatalogKindNameNameFlag             = resourceByCatalogKindNameFlags.String("name", "REQUIRED", "Name of resource")
 		resourceByCatalogKindNamePipelinesversionFlag = resourceByCatalogKindNameFlags.String("pipelinesversion", "", "")
+		resourceByCatalogKindNamePrereleaseFlag       = resourceByCatalogKindNameFlags.String("prerelease", "", "")
+		resourceByCatalogKindNameTokenFlag            = resourceByCatalogKindNameFlags.String("token", "", "")
 
-		resourceByIDFlags  = flag.NewFlagSet("by-id", flag.ExitOnError)
-		resourceByIDIDFlag = resourceByIDFlags.String("id", "REQUIRED", "ID of a resource")
+		resourceByIDFlags     = flag.NewFlagSet("by-id", flag.ExitOnError)
+		resourceByIDIDFlag    = resourceByIDFlags.String("id", "REQUIRED", "ID of a resource")
+		resourceByIDTokenFlag = resourceByIDFlags.String("token", "", "")
 
 		resourceGetRawYamlByCatalogKindNameVersionFlags       = flag.NewFlagSet("get-raw-yaml-by-catalog-kind-name-version", flag.ExitOnError)
 		resourceGetRawYamlByCatalogKindNameVersionCatalogFlag = resourceGetRawYamlByCatalogKindNameVersionFlags.String("catalog", "REQUIRED", "name of catalog")
 		resourceGetRawYamlByCatalogKindNameVersionKindFlag    = resourceGetRawYamlByCatalogKindNameVersionFlags.String("kind", "REQUIRED", "kind of resource")
 		resourceGetRawYamlByCatalogKindNameVersionNameFlag    = resourceGetRawYamlByCatalogKindNameVersionFlags.String("name", "REQUIRED", "name of resource")
 		resourceGetRawYamlByCatalogKindNameVersionVersionFlag = resourceGetRawYamlByCatalogKindNameVersionFlags.String("version", "REQUIRED", "version of resource")
+		resourceGetRawYamlByCatalogKindNameVersionTokenFlag   = resourceGetRawYamlByCatalogKindNameVersionFlags.String("token", "", "")
 
 		resourceGetLatestRawYamlByCatalogKindNameFlags       = flag.NewFlagSet("get-latest-raw-yaml-by-catalog-kind-name", flag.ExitOnError)
 		resourceGetLatestRawYamlByCatalogKindNameCatalogFlag = resourceGetLatestRawYamlByCatalogKindNameFlags.String("catalog", "REQUIRED", "name of catalog")
 		resourceGetLatestRawYamlByCatalogKindNameKindFlag    = resourceGetLatestRawYamlByCatalogKindNameFlags.String("kind", "REQUIRED", "kind of resource")
 		resourceGetLatestRawYamlByCatalogKindNameNameFlag    = resourceGetLatestRawYamlByCatalogKindNameFlags.String("name", "REQUIRED", "name of resource")
+		resourceGetLatestRawYamlByCatalogKindNameTokenFlag   = resourceGetLatestRawYamlByCatalogKindNameFlags.String("token", "", "")
 	)
 	catalogFlags.Usage = catalogUsage
 	catalogListFlags.Usage = catalogListUsage
@@ -130,9 +209,18 @@ func ParseEndpoint(
 	resourceQueryFlags.Usage = resourceQueryUsage
 	resourceListFlags.Usage = resourceListUsage
 	resourceVersionsByIDFlags.Usage = resourceVersionsByIDUsage
+	resourceListReviewsFlags.Usage = resourceListReviewsUsage
+	resourceCreateReviewFlags.Usage = resourceCreateReviewUsage
+	resourceUpdateReviewFlags.Usage = resourceUpdateReviewUsage
+	resourceDeleteReviewFlags.Usage = resourceDeleteReviewUsage
+	resourceDependenciesFlags.Usage = resourceDependenciesUsage
+	resourceDependentsFlags.Usage = resourceDependentsUsage
 	resourceByCatalogKindNameVersionFlags.Usage = resourceByCatalogKindNameVersionUsage
 	resourceByCatalogKindNameVersionReadmeFlags.Usage = resourceByCatalogKindNameVersionReadmeUsage
 	resourceByCatalogKindNameVersionYamlFlags.Usage = resourceByCatalogKindNameVersionYamlUsage
+	resourceByCatalogKindNameVersionLintFlags.Usage = resourceByCatalogKindNameVersionLintUsage
+	resourceByCatalogKindNameVersionImagesFlags.Usage = resourceByCatalogKindNameVersionImagesUsage
+	resourceByImageFlags.Usage = resourceByImageUsage
 	resourceByVersionIDFlags.Usage = resourceByVersionIDUsage
 	resourceByCatalogKindNameFlags.Usage = resourceByCatalogKindNameUsage
 	resourceByIDFlags.Usage = resourceByIDUsage
@@ -191,6 +279,24 @@ func ParseEndpoint(
 			case "versions-by-id":
 				epf = resourceVersionsByIDFlags
 
+			case "list-reviews":
+				epf = resourceListReviewsFlags
+
+			case "create-review":
+				epf = resourceCreateReviewFlags
+
+			case "update-review":
+				epf = resourceUpdateReviewFlags
+
+			case "delete-review":
+				epf = resourceDeleteReviewFlags
+
+			case "dependencies":
+				epf = resourceDependenciesFlags
+
+			case "dependents":
+				epf = resourceDependentsFlags
+
 			case "by-catalog-kind-name-version":
 				epf = resourceByCatalogKindNameVersionFlags
 
@@ -200,6 +306,15 @@ func ParseEndpoint(
 			case "by-catalog-kind-name-version-yaml":
 				epf = resourceByCatalogKindNameVersionYamlFlags
 
+			case "by-catalog-kind-name-version-lint":
+				epf = resourceByCatalogKindNameVersionLintFlags
+
+			case "by-catalog-kind-name-version-images":
+				epf = resourceByCatalogKindNameVersionImagesFlags
+
+			case "by-image":
+				epf = resourceByImageFlags
+
 			case "by-version-id":
 				epf = resourceByVersionIDFlags
 
@@ -242,43 +357,71 @@ func ParseEndpoint(
 			switch epn {
 			case "list":
 				endpoint = c.List()
+				data, err = catalogc.BuildListPayload(*catalogListTokenFlag)
 			}
 		case "resource":
 			c := resourcec.NewClient(scheme, host, doer, enc, dec, restore)
 			switch epn {
 			case "query":
 				endpoint = c.Query()
-				data, err = resourcec.BuildQueryPayload(*resourceQueryNameFlag, *resourceQueryCatalogsFlag, *resourceQueryCategoriesFlag, *resourceQueryKindsFlag, *resourceQueryTagsFlag, *resourceQueryPlatformsFlag, *resourceQueryLimitFlag, *resourceQueryMatchFlag)
+				data, err = resourcec.BuildQueryPayload(*resourceQueryNameFlag, *resourceQueryCatalogsFlag, *resourceQueryCategoriesFlag, *resourceQueryKindsFlag, *resourceQueryTagsFlag, *resourceQueryPlatformsFlag, *resourceQueryAPIVersionsFlag, *resourceQueryVerificationsFlag, *resourceQueryLimitFlag, *resourceQueryMatchFlag, *resourceQuerySortFlag, *resourceQueryCursorFlag, *resourceQueryPrereleaseFlag, *resourceQueryTokenFlag)
 			case "list":
 				endpoint = c.List()
-				data, err = resourcec.BuildListPayload(*resourceListLimitFlag)
+				data, err = resourcec.BuildListPayload(*resourceListLimitFlag, *resourceListSortFlag, *resourceListCursorFlag, *resourceListPrereleaseFlag, *resourceListTokenFlag)
 			case "versions-by-id":
 				endpoint = c.VersionsByID()
-				data, err = resourcec.BuildVersionsByIDPayload(*resourceVersionsByIDIDFlag)
+				data, err = resourcec.BuildVersionsByIDPayload(*resourceVersionsByIDIDFlag, *resourceVersionsByIDPrereleaseFlag, *resourceVersionsByIDTokenFlag)
+			case "list-reviews":
+				endpoint = c.ListReviews()
+				data, err = resourcec.BuildListReviewsPayload(*resourceListReviewsIDFlag, *resourceListReviewsLimitFlag, *resourceListReviewsCursorFlag, *resourceListReviewsTokenFlag)
+			case "create-review":
+				endpoint = c.CreateReview()
+				data, err = resourcec.BuildCreateReviewPayload(*resourceCreateReviewBodyFlag, *resourceCreateReviewIDFlag, *resourceCreateReviewTokenFlag)
+			case "update-review":
+				endpoint = c.UpdateReview()
+				data, err = resourcec.BuildUpdateReviewPayload(*resourceUpdateReviewBodyFlag, *resourceUpdateReviewIDFlag, *resourceUpdateReviewReviewIDFlag, *resourceUpdateReviewTokenFlag)
+			case "delete-review":
+				endpoint = c.DeleteReview()
+				data, err = resourcec.BuildDeleteReviewPayload(*resourceDeleteReviewIDFlag, *resourceDeleteReviewReviewIDFlag, *resourceDeleteReviewTokenFlag)
+			case "dependencies":
+				endpoint = c.Dependencies()
+				data, err = resourcec.BuildDependenciesPayload(*resourceDependenciesIDFlag, *resourceDependenciesVersionFlag, *resourceDependenciesTokenFlag)
+			case "dependents":
+				endpoint = c.Dependents()
+				data, err = resourcec.BuildDependentsPayload(*resourceDependentsIDFlag, *resourceDependentsVersionFlag, *resourceDependentsTokenFlag)
 			case "by-catalog-kind-name-version":
 				endpoint = c.ByCatalogKindNameVersion()
-				data, err = resourcec.BuildByCatalogKindNameVersionPayload(*resourceByCatalogKindNameVersionCatalogFlag, *resourceByCatalogKindNameVersionKindFlag, *resourceByCatalogKindNameVersionNameFlag, *resourceByCatalogKindNameVersionVersionFlag)
+				data, err = resourcec.BuildByCatalogKindNameVersionPayload(*resourceByCatalogKindNameVersionCatalogFlag, *resourceByCatalogKindNameVersionKindFlag, *resourceByCatalogKindNameVersionNameFlag, *resourceByCatalogKindNameVersionVersionFlag, *resourceByCatalogKindNameVersionTokenFlag)
 			case "by-catalog-kind-name-version-readme":
 				endpoint = c.ByCatalogKindNameVersionReadme()
-				data, err = resourcec.BuildByCatalogKindNameVersionReadmePayload(*resourceByCatalogKindNameVersionReadmeCatalogFlag, *resourceByCatalogKindNameVersionReadmeKindFlag, *resourceByCatalogKindNameVersionReadmeNameFlag, *resourceByCatalogKindNameVersionReadmeVersionFlag)
+				data, err = resourcec.BuildByCatalogKindNameVersionReadmePayload(*resourceByCatalogKindNameVersionReadmeCatalogFlag, *resourceByCatalogKindNameVersionReadmeKindFlag, *resourceByCatalogKindNameVersionReadmeNameFlag, *resourceByCatalogKindNameVersionReadmeVersionFlag, *resourceByCatalogKindNameVersionReadmeTokenFlag)
 			case "by-catalog-kind-name-version-yaml":
 				endpoint = c.ByCatalogKindNameVersionYaml()
-				data, err = resourcec.BuildByCatalogKindNameVersionYamlPayload(*resourceByCatalogKindNameVersionYamlCatalogFlag, *resourceByCatalogKindNameVersionYamlKindFlag, *resourceByCatalogKindNameVersionYamlNameFlag, *resourceByCatalogKindNameVersionYamlVersionFlag)
+				data, err = resourcec.BuildByCatalogKindNameVersionYamlPayload(*resourceByCatalogKindNameVersionYamlCatalogFlag, *resourceByCatalogKindNameVersionYamlKindFlag, *resourceByCatalogKindNameVersionYamlNameFlag, *resourceByCatalogKindNameVersionYamlVersionFlag, *resourceByCatalogKindNameVersionYamlInstallFlag, *resourceByCatalogKindNameVersionYamlTokenFlag)
+			case "by-catalog-kind-name-version-lint":
+				endpoint = c.ByCatalogKindNameVersionLint()
+				data, err = resourcec.BuildByCatalogKindNameVersionLintPayload(*resourceByCatalogKindNameVersionLintCatalogFlag, *resourceByCatalogKindNameVersionLintKindFlag, *resourceByCatalogKindNameVersionLintNameFlag, *resourceByCatalogKindNameVersionLintVersionFlag, *resourceByCatalogKindNameVersionLintTokenFlag)
+			case "by-catalog-kind-name-version-images":
+				endpoint = c.ByCatalogKindNameVersionImages()
+				data, err = resourcec.BuildByCatalogKindNameVersionImagesPayload(*resourceByCatalogKindNameVersionImagesCatalogFlag, *resourceByCatalogKindNameVersionImagesKindFlag, *resourceByCatalogKindNameVersionImagesNameFlag, *resourceByCatalogKindNameVersionImagesVersionFlag, *resourceByCatalogKindNameVersionImagesTokenFlag)
+			case "by-image":
+				endpoint = c.ByImage()
+				data, err = resourcec.BuildByImagePayload(*resourceByImageRefFlag, *resourceByImageLimitFlag, *resourceByImageTokenFlag)
 			case "by-version-id":
 				endpoint = c.ByVersionID()
-				data, err = resourcec.BuildByVersionIDPayload(*resourceByVersionIDVersionIDFlag)
+				data, err = resourcec.BuildByVersionIDPayload(*resourceByVersionIDVersionIDFlag, *resourceByVersionIDTokenFlag)
 			case "by-catalog-kind-name":
 				endpoint = c.ByCatalogKindName()
-				data, err = resourcec.BuildByCatalogKindNamePayload(*resourceByCatalogKindNameCatalogFlag, *resourceByCatalogKindNameKindFlag, *resourceByCatalogKindNameNameFlag, *resourceByCatalogKindNamePipelinesversionFlag)
+				data, err = resourcec.BuildByCatalogKindNamePayload(*resourceByCatalogKindNameCatalogFlag, *resourceByCatalogKindNameKindFlag, *resourceByCatalogKindNameNameFlag, *resourceByCatalogKindNamePipelinesversionFlag, *resourceByCatalogKindNamePrereleaseFlag, *resourceByCatalogKindNameTokenFlag)
 			case "by-id":
 				endpoint = c.ByID()
-				data, err = resourcec.BuildByIDPayload(*resourceByIDIDFlag)
+				data, err = resourcec.BuildByIDPayload(*resourceByIDIDFlag, *resourceByIDTokenFlag)
 			case "get-raw-yaml-by-catalog-kind-name-version":
 				endpoint = c.GetRawYamlByCatalogKindNameVersion()
-				data, err = resourcec.BuildGetRawYamlByCatalogKindNameVersionPayload(*resourceGetRawYamlByCatalogKindNameVersionCatalogFlag, *resourceGetRawYamlByCatalogKindNameVersionKindFlag, *resourceGetRawYamlByCatalogKindNameVersionNameFlag, *resourceGetRawYamlByCatalogKindNameVersionVersionFlag)
+				data, err = resourcec.BuildGetRawYamlByCatalogKindNameVersionPayload(*resourceGetRawYamlByCatalogKindNameVersionCatalogFlag, *resourceGetRawYamlByCatalogKindNameVersionKindFlag, *resourceGetRawYamlByCatalogKindNameVersionNameFlag, *resourceGetRawYamlByCatalogKindNameVersionVersionFlag, *resourceGetRawYamlByCatalogKindNameVersionTokenFlag)
 			case "get-latest-raw-yaml-by-catalog-kind-name":
 				endpoint = c.GetLatestRawYamlByCatalogKindName()
-				data, err = resourcec.BuildGetLatestRawYamlByCatalogKindNamePayload(*resourceGetLatestRawYamlByCatalogKindNameCatalogFlag, *resourceGetLatestRawYamlByCatalogKindNameKindFlag, *resourceGetLatestRawYamlByCatalogKindNameNameFlag)
+				data, err = resourcec.BuildGetLatestRawYamlByCatalogKindNamePayload(*resourceGetLatestRawYamlByCatalogKindNameCatalogFlag, *resourceGetLatestRawYamlByCatalogKindNameKindFlag, *resourceGetLatestRawYamlByCatalogKindNameNameFlag, *resourceGetLatestRawYamlByCatalogKindNameTokenFlag)
 			}
 		}
 	}
@@ -296,19 +439,20 @@ Usage:
     %[1]s [globalflags] catalog COMMAND [flags]
 
 COMMAND:
-    list: List all Catalogs
+    list: List all Catalogs, private catalogs are listed only for the users allowed to see them
 
 Additional help:
     %[1]s catalog COMMAND --help
 `, os.Args[0])
 }
 func catalogListUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] catalog list
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] catalog list -token STRING
 
-List all Catalogs
+List all Catalogs, private catalogs are listed only for the users allowed to see them
+    -token STRING: 
 
 Example:
-    %[1]s catalog list
+    %[1]s catalog list --token "Non saepe maiores quia nam est."
 `, os.Args[0])
 }
 
@@ -319,12 +463,21 @@ Usage:
     %[1]s [globalflags] resource COMMAND [flags]
 
 COMMAND:
-    query: Find resources by a combination of name, kind, catalog, categories, platforms and tags
+    query: Find resources by a combination of name, kind, catalog, categories, platforms, apiVersions, verifications and tags
     list: List all resources sorted by rating and name
     versions-by-id: Find all versions of a resource by its id
+    list-reviews: List the reviews of a resource, latest first
+    create-review: Write a review on a version of a resource
+    update-review: Edit a review written by the user
+    delete-review: Delete a review written by the user
+    dependencies: List the tasks referenced by a version of a pipeline, by default the latest version
+    dependents: List the versions of the pipelines referencing a task
     by-catalog-kind-name-version: Find resource using name of catalog & name, kind and version of resource
     by-catalog-kind-name-version-readme: Find resource README using name of catalog & name, kind and version of resource
     by-catalog-kind-name-version-yaml: Find resource YAML using name of catalog & name, kind and version of resource
+    by-catalog-kind-name-version-lint: Find the problems found by the lint rules in a resource using name of catalog & name, kind and version of resource
+    by-catalog-kind-name-version-images: Find the images of the steps and sidecars of a resource using name of catalog & name, kind and version of resource
+    by-image: Find the versions of the resources using an image, an image without tag and digest matches all the tags and digests of the image
     by-version-id: Find a resource using its version's id
     by-catalog-kind-name: Find resources using name of catalog, resource name and kind of resource
     by-id: Find a resource using it's id
@@ -336,17 +489,23 @@ Additional help:
 `, os.Args[0])
 }
 func resourceQueryUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource query -name STRING -catalogs JSON -categories JSON -kinds JSON -tags JSON -platforms JSON -limit UINT -match STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource query -name STRING -catalogs JSON -categories JSON -kinds JSON -tags JSON -platforms JSON -api-versions JSON -verifications JSON -limit UINT -match STRING -sort STRING -cursor STRING -prerelease BOOL -token STRING
 
-Find resources by a combination of name, kind, catalog, categories, platforms and tags
+Find resources by a combination of name, kind, catalog, categories, platforms, apiVersions, verifications and tags
     -name STRING: 
     -catalogs JSON: 
     -categories JSON: 
     -kinds JSON: 
     -tags JSON: 
     -platforms JSON: 
+    -api-versions JSON: 
+    -verifications JSON: 
     -limit UINT: 
     -match STRING: 
+    -sort STRING: 
+    -cursor STRING: 
+    -prerelease BOOL: 
+    -token STRING: 
 
 Example:
     %[1]s resource query --name "buildah" --catalogs '[
@@ -364,133 +523,282 @@ Example:
    ]' --platforms '[
       "linux/s390x",
       "linux/amd64"
-   ]' --limit 100 --match "exact"
+   ]' --api-versions '[
+      "tekton.dev/v1",
+      "tekton.dev/v1beta1"
+   ]' --verifications '[
+      "verified"
+   ]' --limit 100 --match "exact" --sort "updated" --cursor "Illum aut vero veniam nihil vel." --prerelease true --token "Exercitationem molestias id eum illum rerum."
 `, os.Args[0])
 }
 
 func resourceListUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource list -limit UINT
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource list -limit UINT -sort STRING -cursor STRING -prerelease BOOL -token STRING
 
 List all resources sorted by rating and name
     -limit UINT: 
+    -sort STRING: 
+    -cursor STRING: 
+    -prerelease BOOL: 
+    -token STRING: 
 
 Example:
-    %[1]s resource list --limit 100
+    %[1]s resource list --limit 100 --sort "updated" --cursor "Ea ut." --prerelease false --token "Maxime quia."
 `, os.Args[0])
 }
 
 func resourceVersionsByIDUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource versions-by-id -id UINT
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource versions-by-id -id UINT -prerelease BOOL -token STRING
 
 Find all versions of a resource by its id
     -id UINT: ID of a resource
+    -prerelease BOOL: 
+    -token STRING: 
 
 Example:
-    %[1]s resource versions-by-id --id 1
+    %[1]s resource versions-by-id --id 1 --prerelease true --token "Ut provident sed harum aliquid."
+`, os.Args[0])
+}
+
+func resourceListReviewsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource list-reviews -id UINT -limit UINT -cursor STRING -token STRING
+
+List the reviews of a resource, latest first
+    -id UINT: ID of a resource
+    -limit UINT: 
+    -cursor STRING: 
+    -token STRING: 
+
+Example:
+    %[1]s resource list-reviews --id 1 --limit 20 --cursor "Corporis ea incidunt consequatur nostrum." --token "Ab assumenda natus velit."
+`, os.Args[0])
+}
+
+func resourceCreateReviewUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource create-review -body JSON -id UINT -token STRING
+
+Write a review on a version of a resource
+    -body JSON: 
+    -id UINT: ID of a resource
+    -token STRING: 
+
+Example:
+    %[1]s resource create-review --body '{
+      "text": "Works well, the workspace for the credentials could be optional",
+      "version": "0.1"
+   }' --id 1 --token "Deleniti aperiam laborum possimus."
+`, os.Args[0])
+}
+
+func resourceUpdateReviewUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource update-review -body JSON -id UINT -review-id UINT -token STRING
+
+Edit a review written by the user
+    -body JSON: 
+    -id UINT: ID of a resource
+    -review-id UINT: ID of the review
+    -token STRING: 
+
+Example:
+    %[1]s resource update-review --body '{
+      "text": "Works well, the workspace for the credentials could be optional"
+   }' --id 1 --review-id 1 --token "Eos et nam."
+`, os.Args[0])
+}
+
+func resourceDeleteReviewUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource delete-review -id UINT -review-id UINT -token STRING
+
+Delete a review written by the user
+    -id UINT: ID of a resource
+    -review-id UINT: ID of the review
+    -token STRING: 
+
+Example:
+    %[1]s resource delete-review --id 1 --review-id 1 --token "Quia laboriosam."
+`, os.Args[0])
+}
+
+func resourceDependenciesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource dependencies -id UINT -version STRING -token STRING
+
+List the tasks referenced by a version of a pipeline, by default the latest version
+    -id UINT: ID of a resource
+    -version STRING: 
+    -token STRING: 
+
+Example:
+    %[1]s resource dependencies --id 1 --version "0.1" --token "Qui qui soluta."
+`, os.Args[0])
+}
+
+func resourceDependentsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource dependents -id UINT -version STRING -token STRING
+
+List the versions of the pipelines referencing a task
+    -id UINT: ID of a resource
+    -version STRING: 
+    -token STRING: 
+
+Example:
+    %[1]s resource dependents --id 1 --version "0.9" --token "Quia et laudantium ut."
 `, os.Args[0])
 }
 
 func resourceByCatalogKindNameVersionUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-catalog-kind-name-version -catalog STRING -kind STRING -name STRING -version STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-catalog-kind-name-version -catalog STRING -kind STRING -name STRING -version STRING -token STRING
 
 Find resource using name of catalog & name, kind and version of resource
     -catalog STRING: name of catalog
     -kind STRING: kind of resource
     -name STRING: name of resource
     -version STRING: version of resource
+    -token STRING: 
 
 Example:
-    %[1]s resource by-catalog-kind-name-version --catalog "tekton" --kind "task" --name "buildah" --version "0.1"
+    %[1]s resource by-catalog-kind-name-version --catalog "tekton" --kind "task" --name "buildah" --version "0.1" --token "Unde voluptatem magni voluptatem ut."
 `, os.Args[0])
 }
 
 func resourceByCatalogKindNameVersionReadmeUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-catalog-kind-name-version-readme -catalog STRING -kind STRING -name STRING -version STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-catalog-kind-name-version-readme -catalog STRING -kind STRING -name STRING -version STRING -token STRING
 
 Find resource README using name of catalog & name, kind and version of resource
     -catalog STRING: name of catalog
     -kind STRING: kind of resource
     -name STRING: name of resource
     -version STRING: version of resource
+    -token STRING: 
 
 Example:
-    %[1]s resource by-catalog-kind-name-version-readme --catalog "tekton" --kind "task" --name "buildah" --version "0.1"
+    %[1]s resource by-catalog-kind-name-version-readme --catalog "tekton" --kind "task" --name "buildah" --version "0.1" --token "Natus velit vitae itaque expedita sapiente quis."
 `, os.Args[0])
 }
 
 func resourceByCatalogKindNameVersionYamlUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-catalog-kind-name-version-yaml -catalog STRING -kind STRING -name STRING -version STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-catalog-kind-name-version-yaml -catalog STRING -kind STRING -name STRING -version STRING -install BOOL -token STRING
 
 Find resource YAML using name of catalog & name, kind and version of resource
     -catalog STRING: name of catalog
     -kind STRING: kind of resource
     -name STRING: name of resource
     -version STRING: version of resource
+    -install BOOL: 
+    -token STRING: 
+
+Example:
+    %[1]s resource by-catalog-kind-name-version-yaml --catalog "tekton" --kind "pipeline" --name "buildah" --version "0.1" --install true --token "Ut accusantium ea."
+`, os.Args[0])
+}
+
+func resourceByCatalogKindNameVersionLintUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-catalog-kind-name-version-lint -catalog STRING -kind STRING -name STRING -version STRING -token STRING
+
+Find the problems found by the lint rules in a resource using name of catalog & name, kind and version of resource
+    -catalog STRING: name of catalog
+    -kind STRING: kind of resource
+    -name STRING: name of resource
+    -version STRING: version of resource
+    -token STRING: 
+
+Example:
+    %[1]s resource by-catalog-kind-name-version-lint --catalog "tekton" --kind "task" --name "buildah" --version "0.1" --token "Aut sed voluptas."
+`, os.Args[0])
+}
+
+func resourceByCatalogKindNameVersionImagesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-catalog-kind-name-version-images -catalog STRING -kind STRING -name STRING -version STRING -token STRING
+
+Find the images of the steps and sidecars of a resource using name of catalog & name, kind and version of resource
+    -catalog STRING: name of catalog
+    -kind STRING: kind of resource
+    -name STRING: name of resource
+    -version STRING: version of resource
+    -token STRING: 
+
+Example:
+    %[1]s resource by-catalog-kind-name-version-images --catalog "tekton" --kind "pipeline" --name "buildah" --version "0.1" --token "Omnis dolorum hic quia iure."
+`, os.Args[0])
+}
+
+func resourceByImageUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-image -ref STRING -limit UINT -token STRING
+
+Find the versions of the resources using an image, an image without tag and digest matches all the tags and digests of the image
+    -ref STRING: 
+    -limit UINT: 
+    -token STRING: 
 
 Example:
-    %[1]s resource by-catalog-kind-name-version-yaml --catalog "tekton" --kind "pipeline" --name "buildah" --version "0.1"
+    %[1]s resource by-image --ref "alpine:3.18" --limit 100 --token "Consectetur veritatis consequuntur."
 `, os.Args[0])
 }
 
 func resourceByVersionIDUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-version-id -version-id UINT
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-version-id -version-id UINT -token STRING
 
 Find a resource using its version's id
     -version-id UINT: Version ID of a resource's version
+    -token STRING: 
 
 Example:
-    %[1]s resource by-version-id --version-id 1
+    %[1]s resource by-version-id --version-id 1 --token "Magnam ea voluptas ipsam molestias assumenda."
 `, os.Args[0])
 }
 
 func resourceByCatalogKindNameUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-catalog-kind-name -catalog STRING -kind STRING -name STRING -pipelinesversion STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-catalog-kind-name -catalog STRING -kind STRING -name STRING -pipelinesversion STRING -prerelease BOOL -token STRING
 
 Find resources using name of catalog, resource name and kind of resource
     -catalog STRING: name of catalog
     -kind STRING: kind of resource
     -name STRING: Name of resource
     -pipelinesversion STRING: 
+    -prerelease BOOL: 
+    -token STRING: 
 
 Example:
-    %[1]s resource by-catalog-kind-name --catalog "tekton" --kind "task" --name "buildah" --pipelinesversion "0.21.0"
+    %[1]s resource by-catalog-kind-name --catalog "tekton" --kind "task" --name "buildah" --pipelinesversion "0.21.0" --prerelease false --token "A porro et dicta."
 `, os.Args[0])
 }
 
 func resourceByIDUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-id -id UINT
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource by-id -id UINT -token STRING
 
 Find a resource using it's id
     -id UINT: ID of a resource
+    -token STRING: 
 
 Example:
-    %[1]s resource by-id --id 1
+    %[1]s resource by-id --id 1 --token "Ullam accusantium."
 `, os.Args[0])
 }
 
 func resourceGetRawYamlByCatalogKindNameVersionUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource get-raw-yaml-by-catalog-kind-name-version -catalog STRING -kind STRING -name STRING -version STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource get-raw-yaml-by-catalog-kind-name-version -catalog STRING -kind STRING -name STRING -version STRING -token STRING
 
 Fetch a raw resource yaml file using the name of catalog, resource name, kind, and version
     -catalog STRING: name of catalog
     -kind STRING: kind of resource
     -name STRING: name of resource
     -version STRING: version of resource
+    -token STRING: 
 
 Example:
-    %[1]s resource get-raw-yaml-by-catalog-kind-name-version --catalog "tekton" --kind "pipeline" --name "buildah" --version "0.1"
+    %[1]s resource get-raw-yaml-by-catalog-kind-name-version --catalog "tekton" --kind "pipeline" --name "buildah" --version "0.1" --token "Ducimus in."
 `, os.Args[0])
 }
 
 func resourceGetLatestRawYamlByCatalogKindNameUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource get-latest-raw-yaml-by-catalog-kind-name -catalog STRING -kind STRING -name STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] resource get-latest-raw-yaml-by-catalog-kind-name -catalog STRING -kind STRING -name STRING -token STRING
 
 Fetch a raw latest resource yaml file using the name of catalog, resource name, and kind
     -catalog STRING: name of catalog
     -kind STRING: kind of resource
     -name STRING: name of resource
+    -token STRING: 
 
 Example:
-    %[1]s resource get-latest-raw-yaml-by-catalog-kind-name --catalog "tekton" --kind "pipeline" --name "buildah"
+    %[1]s resource get-latest-raw-yaml-by-catalog-kind-name --catalog "tekton" --kind "pipeline" --name "buildah" --token "Similique commodi ratione et quo aliquam."
 `, os.Args[0])
 }
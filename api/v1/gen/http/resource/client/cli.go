@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"unicode/utf8"
 
 	resource "github.com/tektoncd/hub/api/v1/gen/resource"
 	goa "goa.design/goa/v3/pkg"
@@ -18,7 +19,7 @@ import (
 
 // BuildQueryPayload builds the payload for the resource Query endpoint from
 // CLI flags.
-func BuildQueryPayload(resourceQueryName string, resourceQueryCatalogs string, resourceQueryCategories string, resourceQueryKinds string, resourceQueryTags string, resourceQueryPlatforms string, resourceQueryLimit string, resourceQueryMatch string) (*resource.QueryPayload, error) {
+func BuildQueryPayload(resourceQueryName string, resourceQueryCatalogs string, resourceQueryCategories string, resourceQueryKinds string, resourceQueryTags string, resourceQueryPlatforms string, resourceQueryAPIVersions string, resourceQueryVerifications string, resourceQueryLimit string, resourceQueryMatch string, resourceQuerySort string, resourceQueryCursor string, resourceQueryPrerelease string, resourceQueryToken string) (*resource.QueryPayload, error) {
 	var err error
 	var name string
 	{
@@ -71,6 +72,32 @@ func BuildQueryPayload(resourceQueryName string, resourceQueryCatalogs string, r
 			}
 		}
 	}
+	var apiVersions []string
+	{
+		if resourceQueryAPIVersions != "" {
+			err = json.Unmarshal([]byte(resourceQueryAPIVersions), &apiVersions)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for apiVersions, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"tekton.dev/v1\",\n      \"tekton.dev/v1beta1\"\n   ]'")
+			}
+		}
+	}
+	var verifications []string
+	{
+		if resourceQueryVerifications != "" {
+			err = json.Unmarshal([]byte(resourceQueryVerifications), &verifications)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for verifications, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"verified\"\n   ]'")
+			}
+			for _, e := range verifications {
+				if !(e == "verified" || e == "unsigned" || e == "invalid") {
+					err = goa.MergeErrors(err, goa.InvalidEnumValueError("verifications[*]", e, []any{"verified", "unsigned", "invalid"}))
+				}
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	var limit uint
 	{
 		if resourceQueryLimit != "" {
@@ -86,14 +113,47 @@ func BuildQueryPayload(resourceQueryName string, resourceQueryCatalogs string, r
 	{
 		if resourceQueryMatch != "" {
 			match = resourceQueryMatch
-			if !(match == "exact" || match == "contains") {
-				err = goa.MergeErrors(err, goa.InvalidEnumValueError("match", match, []any{"exact", "contains"}))
+			if !(match == "exact" || match == "contains" || match == "fulltext") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("match", match, []any{"exact", "contains", "fulltext"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var sort *string
+	{
+		if resourceQuerySort != "" {
+			sort = &resourceQuerySort
+			if !(*sort == "rating" || *sort == "name" || *sort == "updated" || *sort == "versions" || *sort == "popular") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", *sort, []any{"rating", "name", "updated", "versions", "popular"}))
 			}
 			if err != nil {
 				return nil, err
 			}
 		}
 	}
+	var cursor *string
+	{
+		if resourceQueryCursor != "" {
+			cursor = &resourceQueryCursor
+		}
+	}
+	var prerelease bool
+	{
+		if resourceQueryPrerelease != "" {
+			prerelease, err = strconv.ParseBool(resourceQueryPrerelease)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for prerelease, must be BOOL")
+			}
+		}
+	}
+	var token *string
+	{
+		if resourceQueryToken != "" {
+			token = &resourceQueryToken
+		}
+	}
 	v := &resource.QueryPayload{}
 	v.Name = name
 	v.Catalogs = catalogs
@@ -101,15 +161,21 @@ func BuildQueryPayload(resourceQueryName string, resourceQueryCatalogs string, r
 	v.Kinds = kinds
 	v.Tags = tags
 	v.Platforms = platforms
+	v.APIVersions = apiVersions
+	v.Verifications = verifications
 	v.Limit = limit
 	v.Match = match
+	v.Sort = sort
+	v.Cursor = cursor
+	v.Prerelease = prerelease
+	v.Token = token
 
 	return v, nil
 }
 
 // BuildListPayload builds the payload for the resource List endpoint from CLI
 // flags.
-func BuildListPayload(resourceListLimit string) (*resource.ListPayload, error) {
+func BuildListPayload(resourceListLimit string, resourceListSort string, resourceListCursor string, resourceListPrerelease string, resourceListToken string) (*resource.ListPayload, error) {
 	var err error
 	var limit uint
 	{
@@ -122,15 +188,52 @@ func BuildListPayload(resourceListLimit string) (*resource.ListPayload, error) {
 			}
 		}
 	}
+	var sort *string
+	{
+		if resourceListSort != "" {
+			sort = &resourceListSort
+			if !(*sort == "rating" || *sort == "name" || *sort == "updated" || *sort == "versions" || *sort == "popular") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", *sort, []any{"rating", "name", "updated", "versions", "popular"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var cursor *string
+	{
+		if resourceListCursor != "" {
+			cursor = &resourceListCursor
+		}
+	}
+	var prerelease bool
+	{
+		if resourceListPrerelease != "" {
+			prerelease, err = strconv.ParseBool(resourceListPrerelease)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for prerelease, must be BOOL")
+			}
+		}
+	}
+	var token *string
+	{
+		if resourceListToken != "" {
+			token = &resourceListToken
+		}
+	}
 	v := &resource.ListPayload{}
 	v.Limit = limit
+	v.Sort = sort
+	v.Cursor = cursor
+	v.Prerelease = prerelease
+	v.Token = token
 
 	return v, nil
 }
 
 // BuildVersionsByIDPayload builds the payload for the resource VersionsByID
 // endpoint from CLI flags.
-func BuildVersionsByIDPayload(resourceVersionsByIDID string) (*resource.VersionsByIDPayload, error) {
+func BuildVersionsByIDPayload(resourceVersionsByIDID string, resourceVersionsByIDPrerelease string, resourceVersionsByIDToken string) (*resource.VersionsByIDPayload, error) {
 	var err error
 	var id uint
 	{
@@ -141,15 +244,272 @@ func BuildVersionsByIDPayload(resourceVersionsByIDID string) (*resource.Versions
 			return nil, fmt.Errorf("invalid value for id, must be UINT")
 		}
 	}
+	var prerelease bool
+	{
+		if resourceVersionsByIDPrerelease != "" {
+			prerelease, err = strconv.ParseBool(resourceVersionsByIDPrerelease)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for prerelease, must be BOOL")
+			}
+		}
+	}
+	var token *string
+	{
+		if resourceVersionsByIDToken != "" {
+			token = &resourceVersionsByIDToken
+		}
+	}
 	v := &resource.VersionsByIDPayload{}
 	v.ID = id
+	v.Prerelease = prerelease
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListReviewsPayload builds the payload for the resource ListReviews
+// endpoint from CLI flags.
+func BuildListReviewsPayload(resourceListReviewsID string, resourceListReviewsLimit string, resourceListReviewsCursor string, resourceListReviewsToken string) (*resource.ListReviewsPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(resourceListReviewsID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var limit uint
+	{
+		if resourceListReviewsLimit != "" {
+			var v uint64
+			v, err = strconv.ParseUint(resourceListReviewsLimit, 10, strconv.IntSize)
+			limit = uint(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be UINT")
+			}
+		}
+	}
+	var cursor *string
+	{
+		if resourceListReviewsCursor != "" {
+			cursor = &resourceListReviewsCursor
+		}
+	}
+	var token *string
+	{
+		if resourceListReviewsToken != "" {
+			token = &resourceListReviewsToken
+		}
+	}
+	v := &resource.ListReviewsPayload{}
+	v.ID = id
+	v.Limit = limit
+	v.Cursor = cursor
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCreateReviewPayload builds the payload for the resource CreateReview
+// endpoint from CLI flags.
+func BuildCreateReviewPayload(resourceCreateReviewBody string, resourceCreateReviewID string, resourceCreateReviewToken string) (*resource.CreateReviewPayload, error) {
+	var err error
+	var body CreateReviewRequestBody
+	{
+		err = json.Unmarshal([]byte(resourceCreateReviewBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"text\": \"Works well, the workspace for the credentials could be optional\",\n      \"version\": \"0.1\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Text) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.text", body.Text, utf8.RuneCountInString(body.Text), 1, true))
+		}
+		if utf8.RuneCountInString(body.Text) > 5000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.text", body.Text, utf8.RuneCountInString(body.Text), 5000, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(resourceCreateReviewID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var token string
+	{
+		token = resourceCreateReviewToken
+	}
+	v := &resource.CreateReviewPayload{
+		Version: body.Version,
+		Text:    body.Text,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildUpdateReviewPayload builds the payload for the resource UpdateReview
+// endpoint from CLI flags.
+func BuildUpdateReviewPayload(resourceUpdateReviewBody string, resourceUpdateReviewID string, resourceUpdateReviewReviewID string, resourceUpdateReviewToken string) (*resource.UpdateReviewPayload, error) {
+	var err error
+	var body UpdateReviewRequestBody
+	{
+		err = json.Unmarshal([]byte(resourceUpdateReviewBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"text\": \"Works well, the workspace for the credentials could be optional\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Text) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.text", body.Text, utf8.RuneCountInString(body.Text), 1, true))
+		}
+		if utf8.RuneCountInString(body.Text) > 5000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.text", body.Text, utf8.RuneCountInString(body.Text), 5000, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(resourceUpdateReviewID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var reviewID uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(resourceUpdateReviewReviewID, 10, strconv.IntSize)
+		reviewID = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for reviewID, must be UINT")
+		}
+	}
+	var token string
+	{
+		token = resourceUpdateReviewToken
+	}
+	v := &resource.UpdateReviewPayload{
+		Text: body.Text,
+	}
+	v.ID = id
+	v.ReviewID = reviewID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildDeleteReviewPayload builds the payload for the resource DeleteReview
+// endpoint from CLI flags.
+func BuildDeleteReviewPayload(resourceDeleteReviewID string, resourceDeleteReviewReviewID string, resourceDeleteReviewToken string) (*resource.DeleteReviewPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(resourceDeleteReviewID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var reviewID uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(resourceDeleteReviewReviewID, 10, strconv.IntSize)
+		reviewID = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for reviewID, must be UINT")
+		}
+	}
+	var token string
+	{
+		token = resourceDeleteReviewToken
+	}
+	v := &resource.DeleteReviewPayload{}
+	v.ID = id
+	v.ReviewID = reviewID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildDependenciesPayload builds the payload for the resource Dependencies
+// endpoint from CLI flags.
+func BuildDependenciesPayload(resourceDependenciesID string, resourceDependenciesVersion string, resourceDependenciesToken string) (*resource.DependenciesPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(resourceDependenciesID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var version *string
+	{
+		if resourceDependenciesVersion != "" {
+			version = &resourceDependenciesVersion
+		}
+	}
+	var token *string
+	{
+		if resourceDependenciesToken != "" {
+			token = &resourceDependenciesToken
+		}
+	}
+	v := &resource.DependenciesPayload{}
+	v.ID = id
+	v.Version = version
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildDependentsPayload builds the payload for the resource Dependents
+// endpoint from CLI flags.
+func BuildDependentsPayload(resourceDependentsID string, resourceDependentsVersion string, resourceDependentsToken string) (*resource.DependentsPayload, error) {
+	var err error
+	var id uint
+	{
+		var v uint64
+		v, err = strconv.ParseUint(resourceDependentsID, 10, strconv.IntSize)
+		id = uint(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be UINT")
+		}
+	}
+	var version *string
+	{
+		if resourceDependentsVersion != "" {
+			version = &resourceDependentsVersion
+		}
+	}
+	var token *string
+	{
+		if resourceDependentsToken != "" {
+			token = &resourceDependentsToken
+		}
+	}
+	v := &resource.DependentsPayload{}
+	v.ID = id
+	v.Version = version
+	v.Token = token
 
 	return v, nil
 }
 
 // BuildByCatalogKindNameVersionPayload builds the payload for the resource
 // ByCatalogKindNameVersion endpoint from CLI flags.
-func BuildByCatalogKindNameVersionPayload(resourceByCatalogKindNameVersionCatalog string, resourceByCatalogKindNameVersionKind string, resourceByCatalogKindNameVersionName string, resourceByCatalogKindNameVersionVersion string) (*resource.ByCatalogKindNameVersionPayload, error) {
+func BuildByCatalogKindNameVersionPayload(resourceByCatalogKindNameVersionCatalog string, resourceByCatalogKindNameVersionKind string, resourceByCatalogKindNameVersionName string, resourceByCatalogKindNameVersionVersion string, resourceByCatalogKindNameVersionToken string) (*resource.ByCatalogKindNameVersionPayload, error) {
 	var err error
 	var catalog string
 	{
@@ -158,8 +518,8 @@ func BuildByCatalogKindNameVersionPayload(resourceByCatalogKindNameVersionCatalo
 	var kind string
 	{
 		kind = resourceByCatalogKindNameVersionKind
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		if err != nil {
 			return nil, err
@@ -173,18 +533,25 @@ func BuildByCatalogKindNameVersionPayload(resourceByCatalogKindNameVersionCatalo
 	{
 		version = resourceByCatalogKindNameVersionVersion
 	}
+	var token *string
+	{
+		if resourceByCatalogKindNameVersionToken != "" {
+			token = &resourceByCatalogKindNameVersionToken
+		}
+	}
 	v := &resource.ByCatalogKindNameVersionPayload{}
 	v.Catalog = catalog
 	v.Kind = kind
 	v.Name = name
 	v.Version = version
+	v.Token = token
 
 	return v, nil
 }
 
 // BuildByCatalogKindNameVersionReadmePayload builds the payload for the
 // resource ByCatalogKindNameVersionReadme endpoint from CLI flags.
-func BuildByCatalogKindNameVersionReadmePayload(resourceByCatalogKindNameVersionReadmeCatalog string, resourceByCatalogKindNameVersionReadmeKind string, resourceByCatalogKindNameVersionReadmeName string, resourceByCatalogKindNameVersionReadmeVersion string) (*resource.ByCatalogKindNameVersionReadmePayload, error) {
+func BuildByCatalogKindNameVersionReadmePayload(resourceByCatalogKindNameVersionReadmeCatalog string, resourceByCatalogKindNameVersionReadmeKind string, resourceByCatalogKindNameVersionReadmeName string, resourceByCatalogKindNameVersionReadmeVersion string, resourceByCatalogKindNameVersionReadmeToken string) (*resource.ByCatalogKindNameVersionReadmePayload, error) {
 	var err error
 	var catalog string
 	{
@@ -193,8 +560,8 @@ func BuildByCatalogKindNameVersionReadmePayload(resourceByCatalogKindNameVersion
 	var kind string
 	{
 		kind = resourceByCatalogKindNameVersionReadmeKind
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		if err != nil {
 			return nil, err
@@ -208,18 +575,25 @@ func BuildByCatalogKindNameVersionReadmePayload(resourceByCatalogKindNameVersion
 	{
 		version = resourceByCatalogKindNameVersionReadmeVersion
 	}
+	var token *string
+	{
+		if resourceByCatalogKindNameVersionReadmeToken != "" {
+			token = &resourceByCatalogKindNameVersionReadmeToken
+		}
+	}
 	v := &resource.ByCatalogKindNameVersionReadmePayload{}
 	v.Catalog = catalog
 	v.Kind = kind
 	v.Name = name
 	v.Version = version
+	v.Token = token
 
 	return v, nil
 }
 
 // BuildByCatalogKindNameVersionYamlPayload builds the payload for the resource
 // ByCatalogKindNameVersionYaml endpoint from CLI flags.
-func BuildByCatalogKindNameVersionYamlPayload(resourceByCatalogKindNameVersionYamlCatalog string, resourceByCatalogKindNameVersionYamlKind string, resourceByCatalogKindNameVersionYamlName string, resourceByCatalogKindNameVersionYamlVersion string) (*resource.ByCatalogKindNameVersionYamlPayload, error) {
+func BuildByCatalogKindNameVersionYamlPayload(resourceByCatalogKindNameVersionYamlCatalog string, resourceByCatalogKindNameVersionYamlKind string, resourceByCatalogKindNameVersionYamlName string, resourceByCatalogKindNameVersionYamlVersion string, resourceByCatalogKindNameVersionYamlInstall string, resourceByCatalogKindNameVersionYamlToken string) (*resource.ByCatalogKindNameVersionYamlPayload, error) {
 	var err error
 	var catalog string
 	{
@@ -228,8 +602,8 @@ func BuildByCatalogKindNameVersionYamlPayload(resourceByCatalogKindNameVersionYa
 	var kind string
 	{
 		kind = resourceByCatalogKindNameVersionYamlKind
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		if err != nil {
 			return nil, err
@@ -243,18 +617,152 @@ func BuildByCatalogKindNameVersionYamlPayload(resourceByCatalogKindNameVersionYa
 	{
 		version = resourceByCatalogKindNameVersionYamlVersion
 	}
+	var install bool
+	{
+		if resourceByCatalogKindNameVersionYamlInstall != "" {
+			install, err = strconv.ParseBool(resourceByCatalogKindNameVersionYamlInstall)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for install, must be BOOL")
+			}
+		}
+	}
+	var token *string
+	{
+		if resourceByCatalogKindNameVersionYamlToken != "" {
+			token = &resourceByCatalogKindNameVersionYamlToken
+		}
+	}
 	v := &resource.ByCatalogKindNameVersionYamlPayload{}
 	v.Catalog = catalog
 	v.Kind = kind
 	v.Name = name
 	v.Version = version
+	v.Install = install
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildByCatalogKindNameVersionLintPayload builds the payload for the resource
+// ByCatalogKindNameVersionLint endpoint from CLI flags.
+func BuildByCatalogKindNameVersionLintPayload(resourceByCatalogKindNameVersionLintCatalog string, resourceByCatalogKindNameVersionLintKind string, resourceByCatalogKindNameVersionLintName string, resourceByCatalogKindNameVersionLintVersion string, resourceByCatalogKindNameVersionLintToken string) (*resource.ByCatalogKindNameVersionLintPayload, error) {
+	var err error
+	var catalog string
+	{
+		catalog = resourceByCatalogKindNameVersionLintCatalog
+	}
+	var kind string
+	{
+		kind = resourceByCatalogKindNameVersionLintKind
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var name string
+	{
+		name = resourceByCatalogKindNameVersionLintName
+	}
+	var version string
+	{
+		version = resourceByCatalogKindNameVersionLintVersion
+	}
+	var token *string
+	{
+		if resourceByCatalogKindNameVersionLintToken != "" {
+			token = &resourceByCatalogKindNameVersionLintToken
+		}
+	}
+	v := &resource.ByCatalogKindNameVersionLintPayload{}
+	v.Catalog = catalog
+	v.Kind = kind
+	v.Name = name
+	v.Version = version
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildByCatalogKindNameVersionImagesPayload builds the payload for the
+// resource ByCatalogKindNameVersionImages endpoint from CLI flags.
+func BuildByCatalogKindNameVersionImagesPayload(resourceByCatalogKindNameVersionImagesCatalog string, resourceByCatalogKindNameVersionImagesKind string, resourceByCatalogKindNameVersionImagesName string, resourceByCatalogKindNameVersionImagesVersion string, resourceByCatalogKindNameVersionImagesToken string) (*resource.ByCatalogKindNameVersionImagesPayload, error) {
+	var err error
+	var catalog string
+	{
+		catalog = resourceByCatalogKindNameVersionImagesCatalog
+	}
+	var kind string
+	{
+		kind = resourceByCatalogKindNameVersionImagesKind
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var name string
+	{
+		name = resourceByCatalogKindNameVersionImagesName
+	}
+	var version string
+	{
+		version = resourceByCatalogKindNameVersionImagesVersion
+	}
+	var token *string
+	{
+		if resourceByCatalogKindNameVersionImagesToken != "" {
+			token = &resourceByCatalogKindNameVersionImagesToken
+		}
+	}
+	v := &resource.ByCatalogKindNameVersionImagesPayload{}
+	v.Catalog = catalog
+	v.Kind = kind
+	v.Name = name
+	v.Version = version
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildByImagePayload builds the payload for the resource ByImage endpoint
+// from CLI flags.
+func BuildByImagePayload(resourceByImageRef string, resourceByImageLimit string, resourceByImageToken string) (*resource.ByImagePayload, error) {
+	var err error
+	var ref string
+	{
+		ref = resourceByImageRef
+	}
+	var limit uint
+	{
+		if resourceByImageLimit != "" {
+			var v uint64
+			v, err = strconv.ParseUint(resourceByImageLimit, 10, strconv.IntSize)
+			limit = uint(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be UINT")
+			}
+		}
+	}
+	var token *string
+	{
+		if resourceByImageToken != "" {
+			token = &resourceByImageToken
+		}
+	}
+	v := &resource.ByImagePayload{}
+	v.Ref = ref
+	v.Limit = limit
+	v.Token = token
 
 	return v, nil
 }
 
 // BuildByVersionIDPayload builds the payload for the resource ByVersionId
 // endpoint from CLI flags.
-func BuildByVersionIDPayload(resourceByVersionIDVersionID string) (*resource.ByVersionIDPayload, error) {
+func BuildByVersionIDPayload(resourceByVersionIDVersionID string, resourceByVersionIDToken string) (*resource.ByVersionIDPayload, error) {
 	var err error
 	var versionID uint
 	{
@@ -265,15 +773,22 @@ func BuildByVersionIDPayload(resourceByVersionIDVersionID string) (*resource.ByV
 			return nil, fmt.Errorf("invalid value for versionID, must be UINT")
 		}
 	}
+	var token *string
+	{
+		if resourceByVersionIDToken != "" {
+			token = &resourceByVersionIDToken
+		}
+	}
 	v := &resource.ByVersionIDPayload{}
 	v.VersionID = versionID
+	v.Token = token
 
 	return v, nil
 }
 
 // BuildByCatalogKindNamePayload builds the payload for the resource
 // ByCatalogKindName endpoint from CLI flags.
-func BuildByCatalogKindNamePayload(resourceByCatalogKindNameCatalog string, resourceByCatalogKindNameKind string, resourceByCatalogKindNameName string, resourceByCatalogKindNamePipelinesversion string) (*resource.ByCatalogKindNamePayload, error) {
+func BuildByCatalogKindNamePayload(resourceByCatalogKindNameCatalog string, resourceByCatalogKindNameKind string, resourceByCatalogKindNameName string, resourceByCatalogKindNamePipelinesversion string, resourceByCatalogKindNamePrerelease string, resourceByCatalogKindNameToken string) (*resource.ByCatalogKindNamePayload, error) {
 	var err error
 	var catalog string
 	{
@@ -282,8 +797,8 @@ func BuildByCatalogKindNamePayload(resourceByCatalogKindNameCatalog string, reso
 	var kind string
 	{
 		kind = resourceByCatalogKindNameKind
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		if err != nil {
 			return nil, err
@@ -303,18 +818,35 @@ func BuildByCatalogKindNamePayload(resourceByCatalogKindNameCatalog string, reso
 			}
 		}
 	}
+	var prerelease bool
+	{
+		if resourceByCatalogKindNamePrerelease != "" {
+			prerelease, err = strconv.ParseBool(resourceByCatalogKindNamePrerelease)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for prerelease, must be BOOL")
+			}
+		}
+	}
+	var token *string
+	{
+		if resourceByCatalogKindNameToken != "" {
+			token = &resourceByCatalogKindNameToken
+		}
+	}
 	v := &resource.ByCatalogKindNamePayload{}
 	v.Catalog = catalog
 	v.Kind = kind
 	v.Name = name
 	v.Pipelinesversion = pipelinesversion
+	v.Prerelease = prerelease
+	v.Token = token
 
 	return v, nil
 }
 
 // BuildByIDPayload builds the payload for the resource ById endpoint from CLI
 // flags.
-func BuildByIDPayload(resourceByIDID string) (*resource.ByIDPayload, error) {
+func BuildByIDPayload(resourceByIDID string, resourceByIDToken string) (*resource.ByIDPayload, error) {
 	var err error
 	var id uint
 	{
@@ -325,15 +857,22 @@ func BuildByIDPayload(resourceByIDID string) (*resource.ByIDPayload, error) {
 			return nil, fmt.Errorf("invalid value for id, must be UINT")
 		}
 	}
+	var token *string
+	{
+		if resourceByIDToken != "" {
+			token = &resourceByIDToken
+		}
+	}
 	v := &resource.ByIDPayload{}
 	v.ID = id
+	v.Token = token
 
 	return v, nil
 }
 
 // BuildGetRawYamlByCatalogKindNameVersionPayload builds the payload for the
 // resource GetRawYamlByCatalogKindNameVersion endpoint from CLI flags.
-func BuildGetRawYamlByCatalogKindNameVersionPayload(resourceGetRawYamlByCatalogKindNameVersionCatalog string, resourceGetRawYamlByCatalogKindNameVersionKind string, resourceGetRawYamlByCatalogKindNameVersionName string, resourceGetRawYamlByCatalogKindNameVersionVersion string) (*resource.GetRawYamlByCatalogKindNameVersionPayload, error) {
+func BuildGetRawYamlByCatalogKindNameVersionPayload(resourceGetRawYamlByCatalogKindNameVersionCatalog string, resourceGetRawYamlByCatalogKindNameVersionKind string, resourceGetRawYamlByCatalogKindNameVersionName string, resourceGetRawYamlByCatalogKindNameVersionVersion string, resourceGetRawYamlByCatalogKindNameVersionToken string) (*resource.GetRawYamlByCatalogKindNameVersionPayload, error) {
 	var err error
 	var catalog string
 	{
@@ -342,8 +881,8 @@ func BuildGetRawYamlByCatalogKindNameVersionPayload(resourceGetRawYamlByCatalogK
 	var kind string
 	{
 		kind = resourceGetRawYamlByCatalogKindNameVersionKind
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		if err != nil {
 			return nil, err
@@ -357,18 +896,25 @@ func BuildGetRawYamlByCatalogKindNameVersionPayload(resourceGetRawYamlByCatalogK
 	{
 		version = resourceGetRawYamlByCatalogKindNameVersionVersion
 	}
+	var token *string
+	{
+		if resourceGetRawYamlByCatalogKindNameVersionToken != "" {
+			token = &resourceGetRawYamlByCatalogKindNameVersionToken
+		}
+	}
 	v := &resource.GetRawYamlByCatalogKindNameVersionPayload{}
 	v.Catalog = catalog
 	v.Kind = kind
 	v.Name = name
 	v.Version = version
+	v.Token = token
 
 	return v, nil
 }
 
 // BuildGetLatestRawYamlByCatalogKindNamePayload builds the payload for the
 // resource GetLatestRawYamlByCatalogKindName endpoint from CLI flags.
-func BuildGetLatestRawYamlByCatalogKindNamePayload(resourceGetLatestRawYamlByCatalogKindNameCatalog string, resourceGetLatestRawYamlByCatalogKindNameKind string, resourceGetLatestRawYamlByCatalogKindNameName string) (*resource.GetLatestRawYamlByCatalogKindNamePayload, error) {
+func BuildGetLatestRawYamlByCatalogKindNamePayload(resourceGetLatestRawYamlByCatalogKindNameCatalog string, resourceGetLatestRawYamlByCatalogKindNameKind string, resourceGetLatestRawYamlByCatalogKindNameName string, resourceGetLatestRawYamlByCatalogKindNameToken string) (*resource.GetLatestRawYamlByCatalogKindNamePayload, error) {
 	var err error
 	var catalog string
 	{
@@ -377,8 +923,8 @@ func BuildGetLatestRawYamlByCatalogKindNamePayload(resourceGetLatestRawYamlByCat
 	var kind string
 	{
 		kind = resourceGetLatestRawYamlByCatalogKindNameKind
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		if err != nil {
 			return nil, err
@@ -388,10 +934,17 @@ func BuildGetLatestRawYamlByCatalogKindNamePayload(resourceGetLatestRawYamlByCat
 	{
 		name = resourceGetLatestRawYamlByCatalogKindNameName
 	}
+	var token *string
+	{
+		if resourceGetLatestRawYamlByCatalogKindNameToken != "" {
+			token = &resourceGetLatestRawYamlByCatalogKindNameToken
+		}
+	}
 	v := &resource.GetLatestRawYamlByCatalogKindNamePayload{}
 	v.Catalog = catalog
 	v.Kind = kind
 	v.Name = name
+	v.Token = token
 
 	return v, nil
 }
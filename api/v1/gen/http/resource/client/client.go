@@ -28,6 +28,30 @@ type Client struct {
 	// VersionsByID endpoint.
 	VersionsByIDDoer goahttp.Doer
 
+	// ListReviews Doer is the HTTP client used to make requests to the ListReviews
+	// endpoint.
+	ListReviewsDoer goahttp.Doer
+
+	// CreateReview Doer is the HTTP client used to make requests to the
+	// CreateReview endpoint.
+	CreateReviewDoer goahttp.Doer
+
+	// UpdateReview Doer is the HTTP client used to make requests to the
+	// UpdateReview endpoint.
+	UpdateReviewDoer goahttp.Doer
+
+	// DeleteReview Doer is the HTTP client used to make requests to the
+	// DeleteReview endpoint.
+	DeleteReviewDoer goahttp.Doer
+
+	// Dependencies Doer is the HTTP client used to make requests to the
+	// Dependencies endpoint.
+	DependenciesDoer goahttp.Doer
+
+	// Dependents Doer is the HTTP client used to make requests to the Dependents
+	// endpoint.
+	DependentsDoer goahttp.Doer
+
 	// ByCatalogKindNameVersion Doer is the HTTP client used to make requests to
 	// the ByCatalogKindNameVersion endpoint.
 	ByCatalogKindNameVersionDoer goahttp.Doer
@@ -40,6 +64,18 @@ type Client struct {
 	// to the ByCatalogKindNameVersionYaml endpoint.
 	ByCatalogKindNameVersionYamlDoer goahttp.Doer
 
+	// ByCatalogKindNameVersionLint Doer is the HTTP client used to make requests
+	// to the ByCatalogKindNameVersionLint endpoint.
+	ByCatalogKindNameVersionLintDoer goahttp.Doer
+
+	// ByCatalogKindNameVersionImages Doer is the HTTP client used to make requests
+	// to the ByCatalogKindNameVersionImages endpoint.
+	ByCatalogKindNameVersionImagesDoer goahttp.Doer
+
+	// ByImage Doer is the HTTP client used to make requests to the ByImage
+	// endpoint.
+	ByImageDoer goahttp.Doer
+
 	// ByVersionID Doer is the HTTP client used to make requests to the ByVersionId
 	// endpoint.
 	ByVersionIDDoer goahttp.Doer
@@ -85,9 +121,18 @@ func NewClient(
 		QueryDoer:                              doer,
 		ListDoer:                               doer,
 		VersionsByIDDoer:                       doer,
+		ListReviewsDoer:                        doer,
+		CreateReviewDoer:                       doer,
+		UpdateReviewDoer:                       doer,
+		DeleteReviewDoer:                       doer,
+		DependenciesDoer:                       doer,
+		DependentsDoer:                         doer,
 		ByCatalogKindNameVersionDoer:           doer,
 		ByCatalogKindNameVersionReadmeDoer:     doer,
 		ByCatalogKindNameVersionYamlDoer:       doer,
+		ByCatalogKindNameVersionLintDoer:       doer,
+		ByCatalogKindNameVersionImagesDoer:     doer,
+		ByImageDoer:                            doer,
 		ByVersionIDDoer:                        doer,
 		ByCatalogKindNameDoer:                  doer,
 		ByIDDoer:                               doer,
@@ -154,6 +199,7 @@ func (c *Client) List() goa.Endpoint {
 // service VersionsByID server.
 func (c *Client) VersionsByID() goa.Endpoint {
 	var (
+		encodeRequest  = EncodeVersionsByIDRequest(c.encoder)
 		decodeResponse = DecodeVersionsByIDResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
@@ -161,6 +207,10 @@ func (c *Client) VersionsByID() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
 		resp, err := c.VersionsByIDDoer.Do(req)
 		if err != nil {
 			return nil, goahttp.ErrRequestError("resource", "VersionsByID", err)
@@ -169,10 +219,155 @@ func (c *Client) VersionsByID() goa.Endpoint {
 	}
 }
 
+// ListReviews returns an endpoint that makes HTTP requests to the resource
+// service ListReviews server.
+func (c *Client) ListReviews() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListReviewsRequest(c.encoder)
+		decodeResponse = DecodeListReviewsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListReviewsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListReviewsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("resource", "ListReviews", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateReview returns an endpoint that makes HTTP requests to the resource
+// service CreateReview server.
+func (c *Client) CreateReview() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateReviewRequest(c.encoder)
+		decodeResponse = DecodeCreateReviewResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateReviewRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateReviewDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("resource", "CreateReview", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateReview returns an endpoint that makes HTTP requests to the resource
+// service UpdateReview server.
+func (c *Client) UpdateReview() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateReviewRequest(c.encoder)
+		decodeResponse = DecodeUpdateReviewResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateReviewRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateReviewDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("resource", "UpdateReview", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteReview returns an endpoint that makes HTTP requests to the resource
+// service DeleteReview server.
+func (c *Client) DeleteReview() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteReviewRequest(c.encoder)
+		decodeResponse = DecodeDeleteReviewResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteReviewRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteReviewDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("resource", "DeleteReview", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Dependencies returns an endpoint that makes HTTP requests to the resource
+// service Dependencies server.
+func (c *Client) Dependencies() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDependenciesRequest(c.encoder)
+		decodeResponse = DecodeDependenciesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDependenciesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DependenciesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("resource", "Dependencies", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Dependents returns an endpoint that makes HTTP requests to the resource
+// service Dependents server.
+func (c *Client) Dependents() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDependentsRequest(c.encoder)
+		decodeResponse = DecodeDependentsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDependentsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DependentsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("resource", "Dependents", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // ByCatalogKindNameVersion returns an endpoint that makes HTTP requests to the
 // resource service ByCatalogKindNameVersion server.
 func (c *Client) ByCatalogKindNameVersion() goa.Endpoint {
 	var (
+		encodeRequest  = EncodeByCatalogKindNameVersionRequest(c.encoder)
 		decodeResponse = DecodeByCatalogKindNameVersionResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
@@ -180,6 +375,10 @@ func (c *Client) ByCatalogKindNameVersion() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
 		resp, err := c.ByCatalogKindNameVersionDoer.Do(req)
 		if err != nil {
 			return nil, goahttp.ErrRequestError("resource", "ByCatalogKindNameVersion", err)
@@ -192,6 +391,7 @@ func (c *Client) ByCatalogKindNameVersion() goa.Endpoint {
 // to the resource service ByCatalogKindNameVersionReadme server.
 func (c *Client) ByCatalogKindNameVersionReadme() goa.Endpoint {
 	var (
+		encodeRequest  = EncodeByCatalogKindNameVersionReadmeRequest(c.encoder)
 		decodeResponse = DecodeByCatalogKindNameVersionReadmeResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
@@ -199,6 +399,10 @@ func (c *Client) ByCatalogKindNameVersionReadme() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
 		resp, err := c.ByCatalogKindNameVersionReadmeDoer.Do(req)
 		if err != nil {
 			return nil, goahttp.ErrRequestError("resource", "ByCatalogKindNameVersionReadme", err)
@@ -211,6 +415,7 @@ func (c *Client) ByCatalogKindNameVersionReadme() goa.Endpoint {
 // the resource service ByCatalogKindNameVersionYaml server.
 func (c *Client) ByCatalogKindNameVersionYaml() goa.Endpoint {
 	var (
+		encodeRequest  = EncodeByCatalogKindNameVersionYamlRequest(c.encoder)
 		decodeResponse = DecodeByCatalogKindNameVersionYamlResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
@@ -218,6 +423,10 @@ func (c *Client) ByCatalogKindNameVersionYaml() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
 		resp, err := c.ByCatalogKindNameVersionYamlDoer.Do(req)
 		if err != nil {
 			return nil, goahttp.ErrRequestError("resource", "ByCatalogKindNameVersionYaml", err)
@@ -226,10 +435,83 @@ func (c *Client) ByCatalogKindNameVersionYaml() goa.Endpoint {
 	}
 }
 
+// ByCatalogKindNameVersionLint returns an endpoint that makes HTTP requests to
+// the resource service ByCatalogKindNameVersionLint server.
+func (c *Client) ByCatalogKindNameVersionLint() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeByCatalogKindNameVersionLintRequest(c.encoder)
+		decodeResponse = DecodeByCatalogKindNameVersionLintResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildByCatalogKindNameVersionLintRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ByCatalogKindNameVersionLintDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("resource", "ByCatalogKindNameVersionLint", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ByCatalogKindNameVersionImages returns an endpoint that makes HTTP requests
+// to the resource service ByCatalogKindNameVersionImages server.
+func (c *Client) ByCatalogKindNameVersionImages() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeByCatalogKindNameVersionImagesRequest(c.encoder)
+		decodeResponse = DecodeByCatalogKindNameVersionImagesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildByCatalogKindNameVersionImagesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ByCatalogKindNameVersionImagesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("resource", "ByCatalogKindNameVersionImages", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ByImage returns an endpoint that makes HTTP requests to the resource service
+// ByImage server.
+func (c *Client) ByImage() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeByImageRequest(c.encoder)
+		decodeResponse = DecodeByImageResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildByImageRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ByImageDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("resource", "ByImage", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // ByVersionID returns an endpoint that makes HTTP requests to the resource
 // service ByVersionId server.
 func (c *Client) ByVersionID() goa.Endpoint {
 	var (
+		encodeRequest  = EncodeByVersionIDRequest(c.encoder)
 		decodeResponse = DecodeByVersionIDResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
@@ -237,6 +519,10 @@ func (c *Client) ByVersionID() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
 		resp, err := c.ByVersionIDDoer.Do(req)
 		if err != nil {
 			return nil, goahttp.ErrRequestError("resource", "ByVersionId", err)
@@ -273,6 +559,7 @@ func (c *Client) ByCatalogKindName() goa.Endpoint {
 // ById server.
 func (c *Client) ByID() goa.Endpoint {
 	var (
+		encodeRequest  = EncodeByIDRequest(c.encoder)
 		decodeResponse = DecodeByIDResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
@@ -280,6 +567,10 @@ func (c *Client) ByID() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
 		resp, err := c.ByIDDoer.Do(req)
 		if err != nil {
 			return nil, goahttp.ErrRequestError("resource", "ById", err)
@@ -292,6 +583,7 @@ func (c *Client) ByID() goa.Endpoint {
 // requests to the resource service GetRawYamlByCatalogKindNameVersion server.
 func (c *Client) GetRawYamlByCatalogKindNameVersion() goa.Endpoint {
 	var (
+		encodeRequest  = EncodeGetRawYamlByCatalogKindNameVersionRequest(c.encoder)
 		decodeResponse = DecodeGetRawYamlByCatalogKindNameVersionResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
@@ -299,6 +591,10 @@ func (c *Client) GetRawYamlByCatalogKindNameVersion() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
 		resp, err := c.GetRawYamlByCatalogKindNameVersionDoer.Do(req)
 		if err != nil {
 			return nil, goahttp.ErrRequestError("resource", "GetRawYamlByCatalogKindNameVersion", err)
@@ -316,6 +612,7 @@ func (c *Client) GetRawYamlByCatalogKindNameVersion() goa.Endpoint {
 // requests to the resource service GetLatestRawYamlByCatalogKindName server.
 func (c *Client) GetLatestRawYamlByCatalogKindName() goa.Endpoint {
 	var (
+		encodeRequest  = EncodeGetLatestRawYamlByCatalogKindNameRequest(c.encoder)
 		decodeResponse = DecodeGetLatestRawYamlByCatalogKindNameResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
@@ -323,6 +620,10 @@ func (c *Client) GetLatestRawYamlByCatalogKindName() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
 		resp, err := c.GetLatestRawYamlByCatalogKindNameDoer.Do(req)
 		if err != nil {
 			return nil, goahttp.ErrRequestError("resource", "GetLatestRawYamlByCatalogKindName", err)
@@ -14,6 +14,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 
 	resource "github.com/tektoncd/hub/api/v1/gen/resource"
 	resourceviews "github.com/tektoncd/hub/api/v1/gen/resource/views"
@@ -43,6 +44,14 @@ func EncodeQueryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.
 		if !ok {
 			return goahttp.ErrInvalidType("resource", "Query", "*resource.QueryPayload", v)
 		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
 		values := req.URL.Query()
 		values.Add("name", p.Name)
 		for _, value := range p.Catalogs {
@@ -60,8 +69,21 @@ func EncodeQueryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.
 		for _, value := range p.Platforms {
 			values.Add("platforms", value)
 		}
+		for _, value := range p.APIVersions {
+			values.Add("apiVersions", value)
+		}
+		for _, value := range p.Verifications {
+			values.Add("verifications", value)
+		}
 		values.Add("limit", fmt.Sprintf("%v", p.Limit))
 		values.Add("match", p.Match)
+		if p.Sort != nil {
+			values.Add("sort", *p.Sort)
+		}
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		values.Add("prerelease", fmt.Sprintf("%v", p.Prerelease))
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
@@ -73,7 +95,9 @@ func EncodeQueryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.
 // DecodeQueryResponse may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
 //   - "invalid-kind" (type *goa.ServiceError): http.StatusBadRequest
+//   - "invalid-cursor" (type *goa.ServiceError): http.StatusBadRequest
 //   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
 //   - error: internal error
 func DecodeQueryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -122,33 +146,68 @@ func DecodeQueryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBo
 			}
 			return nil, NewQueryInternalError(&body)
 		case http.StatusBadRequest:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invalid-kind":
+				var (
+					body QueryInvalidKindResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("resource", "Query", err)
+				}
+				err = ValidateQueryInvalidKindResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("resource", "Query", err)
+				}
+				return nil, NewQueryInvalidKind(&body)
+			case "invalid-cursor":
+				var (
+					body QueryInvalidCursorResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("resource", "Query", err)
+				}
+				err = ValidateQueryInvalidCursorResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("resource", "Query", err)
+				}
+				return nil, NewQueryInvalidCursor(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("resource", "Query", resp.StatusCode, string(body))
+			}
+		case http.StatusNotFound:
 			var (
-				body QueryInvalidKindResponseBody
+				body QueryNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
 				return nil, goahttp.ErrDecodingError("resource", "Query", err)
 			}
-			err = ValidateQueryInvalidKindResponseBody(&body)
+			err = ValidateQueryNotFoundResponseBody(&body)
 			if err != nil {
 				return nil, goahttp.ErrValidationError("resource", "Query", err)
 			}
-			return nil, NewQueryInvalidKind(&body)
-		case http.StatusNotFound:
+			return nil, NewQueryNotFound(&body)
+		case http.StatusUnauthorized:
 			var (
-				body QueryNotFoundResponseBody
+				body QueryInvalidTokenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
 				return nil, goahttp.ErrDecodingError("resource", "Query", err)
 			}
-			err = ValidateQueryNotFoundResponseBody(&body)
+			err = ValidateQueryInvalidTokenResponseBody(&body)
 			if err != nil {
 				return nil, goahttp.ErrValidationError("resource", "Query", err)
 			}
-			return nil, NewQueryNotFound(&body)
+			return nil, NewQueryInvalidToken(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("resource", "Query", resp.StatusCode, string(body))
@@ -179,8 +238,23 @@ func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.R
 		if !ok {
 			return goahttp.ErrInvalidType("resource", "List", "*resource.ListPayload", v)
 		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
 		values := req.URL.Query()
 		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		if p.Sort != nil {
+			values.Add("sort", *p.Sort)
+		}
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		values.Add("prerelease", fmt.Sprintf("%v", p.Prerelease))
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
@@ -191,7 +265,9 @@ func EncodeListRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.R
 // restored after having been read.
 // DecodeListResponse may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "invalid-cursor" (type *goa.ServiceError): http.StatusBadRequest
 //   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
 //   - error: internal error
 func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -239,6 +315,20 @@ func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBod
 				return nil, goahttp.ErrValidationError("resource", "List", err)
 			}
 			return nil, NewListInternalError(&body)
+		case http.StatusBadRequest:
+			var (
+				body ListInvalidCursorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "List", err)
+			}
+			err = ValidateListInvalidCursorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "List", err)
+			}
+			return nil, NewListInvalidCursor(&body)
 		case http.StatusNotFound:
 			var (
 				body ListNotFoundResponseBody
@@ -253,6 +343,20 @@ func DecodeListResponse(decoder func(*http.Response) goahttp.Decoder, restoreBod
 				return nil, goahttp.ErrValidationError("resource", "List", err)
 			}
 			return nil, NewListNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "List", err)
+			}
+			err = ValidateListInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "List", err)
+			}
+			return nil, NewListInvalidToken(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("resource", "List", resp.StatusCode, string(body))
@@ -285,12 +389,36 @@ func (c *Client) BuildVersionsByIDRequest(ctx context.Context, v any) (*http.Req
 	return req, nil
 }
 
+// EncodeVersionsByIDRequest returns an encoder for requests sent to the
+// resource VersionsByID server.
+func EncodeVersionsByIDRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.VersionsByIDPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "VersionsByID", "*resource.VersionsByIDPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("prerelease", fmt.Sprintf("%v", p.Prerelease))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
 // DecodeVersionsByIDResponse returns a decoder for responses returned by the
 // resource VersionsByID endpoint. restoreBody controls whether the response
 // body should be restored after having been read.
 // DecodeVersionsByIDResponse may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
 //   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
 //   - error: internal error
 func DecodeVersionsByIDResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -352,6 +480,20 @@ func DecodeVersionsByIDResponse(decoder func(*http.Response) goahttp.Decoder, re
 				return nil, goahttp.ErrValidationError("resource", "VersionsByID", err)
 			}
 			return nil, NewVersionsByIDNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body VersionsByIDInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "VersionsByID", err)
+			}
+			err = ValidateVersionsByIDInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "VersionsByID", err)
+			}
+			return nil, NewVersionsByIDInvalidToken(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("resource", "VersionsByID", resp.StatusCode, string(body))
@@ -359,30 +501,23 @@ func DecodeVersionsByIDResponse(decoder func(*http.Response) goahttp.Decoder, re
 	}
 }
 
-// BuildByCatalogKindNameVersionRequest instantiates a HTTP request object with
-// method and path set to call the "resource" service
-// "ByCatalogKindNameVersion" endpoint
-func (c *Client) BuildByCatalogKindNameVersionRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildListReviewsRequest instantiates a HTTP request object with method and
+// path set to call the "resource" service "ListReviews" endpoint
+func (c *Client) BuildListReviewsRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		catalog string
-		kind    string
-		name    string
-		version string
+		id uint
 	)
 	{
-		p, ok := v.(*resource.ByCatalogKindNameVersionPayload)
+		p, ok := v.(*resource.ListReviewsPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("resource", "ByCatalogKindNameVersion", "*resource.ByCatalogKindNameVersionPayload", v)
+			return nil, goahttp.ErrInvalidType("resource", "ListReviews", "*resource.ListReviewsPayload", v)
 		}
-		catalog = p.Catalog
-		kind = p.Kind
-		name = p.Name
-		version = p.Version
+		id = p.ID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ByCatalogKindNameVersionResourcePath(catalog, kind, name, version)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListReviewsResourcePath(id)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("resource", "ByCatalogKindNameVersion", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("resource", "ListReviews", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -391,14 +526,42 @@ func (c *Client) BuildByCatalogKindNameVersionRequest(ctx context.Context, v any
 	return req, nil
 }
 
-// DecodeByCatalogKindNameVersionResponse returns a decoder for responses
-// returned by the resource ByCatalogKindNameVersion endpoint. restoreBody
-// controls whether the response body should be restored after having been read.
-// DecodeByCatalogKindNameVersionResponse may return the following errors:
+// EncodeListReviewsRequest returns an encoder for requests sent to the
+// resource ListReviews server.
+func EncodeListReviewsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.ListReviewsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "ListReviews", "*resource.ListReviewsPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListReviewsResponse returns a decoder for responses returned by the
+// resource ListReviews endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListReviewsResponse may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "invalid-cursor" (type *goa.ServiceError): http.StatusBadRequest
 //   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeByCatalogKindNameVersionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeListReviewsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -415,60 +578,1381 @@ func DecodeByCatalogKindNameVersionResponse(decoder func(*http.Response) goahttp
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body ByCatalogKindNameVersionResponseBody
+				body ListReviewsResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersion", err)
+				return nil, goahttp.ErrDecodingError("resource", "ListReviews", err)
 			}
-			p := NewByCatalogKindNameVersionResourceVersionOK(&body)
-			view := "default"
-			vres := &resourceviews.ResourceVersion{Projected: p, View: view}
-			if err = resourceviews.ValidateResourceVersion(vres); err != nil {
-				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersion", err)
+			err = ValidateListReviewsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ListReviews", err)
 			}
-			res := resource.NewResourceVersion(vres)
+			res := NewListReviewsReviewsOK(&body)
 			return res, nil
 		case http.StatusInternalServerError:
 			var (
-				body ByCatalogKindNameVersionInternalErrorResponseBody
+				body ListReviewsInternalErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersion", err)
+				return nil, goahttp.ErrDecodingError("resource", "ListReviews", err)
 			}
-			err = ValidateByCatalogKindNameVersionInternalErrorResponseBody(&body)
+			err = ValidateListReviewsInternalErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersion", err)
+				return nil, goahttp.ErrValidationError("resource", "ListReviews", err)
 			}
-			return nil, NewByCatalogKindNameVersionInternalError(&body)
+			return nil, NewListReviewsInternalError(&body)
+		case http.StatusBadRequest:
+			var (
+				body ListReviewsInvalidCursorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ListReviews", err)
+			}
+			err = ValidateListReviewsInvalidCursorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ListReviews", err)
+			}
+			return nil, NewListReviewsInvalidCursor(&body)
 		case http.StatusNotFound:
 			var (
-				body ByCatalogKindNameVersionNotFoundResponseBody
+				body ListReviewsNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersion", err)
+				return nil, goahttp.ErrDecodingError("resource", "ListReviews", err)
 			}
-			err = ValidateByCatalogKindNameVersionNotFoundResponseBody(&body)
+			err = ValidateListReviewsNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersion", err)
+				return nil, goahttp.ErrValidationError("resource", "ListReviews", err)
+			}
+			return nil, NewListReviewsNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListReviewsInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ListReviews", err)
+			}
+			err = ValidateListReviewsInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ListReviews", err)
+			}
+			return nil, NewListReviewsInvalidToken(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("resource", "ListReviews", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateReviewRequest instantiates a HTTP request object with method and
+// path set to call the "resource" service "CreateReview" endpoint
+func (c *Client) BuildCreateReviewRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*resource.CreateReviewPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("resource", "CreateReview", "*resource.CreateReviewPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateReviewResourcePath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("resource", "CreateReview", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateReviewRequest returns an encoder for requests sent to the
+// resource CreateReview server.
+func EncodeCreateReviewRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.CreateReviewPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "CreateReview", "*resource.CreateReviewPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewCreateReviewRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("resource", "CreateReview", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateReviewResponse returns a decoder for responses returned by the
+// resource CreateReview endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCreateReviewResponse may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "invalid-payload" (type *goa.ServiceError): http.StatusBadRequest
+//   - "invalid-scopes" (type *goa.ServiceError): http.StatusForbidden
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateReviewResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CreateReviewResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "CreateReview", err)
+			}
+			err = ValidateCreateReviewResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "CreateReview", err)
+			}
+			res := NewCreateReviewReviewOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body CreateReviewInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "CreateReview", err)
+			}
+			err = ValidateCreateReviewInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "CreateReview", err)
+			}
+			return nil, NewCreateReviewInternalError(&body)
+		case http.StatusBadRequest:
+			var (
+				body CreateReviewInvalidPayloadResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "CreateReview", err)
+			}
+			err = ValidateCreateReviewInvalidPayloadResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "CreateReview", err)
+			}
+			return nil, NewCreateReviewInvalidPayload(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateReviewInvalidScopesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "CreateReview", err)
+			}
+			err = ValidateCreateReviewInvalidScopesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "CreateReview", err)
+			}
+			return nil, NewCreateReviewInvalidScopes(&body)
+		case http.StatusNotFound:
+			var (
+				body CreateReviewNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "CreateReview", err)
+			}
+			err = ValidateCreateReviewNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "CreateReview", err)
+			}
+			return nil, NewCreateReviewNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CreateReviewInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "CreateReview", err)
+			}
+			err = ValidateCreateReviewInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "CreateReview", err)
+			}
+			return nil, NewCreateReviewInvalidToken(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("resource", "CreateReview", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateReviewRequest instantiates a HTTP request object with method and
+// path set to call the "resource" service "UpdateReview" endpoint
+func (c *Client) BuildUpdateReviewRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id       uint
+		reviewID uint
+	)
+	{
+		p, ok := v.(*resource.UpdateReviewPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("resource", "UpdateReview", "*resource.UpdateReviewPayload", v)
+		}
+		id = p.ID
+		reviewID = p.ReviewID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateReviewResourcePath(id, reviewID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("resource", "UpdateReview", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateReviewRequest returns an encoder for requests sent to the
+// resource UpdateReview server.
+func EncodeUpdateReviewRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.UpdateReviewPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "UpdateReview", "*resource.UpdateReviewPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		body := NewUpdateReviewRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("resource", "UpdateReview", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateReviewResponse returns a decoder for responses returned by the
+// resource UpdateReview endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeUpdateReviewResponse may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "invalid-scopes" (type *goa.ServiceError): http.StatusForbidden
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeUpdateReviewResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateReviewResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "UpdateReview", err)
+			}
+			err = ValidateUpdateReviewResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "UpdateReview", err)
+			}
+			res := NewUpdateReviewReviewOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body UpdateReviewInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "UpdateReview", err)
+			}
+			err = ValidateUpdateReviewInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "UpdateReview", err)
+			}
+			return nil, NewUpdateReviewInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateReviewInvalidScopesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "UpdateReview", err)
+			}
+			err = ValidateUpdateReviewInvalidScopesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "UpdateReview", err)
+			}
+			return nil, NewUpdateReviewInvalidScopes(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateReviewNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "UpdateReview", err)
+			}
+			err = ValidateUpdateReviewNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "UpdateReview", err)
+			}
+			return nil, NewUpdateReviewNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body UpdateReviewInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "UpdateReview", err)
+			}
+			err = ValidateUpdateReviewInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "UpdateReview", err)
+			}
+			return nil, NewUpdateReviewInvalidToken(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("resource", "UpdateReview", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteReviewRequest instantiates a HTTP request object with method and
+// path set to call the "resource" service "DeleteReview" endpoint
+func (c *Client) BuildDeleteReviewRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id       uint
+		reviewID uint
+	)
+	{
+		p, ok := v.(*resource.DeleteReviewPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("resource", "DeleteReview", "*resource.DeleteReviewPayload", v)
+		}
+		id = p.ID
+		reviewID = p.ReviewID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteReviewResourcePath(id, reviewID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("resource", "DeleteReview", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteReviewRequest returns an encoder for requests sent to the
+// resource DeleteReview server.
+func EncodeDeleteReviewRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.DeleteReviewPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "DeleteReview", "*resource.DeleteReviewPayload", v)
+		}
+		{
+			head := p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteReviewResponse returns a decoder for responses returned by the
+// resource DeleteReview endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeDeleteReviewResponse may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "invalid-scopes" (type *goa.ServiceError): http.StatusForbidden
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteReviewResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusInternalServerError:
+			var (
+				body DeleteReviewInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "DeleteReview", err)
+			}
+			err = ValidateDeleteReviewInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "DeleteReview", err)
+			}
+			return nil, NewDeleteReviewInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteReviewInvalidScopesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "DeleteReview", err)
+			}
+			err = ValidateDeleteReviewInvalidScopesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "DeleteReview", err)
+			}
+			return nil, NewDeleteReviewInvalidScopes(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteReviewNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "DeleteReview", err)
+			}
+			err = ValidateDeleteReviewNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "DeleteReview", err)
+			}
+			return nil, NewDeleteReviewNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DeleteReviewInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "DeleteReview", err)
+			}
+			err = ValidateDeleteReviewInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "DeleteReview", err)
+			}
+			return nil, NewDeleteReviewInvalidToken(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("resource", "DeleteReview", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDependenciesRequest instantiates a HTTP request object with method and
+// path set to call the "resource" service "Dependencies" endpoint
+func (c *Client) BuildDependenciesRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*resource.DependenciesPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("resource", "Dependencies", "*resource.DependenciesPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DependenciesResourcePath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("resource", "Dependencies", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDependenciesRequest returns an encoder for requests sent to the
+// resource Dependencies server.
+func EncodeDependenciesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.DependenciesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "Dependencies", "*resource.DependenciesPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("version", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDependenciesResponse returns a decoder for responses returned by the
+// resource Dependencies endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeDependenciesResponse may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDependenciesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DependenciesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "Dependencies", err)
+			}
+			err = ValidateDependenciesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "Dependencies", err)
+			}
+			res := NewDependenciesPipelineDependenciesOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body DependenciesInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "Dependencies", err)
+			}
+			err = ValidateDependenciesInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "Dependencies", err)
+			}
+			return nil, NewDependenciesInternalError(&body)
+		case http.StatusNotFound:
+			var (
+				body DependenciesNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "Dependencies", err)
+			}
+			err = ValidateDependenciesNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "Dependencies", err)
+			}
+			return nil, NewDependenciesNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DependenciesInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "Dependencies", err)
+			}
+			err = ValidateDependenciesInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "Dependencies", err)
+			}
+			return nil, NewDependenciesInvalidToken(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("resource", "Dependencies", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDependentsRequest instantiates a HTTP request object with method and
+// path set to call the "resource" service "Dependents" endpoint
+func (c *Client) BuildDependentsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id uint
+	)
+	{
+		p, ok := v.(*resource.DependentsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("resource", "Dependents", "*resource.DependentsPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DependentsResourcePath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("resource", "Dependents", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDependentsRequest returns an encoder for requests sent to the resource
+// Dependents server.
+func EncodeDependentsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.DependentsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "Dependents", "*resource.DependentsPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		if p.Version != nil {
+			values.Add("version", *p.Version)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDependentsResponse returns a decoder for responses returned by the
+// resource Dependents endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDependentsResponse may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDependentsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DependentsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "Dependents", err)
+			}
+			err = ValidateDependentsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "Dependents", err)
+			}
+			res := NewDependentsTaskDependentsOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body DependentsInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "Dependents", err)
+			}
+			err = ValidateDependentsInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "Dependents", err)
+			}
+			return nil, NewDependentsInternalError(&body)
+		case http.StatusNotFound:
+			var (
+				body DependentsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "Dependents", err)
+			}
+			err = ValidateDependentsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "Dependents", err)
+			}
+			return nil, NewDependentsNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DependentsInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "Dependents", err)
+			}
+			err = ValidateDependentsInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "Dependents", err)
+			}
+			return nil, NewDependentsInvalidToken(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("resource", "Dependents", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildByCatalogKindNameVersionRequest instantiates a HTTP request object with
+// method and path set to call the "resource" service
+// "ByCatalogKindNameVersion" endpoint
+func (c *Client) BuildByCatalogKindNameVersionRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		catalog string
+		kind    string
+		name    string
+		version string
+	)
+	{
+		p, ok := v.(*resource.ByCatalogKindNameVersionPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("resource", "ByCatalogKindNameVersion", "*resource.ByCatalogKindNameVersionPayload", v)
+		}
+		catalog = p.Catalog
+		kind = p.Kind
+		name = p.Name
+		version = p.Version
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ByCatalogKindNameVersionResourcePath(catalog, kind, name, version)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("resource", "ByCatalogKindNameVersion", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeByCatalogKindNameVersionRequest returns an encoder for requests sent
+// to the resource ByCatalogKindNameVersion server.
+func EncodeByCatalogKindNameVersionRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.ByCatalogKindNameVersionPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "ByCatalogKindNameVersion", "*resource.ByCatalogKindNameVersionPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeByCatalogKindNameVersionResponse returns a decoder for responses
+// returned by the resource ByCatalogKindNameVersion endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeByCatalogKindNameVersionResponse may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeByCatalogKindNameVersionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ByCatalogKindNameVersionResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersion", err)
+			}
+			p := NewByCatalogKindNameVersionResourceVersionOK(&body)
+			view := "default"
+			vres := &resourceviews.ResourceVersion{Projected: p, View: view}
+			if err = resourceviews.ValidateResourceVersion(vres); err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersion", err)
+			}
+			res := resource.NewResourceVersion(vres)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body ByCatalogKindNameVersionInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersion", err)
+			}
+			err = ValidateByCatalogKindNameVersionInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersion", err)
+			}
+			return nil, NewByCatalogKindNameVersionInternalError(&body)
+		case http.StatusNotFound:
+			var (
+				body ByCatalogKindNameVersionNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersion", err)
+			}
+			err = ValidateByCatalogKindNameVersionNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersion", err)
+			}
+			return nil, NewByCatalogKindNameVersionNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ByCatalogKindNameVersionInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersion", err)
+			}
+			err = ValidateByCatalogKindNameVersionInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersion", err)
+			}
+			return nil, NewByCatalogKindNameVersionInvalidToken(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("resource", "ByCatalogKindNameVersion", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildByCatalogKindNameVersionReadmeRequest instantiates a HTTP request
+// object with method and path set to call the "resource" service
+// "ByCatalogKindNameVersionReadme" endpoint
+func (c *Client) BuildByCatalogKindNameVersionReadmeRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		catalog string
+		kind    string
+		name    string
+		version string
+	)
+	{
+		p, ok := v.(*resource.ByCatalogKindNameVersionReadmePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("resource", "ByCatalogKindNameVersionReadme", "*resource.ByCatalogKindNameVersionReadmePayload", v)
+		}
+		catalog = p.Catalog
+		kind = p.Kind
+		name = p.Name
+		version = p.Version
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ByCatalogKindNameVersionReadmeResourcePath(catalog, kind, name, version)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("resource", "ByCatalogKindNameVersionReadme", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeByCatalogKindNameVersionReadmeRequest returns an encoder for requests
+// sent to the resource ByCatalogKindNameVersionReadme server.
+func EncodeByCatalogKindNameVersionReadmeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.ByCatalogKindNameVersionReadmePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "ByCatalogKindNameVersionReadme", "*resource.ByCatalogKindNameVersionReadmePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeByCatalogKindNameVersionReadmeResponse returns a decoder for responses
+// returned by the resource ByCatalogKindNameVersionReadme endpoint.
+// restoreBody controls whether the response body should be restored after
+// having been read.
+// DecodeByCatalogKindNameVersionReadmeResponse may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeByCatalogKindNameVersionReadmeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ByCatalogKindNameVersionReadmeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionReadme", err)
+			}
+			p := NewByCatalogKindNameVersionReadmeResourceVersionReadmeOK(&body)
+			view := "default"
+			vres := &resourceviews.ResourceVersionReadme{Projected: p, View: view}
+			if err = resourceviews.ValidateResourceVersionReadme(vres); err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionReadme", err)
+			}
+			res := resource.NewResourceVersionReadme(vres)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body ByCatalogKindNameVersionReadmeInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionReadme", err)
+			}
+			err = ValidateByCatalogKindNameVersionReadmeInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionReadme", err)
+			}
+			return nil, NewByCatalogKindNameVersionReadmeInternalError(&body)
+		case http.StatusNotFound:
+			var (
+				body ByCatalogKindNameVersionReadmeNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionReadme", err)
+			}
+			err = ValidateByCatalogKindNameVersionReadmeNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionReadme", err)
+			}
+			return nil, NewByCatalogKindNameVersionReadmeNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ByCatalogKindNameVersionReadmeInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionReadme", err)
+			}
+			err = ValidateByCatalogKindNameVersionReadmeInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionReadme", err)
+			}
+			return nil, NewByCatalogKindNameVersionReadmeInvalidToken(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("resource", "ByCatalogKindNameVersionReadme", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildByCatalogKindNameVersionYamlRequest instantiates a HTTP request object
+// with method and path set to call the "resource" service
+// "ByCatalogKindNameVersionYaml" endpoint
+func (c *Client) BuildByCatalogKindNameVersionYamlRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		catalog string
+		kind    string
+		name    string
+		version string
+	)
+	{
+		p, ok := v.(*resource.ByCatalogKindNameVersionYamlPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("resource", "ByCatalogKindNameVersionYaml", "*resource.ByCatalogKindNameVersionYamlPayload", v)
+		}
+		catalog = p.Catalog
+		kind = p.Kind
+		name = p.Name
+		version = p.Version
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ByCatalogKindNameVersionYamlResourcePath(catalog, kind, name, version)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("resource", "ByCatalogKindNameVersionYaml", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeByCatalogKindNameVersionYamlRequest returns an encoder for requests
+// sent to the resource ByCatalogKindNameVersionYaml server.
+func EncodeByCatalogKindNameVersionYamlRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.ByCatalogKindNameVersionYamlPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "ByCatalogKindNameVersionYaml", "*resource.ByCatalogKindNameVersionYamlPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("install", fmt.Sprintf("%v", p.Install))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeByCatalogKindNameVersionYamlResponse returns a decoder for responses
+// returned by the resource ByCatalogKindNameVersionYaml endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeByCatalogKindNameVersionYamlResponse may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeByCatalogKindNameVersionYamlResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ByCatalogKindNameVersionYamlResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionYaml", err)
+			}
+			p := NewByCatalogKindNameVersionYamlResourceVersionYamlOK(&body)
+			view := "default"
+			vres := &resourceviews.ResourceVersionYaml{Projected: p, View: view}
+			if err = resourceviews.ValidateResourceVersionYaml(vres); err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionYaml", err)
+			}
+			res := resource.NewResourceVersionYaml(vres)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body ByCatalogKindNameVersionYamlInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionYaml", err)
+			}
+			err = ValidateByCatalogKindNameVersionYamlInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionYaml", err)
+			}
+			return nil, NewByCatalogKindNameVersionYamlInternalError(&body)
+		case http.StatusNotFound:
+			var (
+				body ByCatalogKindNameVersionYamlNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionYaml", err)
+			}
+			err = ValidateByCatalogKindNameVersionYamlNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionYaml", err)
+			}
+			return nil, NewByCatalogKindNameVersionYamlNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ByCatalogKindNameVersionYamlInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionYaml", err)
+			}
+			err = ValidateByCatalogKindNameVersionYamlInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionYaml", err)
+			}
+			return nil, NewByCatalogKindNameVersionYamlInvalidToken(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("resource", "ByCatalogKindNameVersionYaml", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildByCatalogKindNameVersionLintRequest instantiates a HTTP request object
+// with method and path set to call the "resource" service
+// "ByCatalogKindNameVersionLint" endpoint
+func (c *Client) BuildByCatalogKindNameVersionLintRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		catalog string
+		kind    string
+		name    string
+		version string
+	)
+	{
+		p, ok := v.(*resource.ByCatalogKindNameVersionLintPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("resource", "ByCatalogKindNameVersionLint", "*resource.ByCatalogKindNameVersionLintPayload", v)
+		}
+		catalog = p.Catalog
+		kind = p.Kind
+		name = p.Name
+		version = p.Version
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ByCatalogKindNameVersionLintResourcePath(catalog, kind, name, version)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("resource", "ByCatalogKindNameVersionLint", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeByCatalogKindNameVersionLintRequest returns an encoder for requests
+// sent to the resource ByCatalogKindNameVersionLint server.
+func EncodeByCatalogKindNameVersionLintRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.ByCatalogKindNameVersionLintPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "ByCatalogKindNameVersionLint", "*resource.ByCatalogKindNameVersionLintPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeByCatalogKindNameVersionLintResponse returns a decoder for responses
+// returned by the resource ByCatalogKindNameVersionLint endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeByCatalogKindNameVersionLintResponse may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeByCatalogKindNameVersionLintResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ByCatalogKindNameVersionLintResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionLint", err)
+			}
+			err = ValidateByCatalogKindNameVersionLintResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionLint", err)
+			}
+			res := NewByCatalogKindNameVersionLintVersionLintOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body ByCatalogKindNameVersionLintInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionLint", err)
+			}
+			err = ValidateByCatalogKindNameVersionLintInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionLint", err)
+			}
+			return nil, NewByCatalogKindNameVersionLintInternalError(&body)
+		case http.StatusNotFound:
+			var (
+				body ByCatalogKindNameVersionLintNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionLint", err)
+			}
+			err = ValidateByCatalogKindNameVersionLintNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionLint", err)
+			}
+			return nil, NewByCatalogKindNameVersionLintNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ByCatalogKindNameVersionLintInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionLint", err)
+			}
+			err = ValidateByCatalogKindNameVersionLintInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionLint", err)
 			}
-			return nil, NewByCatalogKindNameVersionNotFound(&body)
+			return nil, NewByCatalogKindNameVersionLintInvalidToken(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("resource", "ByCatalogKindNameVersion", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("resource", "ByCatalogKindNameVersionLint", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildByCatalogKindNameVersionReadmeRequest instantiates a HTTP request
+// BuildByCatalogKindNameVersionImagesRequest instantiates a HTTP request
 // object with method and path set to call the "resource" service
-// "ByCatalogKindNameVersionReadme" endpoint
-func (c *Client) BuildByCatalogKindNameVersionReadmeRequest(ctx context.Context, v any) (*http.Request, error) {
+// "ByCatalogKindNameVersionImages" endpoint
+func (c *Client) BuildByCatalogKindNameVersionImagesRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		catalog string
 		kind    string
@@ -476,19 +1960,19 @@ func (c *Client) BuildByCatalogKindNameVersionReadmeRequest(ctx context.Context,
 		version string
 	)
 	{
-		p, ok := v.(*resource.ByCatalogKindNameVersionReadmePayload)
+		p, ok := v.(*resource.ByCatalogKindNameVersionImagesPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("resource", "ByCatalogKindNameVersionReadme", "*resource.ByCatalogKindNameVersionReadmePayload", v)
+			return nil, goahttp.ErrInvalidType("resource", "ByCatalogKindNameVersionImages", "*resource.ByCatalogKindNameVersionImagesPayload", v)
 		}
 		catalog = p.Catalog
 		kind = p.Kind
 		name = p.Name
 		version = p.Version
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ByCatalogKindNameVersionReadmeResourcePath(catalog, kind, name, version)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ByCatalogKindNameVersionImagesResourcePath(catalog, kind, name, version)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("resource", "ByCatalogKindNameVersionReadme", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("resource", "ByCatalogKindNameVersionImages", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -497,15 +1981,36 @@ func (c *Client) BuildByCatalogKindNameVersionReadmeRequest(ctx context.Context,
 	return req, nil
 }
 
-// DecodeByCatalogKindNameVersionReadmeResponse returns a decoder for responses
-// returned by the resource ByCatalogKindNameVersionReadme endpoint.
+// EncodeByCatalogKindNameVersionImagesRequest returns an encoder for requests
+// sent to the resource ByCatalogKindNameVersionImages server.
+func EncodeByCatalogKindNameVersionImagesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.ByCatalogKindNameVersionImagesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "ByCatalogKindNameVersionImages", "*resource.ByCatalogKindNameVersionImagesPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
+// DecodeByCatalogKindNameVersionImagesResponse returns a decoder for responses
+// returned by the resource ByCatalogKindNameVersionImages endpoint.
 // restoreBody controls whether the response body should be restored after
 // having been read.
-// DecodeByCatalogKindNameVersionReadmeResponse may return the following errors:
+// DecodeByCatalogKindNameVersionImagesResponse may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
 //   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeByCatalogKindNameVersionReadmeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeByCatalogKindNameVersionImagesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -522,80 +2027,75 @@ func DecodeByCatalogKindNameVersionReadmeResponse(decoder func(*http.Response) g
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body ByCatalogKindNameVersionReadmeResponseBody
+				body ByCatalogKindNameVersionImagesResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionReadme", err)
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionImages", err)
 			}
-			p := NewByCatalogKindNameVersionReadmeResourceVersionReadmeOK(&body)
-			view := "default"
-			vres := &resourceviews.ResourceVersionReadme{Projected: p, View: view}
-			if err = resourceviews.ValidateResourceVersionReadme(vres); err != nil {
-				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionReadme", err)
+			err = ValidateByCatalogKindNameVersionImagesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionImages", err)
 			}
-			res := resource.NewResourceVersionReadme(vres)
+			res := NewByCatalogKindNameVersionImagesVersionImagesOK(&body)
 			return res, nil
 		case http.StatusInternalServerError:
 			var (
-				body ByCatalogKindNameVersionReadmeInternalErrorResponseBody
+				body ByCatalogKindNameVersionImagesInternalErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionReadme", err)
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionImages", err)
 			}
-			err = ValidateByCatalogKindNameVersionReadmeInternalErrorResponseBody(&body)
+			err = ValidateByCatalogKindNameVersionImagesInternalErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionReadme", err)
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionImages", err)
 			}
-			return nil, NewByCatalogKindNameVersionReadmeInternalError(&body)
+			return nil, NewByCatalogKindNameVersionImagesInternalError(&body)
 		case http.StatusNotFound:
 			var (
-				body ByCatalogKindNameVersionReadmeNotFoundResponseBody
+				body ByCatalogKindNameVersionImagesNotFoundResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionReadme", err)
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionImages", err)
 			}
-			err = ValidateByCatalogKindNameVersionReadmeNotFoundResponseBody(&body)
+			err = ValidateByCatalogKindNameVersionImagesNotFoundResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionReadme", err)
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionImages", err)
 			}
-			return nil, NewByCatalogKindNameVersionReadmeNotFound(&body)
+			return nil, NewByCatalogKindNameVersionImagesNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ByCatalogKindNameVersionImagesInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionImages", err)
+			}
+			err = ValidateByCatalogKindNameVersionImagesInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionImages", err)
+			}
+			return nil, NewByCatalogKindNameVersionImagesInvalidToken(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("resource", "ByCatalogKindNameVersionReadme", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("resource", "ByCatalogKindNameVersionImages", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildByCatalogKindNameVersionYamlRequest instantiates a HTTP request object
-// with method and path set to call the "resource" service
-// "ByCatalogKindNameVersionYaml" endpoint
-func (c *Client) BuildByCatalogKindNameVersionYamlRequest(ctx context.Context, v any) (*http.Request, error) {
-	var (
-		catalog string
-		kind    string
-		name    string
-		version string
-	)
-	{
-		p, ok := v.(*resource.ByCatalogKindNameVersionYamlPayload)
-		if !ok {
-			return nil, goahttp.ErrInvalidType("resource", "ByCatalogKindNameVersionYaml", "*resource.ByCatalogKindNameVersionYamlPayload", v)
-		}
-		catalog = p.Catalog
-		kind = p.Kind
-		name = p.Name
-		version = p.Version
-	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ByCatalogKindNameVersionYamlResourcePath(catalog, kind, name, version)}
+// BuildByImageRequest instantiates a HTTP request object with method and path
+// set to call the "resource" service "ByImage" endpoint
+func (c *Client) BuildByImageRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ByImageResourcePath()}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("resource", "ByCatalogKindNameVersionYaml", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("resource", "ByImage", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -604,14 +2104,38 @@ func (c *Client) BuildByCatalogKindNameVersionYamlRequest(ctx context.Context, v
 	return req, nil
 }
 
-// DecodeByCatalogKindNameVersionYamlResponse returns a decoder for responses
-// returned by the resource ByCatalogKindNameVersionYaml endpoint. restoreBody
-// controls whether the response body should be restored after having been read.
-// DecodeByCatalogKindNameVersionYamlResponse may return the following errors:
+// EncodeByImageRequest returns an encoder for requests sent to the resource
+// ByImage server.
+func EncodeByImageRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.ByImagePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "ByImage", "*resource.ByImagePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		values := req.URL.Query()
+		values.Add("ref", p.Ref)
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeByImageResponse returns a decoder for responses returned by the
+// resource ByImage endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeByImageResponse may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
-//   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
 //   - error: internal error
-func DecodeByCatalogKindNameVersionYamlResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeByImageResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -628,52 +2152,50 @@ func DecodeByCatalogKindNameVersionYamlResponse(decoder func(*http.Response) goa
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body ByCatalogKindNameVersionYamlResponseBody
+				body ByImageResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionYaml", err)
+				return nil, goahttp.ErrDecodingError("resource", "ByImage", err)
 			}
-			p := NewByCatalogKindNameVersionYamlResourceVersionYamlOK(&body)
-			view := "default"
-			vres := &resourceviews.ResourceVersionYaml{Projected: p, View: view}
-			if err = resourceviews.ValidateResourceVersionYaml(vres); err != nil {
-				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionYaml", err)
+			err = ValidateByImageResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByImage", err)
 			}
-			res := resource.NewResourceVersionYaml(vres)
+			res := NewByImageImageUsersOK(&body)
 			return res, nil
 		case http.StatusInternalServerError:
 			var (
-				body ByCatalogKindNameVersionYamlInternalErrorResponseBody
+				body ByImageInternalErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionYaml", err)
+				return nil, goahttp.ErrDecodingError("resource", "ByImage", err)
 			}
-			err = ValidateByCatalogKindNameVersionYamlInternalErrorResponseBody(&body)
+			err = ValidateByImageInternalErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionYaml", err)
+				return nil, goahttp.ErrValidationError("resource", "ByImage", err)
 			}
-			return nil, NewByCatalogKindNameVersionYamlInternalError(&body)
-		case http.StatusNotFound:
+			return nil, NewByImageInternalError(&body)
+		case http.StatusUnauthorized:
 			var (
-				body ByCatalogKindNameVersionYamlNotFoundResponseBody
+				body ByImageInvalidTokenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindNameVersionYaml", err)
+				return nil, goahttp.ErrDecodingError("resource", "ByImage", err)
 			}
-			err = ValidateByCatalogKindNameVersionYamlNotFoundResponseBody(&body)
+			err = ValidateByImageInvalidTokenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindNameVersionYaml", err)
+				return nil, goahttp.ErrValidationError("resource", "ByImage", err)
 			}
-			return nil, NewByCatalogKindNameVersionYamlNotFound(&body)
+			return nil, NewByImageInvalidToken(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("resource", "ByCatalogKindNameVersionYaml", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("resource", "ByImage", resp.StatusCode, string(body))
 		}
 	}
 }
@@ -703,12 +2225,33 @@ func (c *Client) BuildByVersionIDRequest(ctx context.Context, v any) (*http.Requ
 	return req, nil
 }
 
+// EncodeByVersionIDRequest returns an encoder for requests sent to the
+// resource ByVersionId server.
+func EncodeByVersionIDRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.ByVersionIDPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "ByVersionId", "*resource.ByVersionIDPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
 // DecodeByVersionIDResponse returns a decoder for responses returned by the
 // resource ByVersionId endpoint. restoreBody controls whether the response
 // body should be restored after having been read.
 // DecodeByVersionIDResponse may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
 //   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
 //   - error: internal error
 func DecodeByVersionIDResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -770,6 +2313,20 @@ func DecodeByVersionIDResponse(decoder func(*http.Response) goahttp.Decoder, res
 				return nil, goahttp.ErrValidationError("resource", "ByVersionId", err)
 			}
 			return nil, NewByVersionIDNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ByVersionIDInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByVersionId", err)
+			}
+			err = ValidateByVersionIDInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByVersionId", err)
+			}
+			return nil, NewByVersionIDInvalidToken(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("resource", "ByVersionId", resp.StatusCode, string(body))
@@ -814,10 +2371,19 @@ func EncodeByCatalogKindNameRequest(encoder func(*http.Request) goahttp.Encoder)
 		if !ok {
 			return goahttp.ErrInvalidType("resource", "ByCatalogKindName", "*resource.ByCatalogKindNamePayload", v)
 		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
 		values := req.URL.Query()
 		if p.Pipelinesversion != nil {
 			values.Add("pipelinesversion", *p.Pipelinesversion)
 		}
+		values.Add("prerelease", fmt.Sprintf("%v", p.Prerelease))
 		req.URL.RawQuery = values.Encode()
 		return nil
 	}
@@ -829,6 +2395,7 @@ func EncodeByCatalogKindNameRequest(encoder func(*http.Request) goahttp.Encoder)
 // DecodeByCatalogKindNameResponse may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
 //   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
 //   - error: internal error
 func DecodeByCatalogKindNameResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -890,6 +2457,20 @@ func DecodeByCatalogKindNameResponse(decoder func(*http.Response) goahttp.Decode
 				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindName", err)
 			}
 			return nil, NewByCatalogKindNameNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ByCatalogKindNameInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ByCatalogKindName", err)
+			}
+			err = ValidateByCatalogKindNameInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ByCatalogKindName", err)
+			}
+			return nil, NewByCatalogKindNameInvalidToken(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("resource", "ByCatalogKindName", resp.StatusCode, string(body))
@@ -922,12 +2503,33 @@ func (c *Client) BuildByIDRequest(ctx context.Context, v any) (*http.Request, er
 	return req, nil
 }
 
+// EncodeByIDRequest returns an encoder for requests sent to the resource ById
+// server.
+func EncodeByIDRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.ByIDPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "ById", "*resource.ByIDPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
 // DecodeByIDResponse returns a decoder for responses returned by the resource
 // ById endpoint. restoreBody controls whether the response body should be
 // restored after having been read.
 // DecodeByIDResponse may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
 //   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
 //   - error: internal error
 func DecodeByIDResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -989,6 +2591,20 @@ func DecodeByIDResponse(decoder func(*http.Response) goahttp.Decoder, restoreBod
 				return nil, goahttp.ErrValidationError("resource", "ById", err)
 			}
 			return nil, NewByIDNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ByIDInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "ById", err)
+			}
+			err = ValidateByIDInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "ById", err)
+			}
+			return nil, NewByIDInvalidToken(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("resource", "ById", resp.StatusCode, string(body))
@@ -1028,6 +2644,26 @@ func (c *Client) BuildGetRawYamlByCatalogKindNameVersionRequest(ctx context.Cont
 	return req, nil
 }
 
+// EncodeGetRawYamlByCatalogKindNameVersionRequest returns an encoder for
+// requests sent to the resource GetRawYamlByCatalogKindNameVersion server.
+func EncodeGetRawYamlByCatalogKindNameVersionRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.GetRawYamlByCatalogKindNameVersionPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "GetRawYamlByCatalogKindNameVersion", "*resource.GetRawYamlByCatalogKindNameVersionPayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
 // DecodeGetRawYamlByCatalogKindNameVersionResponse returns a decoder for
 // responses returned by the resource GetRawYamlByCatalogKindNameVersion
 // endpoint. restoreBody controls whether the response body should be restored
@@ -1036,6 +2672,7 @@ func (c *Client) BuildGetRawYamlByCatalogKindNameVersionRequest(ctx context.Cont
 // errors:
 //   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
 //   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
 //   - error: internal error
 func DecodeGetRawYamlByCatalogKindNameVersionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -1080,6 +2717,20 @@ func DecodeGetRawYamlByCatalogKindNameVersionResponse(decoder func(*http.Respons
 				return nil, goahttp.ErrValidationError("resource", "GetRawYamlByCatalogKindNameVersion", err)
 			}
 			return nil, NewGetRawYamlByCatalogKindNameVersionNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetRawYamlByCatalogKindNameVersionInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "GetRawYamlByCatalogKindNameVersion", err)
+			}
+			err = ValidateGetRawYamlByCatalogKindNameVersionInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "GetRawYamlByCatalogKindNameVersion", err)
+			}
+			return nil, NewGetRawYamlByCatalogKindNameVersionInvalidToken(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("resource", "GetRawYamlByCatalogKindNameVersion", resp.StatusCode, string(body))
@@ -1117,6 +2768,26 @@ func (c *Client) BuildGetLatestRawYamlByCatalogKindNameRequest(ctx context.Conte
 	return req, nil
 }
 
+// EncodeGetLatestRawYamlByCatalogKindNameRequest returns an encoder for
+// requests sent to the resource GetLatestRawYamlByCatalogKindName server.
+func EncodeGetLatestRawYamlByCatalogKindNameRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*resource.GetLatestRawYamlByCatalogKindNamePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("resource", "GetLatestRawYamlByCatalogKindName", "*resource.GetLatestRawYamlByCatalogKindNamePayload", v)
+		}
+		if p.Token != nil {
+			head := *p.Token
+			if !strings.Contains(head, " ") {
+				req.Header.Set("Authorization", "Bearer "+head)
+			} else {
+				req.Header.Set("Authorization", head)
+			}
+		}
+		return nil
+	}
+}
+
 // DecodeGetLatestRawYamlByCatalogKindNameResponse returns a decoder for
 // responses returned by the resource GetLatestRawYamlByCatalogKindName
 // endpoint. restoreBody controls whether the response body should be restored
@@ -1125,6 +2796,7 @@ func (c *Client) BuildGetLatestRawYamlByCatalogKindNameRequest(ctx context.Conte
 // errors:
 //   - "internal-error" (type *goa.ServiceError): http.StatusInternalServerError
 //   - "not-found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid-token" (type *goa.ServiceError): http.StatusUnauthorized
 //   - error: internal error
 func DecodeGetLatestRawYamlByCatalogKindNameResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
@@ -1169,6 +2841,20 @@ func DecodeGetLatestRawYamlByCatalogKindNameResponse(decoder func(*http.Response
 				return nil, goahttp.ErrValidationError("resource", "GetLatestRawYamlByCatalogKindName", err)
 			}
 			return nil, NewGetLatestRawYamlByCatalogKindNameNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetLatestRawYamlByCatalogKindNameInvalidTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("resource", "GetLatestRawYamlByCatalogKindName", err)
+			}
+			err = ValidateGetLatestRawYamlByCatalogKindNameInvalidTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("resource", "GetLatestRawYamlByCatalogKindName", err)
+			}
+			return nil, NewGetLatestRawYamlByCatalogKindNameInvalidToken(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("resource", "GetLatestRawYamlByCatalogKindName", resp.StatusCode, string(body))
@@ -1187,6 +2873,7 @@ func unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(v *Resourc
 		HubURLPath:    v.HubURLPath,
 		HubRawURLPath: v.HubRawURLPath,
 		Rating:        v.Rating,
+		Downloads:     v.Downloads,
 	}
 	res.Catalog = unmarshalCatalogResponseBodyToResourceviewsCatalogView(v.Catalog)
 	res.Categories = make([]*resourceviews.CategoryView, len(v.Categories))
@@ -1251,11 +2938,32 @@ func unmarshalResourceVersionDataResponseBodyToResourceviewsResourceVersionDataV
 		HubRawURLPath:       v.HubRawURLPath,
 		UpdatedAt:           v.UpdatedAt,
 		HubURLPath:          v.HubURLPath,
+		Bundle:              v.Bundle,
+		APIVersion:          v.APIVersion,
+		Verification:        v.Verification,
 	}
 	res.Platforms = make([]*resourceviews.PlatformView, len(v.Platforms))
 	for i, val := range v.Platforms {
 		res.Platforms[i] = unmarshalPlatformResponseBodyToResourceviewsPlatformView(val)
 	}
+	if v.Params != nil {
+		res.Params = make([]*resourceviews.ParamSpecView, len(v.Params))
+		for i, val := range v.Params {
+			res.Params[i] = unmarshalParamSpecResponseBodyToResourceviewsParamSpecView(val)
+		}
+	}
+	if v.Workspaces != nil {
+		res.Workspaces = make([]*resourceviews.WorkspaceSpecView, len(v.Workspaces))
+		for i, val := range v.Workspaces {
+			res.Workspaces[i] = unmarshalWorkspaceSpecResponseBodyToResourceviewsWorkspaceSpecView(val)
+		}
+	}
+	if v.Results != nil {
+		res.Results = make([]*resourceviews.ResultSpecView, len(v.Results))
+		for i, val := range v.Results {
+			res.Results[i] = unmarshalResultSpecResponseBodyToResourceviewsResultSpecView(val)
+		}
+	}
 	res.Resource = unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(v.Resource)
 
 	return res
@@ -1272,6 +2980,56 @@ func unmarshalPlatformResponseBodyToResourceviewsPlatformView(v *PlatformRespons
 	return res
 }
 
+// unmarshalParamSpecResponseBodyToResourceviewsParamSpecView builds a value of
+// type *resourceviews.ParamSpecView from a value of type
+// *ParamSpecResponseBody.
+func unmarshalParamSpecResponseBodyToResourceviewsParamSpecView(v *ParamSpecResponseBody) *resourceviews.ParamSpecView {
+	if v == nil {
+		return nil
+	}
+	res := &resourceviews.ParamSpecView{
+		Name:        v.Name,
+		Type:        v.Type,
+		Default:     v.Default,
+		Description: v.Description,
+	}
+
+	return res
+}
+
+// unmarshalWorkspaceSpecResponseBodyToResourceviewsWorkspaceSpecView builds a
+// value of type *resourceviews.WorkspaceSpecView from a value of type
+// *WorkspaceSpecResponseBody.
+func unmarshalWorkspaceSpecResponseBodyToResourceviewsWorkspaceSpecView(v *WorkspaceSpecResponseBody) *resourceviews.WorkspaceSpecView {
+	if v == nil {
+		return nil
+	}
+	res := &resourceviews.WorkspaceSpecView{
+		Name:        v.Name,
+		Description: v.Description,
+		Optional:    v.Optional,
+		ReadOnly:    v.ReadOnly,
+	}
+
+	return res
+}
+
+// unmarshalResultSpecResponseBodyToResourceviewsResultSpecView builds a value
+// of type *resourceviews.ResultSpecView from a value of type
+// *ResultSpecResponseBody.
+func unmarshalResultSpecResponseBodyToResourceviewsResultSpecView(v *ResultSpecResponseBody) *resourceviews.ResultSpecView {
+	if v == nil {
+		return nil
+	}
+	res := &resourceviews.ResultSpecView{
+		Name:        v.Name,
+		Type:        v.Type,
+		Description: v.Description,
+	}
+
+	return res
+}
+
 // unmarshalTagResponseBodyToResourceviewsTagView builds a value of type
 // *resourceviews.TagView from a value of type *TagResponseBody.
 func unmarshalTagResponseBodyToResourceviewsTagView(v *TagResponseBody) *resourceviews.TagView {
@@ -1296,6 +3054,53 @@ func unmarshalVersionsResponseBodyToResourceviewsVersionsView(v *VersionsRespons
 	return res
 }
 
+// unmarshalReviewResponseBodyToResourceReview builds a value of type
+// *resource.Review from a value of type *ReviewResponseBody.
+func unmarshalReviewResponseBodyToResourceReview(v *ReviewResponseBody) *resource.Review {
+	res := &resource.Review{
+		ID:         *v.ID,
+		ResourceID: *v.ResourceID,
+		Version:    *v.Version,
+		UserID:     *v.UserID,
+		Text:       *v.Text,
+		CreatedAt:  *v.CreatedAt,
+		UpdatedAt:  *v.UpdatedAt,
+	}
+
+	return res
+}
+
+// unmarshalDependencyResponseBodyToResourceDependency builds a value of type
+// *resource.Dependency from a value of type *DependencyResponseBody.
+func unmarshalDependencyResponseBodyToResourceDependency(v *DependencyResponseBody) *resource.Dependency {
+	res := &resource.Dependency{
+		Name:       *v.Name,
+		Kind:       *v.Kind,
+		Via:        *v.Via,
+		Version:    v.Version,
+		Catalog:    v.Catalog,
+		Bundle:     v.Bundle,
+		URL:        v.URL,
+		ResourceID: v.ResourceID,
+	}
+
+	return res
+}
+
+// unmarshalDependentResponseBodyToResourceDependent builds a value of type
+// *resource.Dependent from a value of type *DependentResponseBody.
+func unmarshalDependentResponseBodyToResourceDependent(v *DependentResponseBody) *resource.Dependent {
+	res := &resource.Dependent{
+		ResourceID:  *v.ResourceID,
+		Name:        *v.Name,
+		Catalog:     *v.Catalog,
+		Version:     *v.Version,
+		TaskVersion: v.TaskVersion,
+	}
+
+	return res
+}
+
 // unmarshalResourceContentResponseBodyToResourceviewsResourceContentView
 // builds a value of type *resourceviews.ResourceContentView from a value of
 // type *ResourceContentResponseBody.
@@ -1307,3 +3112,30 @@ func unmarshalResourceContentResponseBodyToResourceviewsResourceContentView(v *R
 
 	return res
 }
+
+// unmarshalLintResultResponseBodyToResourceLintResult builds a value of type
+// *resource.LintResult from a value of type *LintResultResponseBody.
+func unmarshalLintResultResponseBodyToResourceLintResult(v *LintResultResponseBody) *resource.LintResult {
+	res := &resource.LintResult{
+		Rule:     *v.Rule,
+		Severity: *v.Severity,
+		Message:  *v.Message,
+	}
+
+	return res
+}
+
+// unmarshalImageUserResponseBodyToResourceImageUser builds a value of type
+// *resource.ImageUser from a value of type *ImageUserResponseBody.
+func unmarshalImageUserResponseBodyToResourceImageUser(v *ImageUserResponseBody) *resource.ImageUser {
+	res := &resource.ImageUser{
+		ResourceID: *v.ResourceID,
+		Catalog:    *v.Catalog,
+		Kind:       *v.Kind,
+		Name:       *v.Name,
+		Version:    *v.Version,
+		Image:      *v.Image,
+	}
+
+	return res
+}
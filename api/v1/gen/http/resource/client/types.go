@@ -8,20 +8,41 @@
 package client
 
 import (
+	resource "github.com/tektoncd/hub/api/v1/gen/resource"
 	resourceviews "github.com/tektoncd/hub/api/v1/gen/resource/views"
 	goa "goa.design/goa/v3/pkg"
 )
 
+// CreateReviewRequestBody is the type of the "resource" service "CreateReview"
+// endpoint HTTP request body.
+type CreateReviewRequestBody struct {
+	// Version of the resource which is reviewed
+	Version string `form:"version" json:"version" xml:"version"`
+	// Text of the review
+	Text string `form:"text" json:"text" xml:"text"`
+}
+
+// UpdateReviewRequestBody is the type of the "resource" service "UpdateReview"
+// endpoint HTTP request body.
+type UpdateReviewRequestBody struct {
+	// Text of the review
+	Text string `form:"text" json:"text" xml:"text"`
+}
+
 // QueryResponseBody is the type of the "resource" service "Query" endpoint
 // HTTP response body.
 type QueryResponseBody struct {
 	Data ResourceDataCollectionResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Cursor to fetch the next page of resources, absent on the last page
+	Next *string `form:"next,omitempty" json:"next,omitempty" xml:"next,omitempty"`
 }
 
 // ListResponseBody is the type of the "resource" service "List" endpoint HTTP
 // response body.
 type ListResponseBody struct {
 	Data ResourceDataCollectionResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Cursor to fetch the next page of resources, absent on the last page
+	Next *string `form:"next,omitempty" json:"next,omitempty" xml:"next,omitempty"`
 }
 
 // VersionsByIDResponseBody is the type of the "resource" service
@@ -30,6 +51,66 @@ type VersionsByIDResponseBody struct {
 	Data *VersionsResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
 }
 
+// ListReviewsResponseBody is the type of the "resource" service "ListReviews"
+// endpoint HTTP response body.
+type ListReviewsResponseBody struct {
+	Data []*ReviewResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+	// Cursor to fetch the next page of reviews, absent on the last page
+	Next *string `form:"next,omitempty" json:"next,omitempty" xml:"next,omitempty"`
+}
+
+// CreateReviewResponseBody is the type of the "resource" service
+// "CreateReview" endpoint HTTP response body.
+type CreateReviewResponseBody struct {
+	// ID of the review
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// ID of the resource
+	ResourceID *uint `form:"resourceId,omitempty" json:"resourceId,omitempty" xml:"resourceId,omitempty"`
+	// Version of the resource which is reviewed
+	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// ID of the user who wrote the review
+	UserID *uint `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Text of the review
+	Text *string `form:"text,omitempty" json:"text,omitempty" xml:"text,omitempty"`
+	// Time at which the review was written
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Time at which the review was last edited
+	UpdatedAt *string `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// UpdateReviewResponseBody is the type of the "resource" service
+// "UpdateReview" endpoint HTTP response body.
+type UpdateReviewResponseBody struct {
+	// ID of the review
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// ID of the resource
+	ResourceID *uint `form:"resourceId,omitempty" json:"resourceId,omitempty" xml:"resourceId,omitempty"`
+	// Version of the resource which is reviewed
+	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// ID of the user who wrote the review
+	UserID *uint `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Text of the review
+	Text *string `form:"text,omitempty" json:"text,omitempty" xml:"text,omitempty"`
+	// Time at which the review was written
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Time at which the review was last edited
+	UpdatedAt *string `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// DependenciesResponseBody is the type of the "resource" service
+// "Dependencies" endpoint HTTP response body.
+type DependenciesResponseBody struct {
+	// Version of the pipeline
+	Version *string                   `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	Data    []*DependencyResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+}
+
+// DependentsResponseBody is the type of the "resource" service "Dependents"
+// endpoint HTTP response body.
+type DependentsResponseBody struct {
+	Data []*DependentResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+}
+
 // ByCatalogKindNameVersionResponseBody is the type of the "resource" service
 // "ByCatalogKindNameVersion" endpoint HTTP response body.
 type ByCatalogKindNameVersionResponseBody struct {
@@ -48,6 +129,25 @@ type ByCatalogKindNameVersionYamlResponseBody struct {
 	Data *ResourceContentResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
 }
 
+// ByCatalogKindNameVersionLintResponseBody is the type of the "resource"
+// service "ByCatalogKindNameVersionLint" endpoint HTTP response body.
+type ByCatalogKindNameVersionLintResponseBody struct {
+	Data []*LintResultResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+}
+
+// ByCatalogKindNameVersionImagesResponseBody is the type of the "resource"
+// service "ByCatalogKindNameVersionImages" endpoint HTTP response body.
+type ByCatalogKindNameVersionImagesResponseBody struct {
+	// References of the images
+	Data []string `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+}
+
+// ByImageResponseBody is the type of the "resource" service "ByImage" endpoint
+// HTTP response body.
+type ByImageResponseBody struct {
+	Data []*ImageUserResponseBody `form:"data,omitempty" json:"data,omitempty" xml:"data,omitempty"`
+}
+
 // ByVersionIDResponseBody is the type of the "resource" service "ByVersionId"
 // endpoint HTTP response body.
 type ByVersionIDResponseBody struct {
@@ -102,6 +202,24 @@ type QueryInvalidKindResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// QueryInvalidCursorResponseBody is the type of the "resource" service "Query"
+// endpoint HTTP response body for the "invalid-cursor" error.
+type QueryInvalidCursorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // QueryNotFoundResponseBody is the type of the "resource" service "Query"
 // endpoint HTTP response body for the "not-found" error.
 type QueryNotFoundResponseBody struct {
@@ -120,6 +238,24 @@ type QueryNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// QueryInvalidTokenResponseBody is the type of the "resource" service "Query"
+// endpoint HTTP response body for the "invalid-token" error.
+type QueryInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // ListInternalErrorResponseBody is the type of the "resource" service "List"
 // endpoint HTTP response body for the "internal-error" error.
 type ListInternalErrorResponseBody struct {
@@ -138,6 +274,24 @@ type ListInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// ListInvalidCursorResponseBody is the type of the "resource" service "List"
+// endpoint HTTP response body for the "invalid-cursor" error.
+type ListInvalidCursorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // ListNotFoundResponseBody is the type of the "resource" service "List"
 // endpoint HTTP response body for the "not-found" error.
 type ListNotFoundResponseBody struct {
@@ -156,6 +310,24 @@ type ListNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// ListInvalidTokenResponseBody is the type of the "resource" service "List"
+// endpoint HTTP response body for the "invalid-token" error.
+type ListInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // VersionsByIDInternalErrorResponseBody is the type of the "resource" service
 // "VersionsByID" endpoint HTTP response body for the "internal-error" error.
 type VersionsByIDInternalErrorResponseBody struct {
@@ -192,10 +364,9 @@ type VersionsByIDNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ByCatalogKindNameVersionInternalErrorResponseBody is the type of the
-// "resource" service "ByCatalogKindNameVersion" endpoint HTTP response body
-// for the "internal-error" error.
-type ByCatalogKindNameVersionInternalErrorResponseBody struct {
+// VersionsByIDInvalidTokenResponseBody is the type of the "resource" service
+// "VersionsByID" endpoint HTTP response body for the "invalid-token" error.
+type VersionsByIDInvalidTokenResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -211,10 +382,9 @@ type ByCatalogKindNameVersionInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ByCatalogKindNameVersionNotFoundResponseBody is the type of the "resource"
-// service "ByCatalogKindNameVersion" endpoint HTTP response body for the
-// "not-found" error.
-type ByCatalogKindNameVersionNotFoundResponseBody struct {
+// ListReviewsInternalErrorResponseBody is the type of the "resource" service
+// "ListReviews" endpoint HTTP response body for the "internal-error" error.
+type ListReviewsInternalErrorResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -230,10 +400,9 @@ type ByCatalogKindNameVersionNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ByCatalogKindNameVersionReadmeInternalErrorResponseBody is the type of the
-// "resource" service "ByCatalogKindNameVersionReadme" endpoint HTTP response
-// body for the "internal-error" error.
-type ByCatalogKindNameVersionReadmeInternalErrorResponseBody struct {
+// ListReviewsInvalidCursorResponseBody is the type of the "resource" service
+// "ListReviews" endpoint HTTP response body for the "invalid-cursor" error.
+type ListReviewsInvalidCursorResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -249,10 +418,9 @@ type ByCatalogKindNameVersionReadmeInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ByCatalogKindNameVersionReadmeNotFoundResponseBody is the type of the
-// "resource" service "ByCatalogKindNameVersionReadme" endpoint HTTP response
-// body for the "not-found" error.
-type ByCatalogKindNameVersionReadmeNotFoundResponseBody struct {
+// ListReviewsNotFoundResponseBody is the type of the "resource" service
+// "ListReviews" endpoint HTTP response body for the "not-found" error.
+type ListReviewsNotFoundResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -268,10 +436,9 @@ type ByCatalogKindNameVersionReadmeNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ByCatalogKindNameVersionYamlInternalErrorResponseBody is the type of the
-// "resource" service "ByCatalogKindNameVersionYaml" endpoint HTTP response
-// body for the "internal-error" error.
-type ByCatalogKindNameVersionYamlInternalErrorResponseBody struct {
+// ListReviewsInvalidTokenResponseBody is the type of the "resource" service
+// "ListReviews" endpoint HTTP response body for the "invalid-token" error.
+type ListReviewsInvalidTokenResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -287,10 +454,9 @@ type ByCatalogKindNameVersionYamlInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ByCatalogKindNameVersionYamlNotFoundResponseBody is the type of the
-// "resource" service "ByCatalogKindNameVersionYaml" endpoint HTTP response
-// body for the "not-found" error.
-type ByCatalogKindNameVersionYamlNotFoundResponseBody struct {
+// CreateReviewInternalErrorResponseBody is the type of the "resource" service
+// "CreateReview" endpoint HTTP response body for the "internal-error" error.
+type CreateReviewInternalErrorResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -306,9 +472,9 @@ type ByCatalogKindNameVersionYamlNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ByVersionIDInternalErrorResponseBody is the type of the "resource" service
-// "ByVersionId" endpoint HTTP response body for the "internal-error" error.
-type ByVersionIDInternalErrorResponseBody struct {
+// CreateReviewInvalidPayloadResponseBody is the type of the "resource" service
+// "CreateReview" endpoint HTTP response body for the "invalid-payload" error.
+type CreateReviewInvalidPayloadResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -324,9 +490,9 @@ type ByVersionIDInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ByVersionIDNotFoundResponseBody is the type of the "resource" service
-// "ByVersionId" endpoint HTTP response body for the "not-found" error.
-type ByVersionIDNotFoundResponseBody struct {
+// CreateReviewInvalidScopesResponseBody is the type of the "resource" service
+// "CreateReview" endpoint HTTP response body for the "invalid-scopes" error.
+type CreateReviewInvalidScopesResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -342,10 +508,9 @@ type ByVersionIDNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ByCatalogKindNameInternalErrorResponseBody is the type of the "resource"
-// service "ByCatalogKindName" endpoint HTTP response body for the
-// "internal-error" error.
-type ByCatalogKindNameInternalErrorResponseBody struct {
+// CreateReviewNotFoundResponseBody is the type of the "resource" service
+// "CreateReview" endpoint HTTP response body for the "not-found" error.
+type CreateReviewNotFoundResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -361,9 +526,9 @@ type ByCatalogKindNameInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ByCatalogKindNameNotFoundResponseBody is the type of the "resource" service
-// "ByCatalogKindName" endpoint HTTP response body for the "not-found" error.
-type ByCatalogKindNameNotFoundResponseBody struct {
+// CreateReviewInvalidTokenResponseBody is the type of the "resource" service
+// "CreateReview" endpoint HTTP response body for the "invalid-token" error.
+type CreateReviewInvalidTokenResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -379,9 +544,9 @@ type ByCatalogKindNameNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ByIDInternalErrorResponseBody is the type of the "resource" service "ById"
-// endpoint HTTP response body for the "internal-error" error.
-type ByIDInternalErrorResponseBody struct {
+// UpdateReviewInternalErrorResponseBody is the type of the "resource" service
+// "UpdateReview" endpoint HTTP response body for the "internal-error" error.
+type UpdateReviewInternalErrorResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -397,9 +562,9 @@ type ByIDInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ByIDNotFoundResponseBody is the type of the "resource" service "ById"
-// endpoint HTTP response body for the "not-found" error.
-type ByIDNotFoundResponseBody struct {
+// UpdateReviewInvalidScopesResponseBody is the type of the "resource" service
+// "UpdateReview" endpoint HTTP response body for the "invalid-scopes" error.
+type UpdateReviewInvalidScopesResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -415,10 +580,9 @@ type ByIDNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// GetRawYamlByCatalogKindNameVersionInternalErrorResponseBody is the type of
-// the "resource" service "GetRawYamlByCatalogKindNameVersion" endpoint HTTP
-// response body for the "internal-error" error.
-type GetRawYamlByCatalogKindNameVersionInternalErrorResponseBody struct {
+// UpdateReviewNotFoundResponseBody is the type of the "resource" service
+// "UpdateReview" endpoint HTTP response body for the "not-found" error.
+type UpdateReviewNotFoundResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -434,10 +598,9 @@ type GetRawYamlByCatalogKindNameVersionInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// GetRawYamlByCatalogKindNameVersionNotFoundResponseBody is the type of the
-// "resource" service "GetRawYamlByCatalogKindNameVersion" endpoint HTTP
-// response body for the "not-found" error.
-type GetRawYamlByCatalogKindNameVersionNotFoundResponseBody struct {
+// UpdateReviewInvalidTokenResponseBody is the type of the "resource" service
+// "UpdateReview" endpoint HTTP response body for the "invalid-token" error.
+type UpdateReviewInvalidTokenResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -453,10 +616,9 @@ type GetRawYamlByCatalogKindNameVersionNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// GetLatestRawYamlByCatalogKindNameInternalErrorResponseBody is the type of
-// the "resource" service "GetLatestRawYamlByCatalogKindName" endpoint HTTP
-// response body for the "internal-error" error.
-type GetLatestRawYamlByCatalogKindNameInternalErrorResponseBody struct {
+// DeleteReviewInternalErrorResponseBody is the type of the "resource" service
+// "DeleteReview" endpoint HTTP response body for the "internal-error" error.
+type DeleteReviewInternalErrorResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -472,10 +634,9 @@ type GetLatestRawYamlByCatalogKindNameInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// GetLatestRawYamlByCatalogKindNameNotFoundResponseBody is the type of the
-// "resource" service "GetLatestRawYamlByCatalogKindName" endpoint HTTP
-// response body for the "not-found" error.
-type GetLatestRawYamlByCatalogKindNameNotFoundResponseBody struct {
+// DeleteReviewInvalidScopesResponseBody is the type of the "resource" service
+// "DeleteReview" endpoint HTTP response body for the "invalid-scopes" error.
+type DeleteReviewInvalidScopesResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -491,557 +652,3388 @@ type GetLatestRawYamlByCatalogKindNameNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ResourceDataCollectionResponseBody is used to define fields on response body
-// types.
-type ResourceDataCollectionResponseBody []*ResourceDataResponseBody
+// DeleteReviewNotFoundResponseBody is the type of the "resource" service
+// "DeleteReview" endpoint HTTP response body for the "not-found" error.
+type DeleteReviewNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
 
-// ResourceDataResponseBody is used to define fields on response body types.
-type ResourceDataResponseBody struct {
-	// ID is the unique id of the resource
-	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Name of resource
+// DeleteReviewInvalidTokenResponseBody is the type of the "resource" service
+// "DeleteReview" endpoint HTTP response body for the "invalid-token" error.
+type DeleteReviewInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// Type of catalog to which resource belongs
-	Catalog *CatalogResponseBody `form:"catalog,omitempty" json:"catalog,omitempty" xml:"catalog,omitempty"`
-	// Categories related to resource
-	Categories []*CategoryResponseBody `form:"categories,omitempty" json:"categories,omitempty" xml:"categories,omitempty"`
-	// Kind of resource
-	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
-	// Url path of the resource in hub
-	HubURLPath *string `form:"hubURLPath,omitempty" json:"hubURLPath,omitempty" xml:"hubURLPath,omitempty"`
-	// Path of the api to get the raw yaml of resource from hub apiserver
-	HubRawURLPath *string `form:"hubRawURLPath,omitempty" json:"hubRawURLPath,omitempty" xml:"hubRawURLPath,omitempty"`
-	// Latest version of resource
-	LatestVersion *ResourceVersionDataResponseBody `form:"latestVersion,omitempty" json:"latestVersion,omitempty" xml:"latestVersion,omitempty"`
-	// Tags related to resource
-	Tags []*TagResponseBody `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
-	// Platforms related to resource
-	Platforms []*PlatformResponseBody `form:"platforms,omitempty" json:"platforms,omitempty" xml:"platforms,omitempty"`
-	// Rating of resource
-	Rating *float64 `form:"rating,omitempty" json:"rating,omitempty" xml:"rating,omitempty"`
-	// List of all versions of a resource
-	Versions []*ResourceVersionDataResponseBody `form:"versions,omitempty" json:"versions,omitempty" xml:"versions,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// CatalogResponseBody is used to define fields on response body types.
-type CatalogResponseBody struct {
-	// ID is the unique id of the catalog
-	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Name of catalog
+// DependenciesInternalErrorResponseBody is the type of the "resource" service
+// "Dependencies" endpoint HTTP response body for the "internal-error" error.
+type DependenciesInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// Type of catalog
-	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
-	// URL of catalog
-	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
-	// Provider of catalog
-	Provider *string `form:"provider,omitempty" json:"provider,omitempty" xml:"provider,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// CategoryResponseBody is used to define fields on response body types.
-type CategoryResponseBody struct {
-	// ID is the unique id of the category
-	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Name of category
+// DependenciesNotFoundResponseBody is the type of the "resource" service
+// "Dependencies" endpoint HTTP response body for the "not-found" error.
+type DependenciesNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// ResourceVersionDataResponseBody is used to define fields on response body
-// types.
-type ResourceVersionDataResponseBody struct {
-	// ID is the unique id of resource's version
-	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Version of resource
-	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
-	// Display name of version
-	DisplayName *string `form:"displayName,omitempty" json:"displayName,omitempty" xml:"displayName,omitempty"`
-	// Deprecation status of a version
-	Deprecated *bool `form:"deprecated,omitempty" json:"deprecated,omitempty" xml:"deprecated,omitempty"`
-	// Description of version
-	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
-	// Minimum pipelines version the resource's version is compatible with
-	MinPipelinesVersion *string `form:"minPipelinesVersion,omitempty" json:"minPipelinesVersion,omitempty" xml:"minPipelinesVersion,omitempty"`
-	// Raw URL of resource's yaml file of the version
-	RawURL *string `form:"rawURL,omitempty" json:"rawURL,omitempty" xml:"rawURL,omitempty"`
-	// Web URL of resource's yaml file of the version
-	WebURL *string `form:"webURL,omitempty" json:"webURL,omitempty" xml:"webURL,omitempty"`
-	// Path of the api to get the raw yaml of resource from hub apiserver
-	HubRawURLPath *string `form:"hubRawURLPath,omitempty" json:"hubRawURLPath,omitempty" xml:"hubRawURLPath,omitempty"`
-	// Timestamp when version was last updated
-	UpdatedAt *string `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
-	// Platforms related to resource version
-	Platforms []*PlatformResponseBody `form:"platforms,omitempty" json:"platforms,omitempty" xml:"platforms,omitempty"`
-	// Url path of the resource in hub
-	HubURLPath *string `form:"hubURLPath,omitempty" json:"hubURLPath,omitempty" xml:"hubURLPath,omitempty"`
-	// Resource to which the version belongs
-	Resource *ResourceDataResponseBody `form:"resource,omitempty" json:"resource,omitempty" xml:"resource,omitempty"`
+// DependenciesInvalidTokenResponseBody is the type of the "resource" service
+// "Dependencies" endpoint HTTP response body for the "invalid-token" error.
+type DependenciesInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// PlatformResponseBody is used to define fields on response body types.
-type PlatformResponseBody struct {
-	// ID is the unique id of platform
-	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Name of platform
+// DependentsInternalErrorResponseBody is the type of the "resource" service
+// "Dependents" endpoint HTTP response body for the "internal-error" error.
+type DependentsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// TagResponseBody is used to define fields on response body types.
-type TagResponseBody struct {
-	// ID is the unique id of tag
-	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Name of tag
+// DependentsNotFoundResponseBody is the type of the "resource" service
+// "Dependents" endpoint HTTP response body for the "not-found" error.
+type DependentsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DependentsInvalidTokenResponseBody is the type of the "resource" service
+// "Dependents" endpoint HTTP response body for the "invalid-token" error.
+type DependentsInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionInternalErrorResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersion" endpoint HTTP response body
+// for the "internal-error" error.
+type ByCatalogKindNameVersionInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionNotFoundResponseBody is the type of the "resource"
+// service "ByCatalogKindNameVersion" endpoint HTTP response body for the
+// "not-found" error.
+type ByCatalogKindNameVersionNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionInvalidTokenResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersion" endpoint HTTP response body
+// for the "invalid-token" error.
+type ByCatalogKindNameVersionInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionReadmeInternalErrorResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionReadme" endpoint HTTP response
+// body for the "internal-error" error.
+type ByCatalogKindNameVersionReadmeInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionReadmeNotFoundResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionReadme" endpoint HTTP response
+// body for the "not-found" error.
+type ByCatalogKindNameVersionReadmeNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionReadmeInvalidTokenResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionReadme" endpoint HTTP response
+// body for the "invalid-token" error.
+type ByCatalogKindNameVersionReadmeInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionYamlInternalErrorResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionYaml" endpoint HTTP response
+// body for the "internal-error" error.
+type ByCatalogKindNameVersionYamlInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionYamlNotFoundResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionYaml" endpoint HTTP response
+// body for the "not-found" error.
+type ByCatalogKindNameVersionYamlNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionYamlInvalidTokenResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionYaml" endpoint HTTP response
+// body for the "invalid-token" error.
+type ByCatalogKindNameVersionYamlInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionLintInternalErrorResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionLint" endpoint HTTP response
+// body for the "internal-error" error.
+type ByCatalogKindNameVersionLintInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionLintNotFoundResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionLint" endpoint HTTP response
+// body for the "not-found" error.
+type ByCatalogKindNameVersionLintNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionLintInvalidTokenResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionLint" endpoint HTTP response
+// body for the "invalid-token" error.
+type ByCatalogKindNameVersionLintInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionImagesInternalErrorResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionImages" endpoint HTTP response
+// body for the "internal-error" error.
+type ByCatalogKindNameVersionImagesInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionImagesNotFoundResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionImages" endpoint HTTP response
+// body for the "not-found" error.
+type ByCatalogKindNameVersionImagesNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameVersionImagesInvalidTokenResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionImages" endpoint HTTP response
+// body for the "invalid-token" error.
+type ByCatalogKindNameVersionImagesInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByImageInternalErrorResponseBody is the type of the "resource" service
+// "ByImage" endpoint HTTP response body for the "internal-error" error.
+type ByImageInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByImageInvalidTokenResponseBody is the type of the "resource" service
+// "ByImage" endpoint HTTP response body for the "invalid-token" error.
+type ByImageInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByVersionIDInternalErrorResponseBody is the type of the "resource" service
+// "ByVersionId" endpoint HTTP response body for the "internal-error" error.
+type ByVersionIDInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByVersionIDNotFoundResponseBody is the type of the "resource" service
+// "ByVersionId" endpoint HTTP response body for the "not-found" error.
+type ByVersionIDNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByVersionIDInvalidTokenResponseBody is the type of the "resource" service
+// "ByVersionId" endpoint HTTP response body for the "invalid-token" error.
+type ByVersionIDInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameInternalErrorResponseBody is the type of the "resource"
+// service "ByCatalogKindName" endpoint HTTP response body for the
+// "internal-error" error.
+type ByCatalogKindNameInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameNotFoundResponseBody is the type of the "resource" service
+// "ByCatalogKindName" endpoint HTTP response body for the "not-found" error.
+type ByCatalogKindNameNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByCatalogKindNameInvalidTokenResponseBody is the type of the "resource"
+// service "ByCatalogKindName" endpoint HTTP response body for the
+// "invalid-token" error.
+type ByCatalogKindNameInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByIDInternalErrorResponseBody is the type of the "resource" service "ById"
+// endpoint HTTP response body for the "internal-error" error.
+type ByIDInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByIDNotFoundResponseBody is the type of the "resource" service "ById"
+// endpoint HTTP response body for the "not-found" error.
+type ByIDNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ByIDInvalidTokenResponseBody is the type of the "resource" service "ById"
+// endpoint HTTP response body for the "invalid-token" error.
+type ByIDInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetRawYamlByCatalogKindNameVersionInternalErrorResponseBody is the type of
+// the "resource" service "GetRawYamlByCatalogKindNameVersion" endpoint HTTP
+// response body for the "internal-error" error.
+type GetRawYamlByCatalogKindNameVersionInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetRawYamlByCatalogKindNameVersionNotFoundResponseBody is the type of the
+// "resource" service "GetRawYamlByCatalogKindNameVersion" endpoint HTTP
+// response body for the "not-found" error.
+type GetRawYamlByCatalogKindNameVersionNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetRawYamlByCatalogKindNameVersionInvalidTokenResponseBody is the type of
+// the "resource" service "GetRawYamlByCatalogKindNameVersion" endpoint HTTP
+// response body for the "invalid-token" error.
+type GetRawYamlByCatalogKindNameVersionInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetLatestRawYamlByCatalogKindNameInternalErrorResponseBody is the type of
+// the "resource" service "GetLatestRawYamlByCatalogKindName" endpoint HTTP
+// response body for the "internal-error" error.
+type GetLatestRawYamlByCatalogKindNameInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetLatestRawYamlByCatalogKindNameNotFoundResponseBody is the type of the
+// "resource" service "GetLatestRawYamlByCatalogKindName" endpoint HTTP
+// response body for the "not-found" error.
+type GetLatestRawYamlByCatalogKindNameNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetLatestRawYamlByCatalogKindNameInvalidTokenResponseBody is the type of the
+// "resource" service "GetLatestRawYamlByCatalogKindName" endpoint HTTP
+// response body for the "invalid-token" error.
+type GetLatestRawYamlByCatalogKindNameInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ResourceDataCollectionResponseBody is used to define fields on response body
+// types.
+type ResourceDataCollectionResponseBody []*ResourceDataResponseBody
+
+// ResourceDataResponseBody is used to define fields on response body types.
+type ResourceDataResponseBody struct {
+	// ID is the unique id of the resource
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of resource
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Type of catalog to which resource belongs
+	Catalog *CatalogResponseBody `form:"catalog,omitempty" json:"catalog,omitempty" xml:"catalog,omitempty"`
+	// Categories related to resource
+	Categories []*CategoryResponseBody `form:"categories,omitempty" json:"categories,omitempty" xml:"categories,omitempty"`
+	// Kind of resource
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Url path of the resource in hub
+	HubURLPath *string `form:"hubURLPath,omitempty" json:"hubURLPath,omitempty" xml:"hubURLPath,omitempty"`
+	// Path of the api to get the raw yaml of resource from hub apiserver
+	HubRawURLPath *string `form:"hubRawURLPath,omitempty" json:"hubRawURLPath,omitempty" xml:"hubRawURLPath,omitempty"`
+	// Latest version of resource
+	LatestVersion *ResourceVersionDataResponseBody `form:"latestVersion,omitempty" json:"latestVersion,omitempty" xml:"latestVersion,omitempty"`
+	// Tags related to resource
+	Tags []*TagResponseBody `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Platforms related to resource
+	Platforms []*PlatformResponseBody `form:"platforms,omitempty" json:"platforms,omitempty" xml:"platforms,omitempty"`
+	// Rating of resource
+	Rating *float64 `form:"rating,omitempty" json:"rating,omitempty" xml:"rating,omitempty"`
+	// Number of downloads of all versions of resource
+	Downloads *uint `form:"downloads,omitempty" json:"downloads,omitempty" xml:"downloads,omitempty"`
+	// List of all versions of a resource
+	Versions []*ResourceVersionDataResponseBody `form:"versions,omitempty" json:"versions,omitempty" xml:"versions,omitempty"`
+}
+
+// CatalogResponseBody is used to define fields on response body types.
+type CatalogResponseBody struct {
+	// ID is the unique id of the catalog
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of catalog
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Type of catalog
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// URL of catalog
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// Provider of catalog
+	Provider *string `form:"provider,omitempty" json:"provider,omitempty" xml:"provider,omitempty"`
+}
+
+// CategoryResponseBody is used to define fields on response body types.
+type CategoryResponseBody struct {
+	// ID is the unique id of the category
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of category
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+}
+
+// ResourceVersionDataResponseBody is used to define fields on response body
+// types.
+type ResourceVersionDataResponseBody struct {
+	// ID is the unique id of resource's version
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Version of resource
+	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Display name of version
+	DisplayName *string `form:"displayName,omitempty" json:"displayName,omitempty" xml:"displayName,omitempty"`
+	// Deprecation status of a version
+	Deprecated *bool `form:"deprecated,omitempty" json:"deprecated,omitempty" xml:"deprecated,omitempty"`
+	// Description of version
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Minimum pipelines version the resource's version is compatible with
+	MinPipelinesVersion *string `form:"minPipelinesVersion,omitempty" json:"minPipelinesVersion,omitempty" xml:"minPipelinesVersion,omitempty"`
+	// Raw URL of resource's yaml file of the version
+	RawURL *string `form:"rawURL,omitempty" json:"rawURL,omitempty" xml:"rawURL,omitempty"`
+	// Web URL of resource's yaml file of the version
+	WebURL *string `form:"webURL,omitempty" json:"webURL,omitempty" xml:"webURL,omitempty"`
+	// Path of the api to get the raw yaml of resource from hub apiserver
+	HubRawURLPath *string `form:"hubRawURLPath,omitempty" json:"hubRawURLPath,omitempty" xml:"hubRawURLPath,omitempty"`
+	// Timestamp when version was last updated
+	UpdatedAt *string `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+	// Platforms related to resource version
+	Platforms []*PlatformResponseBody `form:"platforms,omitempty" json:"platforms,omitempty" xml:"platforms,omitempty"`
+	// Url path of the resource in hub
+	HubURLPath *string `form:"hubURLPath,omitempty" json:"hubURLPath,omitempty" xml:"hubURLPath,omitempty"`
+	// Tekton bundle (OCI image) reference of the version
+	Bundle *string `form:"bundle,omitempty" json:"bundle,omitempty" xml:"bundle,omitempty"`
+	// Tekton apiVersion of the resource manifest
+	APIVersion *string `form:"apiVersion,omitempty" json:"apiVersion,omitempty" xml:"apiVersion,omitempty"`
+	// Verification of the trusted resource signature of the version with the
+	// public keys of its catalog
+	Verification *string `form:"verification,omitempty" json:"verification,omitempty" xml:"verification,omitempty"`
+	// Params of the version
+	Params []*ParamSpecResponseBody `form:"params,omitempty" json:"params,omitempty" xml:"params,omitempty"`
+	// Workspaces of the version
+	Workspaces []*WorkspaceSpecResponseBody `form:"workspaces,omitempty" json:"workspaces,omitempty" xml:"workspaces,omitempty"`
+	// Results of the version
+	Results []*ResultSpecResponseBody `form:"results,omitempty" json:"results,omitempty" xml:"results,omitempty"`
+	// Resource to which the version belongs
+	Resource *ResourceDataResponseBody `form:"resource,omitempty" json:"resource,omitempty" xml:"resource,omitempty"`
+}
+
+// PlatformResponseBody is used to define fields on response body types.
+type PlatformResponseBody struct {
+	// ID is the unique id of platform
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of platform
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+}
+
+// ParamSpecResponseBody is used to define fields on response body types.
+type ParamSpecResponseBody struct {
+	// Name of the param
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Type of the param
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Default of the param, JSON for array and object params
+	Default *string `form:"default,omitempty" json:"default,omitempty" xml:"default,omitempty"`
+	// Description of the param
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+}
+
+// WorkspaceSpecResponseBody is used to define fields on response body types.
+type WorkspaceSpecResponseBody struct {
+	// Name of the workspace
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Description of the workspace
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Whether the workspace may not be bound
+	Optional *bool `form:"optional,omitempty" json:"optional,omitempty" xml:"optional,omitempty"`
+	// Whether the task only reads the workspace
+	ReadOnly *bool `form:"readOnly,omitempty" json:"readOnly,omitempty" xml:"readOnly,omitempty"`
+}
+
+// ResultSpecResponseBody is used to define fields on response body types.
+type ResultSpecResponseBody struct {
+	// Name of the result
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Type of the result
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Description of the result
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+}
+
+// TagResponseBody is used to define fields on response body types.
+type TagResponseBody struct {
+	// ID is the unique id of tag
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of tag
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+}
+
+// VersionsResponseBody is used to define fields on response body types.
+type VersionsResponseBody struct {
+	// Latest Version of resource
+	Latest *ResourceVersionDataResponseBody `form:"latest,omitempty" json:"latest,omitempty" xml:"latest,omitempty"`
+	// List of all versions of resource
+	Versions []*ResourceVersionDataResponseBody `form:"versions,omitempty" json:"versions,omitempty" xml:"versions,omitempty"`
+}
+
+// ReviewResponseBody is used to define fields on response body types.
+type ReviewResponseBody struct {
+	// ID of the review
+	ID *uint `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// ID of the resource
+	ResourceID *uint `form:"resourceId,omitempty" json:"resourceId,omitempty" xml:"resourceId,omitempty"`
+	// Version of the resource which is reviewed
+	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// ID of the user who wrote the review
+	UserID *uint `form:"userId,omitempty" json:"userId,omitempty" xml:"userId,omitempty"`
+	// Text of the review
+	Text *string `form:"text,omitempty" json:"text,omitempty" xml:"text,omitempty"`
+	// Time at which the review was written
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Time at which the review was last edited
+	UpdatedAt *string `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+}
+
+// DependencyResponseBody is used to define fields on response body types.
+type DependencyResponseBody struct {
+	// Name of the task
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Kind of the task
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// How the pipeline references the task
+	Via *string `form:"via,omitempty" json:"via,omitempty" xml:"via,omitempty"`
+	// Version of the task, absent if the reference doesn't pin it
+	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Name of the catalog of the task, if known
+	Catalog *string `form:"catalog,omitempty" json:"catalog,omitempty" xml:"catalog,omitempty"`
+	// Bundle of the task referenced through a bundle
+	Bundle *string `form:"bundle,omitempty" json:"bundle,omitempty" xml:"bundle,omitempty"`
+	// URL of the repository of the task referenced through git
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// ID of the resource in the hub the task resolves to
+	ResourceID *uint `form:"resourceId,omitempty" json:"resourceId,omitempty" xml:"resourceId,omitempty"`
+}
+
+// DependentResponseBody is used to define fields on response body types.
+type DependentResponseBody struct {
+	// ID of the pipeline
+	ResourceID *uint `form:"resourceId,omitempty" json:"resourceId,omitempty" xml:"resourceId,omitempty"`
+	// Name of the pipeline
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Name of the catalog of the pipeline
+	Catalog *string `form:"catalog,omitempty" json:"catalog,omitempty" xml:"catalog,omitempty"`
+	// Version of the pipeline
+	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Version of the task referenced by the pipeline, absent if the reference
+	// doesn't pin it
+	TaskVersion *string `form:"taskVersion,omitempty" json:"taskVersion,omitempty" xml:"taskVersion,omitempty"`
+}
+
+// ResourceContentResponseBody is used to define fields on response body types.
+type ResourceContentResponseBody struct {
+	// Readme
+	Readme *string `form:"readme,omitempty" json:"readme,omitempty" xml:"readme,omitempty"`
+	// Yaml
+	Yaml *string `form:"yaml,omitempty" json:"yaml,omitempty" xml:"yaml,omitempty"`
+}
+
+// LintResultResponseBody is used to define fields on response body types.
+type LintResultResponseBody struct {
+	// ID of the rule
+	Rule *string `form:"rule,omitempty" json:"rule,omitempty" xml:"rule,omitempty"`
+	// Severity of the problem
+	Severity *string `form:"severity,omitempty" json:"severity,omitempty" xml:"severity,omitempty"`
+	// Description of the problem
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ImageUserResponseBody is used to define fields on response body types.
+type ImageUserResponseBody struct {
+	// ID of the resource
+	ResourceID *uint `form:"resourceId,omitempty" json:"resourceId,omitempty" xml:"resourceId,omitempty"`
+	// Name of the catalog of the resource
+	Catalog *string `form:"catalog,omitempty" json:"catalog,omitempty" xml:"catalog,omitempty"`
+	// Kind of the resource
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Name of the resource
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Version of the resource
+	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Reference of the image used by the version
+	Image *string `form:"image,omitempty" json:"image,omitempty" xml:"image,omitempty"`
+}
+
+// NewCreateReviewRequestBody builds the HTTP request body from the payload of
+// the "CreateReview" endpoint of the "resource" service.
+func NewCreateReviewRequestBody(p *resource.CreateReviewPayload) *CreateReviewRequestBody {
+	body := &CreateReviewRequestBody{
+		Version: p.Version,
+		Text:    p.Text,
+	}
+	return body
+}
+
+// NewUpdateReviewRequestBody builds the HTTP request body from the payload of
+// the "UpdateReview" endpoint of the "resource" service.
+func NewUpdateReviewRequestBody(p *resource.UpdateReviewPayload) *UpdateReviewRequestBody {
+	body := &UpdateReviewRequestBody{
+		Text: p.Text,
+	}
+	return body
+}
+
+// NewQueryResourcesOK builds a "resource" service "Query" endpoint result from
+// a HTTP "OK" response.
+func NewQueryResourcesOK(body *QueryResponseBody) *resourceviews.ResourcesView {
+	v := &resourceviews.ResourcesView{
+		Next: body.Next,
+	}
+	v.Data = make([]*resourceviews.ResourceDataView, len(body.Data))
+	for i, val := range body.Data {
+		v.Data[i] = unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(val)
+	}
+
+	return v
+}
+
+// NewQueryInternalError builds a resource service Query endpoint
+// internal-error error.
+func NewQueryInternalError(body *QueryInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewQueryInvalidKind builds a resource service Query endpoint invalid-kind
+// error.
+func NewQueryInvalidKind(body *QueryInvalidKindResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewQueryInvalidCursor builds a resource service Query endpoint
+// invalid-cursor error.
+func NewQueryInvalidCursor(body *QueryInvalidCursorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewQueryNotFound builds a resource service Query endpoint not-found error.
+func NewQueryNotFound(body *QueryNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewQueryInvalidToken builds a resource service Query endpoint invalid-token
+// error.
+func NewQueryInvalidToken(body *QueryInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListResourcesOK builds a "resource" service "List" endpoint result from a
+// HTTP "OK" response.
+func NewListResourcesOK(body *ListResponseBody) *resourceviews.ResourcesView {
+	v := &resourceviews.ResourcesView{
+		Next: body.Next,
+	}
+	v.Data = make([]*resourceviews.ResourceDataView, len(body.Data))
+	for i, val := range body.Data {
+		v.Data[i] = unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(val)
+	}
+
+	return v
+}
+
+// NewListInternalError builds a resource service List endpoint internal-error
+// error.
+func NewListInternalError(body *ListInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListInvalidCursor builds a resource service List endpoint invalid-cursor
+// error.
+func NewListInvalidCursor(body *ListInvalidCursorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListNotFound builds a resource service List endpoint not-found error.
+func NewListNotFound(body *ListNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListInvalidToken builds a resource service List endpoint invalid-token
+// error.
+func NewListInvalidToken(body *ListInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewVersionsByIDResourceVersionsOK builds a "resource" service "VersionsByID"
+// endpoint result from a HTTP "OK" response.
+func NewVersionsByIDResourceVersionsOK(body *VersionsByIDResponseBody) *resourceviews.ResourceVersionsView {
+	v := &resourceviews.ResourceVersionsView{}
+	v.Data = unmarshalVersionsResponseBodyToResourceviewsVersionsView(body.Data)
+
+	return v
+}
+
+// NewVersionsByIDInternalError builds a resource service VersionsByID endpoint
+// internal-error error.
+func NewVersionsByIDInternalError(body *VersionsByIDInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewVersionsByIDNotFound builds a resource service VersionsByID endpoint
+// not-found error.
+func NewVersionsByIDNotFound(body *VersionsByIDNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewVersionsByIDInvalidToken builds a resource service VersionsByID endpoint
+// invalid-token error.
+func NewVersionsByIDInvalidToken(body *VersionsByIDInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListReviewsReviewsOK builds a "resource" service "ListReviews" endpoint
+// result from a HTTP "OK" response.
+func NewListReviewsReviewsOK(body *ListReviewsResponseBody) *resource.Reviews {
+	v := &resource.Reviews{
+		Next: body.Next,
+	}
+	v.Data = make([]*resource.Review, len(body.Data))
+	for i, val := range body.Data {
+		v.Data[i] = unmarshalReviewResponseBodyToResourceReview(val)
+	}
+
+	return v
+}
+
+// NewListReviewsInternalError builds a resource service ListReviews endpoint
+// internal-error error.
+func NewListReviewsInternalError(body *ListReviewsInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListReviewsInvalidCursor builds a resource service ListReviews endpoint
+// invalid-cursor error.
+func NewListReviewsInvalidCursor(body *ListReviewsInvalidCursorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListReviewsNotFound builds a resource service ListReviews endpoint
+// not-found error.
+func NewListReviewsNotFound(body *ListReviewsNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListReviewsInvalidToken builds a resource service ListReviews endpoint
+// invalid-token error.
+func NewListReviewsInvalidToken(body *ListReviewsInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateReviewReviewOK builds a "resource" service "CreateReview" endpoint
+// result from a HTTP "OK" response.
+func NewCreateReviewReviewOK(body *CreateReviewResponseBody) *resource.Review {
+	v := &resource.Review{
+		ID:         *body.ID,
+		ResourceID: *body.ResourceID,
+		Version:    *body.Version,
+		UserID:     *body.UserID,
+		Text:       *body.Text,
+		CreatedAt:  *body.CreatedAt,
+		UpdatedAt:  *body.UpdatedAt,
+	}
+
+	return v
+}
+
+// NewCreateReviewInternalError builds a resource service CreateReview endpoint
+// internal-error error.
+func NewCreateReviewInternalError(body *CreateReviewInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateReviewInvalidPayload builds a resource service CreateReview
+// endpoint invalid-payload error.
+func NewCreateReviewInvalidPayload(body *CreateReviewInvalidPayloadResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateReviewInvalidScopes builds a resource service CreateReview endpoint
+// invalid-scopes error.
+func NewCreateReviewInvalidScopes(body *CreateReviewInvalidScopesResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateReviewNotFound builds a resource service CreateReview endpoint
+// not-found error.
+func NewCreateReviewNotFound(body *CreateReviewNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateReviewInvalidToken builds a resource service CreateReview endpoint
+// invalid-token error.
+func NewCreateReviewInvalidToken(body *CreateReviewInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateReviewReviewOK builds a "resource" service "UpdateReview" endpoint
+// result from a HTTP "OK" response.
+func NewUpdateReviewReviewOK(body *UpdateReviewResponseBody) *resource.Review {
+	v := &resource.Review{
+		ID:         *body.ID,
+		ResourceID: *body.ResourceID,
+		Version:    *body.Version,
+		UserID:     *body.UserID,
+		Text:       *body.Text,
+		CreatedAt:  *body.CreatedAt,
+		UpdatedAt:  *body.UpdatedAt,
+	}
+
+	return v
+}
+
+// NewUpdateReviewInternalError builds a resource service UpdateReview endpoint
+// internal-error error.
+func NewUpdateReviewInternalError(body *UpdateReviewInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateReviewInvalidScopes builds a resource service UpdateReview endpoint
+// invalid-scopes error.
+func NewUpdateReviewInvalidScopes(body *UpdateReviewInvalidScopesResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateReviewNotFound builds a resource service UpdateReview endpoint
+// not-found error.
+func NewUpdateReviewNotFound(body *UpdateReviewNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateReviewInvalidToken builds a resource service UpdateReview endpoint
+// invalid-token error.
+func NewUpdateReviewInvalidToken(body *UpdateReviewInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteReviewInternalError builds a resource service DeleteReview endpoint
+// internal-error error.
+func NewDeleteReviewInternalError(body *DeleteReviewInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteReviewInvalidScopes builds a resource service DeleteReview endpoint
+// invalid-scopes error.
+func NewDeleteReviewInvalidScopes(body *DeleteReviewInvalidScopesResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteReviewNotFound builds a resource service DeleteReview endpoint
+// not-found error.
+func NewDeleteReviewNotFound(body *DeleteReviewNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteReviewInvalidToken builds a resource service DeleteReview endpoint
+// invalid-token error.
+func NewDeleteReviewInvalidToken(body *DeleteReviewInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDependenciesPipelineDependenciesOK builds a "resource" service
+// "Dependencies" endpoint result from a HTTP "OK" response.
+func NewDependenciesPipelineDependenciesOK(body *DependenciesResponseBody) *resource.PipelineDependencies {
+	v := &resource.PipelineDependencies{
+		Version: *body.Version,
+	}
+	v.Data = make([]*resource.Dependency, len(body.Data))
+	for i, val := range body.Data {
+		v.Data[i] = unmarshalDependencyResponseBodyToResourceDependency(val)
+	}
+
+	return v
+}
+
+// NewDependenciesInternalError builds a resource service Dependencies endpoint
+// internal-error error.
+func NewDependenciesInternalError(body *DependenciesInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDependenciesNotFound builds a resource service Dependencies endpoint
+// not-found error.
+func NewDependenciesNotFound(body *DependenciesNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDependenciesInvalidToken builds a resource service Dependencies endpoint
+// invalid-token error.
+func NewDependenciesInvalidToken(body *DependenciesInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDependentsTaskDependentsOK builds a "resource" service "Dependents"
+// endpoint result from a HTTP "OK" response.
+func NewDependentsTaskDependentsOK(body *DependentsResponseBody) *resource.TaskDependents {
+	v := &resource.TaskDependents{}
+	v.Data = make([]*resource.Dependent, len(body.Data))
+	for i, val := range body.Data {
+		v.Data[i] = unmarshalDependentResponseBodyToResourceDependent(val)
+	}
+
+	return v
+}
+
+// NewDependentsInternalError builds a resource service Dependents endpoint
+// internal-error error.
+func NewDependentsInternalError(body *DependentsInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDependentsNotFound builds a resource service Dependents endpoint
+// not-found error.
+func NewDependentsNotFound(body *DependentsNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDependentsInvalidToken builds a resource service Dependents endpoint
+// invalid-token error.
+func NewDependentsInvalidToken(body *DependentsInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionResourceVersionOK builds a "resource" service
+// "ByCatalogKindNameVersion" endpoint result from a HTTP "OK" response.
+func NewByCatalogKindNameVersionResourceVersionOK(body *ByCatalogKindNameVersionResponseBody) *resourceviews.ResourceVersionView {
+	v := &resourceviews.ResourceVersionView{}
+	v.Data = unmarshalResourceVersionDataResponseBodyToResourceviewsResourceVersionDataView(body.Data)
+
+	return v
+}
+
+// NewByCatalogKindNameVersionInternalError builds a resource service
+// ByCatalogKindNameVersion endpoint internal-error error.
+func NewByCatalogKindNameVersionInternalError(body *ByCatalogKindNameVersionInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionNotFound builds a resource service
+// ByCatalogKindNameVersion endpoint not-found error.
+func NewByCatalogKindNameVersionNotFound(body *ByCatalogKindNameVersionNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionInvalidToken builds a resource service
+// ByCatalogKindNameVersion endpoint invalid-token error.
+func NewByCatalogKindNameVersionInvalidToken(body *ByCatalogKindNameVersionInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionReadmeResourceVersionReadmeOK builds a "resource"
+// service "ByCatalogKindNameVersionReadme" endpoint result from a HTTP "OK"
+// response.
+func NewByCatalogKindNameVersionReadmeResourceVersionReadmeOK(body *ByCatalogKindNameVersionReadmeResponseBody) *resourceviews.ResourceVersionReadmeView {
+	v := &resourceviews.ResourceVersionReadmeView{}
+	v.Data = unmarshalResourceContentResponseBodyToResourceviewsResourceContentView(body.Data)
+
+	return v
+}
+
+// NewByCatalogKindNameVersionReadmeInternalError builds a resource service
+// ByCatalogKindNameVersionReadme endpoint internal-error error.
+func NewByCatalogKindNameVersionReadmeInternalError(body *ByCatalogKindNameVersionReadmeInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionReadmeNotFound builds a resource service
+// ByCatalogKindNameVersionReadme endpoint not-found error.
+func NewByCatalogKindNameVersionReadmeNotFound(body *ByCatalogKindNameVersionReadmeNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionReadmeInvalidToken builds a resource service
+// ByCatalogKindNameVersionReadme endpoint invalid-token error.
+func NewByCatalogKindNameVersionReadmeInvalidToken(body *ByCatalogKindNameVersionReadmeInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionYamlResourceVersionYamlOK builds a "resource"
+// service "ByCatalogKindNameVersionYaml" endpoint result from a HTTP "OK"
+// response.
+func NewByCatalogKindNameVersionYamlResourceVersionYamlOK(body *ByCatalogKindNameVersionYamlResponseBody) *resourceviews.ResourceVersionYamlView {
+	v := &resourceviews.ResourceVersionYamlView{}
+	v.Data = unmarshalResourceContentResponseBodyToResourceviewsResourceContentView(body.Data)
+
+	return v
+}
+
+// NewByCatalogKindNameVersionYamlInternalError builds a resource service
+// ByCatalogKindNameVersionYaml endpoint internal-error error.
+func NewByCatalogKindNameVersionYamlInternalError(body *ByCatalogKindNameVersionYamlInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionYamlNotFound builds a resource service
+// ByCatalogKindNameVersionYaml endpoint not-found error.
+func NewByCatalogKindNameVersionYamlNotFound(body *ByCatalogKindNameVersionYamlNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionYamlInvalidToken builds a resource service
+// ByCatalogKindNameVersionYaml endpoint invalid-token error.
+func NewByCatalogKindNameVersionYamlInvalidToken(body *ByCatalogKindNameVersionYamlInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionLintVersionLintOK builds a "resource" service
+// "ByCatalogKindNameVersionLint" endpoint result from a HTTP "OK" response.
+func NewByCatalogKindNameVersionLintVersionLintOK(body *ByCatalogKindNameVersionLintResponseBody) *resource.VersionLint {
+	v := &resource.VersionLint{}
+	v.Data = make([]*resource.LintResult, len(body.Data))
+	for i, val := range body.Data {
+		v.Data[i] = unmarshalLintResultResponseBodyToResourceLintResult(val)
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionLintInternalError builds a resource service
+// ByCatalogKindNameVersionLint endpoint internal-error error.
+func NewByCatalogKindNameVersionLintInternalError(body *ByCatalogKindNameVersionLintInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionLintNotFound builds a resource service
+// ByCatalogKindNameVersionLint endpoint not-found error.
+func NewByCatalogKindNameVersionLintNotFound(body *ByCatalogKindNameVersionLintNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionLintInvalidToken builds a resource service
+// ByCatalogKindNameVersionLint endpoint invalid-token error.
+func NewByCatalogKindNameVersionLintInvalidToken(body *ByCatalogKindNameVersionLintInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionImagesVersionImagesOK builds a "resource" service
+// "ByCatalogKindNameVersionImages" endpoint result from a HTTP "OK" response.
+func NewByCatalogKindNameVersionImagesVersionImagesOK(body *ByCatalogKindNameVersionImagesResponseBody) *resource.VersionImages {
+	v := &resource.VersionImages{}
+	v.Data = make([]string, len(body.Data))
+	for i, val := range body.Data {
+		v.Data[i] = val
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionImagesInternalError builds a resource service
+// ByCatalogKindNameVersionImages endpoint internal-error error.
+func NewByCatalogKindNameVersionImagesInternalError(body *ByCatalogKindNameVersionImagesInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionImagesNotFound builds a resource service
+// ByCatalogKindNameVersionImages endpoint not-found error.
+func NewByCatalogKindNameVersionImagesNotFound(body *ByCatalogKindNameVersionImagesNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameVersionImagesInvalidToken builds a resource service
+// ByCatalogKindNameVersionImages endpoint invalid-token error.
+func NewByCatalogKindNameVersionImagesInvalidToken(body *ByCatalogKindNameVersionImagesInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByImageImageUsersOK builds a "resource" service "ByImage" endpoint result
+// from a HTTP "OK" response.
+func NewByImageImageUsersOK(body *ByImageResponseBody) *resource.ImageUsers {
+	v := &resource.ImageUsers{}
+	v.Data = make([]*resource.ImageUser, len(body.Data))
+	for i, val := range body.Data {
+		v.Data[i] = unmarshalImageUserResponseBodyToResourceImageUser(val)
+	}
+
+	return v
+}
+
+// NewByImageInternalError builds a resource service ByImage endpoint
+// internal-error error.
+func NewByImageInternalError(body *ByImageInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByImageInvalidToken builds a resource service ByImage endpoint
+// invalid-token error.
+func NewByImageInvalidToken(body *ByImageInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByVersionIDResourceVersionOK builds a "resource" service "ByVersionId"
+// endpoint result from a HTTP "OK" response.
+func NewByVersionIDResourceVersionOK(body *ByVersionIDResponseBody) *resourceviews.ResourceVersionView {
+	v := &resourceviews.ResourceVersionView{}
+	v.Data = unmarshalResourceVersionDataResponseBodyToResourceviewsResourceVersionDataView(body.Data)
+
+	return v
+}
+
+// NewByVersionIDInternalError builds a resource service ByVersionId endpoint
+// internal-error error.
+func NewByVersionIDInternalError(body *ByVersionIDInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByVersionIDNotFound builds a resource service ByVersionId endpoint
+// not-found error.
+func NewByVersionIDNotFound(body *ByVersionIDNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByVersionIDInvalidToken builds a resource service ByVersionId endpoint
+// invalid-token error.
+func NewByVersionIDInvalidToken(body *ByVersionIDInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameResourceOK builds a "resource" service
+// "ByCatalogKindName" endpoint result from a HTTP "OK" response.
+func NewByCatalogKindNameResourceOK(body *ByCatalogKindNameResponseBody) *resourceviews.ResourceView {
+	v := &resourceviews.ResourceView{}
+	v.Data = unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(body.Data)
+
+	return v
+}
+
+// NewByCatalogKindNameInternalError builds a resource service
+// ByCatalogKindName endpoint internal-error error.
+func NewByCatalogKindNameInternalError(body *ByCatalogKindNameInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameNotFound builds a resource service ByCatalogKindName
+// endpoint not-found error.
+func NewByCatalogKindNameNotFound(body *ByCatalogKindNameNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByCatalogKindNameInvalidToken builds a resource service ByCatalogKindName
+// endpoint invalid-token error.
+func NewByCatalogKindNameInvalidToken(body *ByCatalogKindNameInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByIDResourceOK builds a "resource" service "ById" endpoint result from a
+// HTTP "OK" response.
+func NewByIDResourceOK(body *ByIDResponseBody) *resourceviews.ResourceView {
+	v := &resourceviews.ResourceView{}
+	v.Data = unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(body.Data)
+
+	return v
+}
+
+// NewByIDInternalError builds a resource service ById endpoint internal-error
+// error.
+func NewByIDInternalError(body *ByIDInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByIDNotFound builds a resource service ById endpoint not-found error.
+func NewByIDNotFound(body *ByIDNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewByIDInvalidToken builds a resource service ById endpoint invalid-token
+// error.
+func NewByIDInvalidToken(body *ByIDInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetRawYamlByCatalogKindNameVersionInternalError builds a resource service
+// GetRawYamlByCatalogKindNameVersion endpoint internal-error error.
+func NewGetRawYamlByCatalogKindNameVersionInternalError(body *GetRawYamlByCatalogKindNameVersionInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetRawYamlByCatalogKindNameVersionNotFound builds a resource service
+// GetRawYamlByCatalogKindNameVersion endpoint not-found error.
+func NewGetRawYamlByCatalogKindNameVersionNotFound(body *GetRawYamlByCatalogKindNameVersionNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetRawYamlByCatalogKindNameVersionInvalidToken builds a resource service
+// GetRawYamlByCatalogKindNameVersion endpoint invalid-token error.
+func NewGetRawYamlByCatalogKindNameVersionInvalidToken(body *GetRawYamlByCatalogKindNameVersionInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetLatestRawYamlByCatalogKindNameInternalError builds a resource service
+// GetLatestRawYamlByCatalogKindName endpoint internal-error error.
+func NewGetLatestRawYamlByCatalogKindNameInternalError(body *GetLatestRawYamlByCatalogKindNameInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetLatestRawYamlByCatalogKindNameNotFound builds a resource service
+// GetLatestRawYamlByCatalogKindName endpoint not-found error.
+func NewGetLatestRawYamlByCatalogKindNameNotFound(body *GetLatestRawYamlByCatalogKindNameNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetLatestRawYamlByCatalogKindNameInvalidToken builds a resource service
+// GetLatestRawYamlByCatalogKindName endpoint invalid-token error.
+func NewGetLatestRawYamlByCatalogKindNameInvalidToken(body *GetLatestRawYamlByCatalogKindNameInvalidTokenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// ValidateListReviewsResponseBody runs the validations defined on
+// ListReviewsResponseBody
+func ValidateListReviewsResponseBody(body *ListReviewsResponseBody) (err error) {
+	if body.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "body"))
+	}
+	for _, e := range body.Data {
+		if e != nil {
+			if err2 := ValidateReviewResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCreateReviewResponseBody runs the validations defined on
+// CreateReviewResponseBody
+func ValidateCreateReviewResponseBody(body *CreateReviewResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ResourceID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resourceId", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userId", "body"))
+	}
+	if body.Text == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("text", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updatedAt", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateUpdateReviewResponseBody runs the validations defined on
+// UpdateReviewResponseBody
+func ValidateUpdateReviewResponseBody(body *UpdateReviewResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ResourceID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resourceId", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userId", "body"))
+	}
+	if body.Text == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("text", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updatedAt", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateDependenciesResponseBody runs the validations defined on
+// DependenciesResponseBody
+func ValidateDependenciesResponseBody(body *DependenciesResponseBody) (err error) {
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "body"))
+	}
+	for _, e := range body.Data {
+		if e != nil {
+			if err2 := ValidateDependencyResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateDependentsResponseBody runs the validations defined on
+// DependentsResponseBody
+func ValidateDependentsResponseBody(body *DependentsResponseBody) (err error) {
+	if body.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "body"))
+	}
+	for _, e := range body.Data {
+		if e != nil {
+			if err2 := ValidateDependentResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateByCatalogKindNameVersionLintResponseBody runs the validations
+// defined on ByCatalogKindNameVersionLintResponseBody
+func ValidateByCatalogKindNameVersionLintResponseBody(body *ByCatalogKindNameVersionLintResponseBody) (err error) {
+	if body.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "body"))
+	}
+	for _, e := range body.Data {
+		if e != nil {
+			if err2 := ValidateLintResultResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateByCatalogKindNameVersionImagesResponseBody runs the validations
+// defined on ByCatalogKindNameVersionImagesResponseBody
+func ValidateByCatalogKindNameVersionImagesResponseBody(body *ByCatalogKindNameVersionImagesResponseBody) (err error) {
+	if body.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "body"))
+	}
+	return
+}
+
+// ValidateByImageResponseBody runs the validations defined on
+// ByImageResponseBody
+func ValidateByImageResponseBody(body *ByImageResponseBody) (err error) {
+	if body.Data == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("data", "body"))
+	}
+	for _, e := range body.Data {
+		if e != nil {
+			if err2 := ValidateImageUserResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateQueryInternalErrorResponseBody runs the validations defined on
+// Query_internal-error_Response_Body
+func ValidateQueryInternalErrorResponseBody(body *QueryInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateQueryInvalidKindResponseBody runs the validations defined on
+// Query_invalid-kind_Response_Body
+func ValidateQueryInvalidKindResponseBody(body *QueryInvalidKindResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateQueryInvalidCursorResponseBody runs the validations defined on
+// Query_invalid-cursor_Response_Body
+func ValidateQueryInvalidCursorResponseBody(body *QueryInvalidCursorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateQueryNotFoundResponseBody runs the validations defined on
+// Query_not-found_Response_Body
+func ValidateQueryNotFoundResponseBody(body *QueryNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateQueryInvalidTokenResponseBody runs the validations defined on
+// Query_invalid-token_Response_Body
+func ValidateQueryInvalidTokenResponseBody(body *QueryInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListInternalErrorResponseBody runs the validations defined on
+// List_internal-error_Response_Body
+func ValidateListInternalErrorResponseBody(body *ListInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListInvalidCursorResponseBody runs the validations defined on
+// List_invalid-cursor_Response_Body
+func ValidateListInvalidCursorResponseBody(body *ListInvalidCursorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// VersionsResponseBody is used to define fields on response body types.
-type VersionsResponseBody struct {
-	// Latest Version of resource
-	Latest *ResourceVersionDataResponseBody `form:"latest,omitempty" json:"latest,omitempty" xml:"latest,omitempty"`
-	// List of all versions of resource
-	Versions []*ResourceVersionDataResponseBody `form:"versions,omitempty" json:"versions,omitempty" xml:"versions,omitempty"`
+// ValidateListNotFoundResponseBody runs the validations defined on
+// List_not-found_Response_Body
+func ValidateListNotFoundResponseBody(body *ListNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// ResourceContentResponseBody is used to define fields on response body types.
-type ResourceContentResponseBody struct {
-	// Readme
-	Readme *string `form:"readme,omitempty" json:"readme,omitempty" xml:"readme,omitempty"`
-	// Yaml
-	Yaml *string `form:"yaml,omitempty" json:"yaml,omitempty" xml:"yaml,omitempty"`
+// ValidateListInvalidTokenResponseBody runs the validations defined on
+// List_invalid-token_Response_Body
+func ValidateListInvalidTokenResponseBody(body *ListInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewQueryResourcesOK builds a "resource" service "Query" endpoint result from
-// a HTTP "OK" response.
-func NewQueryResourcesOK(body *QueryResponseBody) *resourceviews.ResourcesView {
-	v := &resourceviews.ResourcesView{}
-	v.Data = make([]*resourceviews.ResourceDataView, len(body.Data))
-	for i, val := range body.Data {
-		v.Data[i] = unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(val)
+// ValidateVersionsByIDInternalErrorResponseBody runs the validations defined
+// on VersionsByID_internal-error_Response_Body
+func ValidateVersionsByIDInternalErrorResponseBody(body *VersionsByIDInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewQueryInternalError builds a resource service Query endpoint
-// internal-error error.
-func NewQueryInternalError(body *QueryInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateVersionsByIDNotFoundResponseBody runs the validations defined on
+// VersionsByID_not-found_Response_Body
+func ValidateVersionsByIDNotFoundResponseBody(body *VersionsByIDNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewQueryInvalidKind builds a resource service Query endpoint invalid-kind
-// error.
-func NewQueryInvalidKind(body *QueryInvalidKindResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateVersionsByIDInvalidTokenResponseBody runs the validations defined on
+// VersionsByID_invalid-token_Response_Body
+func ValidateVersionsByIDInvalidTokenResponseBody(body *VersionsByIDInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewQueryNotFound builds a resource service Query endpoint not-found error.
-func NewQueryNotFound(body *QueryNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListReviewsInternalErrorResponseBody runs the validations defined on
+// ListReviews_internal-error_Response_Body
+func ValidateListReviewsInternalErrorResponseBody(body *ListReviewsInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewListResourcesOK builds a "resource" service "List" endpoint result from a
-// HTTP "OK" response.
-func NewListResourcesOK(body *ListResponseBody) *resourceviews.ResourcesView {
-	v := &resourceviews.ResourcesView{}
-	v.Data = make([]*resourceviews.ResourceDataView, len(body.Data))
-	for i, val := range body.Data {
-		v.Data[i] = unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(val)
+// ValidateListReviewsInvalidCursorResponseBody runs the validations defined on
+// ListReviews_invalid-cursor_Response_Body
+func ValidateListReviewsInvalidCursorResponseBody(body *ListReviewsInvalidCursorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewListInternalError builds a resource service List endpoint internal-error
-// error.
-func NewListInternalError(body *ListInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListReviewsNotFoundResponseBody runs the validations defined on
+// ListReviews_not-found_Response_Body
+func ValidateListReviewsNotFoundResponseBody(body *ListReviewsNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewListNotFound builds a resource service List endpoint not-found error.
-func NewListNotFound(body *ListNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListReviewsInvalidTokenResponseBody runs the validations defined on
+// ListReviews_invalid-token_Response_Body
+func ValidateListReviewsInvalidTokenResponseBody(body *ListReviewsInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-
-	return v
+	return
 }
 
-// NewVersionsByIDResourceVersionsOK builds a "resource" service "VersionsByID"
-// endpoint result from a HTTP "OK" response.
-func NewVersionsByIDResourceVersionsOK(body *VersionsByIDResponseBody) *resourceviews.ResourceVersionsView {
-	v := &resourceviews.ResourceVersionsView{}
-	v.Data = unmarshalVersionsResponseBodyToResourceviewsVersionsView(body.Data)
-
-	return v
+// ValidateCreateReviewInternalErrorResponseBody runs the validations defined
+// on CreateReview_internal-error_Response_Body
+func ValidateCreateReviewInternalErrorResponseBody(body *CreateReviewInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewVersionsByIDInternalError builds a resource service VersionsByID endpoint
-// internal-error error.
-func NewVersionsByIDInternalError(body *VersionsByIDInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateReviewInvalidPayloadResponseBody runs the validations defined
+// on CreateReview_invalid-payload_Response_Body
+func ValidateCreateReviewInvalidPayloadResponseBody(body *CreateReviewInvalidPayloadResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewVersionsByIDNotFound builds a resource service VersionsByID endpoint
-// not-found error.
-func NewVersionsByIDNotFound(body *VersionsByIDNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateReviewInvalidScopesResponseBody runs the validations defined
+// on CreateReview_invalid-scopes_Response_Body
+func ValidateCreateReviewInvalidScopesResponseBody(body *CreateReviewInvalidScopesResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByCatalogKindNameVersionResourceVersionOK builds a "resource" service
-// "ByCatalogKindNameVersion" endpoint result from a HTTP "OK" response.
-func NewByCatalogKindNameVersionResourceVersionOK(body *ByCatalogKindNameVersionResponseBody) *resourceviews.ResourceVersionView {
-	v := &resourceviews.ResourceVersionView{}
-	v.Data = unmarshalResourceVersionDataResponseBodyToResourceviewsResourceVersionDataView(body.Data)
-
-	return v
+// ValidateCreateReviewNotFoundResponseBody runs the validations defined on
+// CreateReview_not-found_Response_Body
+func ValidateCreateReviewNotFoundResponseBody(body *CreateReviewNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByCatalogKindNameVersionInternalError builds a resource service
-// ByCatalogKindNameVersion endpoint internal-error error.
-func NewByCatalogKindNameVersionInternalError(body *ByCatalogKindNameVersionInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateReviewInvalidTokenResponseBody runs the validations defined on
+// CreateReview_invalid-token_Response_Body
+func ValidateCreateReviewInvalidTokenResponseBody(body *CreateReviewInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByCatalogKindNameVersionNotFound builds a resource service
-// ByCatalogKindNameVersion endpoint not-found error.
-func NewByCatalogKindNameVersionNotFound(body *ByCatalogKindNameVersionNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpdateReviewInternalErrorResponseBody runs the validations defined
+// on UpdateReview_internal-error_Response_Body
+func ValidateUpdateReviewInternalErrorResponseBody(body *UpdateReviewInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByCatalogKindNameVersionReadmeResourceVersionReadmeOK builds a "resource"
-// service "ByCatalogKindNameVersionReadme" endpoint result from a HTTP "OK"
-// response.
-func NewByCatalogKindNameVersionReadmeResourceVersionReadmeOK(body *ByCatalogKindNameVersionReadmeResponseBody) *resourceviews.ResourceVersionReadmeView {
-	v := &resourceviews.ResourceVersionReadmeView{}
-	v.Data = unmarshalResourceContentResponseBodyToResourceviewsResourceContentView(body.Data)
-
-	return v
+// ValidateUpdateReviewInvalidScopesResponseBody runs the validations defined
+// on UpdateReview_invalid-scopes_Response_Body
+func ValidateUpdateReviewInvalidScopesResponseBody(body *UpdateReviewInvalidScopesResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByCatalogKindNameVersionReadmeInternalError builds a resource service
-// ByCatalogKindNameVersionReadme endpoint internal-error error.
-func NewByCatalogKindNameVersionReadmeInternalError(body *ByCatalogKindNameVersionReadmeInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpdateReviewNotFoundResponseBody runs the validations defined on
+// UpdateReview_not-found_Response_Body
+func ValidateUpdateReviewNotFoundResponseBody(body *UpdateReviewNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByCatalogKindNameVersionReadmeNotFound builds a resource service
-// ByCatalogKindNameVersionReadme endpoint not-found error.
-func NewByCatalogKindNameVersionReadmeNotFound(body *ByCatalogKindNameVersionReadmeNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateUpdateReviewInvalidTokenResponseBody runs the validations defined on
+// UpdateReview_invalid-token_Response_Body
+func ValidateUpdateReviewInvalidTokenResponseBody(body *UpdateReviewInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-
-	return v
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByCatalogKindNameVersionYamlResourceVersionYamlOK builds a "resource"
-// service "ByCatalogKindNameVersionYaml" endpoint result from a HTTP "OK"
-// response.
-func NewByCatalogKindNameVersionYamlResourceVersionYamlOK(body *ByCatalogKindNameVersionYamlResponseBody) *resourceviews.ResourceVersionYamlView {
-	v := &resourceviews.ResourceVersionYamlView{}
-	v.Data = unmarshalResourceContentResponseBodyToResourceviewsResourceContentView(body.Data)
-
-	return v
+// ValidateDeleteReviewInternalErrorResponseBody runs the validations defined
+// on DeleteReview_internal-error_Response_Body
+func ValidateDeleteReviewInternalErrorResponseBody(body *DeleteReviewInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByCatalogKindNameVersionYamlInternalError builds a resource service
-// ByCatalogKindNameVersionYaml endpoint internal-error error.
-func NewByCatalogKindNameVersionYamlInternalError(body *ByCatalogKindNameVersionYamlInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDeleteReviewInvalidScopesResponseBody runs the validations defined
+// on DeleteReview_invalid-scopes_Response_Body
+func ValidateDeleteReviewInvalidScopesResponseBody(body *DeleteReviewInvalidScopesResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByCatalogKindNameVersionYamlNotFound builds a resource service
-// ByCatalogKindNameVersionYaml endpoint not-found error.
-func NewByCatalogKindNameVersionYamlNotFound(body *ByCatalogKindNameVersionYamlNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDeleteReviewNotFoundResponseBody runs the validations defined on
+// DeleteReview_not-found_Response_Body
+func ValidateDeleteReviewNotFoundResponseBody(body *DeleteReviewNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByVersionIDResourceVersionOK builds a "resource" service "ByVersionId"
-// endpoint result from a HTTP "OK" response.
-func NewByVersionIDResourceVersionOK(body *ByVersionIDResponseBody) *resourceviews.ResourceVersionView {
-	v := &resourceviews.ResourceVersionView{}
-	v.Data = unmarshalResourceVersionDataResponseBodyToResourceviewsResourceVersionDataView(body.Data)
-
-	return v
+// ValidateDeleteReviewInvalidTokenResponseBody runs the validations defined on
+// DeleteReview_invalid-token_Response_Body
+func ValidateDeleteReviewInvalidTokenResponseBody(body *DeleteReviewInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByVersionIDInternalError builds a resource service ByVersionId endpoint
-// internal-error error.
-func NewByVersionIDInternalError(body *ByVersionIDInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDependenciesInternalErrorResponseBody runs the validations defined
+// on Dependencies_internal-error_Response_Body
+func ValidateDependenciesInternalErrorResponseBody(body *DependenciesInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByVersionIDNotFound builds a resource service ByVersionId endpoint
-// not-found error.
-func NewByVersionIDNotFound(body *ByVersionIDNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDependenciesNotFoundResponseBody runs the validations defined on
+// Dependencies_not-found_Response_Body
+func ValidateDependenciesNotFoundResponseBody(body *DependenciesNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByCatalogKindNameResourceOK builds a "resource" service
-// "ByCatalogKindName" endpoint result from a HTTP "OK" response.
-func NewByCatalogKindNameResourceOK(body *ByCatalogKindNameResponseBody) *resourceviews.ResourceView {
-	v := &resourceviews.ResourceView{}
-	v.Data = unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(body.Data)
-
-	return v
+// ValidateDependenciesInvalidTokenResponseBody runs the validations defined on
+// Dependencies_invalid-token_Response_Body
+func ValidateDependenciesInvalidTokenResponseBody(body *DependenciesInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByCatalogKindNameInternalError builds a resource service
-// ByCatalogKindName endpoint internal-error error.
-func NewByCatalogKindNameInternalError(body *ByCatalogKindNameInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDependentsInternalErrorResponseBody runs the validations defined on
+// Dependents_internal-error_Response_Body
+func ValidateDependentsInternalErrorResponseBody(body *DependentsInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByCatalogKindNameNotFound builds a resource service ByCatalogKindName
-// endpoint not-found error.
-func NewByCatalogKindNameNotFound(body *ByCatalogKindNameNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDependentsNotFoundResponseBody runs the validations defined on
+// Dependents_not-found_Response_Body
+func ValidateDependentsNotFoundResponseBody(body *DependentsNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-
-	return v
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByIDResourceOK builds a "resource" service "ById" endpoint result from a
-// HTTP "OK" response.
-func NewByIDResourceOK(body *ByIDResponseBody) *resourceviews.ResourceView {
-	v := &resourceviews.ResourceView{}
-	v.Data = unmarshalResourceDataResponseBodyToResourceviewsResourceDataView(body.Data)
-
-	return v
+// ValidateDependentsInvalidTokenResponseBody runs the validations defined on
+// Dependents_invalid-token_Response_Body
+func ValidateDependentsInvalidTokenResponseBody(body *DependentsInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByIDInternalError builds a resource service ById endpoint internal-error
-// error.
-func NewByIDInternalError(body *ByIDInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateByCatalogKindNameVersionInternalErrorResponseBody runs the
+// validations defined on ByCatalogKindNameVersion_internal-error_Response_Body
+func ValidateByCatalogKindNameVersionInternalErrorResponseBody(body *ByCatalogKindNameVersionInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewByIDNotFound builds a resource service ById endpoint not-found error.
-func NewByIDNotFound(body *ByIDNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateByCatalogKindNameVersionNotFoundResponseBody runs the validations
+// defined on ByCatalogKindNameVersion_not-found_Response_Body
+func ValidateByCatalogKindNameVersionNotFoundResponseBody(body *ByCatalogKindNameVersionNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetRawYamlByCatalogKindNameVersionInternalError builds a resource service
-// GetRawYamlByCatalogKindNameVersion endpoint internal-error error.
-func NewGetRawYamlByCatalogKindNameVersionInternalError(body *GetRawYamlByCatalogKindNameVersionInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateByCatalogKindNameVersionInvalidTokenResponseBody runs the
+// validations defined on ByCatalogKindNameVersion_invalid-token_Response_Body
+func ValidateByCatalogKindNameVersionInvalidTokenResponseBody(body *ByCatalogKindNameVersionInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetRawYamlByCatalogKindNameVersionNotFound builds a resource service
-// GetRawYamlByCatalogKindNameVersion endpoint not-found error.
-func NewGetRawYamlByCatalogKindNameVersionNotFound(body *GetRawYamlByCatalogKindNameVersionNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateByCatalogKindNameVersionReadmeInternalErrorResponseBody runs the
+// validations defined on
+// ByCatalogKindNameVersionReadme_internal-error_Response_Body
+func ValidateByCatalogKindNameVersionReadmeInternalErrorResponseBody(body *ByCatalogKindNameVersionReadmeInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetLatestRawYamlByCatalogKindNameInternalError builds a resource service
-// GetLatestRawYamlByCatalogKindName endpoint internal-error error.
-func NewGetLatestRawYamlByCatalogKindNameInternalError(body *GetLatestRawYamlByCatalogKindNameInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateByCatalogKindNameVersionReadmeNotFoundResponseBody runs the
+// validations defined on ByCatalogKindNameVersionReadme_not-found_Response_Body
+func ValidateByCatalogKindNameVersionReadmeNotFoundResponseBody(body *ByCatalogKindNameVersionReadmeNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetLatestRawYamlByCatalogKindNameNotFound builds a resource service
-// GetLatestRawYamlByCatalogKindName endpoint not-found error.
-func NewGetLatestRawYamlByCatalogKindNameNotFound(body *GetLatestRawYamlByCatalogKindNameNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateByCatalogKindNameVersionReadmeInvalidTokenResponseBody runs the
+// validations defined on
+// ByCatalogKindNameVersionReadme_invalid-token_Response_Body
+func ValidateByCatalogKindNameVersionReadmeInvalidTokenResponseBody(body *ByCatalogKindNameVersionReadmeInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
+	return
+}
 
-	return v
+// ValidateByCatalogKindNameVersionYamlInternalErrorResponseBody runs the
+// validations defined on
+// ByCatalogKindNameVersionYaml_internal-error_Response_Body
+func ValidateByCatalogKindNameVersionYamlInternalErrorResponseBody(body *ByCatalogKindNameVersionYamlInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// ValidateQueryInternalErrorResponseBody runs the validations defined on
-// Query_internal-error_Response_Body
-func ValidateQueryInternalErrorResponseBody(body *QueryInternalErrorResponseBody) (err error) {
+// ValidateByCatalogKindNameVersionYamlNotFoundResponseBody runs the
+// validations defined on ByCatalogKindNameVersionYaml_not-found_Response_Body
+func ValidateByCatalogKindNameVersionYamlNotFoundResponseBody(body *ByCatalogKindNameVersionYamlNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1063,9 +4055,10 @@ func ValidateQueryInternalErrorResponseBody(body *QueryInternalErrorResponseBody
 	return
 }
 
-// ValidateQueryInvalidKindResponseBody runs the validations defined on
-// Query_invalid-kind_Response_Body
-func ValidateQueryInvalidKindResponseBody(body *QueryInvalidKindResponseBody) (err error) {
+// ValidateByCatalogKindNameVersionYamlInvalidTokenResponseBody runs the
+// validations defined on
+// ByCatalogKindNameVersionYaml_invalid-token_Response_Body
+func ValidateByCatalogKindNameVersionYamlInvalidTokenResponseBody(body *ByCatalogKindNameVersionYamlInvalidTokenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1087,9 +4080,10 @@ func ValidateQueryInvalidKindResponseBody(body *QueryInvalidKindResponseBody) (e
 	return
 }
 
-// ValidateQueryNotFoundResponseBody runs the validations defined on
-// Query_not-found_Response_Body
-func ValidateQueryNotFoundResponseBody(body *QueryNotFoundResponseBody) (err error) {
+// ValidateByCatalogKindNameVersionLintInternalErrorResponseBody runs the
+// validations defined on
+// ByCatalogKindNameVersionLint_internal-error_Response_Body
+func ValidateByCatalogKindNameVersionLintInternalErrorResponseBody(body *ByCatalogKindNameVersionLintInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1111,9 +4105,9 @@ func ValidateQueryNotFoundResponseBody(body *QueryNotFoundResponseBody) (err err
 	return
 }
 
-// ValidateListInternalErrorResponseBody runs the validations defined on
-// List_internal-error_Response_Body
-func ValidateListInternalErrorResponseBody(body *ListInternalErrorResponseBody) (err error) {
+// ValidateByCatalogKindNameVersionLintNotFoundResponseBody runs the
+// validations defined on ByCatalogKindNameVersionLint_not-found_Response_Body
+func ValidateByCatalogKindNameVersionLintNotFoundResponseBody(body *ByCatalogKindNameVersionLintNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1135,9 +4129,10 @@ func ValidateListInternalErrorResponseBody(body *ListInternalErrorResponseBody)
 	return
 }
 
-// ValidateListNotFoundResponseBody runs the validations defined on
-// List_not-found_Response_Body
-func ValidateListNotFoundResponseBody(body *ListNotFoundResponseBody) (err error) {
+// ValidateByCatalogKindNameVersionLintInvalidTokenResponseBody runs the
+// validations defined on
+// ByCatalogKindNameVersionLint_invalid-token_Response_Body
+func ValidateByCatalogKindNameVersionLintInvalidTokenResponseBody(body *ByCatalogKindNameVersionLintInvalidTokenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1159,9 +4154,10 @@ func ValidateListNotFoundResponseBody(body *ListNotFoundResponseBody) (err error
 	return
 }
 
-// ValidateVersionsByIDInternalErrorResponseBody runs the validations defined
-// on VersionsByID_internal-error_Response_Body
-func ValidateVersionsByIDInternalErrorResponseBody(body *VersionsByIDInternalErrorResponseBody) (err error) {
+// ValidateByCatalogKindNameVersionImagesInternalErrorResponseBody runs the
+// validations defined on
+// ByCatalogKindNameVersionImages_internal-error_Response_Body
+func ValidateByCatalogKindNameVersionImagesInternalErrorResponseBody(body *ByCatalogKindNameVersionImagesInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1183,9 +4179,9 @@ func ValidateVersionsByIDInternalErrorResponseBody(body *VersionsByIDInternalErr
 	return
 }
 
-// ValidateVersionsByIDNotFoundResponseBody runs the validations defined on
-// VersionsByID_not-found_Response_Body
-func ValidateVersionsByIDNotFoundResponseBody(body *VersionsByIDNotFoundResponseBody) (err error) {
+// ValidateByCatalogKindNameVersionImagesNotFoundResponseBody runs the
+// validations defined on ByCatalogKindNameVersionImages_not-found_Response_Body
+func ValidateByCatalogKindNameVersionImagesNotFoundResponseBody(body *ByCatalogKindNameVersionImagesNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1207,9 +4203,10 @@ func ValidateVersionsByIDNotFoundResponseBody(body *VersionsByIDNotFoundResponse
 	return
 }
 
-// ValidateByCatalogKindNameVersionInternalErrorResponseBody runs the
-// validations defined on ByCatalogKindNameVersion_internal-error_Response_Body
-func ValidateByCatalogKindNameVersionInternalErrorResponseBody(body *ByCatalogKindNameVersionInternalErrorResponseBody) (err error) {
+// ValidateByCatalogKindNameVersionImagesInvalidTokenResponseBody runs the
+// validations defined on
+// ByCatalogKindNameVersionImages_invalid-token_Response_Body
+func ValidateByCatalogKindNameVersionImagesInvalidTokenResponseBody(body *ByCatalogKindNameVersionImagesInvalidTokenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1231,9 +4228,9 @@ func ValidateByCatalogKindNameVersionInternalErrorResponseBody(body *ByCatalogKi
 	return
 }
 
-// ValidateByCatalogKindNameVersionNotFoundResponseBody runs the validations
-// defined on ByCatalogKindNameVersion_not-found_Response_Body
-func ValidateByCatalogKindNameVersionNotFoundResponseBody(body *ByCatalogKindNameVersionNotFoundResponseBody) (err error) {
+// ValidateByImageInternalErrorResponseBody runs the validations defined on
+// ByImage_internal-error_Response_Body
+func ValidateByImageInternalErrorResponseBody(body *ByImageInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1255,10 +4252,9 @@ func ValidateByCatalogKindNameVersionNotFoundResponseBody(body *ByCatalogKindNam
 	return
 }
 
-// ValidateByCatalogKindNameVersionReadmeInternalErrorResponseBody runs the
-// validations defined on
-// ByCatalogKindNameVersionReadme_internal-error_Response_Body
-func ValidateByCatalogKindNameVersionReadmeInternalErrorResponseBody(body *ByCatalogKindNameVersionReadmeInternalErrorResponseBody) (err error) {
+// ValidateByImageInvalidTokenResponseBody runs the validations defined on
+// ByImage_invalid-token_Response_Body
+func ValidateByImageInvalidTokenResponseBody(body *ByImageInvalidTokenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1280,9 +4276,9 @@ func ValidateByCatalogKindNameVersionReadmeInternalErrorResponseBody(body *ByCat
 	return
 }
 
-// ValidateByCatalogKindNameVersionReadmeNotFoundResponseBody runs the
-// validations defined on ByCatalogKindNameVersionReadme_not-found_Response_Body
-func ValidateByCatalogKindNameVersionReadmeNotFoundResponseBody(body *ByCatalogKindNameVersionReadmeNotFoundResponseBody) (err error) {
+// ValidateByVersionIDInternalErrorResponseBody runs the validations defined on
+// ByVersionId_internal-error_Response_Body
+func ValidateByVersionIDInternalErrorResponseBody(body *ByVersionIDInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1304,10 +4300,9 @@ func ValidateByCatalogKindNameVersionReadmeNotFoundResponseBody(body *ByCatalogK
 	return
 }
 
-// ValidateByCatalogKindNameVersionYamlInternalErrorResponseBody runs the
-// validations defined on
-// ByCatalogKindNameVersionYaml_internal-error_Response_Body
-func ValidateByCatalogKindNameVersionYamlInternalErrorResponseBody(body *ByCatalogKindNameVersionYamlInternalErrorResponseBody) (err error) {
+// ValidateByVersionIDNotFoundResponseBody runs the validations defined on
+// ByVersionId_not-found_Response_Body
+func ValidateByVersionIDNotFoundResponseBody(body *ByVersionIDNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1329,9 +4324,9 @@ func ValidateByCatalogKindNameVersionYamlInternalErrorResponseBody(body *ByCatal
 	return
 }
 
-// ValidateByCatalogKindNameVersionYamlNotFoundResponseBody runs the
-// validations defined on ByCatalogKindNameVersionYaml_not-found_Response_Body
-func ValidateByCatalogKindNameVersionYamlNotFoundResponseBody(body *ByCatalogKindNameVersionYamlNotFoundResponseBody) (err error) {
+// ValidateByVersionIDInvalidTokenResponseBody runs the validations defined on
+// ByVersionId_invalid-token_Response_Body
+func ValidateByVersionIDInvalidTokenResponseBody(body *ByVersionIDInvalidTokenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1353,9 +4348,9 @@ func ValidateByCatalogKindNameVersionYamlNotFoundResponseBody(body *ByCatalogKin
 	return
 }
 
-// ValidateByVersionIDInternalErrorResponseBody runs the validations defined on
-// ByVersionId_internal-error_Response_Body
-func ValidateByVersionIDInternalErrorResponseBody(body *ByVersionIDInternalErrorResponseBody) (err error) {
+// ValidateByCatalogKindNameInternalErrorResponseBody runs the validations
+// defined on ByCatalogKindName_internal-error_Response_Body
+func ValidateByCatalogKindNameInternalErrorResponseBody(body *ByCatalogKindNameInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1377,9 +4372,9 @@ func ValidateByVersionIDInternalErrorResponseBody(body *ByVersionIDInternalError
 	return
 }
 
-// ValidateByVersionIDNotFoundResponseBody runs the validations defined on
-// ByVersionId_not-found_Response_Body
-func ValidateByVersionIDNotFoundResponseBody(body *ByVersionIDNotFoundResponseBody) (err error) {
+// ValidateByCatalogKindNameNotFoundResponseBody runs the validations defined
+// on ByCatalogKindName_not-found_Response_Body
+func ValidateByCatalogKindNameNotFoundResponseBody(body *ByCatalogKindNameNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1401,9 +4396,9 @@ func ValidateByVersionIDNotFoundResponseBody(body *ByVersionIDNotFoundResponseBo
 	return
 }
 
-// ValidateByCatalogKindNameInternalErrorResponseBody runs the validations
-// defined on ByCatalogKindName_internal-error_Response_Body
-func ValidateByCatalogKindNameInternalErrorResponseBody(body *ByCatalogKindNameInternalErrorResponseBody) (err error) {
+// ValidateByCatalogKindNameInvalidTokenResponseBody runs the validations
+// defined on ByCatalogKindName_invalid-token_Response_Body
+func ValidateByCatalogKindNameInvalidTokenResponseBody(body *ByCatalogKindNameInvalidTokenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1425,9 +4420,9 @@ func ValidateByCatalogKindNameInternalErrorResponseBody(body *ByCatalogKindNameI
 	return
 }
 
-// ValidateByCatalogKindNameNotFoundResponseBody runs the validations defined
-// on ByCatalogKindName_not-found_Response_Body
-func ValidateByCatalogKindNameNotFoundResponseBody(body *ByCatalogKindNameNotFoundResponseBody) (err error) {
+// ValidateByIDInternalErrorResponseBody runs the validations defined on
+// ById_internal-error_Response_Body
+func ValidateByIDInternalErrorResponseBody(body *ByIDInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1449,9 +4444,9 @@ func ValidateByCatalogKindNameNotFoundResponseBody(body *ByCatalogKindNameNotFou
 	return
 }
 
-// ValidateByIDInternalErrorResponseBody runs the validations defined on
-// ById_internal-error_Response_Body
-func ValidateByIDInternalErrorResponseBody(body *ByIDInternalErrorResponseBody) (err error) {
+// ValidateByIDNotFoundResponseBody runs the validations defined on
+// ById_not-found_Response_Body
+func ValidateByIDNotFoundResponseBody(body *ByIDNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1473,9 +4468,9 @@ func ValidateByIDInternalErrorResponseBody(body *ByIDInternalErrorResponseBody)
 	return
 }
 
-// ValidateByIDNotFoundResponseBody runs the validations defined on
-// ById_not-found_Response_Body
-func ValidateByIDNotFoundResponseBody(body *ByIDNotFoundResponseBody) (err error) {
+// ValidateByIDInvalidTokenResponseBody runs the validations defined on
+// ById_invalid-token_Response_Body
+func ValidateByIDInvalidTokenResponseBody(body *ByIDInvalidTokenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1547,6 +4542,31 @@ func ValidateGetRawYamlByCatalogKindNameVersionNotFoundResponseBody(body *GetRaw
 	return
 }
 
+// ValidateGetRawYamlByCatalogKindNameVersionInvalidTokenResponseBody runs the
+// validations defined on
+// GetRawYamlByCatalogKindNameVersion_invalid-token_Response_Body
+func ValidateGetRawYamlByCatalogKindNameVersionInvalidTokenResponseBody(body *GetRawYamlByCatalogKindNameVersionInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
 // ValidateGetLatestRawYamlByCatalogKindNameInternalErrorResponseBody runs the
 // validations defined on
 // GetLatestRawYamlByCatalogKindName_internal-error_Response_Body
@@ -1597,6 +4617,31 @@ func ValidateGetLatestRawYamlByCatalogKindNameNotFoundResponseBody(body *GetLate
 	return
 }
 
+// ValidateGetLatestRawYamlByCatalogKindNameInvalidTokenResponseBody runs the
+// validations defined on
+// GetLatestRawYamlByCatalogKindName_invalid-token_Response_Body
+func ValidateGetLatestRawYamlByCatalogKindNameInvalidTokenResponseBody(body *GetLatestRawYamlByCatalogKindNameInvalidTokenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
 // ValidateResourceDataCollectionResponseBody runs the validations defined on
 // ResourceDataCollectionResponseBody
 func ValidateResourceDataCollectionResponseBody(body ResourceDataCollectionResponseBody) (err error) {
@@ -1643,6 +4688,9 @@ func ValidateResourceDataResponseBody(body *ResourceDataResponseBody) (err error
 	if body.Rating == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("rating", "body"))
 	}
+	if body.Downloads == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("downloads", "body"))
+	}
 	if body.Versions == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("versions", "body"))
 	}
@@ -1783,6 +4831,32 @@ func ValidateResourceVersionDataResponseBody(body *ResourceVersionDataResponseBo
 			}
 		}
 	}
+	if body.Verification != nil {
+		if !(*body.Verification == "verified" || *body.Verification == "unsigned" || *body.Verification == "invalid") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.verification", *body.Verification, []any{"verified", "unsigned", "invalid"}))
+		}
+	}
+	for _, e := range body.Params {
+		if e != nil {
+			if err2 := ValidateParamSpecResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Workspaces {
+		if e != nil {
+			if err2 := ValidateWorkspaceSpecResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Results {
+		if e != nil {
+			if err2 := ValidateResultSpecResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	if body.Resource != nil {
 		if err2 := ValidateResourceDataResponseBody(body.Resource); err2 != nil {
 			err = goa.MergeErrors(err, err2)
@@ -1803,6 +4877,64 @@ func ValidatePlatformResponseBody(body *PlatformResponseBody) (err error) {
 	return
 }
 
+// ValidateParamSpecResponseBody runs the validations defined on
+// ParamSpecResponseBody
+func ValidateParamSpecResponseBody(body *ParamSpecResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "string" || *body.Type == "array" || *body.Type == "object") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"string", "array", "object"}))
+		}
+	}
+	return
+}
+
+// ValidateWorkspaceSpecResponseBody runs the validations defined on
+// WorkspaceSpecResponseBody
+func ValidateWorkspaceSpecResponseBody(body *WorkspaceSpecResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.Optional == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("optional", "body"))
+	}
+	if body.ReadOnly == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("readOnly", "body"))
+	}
+	return
+}
+
+// ValidateResultSpecResponseBody runs the validations defined on
+// ResultSpecResponseBody
+func ValidateResultSpecResponseBody(body *ResultSpecResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "string" || *body.Type == "array" || *body.Type == "object") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"string", "array", "object"}))
+		}
+	}
+	return
+}
+
 // ValidateTagResponseBody runs the validations defined on TagResponseBody
 func ValidateTagResponseBody(body *TagResponseBody) (err error) {
 	if body.ID == nil {
@@ -1837,3 +4969,117 @@ func ValidateVersionsResponseBody(body *VersionsResponseBody) (err error) {
 	}
 	return
 }
+
+// ValidateReviewResponseBody runs the validations defined on ReviewResponseBody
+func ValidateReviewResponseBody(body *ReviewResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ResourceID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resourceId", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userId", "body"))
+	}
+	if body.Text == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("text", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updatedAt", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateDependencyResponseBody runs the validations defined on
+// DependencyResponseBody
+func ValidateDependencyResponseBody(body *DependencyResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Via == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("via", "body"))
+	}
+	if body.Via != nil {
+		if !(*body.Via == "name" || *body.Via == "bundle" || *body.Via == "hub" || *body.Via == "git") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.via", *body.Via, []any{"name", "bundle", "hub", "git"}))
+		}
+	}
+	return
+}
+
+// ValidateDependentResponseBody runs the validations defined on
+// DependentResponseBody
+func ValidateDependentResponseBody(body *DependentResponseBody) (err error) {
+	if body.ResourceID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resourceId", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Catalog == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("catalog", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	return
+}
+
+// ValidateLintResultResponseBody runs the validations defined on
+// LintResultResponseBody
+func ValidateLintResultResponseBody(body *LintResultResponseBody) (err error) {
+	if body.Rule == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rule", "body"))
+	}
+	if body.Severity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("severity", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Severity != nil {
+		if !(*body.Severity == "critical" || *body.Severity == "warning" || *body.Severity == "info") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.severity", *body.Severity, []any{"critical", "warning", "info"}))
+		}
+	}
+	return
+}
+
+// ValidateImageUserResponseBody runs the validations defined on
+// ImageUserResponseBody
+func ValidateImageUserResponseBody(body *ImageUserResponseBody) (err error) {
+	if body.ResourceID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resourceId", "body"))
+	}
+	if body.Catalog == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("catalog", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.Image == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("image", "body"))
+	}
+	return
+}
@@ -10,9 +10,12 @@ package server
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 
+	resource "github.com/tektoncd/hub/api/v1/gen/resource"
 	resourceviews "github.com/tektoncd/hub/api/v1/gen/resource/views"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
@@ -35,15 +38,21 @@ func EncodeQueryResponse(encoder func(context.Context, http.ResponseWriter) goah
 func DecodeQueryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			name       string
-			catalogs   []string
-			categories []string
-			kinds      []string
-			tags       []string
-			platforms  []string
-			limit      uint
-			match      string
-			err        error
+			name          string
+			catalogs      []string
+			categories    []string
+			kinds         []string
+			tags          []string
+			platforms     []string
+			apiVersions   []string
+			verifications []string
+			limit         uint
+			match         string
+			sort          *string
+			cursor        *string
+			prerelease    bool
+			token         *string
+			err           error
 		)
 		qp := r.URL.Query()
 		nameRaw := qp.Get("name")
@@ -55,6 +64,13 @@ func DecodeQueryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.D
 		kinds = qp["kinds"]
 		tags = qp["tags"]
 		platforms = qp["platforms"]
+		apiVersions = qp["apiVersions"]
+		verifications = qp["verifications"]
+		for _, e := range verifications {
+			if !(e == "verified" || e == "unsigned" || e == "invalid") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("verifications[*]", e, []any{"verified", "unsigned", "invalid"}))
+			}
+		}
 		{
 			limitRaw := qp.Get("limit")
 			if limitRaw == "" {
@@ -73,13 +89,47 @@ func DecodeQueryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.D
 		} else {
 			match = "contains"
 		}
-		if !(match == "exact" || match == "contains") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("match", match, []any{"exact", "contains"}))
+		if !(match == "exact" || match == "contains" || match == "fulltext") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("match", match, []any{"exact", "contains", "fulltext"}))
+		}
+		sortRaw := qp.Get("sort")
+		if sortRaw != "" {
+			sort = &sortRaw
+		}
+		if sort != nil {
+			if !(*sort == "rating" || *sort == "name" || *sort == "updated" || *sort == "versions" || *sort == "popular") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", *sort, []any{"rating", "name", "updated", "versions", "popular"}))
+			}
+		}
+		cursorRaw := qp.Get("cursor")
+		if cursorRaw != "" {
+			cursor = &cursorRaw
+		}
+		{
+			prereleaseRaw := qp.Get("prerelease")
+			if prereleaseRaw != "" {
+				v, err2 := strconv.ParseBool(prereleaseRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("prerelease", prereleaseRaw, "boolean"))
+				}
+				prerelease = v
+			}
+		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
 		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewQueryPayload(name, catalogs, categories, kinds, tags, platforms, limit, match)
+		payload := NewQueryPayload(name, catalogs, categories, kinds, tags, platforms, apiVersions, verifications, limit, match, sort, cursor, prerelease, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
 
 		return payload, nil
 	}
@@ -121,6 +171,19 @@ func EncodeQueryError(encoder func(context.Context, http.ResponseWriter) goahttp
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
+		case "invalid-cursor":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewQueryInvalidCursorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		case "not-found":
 			var res *goa.ServiceError
 			errors.As(v, &res)
@@ -134,6 +197,19 @@ func EncodeQueryError(encoder func(context.Context, http.ResponseWriter) goahttp
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewQueryInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -157,11 +233,16 @@ func EncodeListResponse(encoder func(context.Context, http.ResponseWriter) goaht
 func DecodeListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			limit uint
-			err   error
+			limit      uint
+			sort       *string
+			cursor     *string
+			prerelease bool
+			token      *string
+			err        error
 		)
+		qp := r.URL.Query()
 		{
-			limitRaw := r.URL.Query().Get("limit")
+			limitRaw := qp.Get("limit")
 			if limitRaw == "" {
 				limit = 1000
 			} else {
@@ -172,10 +253,44 @@ func DecodeListRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.De
 				limit = uint(v)
 			}
 		}
+		sortRaw := qp.Get("sort")
+		if sortRaw != "" {
+			sort = &sortRaw
+		}
+		if sort != nil {
+			if !(*sort == "rating" || *sort == "name" || *sort == "updated" || *sort == "versions" || *sort == "popular") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort", *sort, []any{"rating", "name", "updated", "versions", "popular"}))
+			}
+		}
+		cursorRaw := qp.Get("cursor")
+		if cursorRaw != "" {
+			cursor = &cursorRaw
+		}
+		{
+			prereleaseRaw := qp.Get("prerelease")
+			if prereleaseRaw != "" {
+				v, err2 := strconv.ParseBool(prereleaseRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("prerelease", prereleaseRaw, "boolean"))
+				}
+				prerelease = v
+			}
+		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewListPayload(limit)
+		payload := NewListPayload(limit, sort, cursor, prerelease, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
 
 		return payload, nil
 	}
@@ -204,6 +319,19 @@ func EncodeListError(encoder func(context.Context, http.ResponseWriter) goahttp.
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
+		case "invalid-cursor":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListInvalidCursorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		case "not-found":
 			var res *goa.ServiceError
 			errors.As(v, &res)
@@ -217,6 +345,19 @@ func EncodeListError(encoder func(context.Context, http.ResponseWriter) goahttp.
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -240,31 +381,1166 @@ func EncodeVersionsByIDResponse(encoder func(context.Context, http.ResponseWrite
 func DecodeVersionsByIDRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			id  uint
-			err error
+			id         uint
+			prerelease bool
+			token      *string
+			err        error
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		{
+			prereleaseRaw := r.URL.Query().Get("prerelease")
+			if prereleaseRaw != "" {
+				v, err2 := strconv.ParseBool(prereleaseRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("prerelease", prereleaseRaw, "boolean"))
+				}
+				prerelease = v
+			}
+		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewVersionsByIDPayload(id, prerelease, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeVersionsByIDError returns an encoder for errors returned by the
+// VersionsByID resource endpoint.
+func EncodeVersionsByIDError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewVersionsByIDInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not-found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewVersionsByIDNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewVersionsByIDInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListReviewsResponse returns an encoder for responses returned by the
+// resource ListReviews endpoint.
+func EncodeListReviewsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*resource.Reviews)
+		enc := encoder(ctx, w)
+		body := NewListReviewsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListReviewsRequest returns a decoder for requests sent to the resource
+// ListReviews endpoint.
+func DecodeListReviewsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			id     uint
+			limit  uint
+			cursor *string
+			token  *string
+			err    error
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		qp := r.URL.Query()
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 100
+			} else {
+				v, err2 := strconv.ParseUint(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "unsigned integer"))
+				}
+				limit = uint(v)
+			}
+		}
+		cursorRaw := qp.Get("cursor")
+		if cursorRaw != "" {
+			cursor = &cursorRaw
+		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListReviewsPayload(id, limit, cursor, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListReviewsError returns an encoder for errors returned by the
+// ListReviews resource endpoint.
+func EncodeListReviewsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListReviewsInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "invalid-cursor":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListReviewsInvalidCursorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not-found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListReviewsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListReviewsInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCreateReviewResponse returns an encoder for responses returned by the
+// resource CreateReview endpoint.
+func EncodeCreateReviewResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*resource.Review)
+		enc := encoder(ctx, w)
+		body := NewCreateReviewResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateReviewRequest returns a decoder for requests sent to the
+// resource CreateReview endpoint.
+func DecodeCreateReviewRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body CreateReviewRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateReviewRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			id    uint
+			token string
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCreateReviewPayload(&body, id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeCreateReviewError returns an encoder for errors returned by the
+// CreateReview resource endpoint.
+func EncodeCreateReviewError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateReviewInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "invalid-payload":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateReviewInvalidPayloadResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "invalid-scopes":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateReviewInvalidScopesResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not-found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateReviewNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateReviewInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUpdateReviewResponse returns an encoder for responses returned by the
+// resource UpdateReview endpoint.
+func EncodeUpdateReviewResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*resource.Review)
+		enc := encoder(ctx, w)
+		body := NewUpdateReviewResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateReviewRequest returns a decoder for requests sent to the
+// resource UpdateReview endpoint.
+func DecodeUpdateReviewRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body UpdateReviewRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateReviewRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			id       uint
+			reviewID uint
+			token    string
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		{
+			reviewIDRaw := params["reviewID"]
+			v, err2 := strconv.ParseUint(reviewIDRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("reviewID", reviewIDRaw, "unsigned integer"))
+			}
+			reviewID = uint(v)
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUpdateReviewPayload(&body, id, reviewID, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateReviewError returns an encoder for errors returned by the
+// UpdateReview resource endpoint.
+func EncodeUpdateReviewError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateReviewInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "invalid-scopes":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateReviewInvalidScopesResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not-found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateReviewNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateReviewInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteReviewResponse returns an encoder for responses returned by the
+// resource DeleteReview endpoint.
+func EncodeDeleteReviewResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeDeleteReviewRequest returns a decoder for requests sent to the
+// resource DeleteReview endpoint.
+func DecodeDeleteReviewRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			id       uint
+			reviewID uint
+			token    string
+			err      error
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		{
+			reviewIDRaw := params["reviewID"]
+			v, err2 := strconv.ParseUint(reviewIDRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("reviewID", reviewIDRaw, "unsigned integer"))
+			}
+			reviewID = uint(v)
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteReviewPayload(id, reviewID, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteReviewError returns an encoder for errors returned by the
+// DeleteReview resource endpoint.
+func EncodeDeleteReviewError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteReviewInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "invalid-scopes":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteReviewInvalidScopesResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not-found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteReviewNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteReviewInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDependenciesResponse returns an encoder for responses returned by the
+// resource Dependencies endpoint.
+func EncodeDependenciesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*resource.PipelineDependencies)
+		enc := encoder(ctx, w)
+		body := NewDependenciesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeDependenciesRequest returns a decoder for requests sent to the
+// resource Dependencies endpoint.
+func DecodeDependenciesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			id      uint
+			version *string
+			token   *string
+			err     error
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		versionRaw := r.URL.Query().Get("version")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDependenciesPayload(id, version, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeDependenciesError returns an encoder for errors returned by the
+// Dependencies resource endpoint.
+func EncodeDependenciesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDependenciesInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not-found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDependenciesNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDependenciesInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDependentsResponse returns an encoder for responses returned by the
+// resource Dependents endpoint.
+func EncodeDependentsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*resource.TaskDependents)
+		enc := encoder(ctx, w)
+		body := NewDependentsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeDependentsRequest returns a decoder for requests sent to the resource
+// Dependents endpoint.
+func DecodeDependentsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			id      uint
+			version *string
+			token   *string
+			err     error
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			}
+			id = uint(v)
+		}
+		versionRaw := r.URL.Query().Get("version")
+		if versionRaw != "" {
+			version = &versionRaw
+		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDependentsPayload(id, version, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeDependentsError returns an encoder for errors returned by the
+// Dependents resource endpoint.
+func EncodeDependentsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDependentsInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not-found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDependentsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDependentsInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeByCatalogKindNameVersionResponse returns an encoder for responses
+// returned by the resource ByCatalogKindNameVersion endpoint.
+func EncodeByCatalogKindNameVersionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*resourceviews.ResourceVersion)
+		enc := encoder(ctx, w)
+		body := NewByCatalogKindNameVersionResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeByCatalogKindNameVersionRequest returns a decoder for requests sent to
+// the resource ByCatalogKindNameVersion endpoint.
+func DecodeByCatalogKindNameVersionRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			catalog string
+			kind    string
+			name    string
+			version string
+			token   *string
+			err     error
+
+			params = mux.Vars(r)
+		)
+		catalog = params["catalog"]
+		kind = params["kind"]
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
+		}
+		name = params["name"]
+		version = params["version"]
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewByCatalogKindNameVersionPayload(catalog, kind, name, version, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeByCatalogKindNameVersionError returns an encoder for errors returned
+// by the ByCatalogKindNameVersion resource endpoint.
+func EncodeByCatalogKindNameVersionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameVersionInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not-found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameVersionNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameVersionInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeByCatalogKindNameVersionReadmeResponse returns an encoder for
+// responses returned by the resource ByCatalogKindNameVersionReadme endpoint.
+func EncodeByCatalogKindNameVersionReadmeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*resourceviews.ResourceVersionReadme)
+		enc := encoder(ctx, w)
+		body := NewByCatalogKindNameVersionReadmeResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeByCatalogKindNameVersionReadmeRequest returns a decoder for requests
+// sent to the resource ByCatalogKindNameVersionReadme endpoint.
+func DecodeByCatalogKindNameVersionReadmeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			catalog string
+			kind    string
+			name    string
+			version string
+			token   *string
+			err     error
+
+			params = mux.Vars(r)
+		)
+		catalog = params["catalog"]
+		kind = params["kind"]
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
+		}
+		name = params["name"]
+		version = params["version"]
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewByCatalogKindNameVersionReadmePayload(catalog, kind, name, version, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeByCatalogKindNameVersionReadmeError returns an encoder for errors
+// returned by the ByCatalogKindNameVersionReadme resource endpoint.
+func EncodeByCatalogKindNameVersionReadmeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal-error":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameVersionReadmeInternalErrorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "not-found":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameVersionReadmeNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameVersionReadmeInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeByCatalogKindNameVersionYamlResponse returns an encoder for responses
+// returned by the resource ByCatalogKindNameVersionYaml endpoint.
+func EncodeByCatalogKindNameVersionYamlResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*resourceviews.ResourceVersionYaml)
+		enc := encoder(ctx, w)
+		body := NewByCatalogKindNameVersionYamlResponseBody(res.Projected)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeByCatalogKindNameVersionYamlRequest returns a decoder for requests
+// sent to the resource ByCatalogKindNameVersionYaml endpoint.
+func DecodeByCatalogKindNameVersionYamlRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			catalog string
+			kind    string
+			name    string
+			version string
+			install bool
+			token   *string
+			err     error
 
 			params = mux.Vars(r)
 		)
+		catalog = params["catalog"]
+		kind = params["kind"]
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
+		}
+		name = params["name"]
+		version = params["version"]
 		{
-			idRaw := params["id"]
-			v, err2 := strconv.ParseUint(idRaw, 10, strconv.IntSize)
-			if err2 != nil {
-				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "unsigned integer"))
+			installRaw := r.URL.Query().Get("install")
+			if installRaw != "" {
+				v, err2 := strconv.ParseBool(installRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("install", installRaw, "boolean"))
+				}
+				install = v
 			}
-			id = uint(v)
+		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
 		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewVersionsByIDPayload(id)
+		payload := NewByCatalogKindNameVersionYamlPayload(catalog, kind, name, version, install, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
 
 		return payload, nil
 	}
 }
 
-// EncodeVersionsByIDError returns an encoder for errors returned by the
-// VersionsByID resource endpoint.
-func EncodeVersionsByIDError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeByCatalogKindNameVersionYamlError returns an encoder for errors
+// returned by the ByCatalogKindNameVersionYaml resource endpoint.
+func EncodeByCatalogKindNameVersionYamlError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -280,7 +1556,7 @@ func EncodeVersionsByIDError(encoder func(context.Context, http.ResponseWriter)
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewVersionsByIDInternalErrorResponseBody(res)
+				body = NewByCatalogKindNameVersionYamlInternalErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -293,61 +1569,86 @@ func EncodeVersionsByIDError(encoder func(context.Context, http.ResponseWriter)
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewVersionsByIDNotFoundResponseBody(res)
+				body = NewByCatalogKindNameVersionYamlNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameVersionYamlInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
 	}
 }
 
-// EncodeByCatalogKindNameVersionResponse returns an encoder for responses
-// returned by the resource ByCatalogKindNameVersion endpoint.
-func EncodeByCatalogKindNameVersionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeByCatalogKindNameVersionLintResponse returns an encoder for responses
+// returned by the resource ByCatalogKindNameVersionLint endpoint.
+func EncodeByCatalogKindNameVersionLintResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res := v.(*resourceviews.ResourceVersion)
+		res, _ := v.(*resource.VersionLint)
 		enc := encoder(ctx, w)
-		body := NewByCatalogKindNameVersionResponseBody(res.Projected)
+		body := NewByCatalogKindNameVersionLintResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeByCatalogKindNameVersionRequest returns a decoder for requests sent to
-// the resource ByCatalogKindNameVersion endpoint.
-func DecodeByCatalogKindNameVersionRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeByCatalogKindNameVersionLintRequest returns a decoder for requests
+// sent to the resource ByCatalogKindNameVersionLint endpoint.
+func DecodeByCatalogKindNameVersionLintRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
 			catalog string
 			kind    string
 			name    string
 			version string
+			token   *string
 			err     error
 
 			params = mux.Vars(r)
 		)
 		catalog = params["catalog"]
 		kind = params["kind"]
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		name = params["name"]
 		version = params["version"]
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewByCatalogKindNameVersionPayload(catalog, kind, name, version)
+		payload := NewByCatalogKindNameVersionLintPayload(catalog, kind, name, version, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
 
 		return payload, nil
 	}
 }
 
-// EncodeByCatalogKindNameVersionError returns an encoder for errors returned
-// by the ByCatalogKindNameVersion resource endpoint.
-func EncodeByCatalogKindNameVersionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeByCatalogKindNameVersionLintError returns an encoder for errors
+// returned by the ByCatalogKindNameVersionLint resource endpoint.
+func EncodeByCatalogKindNameVersionLintError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -363,7 +1664,7 @@ func EncodeByCatalogKindNameVersionError(encoder func(context.Context, http.Resp
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewByCatalogKindNameVersionInternalErrorResponseBody(res)
+				body = NewByCatalogKindNameVersionLintInternalErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -376,61 +1677,86 @@ func EncodeByCatalogKindNameVersionError(encoder func(context.Context, http.Resp
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewByCatalogKindNameVersionNotFoundResponseBody(res)
+				body = NewByCatalogKindNameVersionLintNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameVersionLintInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
 	}
 }
 
-// EncodeByCatalogKindNameVersionReadmeResponse returns an encoder for
-// responses returned by the resource ByCatalogKindNameVersionReadme endpoint.
-func EncodeByCatalogKindNameVersionReadmeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeByCatalogKindNameVersionImagesResponse returns an encoder for
+// responses returned by the resource ByCatalogKindNameVersionImages endpoint.
+func EncodeByCatalogKindNameVersionImagesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res := v.(*resourceviews.ResourceVersionReadme)
+		res, _ := v.(*resource.VersionImages)
 		enc := encoder(ctx, w)
-		body := NewByCatalogKindNameVersionReadmeResponseBody(res.Projected)
+		body := NewByCatalogKindNameVersionImagesResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeByCatalogKindNameVersionReadmeRequest returns a decoder for requests
-// sent to the resource ByCatalogKindNameVersionReadme endpoint.
-func DecodeByCatalogKindNameVersionReadmeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeByCatalogKindNameVersionImagesRequest returns a decoder for requests
+// sent to the resource ByCatalogKindNameVersionImages endpoint.
+func DecodeByCatalogKindNameVersionImagesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
 			catalog string
 			kind    string
 			name    string
 			version string
+			token   *string
 			err     error
 
 			params = mux.Vars(r)
 		)
 		catalog = params["catalog"]
 		kind = params["kind"]
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		name = params["name"]
 		version = params["version"]
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewByCatalogKindNameVersionReadmePayload(catalog, kind, name, version)
+		payload := NewByCatalogKindNameVersionImagesPayload(catalog, kind, name, version, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
 
 		return payload, nil
 	}
 }
 
-// EncodeByCatalogKindNameVersionReadmeError returns an encoder for errors
-// returned by the ByCatalogKindNameVersionReadme resource endpoint.
-func EncodeByCatalogKindNameVersionReadmeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeByCatalogKindNameVersionImagesError returns an encoder for errors
+// returned by the ByCatalogKindNameVersionImages resource endpoint.
+func EncodeByCatalogKindNameVersionImagesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -446,7 +1772,7 @@ func EncodeByCatalogKindNameVersionReadmeError(encoder func(context.Context, htt
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewByCatalogKindNameVersionReadmeInternalErrorResponseBody(res)
+				body = NewByCatalogKindNameVersionImagesInternalErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
@@ -459,61 +1785,92 @@ func EncodeByCatalogKindNameVersionReadmeError(encoder func(context.Context, htt
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewByCatalogKindNameVersionReadmeNotFoundResponseBody(res)
+				body = NewByCatalogKindNameVersionImagesNotFoundResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameVersionImagesInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
 	}
 }
 
-// EncodeByCatalogKindNameVersionYamlResponse returns an encoder for responses
-// returned by the resource ByCatalogKindNameVersionYaml endpoint.
-func EncodeByCatalogKindNameVersionYamlResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeByImageResponse returns an encoder for responses returned by the
+// resource ByImage endpoint.
+func EncodeByImageResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res := v.(*resourceviews.ResourceVersionYaml)
+		res, _ := v.(*resource.ImageUsers)
 		enc := encoder(ctx, w)
-		body := NewByCatalogKindNameVersionYamlResponseBody(res.Projected)
+		body := NewByImageResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeByCatalogKindNameVersionYamlRequest returns a decoder for requests
-// sent to the resource ByCatalogKindNameVersionYaml endpoint.
-func DecodeByCatalogKindNameVersionYamlRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeByImageRequest returns a decoder for requests sent to the resource
+// ByImage endpoint.
+func DecodeByImageRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			catalog string
-			kind    string
-			name    string
-			version string
-			err     error
-
-			params = mux.Vars(r)
+			ref   string
+			limit uint
+			token *string
+			err   error
 		)
-		catalog = params["catalog"]
-		kind = params["kind"]
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		qp := r.URL.Query()
+		ref = qp.Get("ref")
+		if ref == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("ref", "query string"))
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 1000
+			} else {
+				v, err2 := strconv.ParseUint(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "unsigned integer"))
+				}
+				limit = uint(v)
+			}
+		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
 		}
-		name = params["name"]
-		version = params["version"]
 		if err != nil {
 			return nil, err
 		}
-		payload := NewByCatalogKindNameVersionYamlPayload(catalog, kind, name, version)
+		payload := NewByImagePayload(ref, limit, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
 
 		return payload, nil
 	}
 }
 
-// EncodeByCatalogKindNameVersionYamlError returns an encoder for errors
-// returned by the ByCatalogKindNameVersionYaml resource endpoint.
-func EncodeByCatalogKindNameVersionYamlError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeByImageError returns an encoder for errors returned by the ByImage
+// resource endpoint.
+func EncodeByImageError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -529,12 +1886,12 @@ func EncodeByCatalogKindNameVersionYamlError(encoder func(context.Context, http.
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewByCatalogKindNameVersionYamlInternalErrorResponseBody(res)
+				body = NewByImageInternalErrorResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusInternalServerError)
 			return enc.Encode(body)
-		case "not-found":
+		case "invalid-token":
 			var res *goa.ServiceError
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
@@ -542,10 +1899,10 @@ func EncodeByCatalogKindNameVersionYamlError(encoder func(context.Context, http.
 			if formatter != nil {
 				body = formatter(ctx, res)
 			} else {
-				body = NewByCatalogKindNameVersionYamlNotFoundResponseBody(res)
+				body = NewByImageInvalidTokenResponseBody(res)
 			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
+			w.WriteHeader(http.StatusUnauthorized)
 			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
@@ -571,6 +1928,7 @@ func DecodeByVersionIDRequest(mux goahttp.Muxer, decoder func(*http.Request) goa
 	return func(r *http.Request) (any, error) {
 		var (
 			versionID uint
+			token     *string
 			err       error
 
 			params = mux.Vars(r)
@@ -583,10 +1941,21 @@ func DecodeByVersionIDRequest(mux goahttp.Muxer, decoder func(*http.Request) goa
 			}
 			versionID = uint(v)
 		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewByVersionIDPayload(versionID)
+		payload := NewByVersionIDPayload(versionID, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
 
 		return payload, nil
 	}
@@ -628,6 +1997,19 @@ func EncodeByVersionIDError(encoder func(context.Context, http.ResponseWriter) g
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByVersionIDInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -655,27 +2037,51 @@ func DecodeByCatalogKindNameRequest(mux goahttp.Muxer, decoder func(*http.Reques
 			kind             string
 			name             string
 			pipelinesversion *string
+			prerelease       bool
+			token            *string
 			err              error
 
 			params = mux.Vars(r)
 		)
 		catalog = params["catalog"]
 		kind = params["kind"]
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		name = params["name"]
-		pipelinesversionRaw := r.URL.Query().Get("pipelinesversion")
+		qp := r.URL.Query()
+		pipelinesversionRaw := qp.Get("pipelinesversion")
 		if pipelinesversionRaw != "" {
 			pipelinesversion = &pipelinesversionRaw
 		}
 		if pipelinesversion != nil {
 			err = goa.MergeErrors(err, goa.ValidatePattern("pipelinesversion", *pipelinesversion, "^\\d+(?:\\.\\d+){0,2}$"))
 		}
+		{
+			prereleaseRaw := qp.Get("prerelease")
+			if prereleaseRaw != "" {
+				v, err2 := strconv.ParseBool(prereleaseRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("prerelease", prereleaseRaw, "boolean"))
+				}
+				prerelease = v
+			}
+		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewByCatalogKindNamePayload(catalog, kind, name, pipelinesversion)
+		payload := NewByCatalogKindNamePayload(catalog, kind, name, pipelinesversion, prerelease, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
 
 		return payload, nil
 	}
@@ -717,6 +2123,19 @@ func EncodeByCatalogKindNameError(encoder func(context.Context, http.ResponseWri
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByCatalogKindNameInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -740,8 +2159,9 @@ func EncodeByIDResponse(encoder func(context.Context, http.ResponseWriter) goaht
 func DecodeByIDRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			id  uint
-			err error
+			id    uint
+			token *string
+			err   error
 
 			params = mux.Vars(r)
 		)
@@ -753,10 +2173,21 @@ func DecodeByIDRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.De
 			}
 			id = uint(v)
 		}
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewByIDPayload(id)
+		payload := NewByIDPayload(id, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
 
 		return payload, nil
 	}
@@ -798,6 +2229,19 @@ func EncodeByIDError(encoder func(context.Context, http.ResponseWriter) goahttp.
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewByIDInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -822,21 +2266,33 @@ func DecodeGetRawYamlByCatalogKindNameVersionRequest(mux goahttp.Muxer, decoder
 			kind    string
 			name    string
 			version string
+			token   *string
 			err     error
 
 			params = mux.Vars(r)
 		)
 		catalog = params["catalog"]
 		kind = params["kind"]
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		name = params["name"]
 		version = params["version"]
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewGetRawYamlByCatalogKindNameVersionPayload(catalog, kind, name, version)
+		payload := NewGetRawYamlByCatalogKindNameVersionPayload(catalog, kind, name, version, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
 
 		return payload, nil
 	}
@@ -878,6 +2334,19 @@ func EncodeGetRawYamlByCatalogKindNameVersionError(encoder func(context.Context,
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetRawYamlByCatalogKindNameVersionInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -901,20 +2370,32 @@ func DecodeGetLatestRawYamlByCatalogKindNameRequest(mux goahttp.Muxer, decoder f
 			catalog string
 			kind    string
 			name    string
+			token   *string
 			err     error
 
 			params = mux.Vars(r)
 		)
 		catalog = params["catalog"]
 		kind = params["kind"]
-		if !(kind == "task" || kind == "pipeline") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline"}))
+		if !(kind == "task" || kind == "pipeline" || kind == "stepaction") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("kind", kind, []any{"task", "pipeline", "stepaction"}))
 		}
 		name = params["name"]
+		tokenRaw := r.Header.Get("Authorization")
+		if tokenRaw != "" {
+			token = &tokenRaw
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewGetLatestRawYamlByCatalogKindNamePayload(catalog, kind, name)
+		payload := NewGetLatestRawYamlByCatalogKindNamePayload(catalog, kind, name, token)
+		if payload.Token != nil {
+			if strings.Contains(*payload.Token, " ") {
+				// Remove authorization scheme prefix (e.g. "Bearer")
+				cred := strings.SplitN(*payload.Token, " ", 2)[1]
+				payload.Token = &cred
+			}
+		}
 
 		return payload, nil
 	}
@@ -956,6 +2437,19 @@ func EncodeGetLatestRawYamlByCatalogKindNameError(encoder func(context.Context,
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
+		case "invalid-token":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetLatestRawYamlByCatalogKindNameInvalidTokenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
 		default:
 			return encodeError(ctx, w, v)
 		}
@@ -973,6 +2467,7 @@ func marshalResourceviewsResourceDataViewToResourceDataResponseBodyWithoutVersio
 		HubURLPath:    *v.HubURLPath,
 		HubRawURLPath: *v.HubRawURLPath,
 		Rating:        *v.Rating,
+		Downloads:     *v.Downloads,
 	}
 	if v.Catalog != nil {
 		res.Catalog = marshalResourceviewsCatalogViewToCatalogResponseBodyMin(v.Catalog)
@@ -1047,6 +2542,9 @@ func marshalResourceviewsResourceVersionDataViewToResourceVersionDataResponseBod
 		HubRawURLPath:       *v.HubRawURLPath,
 		UpdatedAt:           *v.UpdatedAt,
 		HubURLPath:          *v.HubURLPath,
+		Bundle:              v.Bundle,
+		APIVersion:          v.APIVersion,
+		Verification:        v.Verification,
 	}
 	if v.Platforms != nil {
 		res.Platforms = make([]*PlatformResponseBody, len(v.Platforms))
@@ -1125,6 +2623,53 @@ func marshalResourceviewsResourceVersionDataViewToResourceVersionDataResponseBod
 	return res
 }
 
+// marshalResourceReviewToReviewResponseBody builds a value of type
+// *ReviewResponseBody from a value of type *resource.Review.
+func marshalResourceReviewToReviewResponseBody(v *resource.Review) *ReviewResponseBody {
+	res := &ReviewResponseBody{
+		ID:         v.ID,
+		ResourceID: v.ResourceID,
+		Version:    v.Version,
+		UserID:     v.UserID,
+		Text:       v.Text,
+		CreatedAt:  v.CreatedAt,
+		UpdatedAt:  v.UpdatedAt,
+	}
+
+	return res
+}
+
+// marshalResourceDependencyToDependencyResponseBody builds a value of type
+// *DependencyResponseBody from a value of type *resource.Dependency.
+func marshalResourceDependencyToDependencyResponseBody(v *resource.Dependency) *DependencyResponseBody {
+	res := &DependencyResponseBody{
+		Name:       v.Name,
+		Kind:       v.Kind,
+		Via:        v.Via,
+		Version:    v.Version,
+		Catalog:    v.Catalog,
+		Bundle:     v.Bundle,
+		URL:        v.URL,
+		ResourceID: v.ResourceID,
+	}
+
+	return res
+}
+
+// marshalResourceDependentToDependentResponseBody builds a value of type
+// *DependentResponseBody from a value of type *resource.Dependent.
+func marshalResourceDependentToDependentResponseBody(v *resource.Dependent) *DependentResponseBody {
+	res := &DependentResponseBody{
+		ResourceID:  v.ResourceID,
+		Name:        v.Name,
+		Catalog:     v.Catalog,
+		Version:     v.Version,
+		TaskVersion: v.TaskVersion,
+	}
+
+	return res
+}
+
 // marshalResourceviewsResourceVersionDataViewToResourceVersionDataResponseBody
 // builds a value of type *ResourceVersionDataResponseBody from a value of type
 // *resourceviews.ResourceVersionDataView.
@@ -1141,6 +2686,9 @@ func marshalResourceviewsResourceVersionDataViewToResourceVersionDataResponseBod
 		HubRawURLPath:       *v.HubRawURLPath,
 		UpdatedAt:           *v.UpdatedAt,
 		HubURLPath:          *v.HubURLPath,
+		Bundle:              v.Bundle,
+		APIVersion:          v.APIVersion,
+		Verification:        v.Verification,
 	}
 	if v.Platforms != nil {
 		res.Platforms = make([]*PlatformResponseBody, len(v.Platforms))
@@ -1150,6 +2698,24 @@ func marshalResourceviewsResourceVersionDataViewToResourceVersionDataResponseBod
 	} else {
 		res.Platforms = []*PlatformResponseBody{}
 	}
+	if v.Params != nil {
+		res.Params = make([]*ParamSpecResponseBody, len(v.Params))
+		for i, val := range v.Params {
+			res.Params[i] = marshalResourceviewsParamSpecViewToParamSpecResponseBody(val)
+		}
+	}
+	if v.Workspaces != nil {
+		res.Workspaces = make([]*WorkspaceSpecResponseBody, len(v.Workspaces))
+		for i, val := range v.Workspaces {
+			res.Workspaces[i] = marshalResourceviewsWorkspaceSpecViewToWorkspaceSpecResponseBody(val)
+		}
+	}
+	if v.Results != nil {
+		res.Results = make([]*ResultSpecResponseBody, len(v.Results))
+		for i, val := range v.Results {
+			res.Results[i] = marshalResourceviewsResultSpecViewToResultSpecResponseBody(val)
+		}
+	}
 	if v.Resource != nil {
 		res.Resource = marshalResourceviewsResourceDataViewToResourceDataResponseBodyInfo(v.Resource)
 	}
@@ -1157,6 +2723,56 @@ func marshalResourceviewsResourceVersionDataViewToResourceVersionDataResponseBod
 	return res
 }
 
+// marshalResourceviewsParamSpecViewToParamSpecResponseBody builds a value of
+// type *ParamSpecResponseBody from a value of type
+// *resourceviews.ParamSpecView.
+func marshalResourceviewsParamSpecViewToParamSpecResponseBody(v *resourceviews.ParamSpecView) *ParamSpecResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ParamSpecResponseBody{
+		Name:        *v.Name,
+		Type:        *v.Type,
+		Default:     v.Default,
+		Description: *v.Description,
+	}
+
+	return res
+}
+
+// marshalResourceviewsWorkspaceSpecViewToWorkspaceSpecResponseBody builds a
+// value of type *WorkspaceSpecResponseBody from a value of type
+// *resourceviews.WorkspaceSpecView.
+func marshalResourceviewsWorkspaceSpecViewToWorkspaceSpecResponseBody(v *resourceviews.WorkspaceSpecView) *WorkspaceSpecResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &WorkspaceSpecResponseBody{
+		Name:        *v.Name,
+		Description: *v.Description,
+		Optional:    *v.Optional,
+		ReadOnly:    *v.ReadOnly,
+	}
+
+	return res
+}
+
+// marshalResourceviewsResultSpecViewToResultSpecResponseBody builds a value of
+// type *ResultSpecResponseBody from a value of type
+// *resourceviews.ResultSpecView.
+func marshalResourceviewsResultSpecViewToResultSpecResponseBody(v *resourceviews.ResultSpecView) *ResultSpecResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ResultSpecResponseBody{
+		Name:        *v.Name,
+		Type:        *v.Type,
+		Description: *v.Description,
+	}
+
+	return res
+}
+
 // marshalResourceviewsResourceDataViewToResourceDataResponseBodyInfo builds a
 // value of type *ResourceDataResponseBodyInfo from a value of type
 // *resourceviews.ResourceDataView.
@@ -1221,6 +2837,33 @@ func marshalResourceviewsResourceContentViewToResourceContentResponseBodyYaml(v
 	return res
 }
 
+// marshalResourceLintResultToLintResultResponseBody builds a value of type
+// *LintResultResponseBody from a value of type *resource.LintResult.
+func marshalResourceLintResultToLintResultResponseBody(v *resource.LintResult) *LintResultResponseBody {
+	res := &LintResultResponseBody{
+		Rule:     v.Rule,
+		Severity: v.Severity,
+		Message:  v.Message,
+	}
+
+	return res
+}
+
+// marshalResourceImageUserToImageUserResponseBody builds a value of type
+// *ImageUserResponseBody from a value of type *resource.ImageUser.
+func marshalResourceImageUserToImageUserResponseBody(v *resource.ImageUser) *ImageUserResponseBody {
+	res := &ImageUserResponseBody{
+		ResourceID: v.ResourceID,
+		Catalog:    v.Catalog,
+		Kind:       v.Kind,
+		Name:       v.Name,
+		Version:    v.Version,
+		Image:      v.Image,
+	}
+
+	return res
+}
+
 // marshalResourceviewsResourceDataViewToResourceDataResponseBody builds a
 // value of type *ResourceDataResponseBody from a value of type
 // *resourceviews.ResourceDataView.
@@ -1232,6 +2875,7 @@ func marshalResourceviewsResourceDataViewToResourceDataResponseBody(v *resourcev
 		HubURLPath:    *v.HubURLPath,
 		HubRawURLPath: *v.HubRawURLPath,
 		Rating:        *v.Rating,
+		Downloads:     *v.Downloads,
 	}
 	if v.Catalog != nil {
 		res.Catalog = marshalResourceviewsCatalogViewToCatalogResponseBodyMin(v.Catalog)
@@ -1282,6 +2926,7 @@ func marshalResourceviewsResourceVersionDataViewToResourceVersionDataResponseBod
 	res := &ResourceVersionDataResponseBodyTiny{
 		ID:      *v.ID,
 		Version: *v.Version,
+		Bundle:  v.Bundle,
 	}
 
 	return res
@@ -26,6 +26,36 @@ func VersionsByIDResourcePath(id uint) string {
 	return fmt.Sprintf("/v1/resource/%v/versions", id)
 }
 
+// ListReviewsResourcePath returns the URL path to the resource service ListReviews HTTP endpoint.
+func ListReviewsResourcePath(id uint) string {
+	return fmt.Sprintf("/v1/resource/%v/reviews", id)
+}
+
+// CreateReviewResourcePath returns the URL path to the resource service CreateReview HTTP endpoint.
+func CreateReviewResourcePath(id uint) string {
+	return fmt.Sprintf("/v1/resource/%v/reviews", id)
+}
+
+// UpdateReviewResourcePath returns the URL path to the resource service UpdateReview HTTP endpoint.
+func UpdateReviewResourcePath(id uint, reviewID uint) string {
+	return fmt.Sprintf("/v1/resource/%v/reviews/%v", id, reviewID)
+}
+
+// DeleteReviewResourcePath returns the URL path to the resource service DeleteReview HTTP endpoint.
+func DeleteReviewResourcePath(id uint, reviewID uint) string {
+	return fmt.Sprintf("/v1/resource/%v/reviews/%v", id, reviewID)
+}
+
+// DependenciesResourcePath returns the URL path to the resource service Dependencies HTTP endpoint.
+func DependenciesResourcePath(id uint) string {
+	return fmt.Sprintf("/v1/resource/%v/dependencies", id)
+}
+
+// DependentsResourcePath returns the URL path to the resource service Dependents HTTP endpoint.
+func DependentsResourcePath(id uint) string {
+	return fmt.Sprintf("/v1/resource/%v/dependents", id)
+}
+
 // ByCatalogKindNameVersionResourcePath returns the URL path to the resource service ByCatalogKindNameVersion HTTP endpoint.
 func ByCatalogKindNameVersionResourcePath(catalog string, kind string, name string, version string) string {
 	return fmt.Sprintf("/v1/resource/%v/%v/%v/%v", catalog, kind, name, version)
@@ -41,6 +71,21 @@ func ByCatalogKindNameVersionYamlResourcePath(catalog string, kind string, name
 	return fmt.Sprintf("/v1/resource/%v/%v/%v/%v/yaml", catalog, kind, name, version)
 }
 
+// ByCatalogKindNameVersionLintResourcePath returns the URL path to the resource service ByCatalogKindNameVersionLint HTTP endpoint.
+func ByCatalogKindNameVersionLintResourcePath(catalog string, kind string, name string, version string) string {
+	return fmt.Sprintf("/v1/resource/%v/%v/%v/%v/lint", catalog, kind, name, version)
+}
+
+// ByCatalogKindNameVersionImagesResourcePath returns the URL path to the resource service ByCatalogKindNameVersionImages HTTP endpoint.
+func ByCatalogKindNameVersionImagesResourcePath(catalog string, kind string, name string, version string) string {
+	return fmt.Sprintf("/v1/resource/%v/%v/%v/%v/images", catalog, kind, name, version)
+}
+
+// ByImageResourcePath returns the URL path to the resource service ByImage HTTP endpoint.
+func ByImageResourcePath() string {
+	return "/v1/images"
+}
+
 // ByVersionIDResourcePath returns the URL path to the resource service ByVersionId HTTP endpoint.
 func ByVersionIDResourcePath(versionID uint) string {
 	return fmt.Sprintf("/v1/resource/version/%v", versionID)
@@ -25,9 +25,18 @@ type Server struct {
 	Query                              http.Handler
 	List                               http.Handler
 	VersionsByID                       http.Handler
+	ListReviews                        http.Handler
+	CreateReview                       http.Handler
+	UpdateReview                       http.Handler
+	DeleteReview                       http.Handler
+	Dependencies                       http.Handler
+	Dependents                         http.Handler
 	ByCatalogKindNameVersion           http.Handler
 	ByCatalogKindNameVersionReadme     http.Handler
 	ByCatalogKindNameVersionYaml       http.Handler
+	ByCatalogKindNameVersionLint       http.Handler
+	ByCatalogKindNameVersionImages     http.Handler
+	ByImage                            http.Handler
 	ByVersionID                        http.Handler
 	ByCatalogKindName                  http.Handler
 	ByID                               http.Handler
@@ -66,9 +75,18 @@ func New(
 			{"Query", "GET", "/v1/query"},
 			{"List", "GET", "/v1/resources"},
 			{"VersionsByID", "GET", "/v1/resource/{id}/versions"},
+			{"ListReviews", "GET", "/v1/resource/{id}/reviews"},
+			{"CreateReview", "POST", "/v1/resource/{id}/reviews"},
+			{"UpdateReview", "PUT", "/v1/resource/{id}/reviews/{reviewID}"},
+			{"DeleteReview", "DELETE", "/v1/resource/{id}/reviews/{reviewID}"},
+			{"Dependencies", "GET", "/v1/resource/{id}/dependencies"},
+			{"Dependents", "GET", "/v1/resource/{id}/dependents"},
 			{"ByCatalogKindNameVersion", "GET", "/v1/resource/{catalog}/{kind}/{name}/{version}"},
 			{"ByCatalogKindNameVersionReadme", "GET", "/v1/resource/{catalog}/{kind}/{name}/{version}/readme"},
 			{"ByCatalogKindNameVersionYaml", "GET", "/v1/resource/{catalog}/{kind}/{name}/{version}/yaml"},
+			{"ByCatalogKindNameVersionLint", "GET", "/v1/resource/{catalog}/{kind}/{name}/{version}/lint"},
+			{"ByCatalogKindNameVersionImages", "GET", "/v1/resource/{catalog}/{kind}/{name}/{version}/images"},
+			{"ByImage", "GET", "/v1/images"},
 			{"ByVersionID", "GET", "/v1/resource/version/{versionID}"},
 			{"ByCatalogKindName", "GET", "/v1/resource/{catalog}/{kind}/{name}"},
 			{"ByID", "GET", "/v1/resource/{id}"},
@@ -77,9 +95,16 @@ func New(
 			{"CORS", "OPTIONS", "/v1/query"},
 			{"CORS", "OPTIONS", "/v1/resources"},
 			{"CORS", "OPTIONS", "/v1/resource/{id}/versions"},
+			{"CORS", "OPTIONS", "/v1/resource/{id}/reviews"},
+			{"CORS", "OPTIONS", "/v1/resource/{id}/reviews/{reviewID}"},
+			{"CORS", "OPTIONS", "/v1/resource/{id}/dependencies"},
+			{"CORS", "OPTIONS", "/v1/resource/{id}/dependents"},
 			{"CORS", "OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}"},
 			{"CORS", "OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/readme"},
 			{"CORS", "OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/yaml"},
+			{"CORS", "OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/lint"},
+			{"CORS", "OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/images"},
+			{"CORS", "OPTIONS", "/v1/images"},
 			{"CORS", "OPTIONS", "/v1/resource/version/{versionID}"},
 			{"CORS", "OPTIONS", "/v1/resource/{catalog}/{kind}/{name}"},
 			{"CORS", "OPTIONS", "/v1/resource/{id}"},
@@ -89,9 +114,18 @@ func New(
 		Query:                              NewQueryHandler(e.Query, mux, decoder, encoder, errhandler, formatter),
 		List:                               NewListHandler(e.List, mux, decoder, encoder, errhandler, formatter),
 		VersionsByID:                       NewVersionsByIDHandler(e.VersionsByID, mux, decoder, encoder, errhandler, formatter),
+		ListReviews:                        NewListReviewsHandler(e.ListReviews, mux, decoder, encoder, errhandler, formatter),
+		CreateReview:                       NewCreateReviewHandler(e.CreateReview, mux, decoder, encoder, errhandler, formatter),
+		UpdateReview:                       NewUpdateReviewHandler(e.UpdateReview, mux, decoder, encoder, errhandler, formatter),
+		DeleteReview:                       NewDeleteReviewHandler(e.DeleteReview, mux, decoder, encoder, errhandler, formatter),
+		Dependencies:                       NewDependenciesHandler(e.Dependencies, mux, decoder, encoder, errhandler, formatter),
+		Dependents:                         NewDependentsHandler(e.Dependents, mux, decoder, encoder, errhandler, formatter),
 		ByCatalogKindNameVersion:           NewByCatalogKindNameVersionHandler(e.ByCatalogKindNameVersion, mux, decoder, encoder, errhandler, formatter),
 		ByCatalogKindNameVersionReadme:     NewByCatalogKindNameVersionReadmeHandler(e.ByCatalogKindNameVersionReadme, mux, decoder, encoder, errhandler, formatter),
 		ByCatalogKindNameVersionYaml:       NewByCatalogKindNameVersionYamlHandler(e.ByCatalogKindNameVersionYaml, mux, decoder, encoder, errhandler, formatter),
+		ByCatalogKindNameVersionLint:       NewByCatalogKindNameVersionLintHandler(e.ByCatalogKindNameVersionLint, mux, decoder, encoder, errhandler, formatter),
+		ByCatalogKindNameVersionImages:     NewByCatalogKindNameVersionImagesHandler(e.ByCatalogKindNameVersionImages, mux, decoder, encoder, errhandler, formatter),
+		ByImage:                            NewByImageHandler(e.ByImage, mux, decoder, encoder, errhandler, formatter),
 		ByVersionID:                        NewByVersionIDHandler(e.ByVersionID, mux, decoder, encoder, errhandler, formatter),
 		ByCatalogKindName:                  NewByCatalogKindNameHandler(e.ByCatalogKindName, mux, decoder, encoder, errhandler, formatter),
 		ByID:                               NewByIDHandler(e.ByID, mux, decoder, encoder, errhandler, formatter),
@@ -109,9 +143,18 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Query = m(s.Query)
 	s.List = m(s.List)
 	s.VersionsByID = m(s.VersionsByID)
+	s.ListReviews = m(s.ListReviews)
+	s.CreateReview = m(s.CreateReview)
+	s.UpdateReview = m(s.UpdateReview)
+	s.DeleteReview = m(s.DeleteReview)
+	s.Dependencies = m(s.Dependencies)
+	s.Dependents = m(s.Dependents)
 	s.ByCatalogKindNameVersion = m(s.ByCatalogKindNameVersion)
 	s.ByCatalogKindNameVersionReadme = m(s.ByCatalogKindNameVersionReadme)
 	s.ByCatalogKindNameVersionYaml = m(s.ByCatalogKindNameVersionYaml)
+	s.ByCatalogKindNameVersionLint = m(s.ByCatalogKindNameVersionLint)
+	s.ByCatalogKindNameVersionImages = m(s.ByCatalogKindNameVersionImages)
+	s.ByImage = m(s.ByImage)
 	s.ByVersionID = m(s.ByVersionID)
 	s.ByCatalogKindName = m(s.ByCatalogKindName)
 	s.ByID = m(s.ByID)
@@ -128,9 +171,18 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountQueryHandler(mux, h.Query)
 	MountListHandler(mux, h.List)
 	MountVersionsByIDHandler(mux, h.VersionsByID)
+	MountListReviewsHandler(mux, h.ListReviews)
+	MountCreateReviewHandler(mux, h.CreateReview)
+	MountUpdateReviewHandler(mux, h.UpdateReview)
+	MountDeleteReviewHandler(mux, h.DeleteReview)
+	MountDependenciesHandler(mux, h.Dependencies)
+	MountDependentsHandler(mux, h.Dependents)
 	MountByCatalogKindNameVersionHandler(mux, h.ByCatalogKindNameVersion)
 	MountByCatalogKindNameVersionReadmeHandler(mux, h.ByCatalogKindNameVersionReadme)
 	MountByCatalogKindNameVersionYamlHandler(mux, h.ByCatalogKindNameVersionYaml)
+	MountByCatalogKindNameVersionLintHandler(mux, h.ByCatalogKindNameVersionLint)
+	MountByCatalogKindNameVersionImagesHandler(mux, h.ByCatalogKindNameVersionImages)
+	MountByImageHandler(mux, h.ByImage)
 	MountByVersionIDHandler(mux, h.ByVersionID)
 	MountByCatalogKindNameHandler(mux, h.ByCatalogKindName)
 	MountByIDHandler(mux, h.ByID)
@@ -297,6 +349,312 @@ func NewVersionsByIDHandler(
 	})
 }
 
+// MountListReviewsHandler configures the mux to serve the "resource" service
+// "ListReviews" endpoint.
+func MountListReviewsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleResourceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/resource/{id}/reviews", f)
+}
+
+// NewListReviewsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "resource" service "ListReviews" endpoint.
+func NewListReviewsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListReviewsRequest(mux, decoder)
+		encodeResponse = EncodeListReviewsResponse(encoder)
+		encodeError    = EncodeListReviewsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ListReviews")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "resource")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCreateReviewHandler configures the mux to serve the "resource" service
+// "CreateReview" endpoint.
+func MountCreateReviewHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleResourceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/resource/{id}/reviews", f)
+}
+
+// NewCreateReviewHandler creates a HTTP handler which loads the HTTP request
+// and calls the "resource" service "CreateReview" endpoint.
+func NewCreateReviewHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateReviewRequest(mux, decoder)
+		encodeResponse = EncodeCreateReviewResponse(encoder)
+		encodeError    = EncodeCreateReviewError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CreateReview")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "resource")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountUpdateReviewHandler configures the mux to serve the "resource" service
+// "UpdateReview" endpoint.
+func MountUpdateReviewHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleResourceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/v1/resource/{id}/reviews/{reviewID}", f)
+}
+
+// NewUpdateReviewHandler creates a HTTP handler which loads the HTTP request
+// and calls the "resource" service "UpdateReview" endpoint.
+func NewUpdateReviewHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateReviewRequest(mux, decoder)
+		encodeResponse = EncodeUpdateReviewResponse(encoder)
+		encodeError    = EncodeUpdateReviewError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "UpdateReview")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "resource")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteReviewHandler configures the mux to serve the "resource" service
+// "DeleteReview" endpoint.
+func MountDeleteReviewHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleResourceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/resource/{id}/reviews/{reviewID}", f)
+}
+
+// NewDeleteReviewHandler creates a HTTP handler which loads the HTTP request
+// and calls the "resource" service "DeleteReview" endpoint.
+func NewDeleteReviewHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteReviewRequest(mux, decoder)
+		encodeResponse = EncodeDeleteReviewResponse(encoder)
+		encodeError    = EncodeDeleteReviewError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "DeleteReview")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "resource")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDependenciesHandler configures the mux to serve the "resource" service
+// "Dependencies" endpoint.
+func MountDependenciesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleResourceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/resource/{id}/dependencies", f)
+}
+
+// NewDependenciesHandler creates a HTTP handler which loads the HTTP request
+// and calls the "resource" service "Dependencies" endpoint.
+func NewDependenciesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDependenciesRequest(mux, decoder)
+		encodeResponse = EncodeDependenciesResponse(encoder)
+		encodeError    = EncodeDependenciesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Dependencies")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "resource")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDependentsHandler configures the mux to serve the "resource" service
+// "Dependents" endpoint.
+func MountDependentsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleResourceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/resource/{id}/dependents", f)
+}
+
+// NewDependentsHandler creates a HTTP handler which loads the HTTP request and
+// calls the "resource" service "Dependents" endpoint.
+func NewDependentsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDependentsRequest(mux, decoder)
+		encodeResponse = EncodeDependentsResponse(encoder)
+		encodeError    = EncodeDependentsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "Dependents")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "resource")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountByCatalogKindNameVersionHandler configures the mux to serve the
 // "resource" service "ByCatalogKindNameVersion" endpoint.
 func MountByCatalogKindNameVersionHandler(mux goahttp.Muxer, h http.Handler) {
@@ -453,6 +811,161 @@ func NewByCatalogKindNameVersionYamlHandler(
 	})
 }
 
+// MountByCatalogKindNameVersionLintHandler configures the mux to serve the
+// "resource" service "ByCatalogKindNameVersionLint" endpoint.
+func MountByCatalogKindNameVersionLintHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleResourceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/resource/{catalog}/{kind}/{name}/{version}/lint", f)
+}
+
+// NewByCatalogKindNameVersionLintHandler creates a HTTP handler which loads
+// the HTTP request and calls the "resource" service
+// "ByCatalogKindNameVersionLint" endpoint.
+func NewByCatalogKindNameVersionLintHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeByCatalogKindNameVersionLintRequest(mux, decoder)
+		encodeResponse = EncodeByCatalogKindNameVersionLintResponse(encoder)
+		encodeError    = EncodeByCatalogKindNameVersionLintError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ByCatalogKindNameVersionLint")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "resource")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountByCatalogKindNameVersionImagesHandler configures the mux to serve the
+// "resource" service "ByCatalogKindNameVersionImages" endpoint.
+func MountByCatalogKindNameVersionImagesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleResourceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/resource/{catalog}/{kind}/{name}/{version}/images", f)
+}
+
+// NewByCatalogKindNameVersionImagesHandler creates a HTTP handler which loads
+// the HTTP request and calls the "resource" service
+// "ByCatalogKindNameVersionImages" endpoint.
+func NewByCatalogKindNameVersionImagesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeByCatalogKindNameVersionImagesRequest(mux, decoder)
+		encodeResponse = EncodeByCatalogKindNameVersionImagesResponse(encoder)
+		encodeError    = EncodeByCatalogKindNameVersionImagesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ByCatalogKindNameVersionImages")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "resource")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountByImageHandler configures the mux to serve the "resource" service
+// "ByImage" endpoint.
+func MountByImageHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleResourceOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/images", f)
+}
+
+// NewByImageHandler creates a HTTP handler which loads the HTTP request and
+// calls the "resource" service "ByImage" endpoint.
+func NewByImageHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeByImageRequest(mux, decoder)
+		encodeResponse = EncodeByImageResponse(encoder)
+		encodeError    = EncodeByImageError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ByImage")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "resource")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountByVersionIDHandler configures the mux to serve the "resource" service
 // "ByVersionId" endpoint.
 func MountByVersionIDHandler(mux goahttp.Muxer, h http.Handler) {
@@ -791,9 +1304,16 @@ func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
 	mux.Handle("OPTIONS", "/v1/query", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/v1/resources", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/v1/resource/{id}/versions", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/v1/resource/{id}/reviews", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/v1/resource/{id}/reviews/{reviewID}", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/v1/resource/{id}/dependencies", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/v1/resource/{id}/dependents", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/readme", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/yaml", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/lint", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/v1/resource/{catalog}/{kind}/{name}/{version}/images", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/v1/images", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/v1/resource/version/{versionID}", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/v1/resource/{catalog}/{kind}/{name}", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/v1/resource/{id}", h.ServeHTTP)
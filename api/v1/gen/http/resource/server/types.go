@@ -8,21 +8,43 @@
 package server
 
 import (
+	"unicode/utf8"
+
 	resource "github.com/tektoncd/hub/api/v1/gen/resource"
 	resourceviews "github.com/tektoncd/hub/api/v1/gen/resource/views"
 	goa "goa.design/goa/v3/pkg"
 )
 
+// CreateReviewRequestBody is the type of the "resource" service "CreateReview"
+// endpoint HTTP request body.
+type CreateReviewRequestBody struct {
+	// Version of the resource which is reviewed
+	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Text of the review
+	Text *string `form:"text,omitempty" json:"text,omitempty" xml:"text,omitempty"`
+}
+
+// UpdateReviewRequestBody is the type of the "resource" service "UpdateReview"
+// endpoint HTTP request body.
+type UpdateReviewRequestBody struct {
+	// Text of the review
+	Text *string `form:"text,omitempty" json:"text,omitempty" xml:"text,omitempty"`
+}
+
 // QueryResponseBody is the type of the "resource" service "Query" endpoint
 // HTTP response body.
 type QueryResponseBody struct {
 	Data ResourceDataResponseBodyWithoutVersionCollection `form:"data" json:"data" xml:"data"`
+	// Cursor to fetch the next page of resources, absent on the last page
+	Next *string `form:"next,omitempty" json:"next,omitempty" xml:"next,omitempty"`
 }
 
 // ListResponseBody is the type of the "resource" service "List" endpoint HTTP
 // response body.
 type ListResponseBody struct {
 	Data ResourceDataResponseBodyWithoutVersionCollection `form:"data" json:"data" xml:"data"`
+	// Cursor to fetch the next page of resources, absent on the last page
+	Next *string `form:"next,omitempty" json:"next,omitempty" xml:"next,omitempty"`
 }
 
 // VersionsByIDResponseBody is the type of the "resource" service
@@ -31,6 +53,66 @@ type VersionsByIDResponseBody struct {
 	Data *VersionsResponseBody `form:"data" json:"data" xml:"data"`
 }
 
+// ListReviewsResponseBody is the type of the "resource" service "ListReviews"
+// endpoint HTTP response body.
+type ListReviewsResponseBody struct {
+	Data []*ReviewResponseBody `form:"data" json:"data" xml:"data"`
+	// Cursor to fetch the next page of reviews, absent on the last page
+	Next *string `form:"next,omitempty" json:"next,omitempty" xml:"next,omitempty"`
+}
+
+// CreateReviewResponseBody is the type of the "resource" service
+// "CreateReview" endpoint HTTP response body.
+type CreateReviewResponseBody struct {
+	// ID of the review
+	ID uint `form:"id" json:"id" xml:"id"`
+	// ID of the resource
+	ResourceID uint `form:"resourceId" json:"resourceId" xml:"resourceId"`
+	// Version of the resource which is reviewed
+	Version string `form:"version" json:"version" xml:"version"`
+	// ID of the user who wrote the review
+	UserID uint `form:"userId" json:"userId" xml:"userId"`
+	// Text of the review
+	Text string `form:"text" json:"text" xml:"text"`
+	// Time at which the review was written
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// Time at which the review was last edited
+	UpdatedAt string `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+}
+
+// UpdateReviewResponseBody is the type of the "resource" service
+// "UpdateReview" endpoint HTTP response body.
+type UpdateReviewResponseBody struct {
+	// ID of the review
+	ID uint `form:"id" json:"id" xml:"id"`
+	// ID of the resource
+	ResourceID uint `form:"resourceId" json:"resourceId" xml:"resourceId"`
+	// Version of the resource which is reviewed
+	Version string `form:"version" json:"version" xml:"version"`
+	// ID of the user who wrote the review
+	UserID uint `form:"userId" json:"userId" xml:"userId"`
+	// Text of the review
+	Text string `form:"text" json:"text" xml:"text"`
+	// Time at which the review was written
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// Time at which the review was last edited
+	UpdatedAt string `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+}
+
+// DependenciesResponseBody is the type of the "resource" service
+// "Dependencies" endpoint HTTP response body.
+type DependenciesResponseBody struct {
+	// Version of the pipeline
+	Version string                    `form:"version" json:"version" xml:"version"`
+	Data    []*DependencyResponseBody `form:"data" json:"data" xml:"data"`
+}
+
+// DependentsResponseBody is the type of the "resource" service "Dependents"
+// endpoint HTTP response body.
+type DependentsResponseBody struct {
+	Data []*DependentResponseBody `form:"data" json:"data" xml:"data"`
+}
+
 // ByCatalogKindNameVersionResponseBody is the type of the "resource" service
 // "ByCatalogKindNameVersion" endpoint HTTP response body.
 type ByCatalogKindNameVersionResponseBody struct {
@@ -49,6 +131,25 @@ type ByCatalogKindNameVersionYamlResponseBody struct {
 	Data *ResourceContentResponseBodyYaml `form:"data" json:"data" xml:"data"`
 }
 
+// ByCatalogKindNameVersionLintResponseBody is the type of the "resource"
+// service "ByCatalogKindNameVersionLint" endpoint HTTP response body.
+type ByCatalogKindNameVersionLintResponseBody struct {
+	Data []*LintResultResponseBody `form:"data" json:"data" xml:"data"`
+}
+
+// ByCatalogKindNameVersionImagesResponseBody is the type of the "resource"
+// service "ByCatalogKindNameVersionImages" endpoint HTTP response body.
+type ByCatalogKindNameVersionImagesResponseBody struct {
+	// References of the images
+	Data []string `form:"data" json:"data" xml:"data"`
+}
+
+// ByImageResponseBody is the type of the "resource" service "ByImage" endpoint
+// HTTP response body.
+type ByImageResponseBody struct {
+	Data []*ImageUserResponseBody `form:"data" json:"data" xml:"data"`
+}
+
 // ByVersionIDResponseBody is the type of the "resource" service "ByVersionId"
 // endpoint HTTP response body.
 type ByVersionIDResponseBody struct {
@@ -103,6 +204,24 @@ type QueryInvalidKindResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// QueryInvalidCursorResponseBody is the type of the "resource" service "Query"
+// endpoint HTTP response body for the "invalid-cursor" error.
+type QueryInvalidCursorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // QueryNotFoundResponseBody is the type of the "resource" service "Query"
 // endpoint HTTP response body for the "not-found" error.
 type QueryNotFoundResponseBody struct {
@@ -121,6 +240,24 @@ type QueryNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// QueryInvalidTokenResponseBody is the type of the "resource" service "Query"
+// endpoint HTTP response body for the "invalid-token" error.
+type QueryInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // ListInternalErrorResponseBody is the type of the "resource" service "List"
 // endpoint HTTP response body for the "internal-error" error.
 type ListInternalErrorResponseBody struct {
@@ -139,6 +276,24 @@ type ListInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// ListInvalidCursorResponseBody is the type of the "resource" service "List"
+// endpoint HTTP response body for the "invalid-cursor" error.
+type ListInvalidCursorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // ListNotFoundResponseBody is the type of the "resource" service "List"
 // endpoint HTTP response body for the "not-found" error.
 type ListNotFoundResponseBody struct {
@@ -157,6 +312,24 @@ type ListNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// ListInvalidTokenResponseBody is the type of the "resource" service "List"
+// endpoint HTTP response body for the "invalid-token" error.
+type ListInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // VersionsByIDInternalErrorResponseBody is the type of the "resource" service
 // "VersionsByID" endpoint HTTP response body for the "internal-error" error.
 type VersionsByIDInternalErrorResponseBody struct {
@@ -193,10 +366,9 @@ type VersionsByIDNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ByCatalogKindNameVersionInternalErrorResponseBody is the type of the
-// "resource" service "ByCatalogKindNameVersion" endpoint HTTP response body
-// for the "internal-error" error.
-type ByCatalogKindNameVersionInternalErrorResponseBody struct {
+// VersionsByIDInvalidTokenResponseBody is the type of the "resource" service
+// "VersionsByID" endpoint HTTP response body for the "invalid-token" error.
+type VersionsByIDInvalidTokenResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -212,10 +384,9 @@ type ByCatalogKindNameVersionInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ByCatalogKindNameVersionNotFoundResponseBody is the type of the "resource"
-// service "ByCatalogKindNameVersion" endpoint HTTP response body for the
-// "not-found" error.
-type ByCatalogKindNameVersionNotFoundResponseBody struct {
+// ListReviewsInternalErrorResponseBody is the type of the "resource" service
+// "ListReviews" endpoint HTTP response body for the "internal-error" error.
+type ListReviewsInternalErrorResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -231,10 +402,9 @@ type ByCatalogKindNameVersionNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ByCatalogKindNameVersionReadmeInternalErrorResponseBody is the type of the
-// "resource" service "ByCatalogKindNameVersionReadme" endpoint HTTP response
-// body for the "internal-error" error.
-type ByCatalogKindNameVersionReadmeInternalErrorResponseBody struct {
+// ListReviewsInvalidCursorResponseBody is the type of the "resource" service
+// "ListReviews" endpoint HTTP response body for the "invalid-cursor" error.
+type ListReviewsInvalidCursorResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -250,10 +420,9 @@ type ByCatalogKindNameVersionReadmeInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ByCatalogKindNameVersionReadmeNotFoundResponseBody is the type of the
-// "resource" service "ByCatalogKindNameVersionReadme" endpoint HTTP response
-// body for the "not-found" error.
-type ByCatalogKindNameVersionReadmeNotFoundResponseBody struct {
+// ListReviewsNotFoundResponseBody is the type of the "resource" service
+// "ListReviews" endpoint HTTP response body for the "not-found" error.
+type ListReviewsNotFoundResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -269,10 +438,9 @@ type ByCatalogKindNameVersionReadmeNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ByCatalogKindNameVersionYamlInternalErrorResponseBody is the type of the
-// "resource" service "ByCatalogKindNameVersionYaml" endpoint HTTP response
-// body for the "internal-error" error.
-type ByCatalogKindNameVersionYamlInternalErrorResponseBody struct {
+// ListReviewsInvalidTokenResponseBody is the type of the "resource" service
+// "ListReviews" endpoint HTTP response body for the "invalid-token" error.
+type ListReviewsInvalidTokenResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -288,10 +456,9 @@ type ByCatalogKindNameVersionYamlInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ByCatalogKindNameVersionYamlNotFoundResponseBody is the type of the
-// "resource" service "ByCatalogKindNameVersionYaml" endpoint HTTP response
-// body for the "not-found" error.
-type ByCatalogKindNameVersionYamlNotFoundResponseBody struct {
+// CreateReviewInternalErrorResponseBody is the type of the "resource" service
+// "CreateReview" endpoint HTTP response body for the "internal-error" error.
+type CreateReviewInternalErrorResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -307,9 +474,9 @@ type ByCatalogKindNameVersionYamlNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ByVersionIDInternalErrorResponseBody is the type of the "resource" service
-// "ByVersionId" endpoint HTTP response body for the "internal-error" error.
-type ByVersionIDInternalErrorResponseBody struct {
+// CreateReviewInvalidPayloadResponseBody is the type of the "resource" service
+// "CreateReview" endpoint HTTP response body for the "invalid-payload" error.
+type CreateReviewInvalidPayloadResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -325,9 +492,9 @@ type ByVersionIDInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ByVersionIDNotFoundResponseBody is the type of the "resource" service
-// "ByVersionId" endpoint HTTP response body for the "not-found" error.
-type ByVersionIDNotFoundResponseBody struct {
+// CreateReviewInvalidScopesResponseBody is the type of the "resource" service
+// "CreateReview" endpoint HTTP response body for the "invalid-scopes" error.
+type CreateReviewInvalidScopesResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -343,10 +510,9 @@ type ByVersionIDNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ByCatalogKindNameInternalErrorResponseBody is the type of the "resource"
-// service "ByCatalogKindName" endpoint HTTP response body for the
-// "internal-error" error.
-type ByCatalogKindNameInternalErrorResponseBody struct {
+// CreateReviewNotFoundResponseBody is the type of the "resource" service
+// "CreateReview" endpoint HTTP response body for the "not-found" error.
+type CreateReviewNotFoundResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -362,9 +528,9 @@ type ByCatalogKindNameInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ByCatalogKindNameNotFoundResponseBody is the type of the "resource" service
-// "ByCatalogKindName" endpoint HTTP response body for the "not-found" error.
-type ByCatalogKindNameNotFoundResponseBody struct {
+// CreateReviewInvalidTokenResponseBody is the type of the "resource" service
+// "CreateReview" endpoint HTTP response body for the "invalid-token" error.
+type CreateReviewInvalidTokenResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -380,9 +546,9 @@ type ByCatalogKindNameNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ByIDInternalErrorResponseBody is the type of the "resource" service "ById"
-// endpoint HTTP response body for the "internal-error" error.
-type ByIDInternalErrorResponseBody struct {
+// UpdateReviewInternalErrorResponseBody is the type of the "resource" service
+// "UpdateReview" endpoint HTTP response body for the "internal-error" error.
+type UpdateReviewInternalErrorResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -398,9 +564,9 @@ type ByIDInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ByIDNotFoundResponseBody is the type of the "resource" service "ById"
-// endpoint HTTP response body for the "not-found" error.
-type ByIDNotFoundResponseBody struct {
+// UpdateReviewInvalidScopesResponseBody is the type of the "resource" service
+// "UpdateReview" endpoint HTTP response body for the "invalid-scopes" error.
+type UpdateReviewInvalidScopesResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -416,10 +582,9 @@ type ByIDNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// GetRawYamlByCatalogKindNameVersionInternalErrorResponseBody is the type of
-// the "resource" service "GetRawYamlByCatalogKindNameVersion" endpoint HTTP
-// response body for the "internal-error" error.
-type GetRawYamlByCatalogKindNameVersionInternalErrorResponseBody struct {
+// UpdateReviewNotFoundResponseBody is the type of the "resource" service
+// "UpdateReview" endpoint HTTP response body for the "not-found" error.
+type UpdateReviewNotFoundResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -435,10 +600,9 @@ type GetRawYamlByCatalogKindNameVersionInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// GetRawYamlByCatalogKindNameVersionNotFoundResponseBody is the type of the
-// "resource" service "GetRawYamlByCatalogKindNameVersion" endpoint HTTP
-// response body for the "not-found" error.
-type GetRawYamlByCatalogKindNameVersionNotFoundResponseBody struct {
+// UpdateReviewInvalidTokenResponseBody is the type of the "resource" service
+// "UpdateReview" endpoint HTTP response body for the "invalid-token" error.
+type UpdateReviewInvalidTokenResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -454,10 +618,9 @@ type GetRawYamlByCatalogKindNameVersionNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// GetLatestRawYamlByCatalogKindNameInternalErrorResponseBody is the type of
-// the "resource" service "GetLatestRawYamlByCatalogKindName" endpoint HTTP
-// response body for the "internal-error" error.
-type GetLatestRawYamlByCatalogKindNameInternalErrorResponseBody struct {
+// DeleteReviewInternalErrorResponseBody is the type of the "resource" service
+// "DeleteReview" endpoint HTTP response body for the "internal-error" error.
+type DeleteReviewInternalErrorResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -473,10 +636,9 @@ type GetLatestRawYamlByCatalogKindNameInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// GetLatestRawYamlByCatalogKindNameNotFoundResponseBody is the type of the
-// "resource" service "GetLatestRawYamlByCatalogKindName" endpoint HTTP
-// response body for the "not-found" error.
-type GetLatestRawYamlByCatalogKindNameNotFoundResponseBody struct {
+// DeleteReviewInvalidScopesResponseBody is the type of the "resource" service
+// "DeleteReview" endpoint HTTP response body for the "invalid-scopes" error.
+type DeleteReviewInvalidScopesResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -492,338 +654,1901 @@ type GetLatestRawYamlByCatalogKindNameNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ResourceDataResponseBodyWithoutVersionCollection is used to define fields on
-// response body types.
-type ResourceDataResponseBodyWithoutVersionCollection []*ResourceDataResponseBodyWithoutVersion
+// DeleteReviewNotFoundResponseBody is the type of the "resource" service
+// "DeleteReview" endpoint HTTP response body for the "not-found" error.
+type DeleteReviewNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
 
-// ResourceDataResponseBodyWithoutVersion is used to define fields on response
-// body types.
-type ResourceDataResponseBodyWithoutVersion struct {
-	// ID is the unique id of the resource
-	ID uint `form:"id" json:"id" xml:"id"`
-	// Name of resource
+// DeleteReviewInvalidTokenResponseBody is the type of the "resource" service
+// "DeleteReview" endpoint HTTP response body for the "invalid-token" error.
+type DeleteReviewInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
-	// Type of catalog to which resource belongs
-	Catalog *CatalogResponseBodyMin `form:"catalog" json:"catalog" xml:"catalog"`
-	// Categories related to resource
-	Categories []*CategoryResponseBody `form:"categories" json:"categories" xml:"categories"`
-	// Kind of resource
-	Kind string `form:"kind" json:"kind" xml:"kind"`
-	// Url path of the resource in hub
-	HubURLPath string `form:"hubURLPath" json:"hubURLPath" xml:"hubURLPath"`
-	// Path of the api to get the raw yaml of resource from hub apiserver
-	HubRawURLPath string `form:"hubRawURLPath" json:"hubRawURLPath" xml:"hubRawURLPath"`
-	// Latest version of resource
-	LatestVersion *ResourceVersionDataResponseBodyWithoutResource `form:"latestVersion" json:"latestVersion" xml:"latestVersion"`
-	// Tags related to resource
-	Tags []*TagResponseBody `form:"tags" json:"tags" xml:"tags"`
-	// Platforms related to resource
-	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
-	// Rating of resource
-	Rating float64 `form:"rating" json:"rating" xml:"rating"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// CatalogResponseBodyMin is used to define fields on response body types.
-type CatalogResponseBodyMin struct {
-	// ID is the unique id of the catalog
-	ID uint `form:"id" json:"id" xml:"id"`
-	// Name of catalog
+// DependenciesInternalErrorResponseBody is the type of the "resource" service
+// "Dependencies" endpoint HTTP response body for the "internal-error" error.
+type DependenciesInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
-	// Type of catalog
-	Type string `form:"type" json:"type" xml:"type"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// CategoryResponseBody is used to define fields on response body types.
-type CategoryResponseBody struct {
-	// ID is the unique id of the category
-	ID uint `form:"id" json:"id" xml:"id"`
-	// Name of category
+// DependenciesNotFoundResponseBody is the type of the "resource" service
+// "Dependencies" endpoint HTTP response body for the "not-found" error.
+type DependenciesNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ResourceVersionDataResponseBodyWithoutResource is used to define fields on
-// response body types.
-type ResourceVersionDataResponseBodyWithoutResource struct {
-	// ID is the unique id of resource's version
-	ID uint `form:"id" json:"id" xml:"id"`
-	// Version of resource
-	Version string `form:"version" json:"version" xml:"version"`
-	// Display name of version
-	DisplayName string `form:"displayName" json:"displayName" xml:"displayName"`
-	// Deprecation status of a version
-	Deprecated *bool `form:"deprecated,omitempty" json:"deprecated,omitempty" xml:"deprecated,omitempty"`
-	// Description of version
-	Description string `form:"description" json:"description" xml:"description"`
-	// Minimum pipelines version the resource's version is compatible with
-	MinPipelinesVersion string `form:"minPipelinesVersion" json:"minPipelinesVersion" xml:"minPipelinesVersion"`
-	// Raw URL of resource's yaml file of the version
-	RawURL string `form:"rawURL" json:"rawURL" xml:"rawURL"`
-	// Web URL of resource's yaml file of the version
-	WebURL string `form:"webURL" json:"webURL" xml:"webURL"`
-	// Path of the api to get the raw yaml of resource from hub apiserver
-	HubRawURLPath string `form:"hubRawURLPath" json:"hubRawURLPath" xml:"hubRawURLPath"`
-	// Url path of the resource in hub
-	HubURLPath string `form:"hubURLPath" json:"hubURLPath" xml:"hubURLPath"`
-	// Timestamp when version was last updated
-	UpdatedAt string `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
-	// Platforms related to resource version
-	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
+// DependenciesInvalidTokenResponseBody is the type of the "resource" service
+// "Dependencies" endpoint HTTP response body for the "invalid-token" error.
+type DependenciesInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// PlatformResponseBody is used to define fields on response body types.
-type PlatformResponseBody struct {
-	// ID is the unique id of platform
-	ID uint `form:"id" json:"id" xml:"id"`
-	// Name of platform
+// DependentsInternalErrorResponseBody is the type of the "resource" service
+// "Dependents" endpoint HTTP response body for the "internal-error" error.
+type DependentsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// TagResponseBody is used to define fields on response body types.
-type TagResponseBody struct {
-	// ID is the unique id of tag
-	ID uint `form:"id" json:"id" xml:"id"`
-	// Name of tag
+// DependentsNotFoundResponseBody is the type of the "resource" service
+// "Dependents" endpoint HTTP response body for the "not-found" error.
+type DependentsNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// VersionsResponseBody is used to define fields on response body types.
-type VersionsResponseBody struct {
-	// Latest Version of resource
-	Latest *ResourceVersionDataResponseBodyMin `form:"latest" json:"latest" xml:"latest"`
-	// List of all versions of resource
-	Versions []*ResourceVersionDataResponseBodyMin `form:"versions" json:"versions" xml:"versions"`
+// DependentsInvalidTokenResponseBody is the type of the "resource" service
+// "Dependents" endpoint HTTP response body for the "invalid-token" error.
+type DependentsInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// ResourceVersionDataResponseBodyMin is used to define fields on response body
-// types.
-type ResourceVersionDataResponseBodyMin struct {
-	// ID is the unique id of resource's version
-	ID uint `form:"id" json:"id" xml:"id"`
-	// Version of resource
-	Version string `form:"version" json:"version" xml:"version"`
-	// Raw URL of resource's yaml file of the version
-	RawURL string `form:"rawURL" json:"rawURL" xml:"rawURL"`
-	// Web URL of resource's yaml file of the version
-	WebURL string `form:"webURL" json:"webURL" xml:"webURL"`
-	// Path of the api to get the raw yaml of resource from hub apiserver
-	HubRawURLPath string `form:"hubRawURLPath" json:"hubRawURLPath" xml:"hubRawURLPath"`
-	// Url path of the resource in hub
-	HubURLPath string `form:"hubURLPath" json:"hubURLPath" xml:"hubURLPath"`
-	// Platforms related to resource version
-	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
+// ByCatalogKindNameVersionInternalErrorResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersion" endpoint HTTP response body
+// for the "internal-error" error.
+type ByCatalogKindNameVersionInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionNotFoundResponseBody is the type of the "resource"
+// service "ByCatalogKindNameVersion" endpoint HTTP response body for the
+// "not-found" error.
+type ByCatalogKindNameVersionNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionInvalidTokenResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersion" endpoint HTTP response body
+// for the "invalid-token" error.
+type ByCatalogKindNameVersionInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionReadmeInternalErrorResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionReadme" endpoint HTTP response
+// body for the "internal-error" error.
+type ByCatalogKindNameVersionReadmeInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionReadmeNotFoundResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionReadme" endpoint HTTP response
+// body for the "not-found" error.
+type ByCatalogKindNameVersionReadmeNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionReadmeInvalidTokenResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionReadme" endpoint HTTP response
+// body for the "invalid-token" error.
+type ByCatalogKindNameVersionReadmeInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionYamlInternalErrorResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionYaml" endpoint HTTP response
+// body for the "internal-error" error.
+type ByCatalogKindNameVersionYamlInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionYamlNotFoundResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionYaml" endpoint HTTP response
+// body for the "not-found" error.
+type ByCatalogKindNameVersionYamlNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionYamlInvalidTokenResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionYaml" endpoint HTTP response
+// body for the "invalid-token" error.
+type ByCatalogKindNameVersionYamlInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionLintInternalErrorResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionLint" endpoint HTTP response
+// body for the "internal-error" error.
+type ByCatalogKindNameVersionLintInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionLintNotFoundResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionLint" endpoint HTTP response
+// body for the "not-found" error.
+type ByCatalogKindNameVersionLintNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionLintInvalidTokenResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionLint" endpoint HTTP response
+// body for the "invalid-token" error.
+type ByCatalogKindNameVersionLintInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionImagesInternalErrorResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionImages" endpoint HTTP response
+// body for the "internal-error" error.
+type ByCatalogKindNameVersionImagesInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionImagesNotFoundResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionImages" endpoint HTTP response
+// body for the "not-found" error.
+type ByCatalogKindNameVersionImagesNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameVersionImagesInvalidTokenResponseBody is the type of the
+// "resource" service "ByCatalogKindNameVersionImages" endpoint HTTP response
+// body for the "invalid-token" error.
+type ByCatalogKindNameVersionImagesInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByImageInternalErrorResponseBody is the type of the "resource" service
+// "ByImage" endpoint HTTP response body for the "internal-error" error.
+type ByImageInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByImageInvalidTokenResponseBody is the type of the "resource" service
+// "ByImage" endpoint HTTP response body for the "invalid-token" error.
+type ByImageInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByVersionIDInternalErrorResponseBody is the type of the "resource" service
+// "ByVersionId" endpoint HTTP response body for the "internal-error" error.
+type ByVersionIDInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByVersionIDNotFoundResponseBody is the type of the "resource" service
+// "ByVersionId" endpoint HTTP response body for the "not-found" error.
+type ByVersionIDNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByVersionIDInvalidTokenResponseBody is the type of the "resource" service
+// "ByVersionId" endpoint HTTP response body for the "invalid-token" error.
+type ByVersionIDInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameInternalErrorResponseBody is the type of the "resource"
+// service "ByCatalogKindName" endpoint HTTP response body for the
+// "internal-error" error.
+type ByCatalogKindNameInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameNotFoundResponseBody is the type of the "resource" service
+// "ByCatalogKindName" endpoint HTTP response body for the "not-found" error.
+type ByCatalogKindNameNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByCatalogKindNameInvalidTokenResponseBody is the type of the "resource"
+// service "ByCatalogKindName" endpoint HTTP response body for the
+// "invalid-token" error.
+type ByCatalogKindNameInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByIDInternalErrorResponseBody is the type of the "resource" service "ById"
+// endpoint HTTP response body for the "internal-error" error.
+type ByIDInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByIDNotFoundResponseBody is the type of the "resource" service "ById"
+// endpoint HTTP response body for the "not-found" error.
+type ByIDNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ByIDInvalidTokenResponseBody is the type of the "resource" service "ById"
+// endpoint HTTP response body for the "invalid-token" error.
+type ByIDInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetRawYamlByCatalogKindNameVersionInternalErrorResponseBody is the type of
+// the "resource" service "GetRawYamlByCatalogKindNameVersion" endpoint HTTP
+// response body for the "internal-error" error.
+type GetRawYamlByCatalogKindNameVersionInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetRawYamlByCatalogKindNameVersionNotFoundResponseBody is the type of the
+// "resource" service "GetRawYamlByCatalogKindNameVersion" endpoint HTTP
+// response body for the "not-found" error.
+type GetRawYamlByCatalogKindNameVersionNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetRawYamlByCatalogKindNameVersionInvalidTokenResponseBody is the type of
+// the "resource" service "GetRawYamlByCatalogKindNameVersion" endpoint HTTP
+// response body for the "invalid-token" error.
+type GetRawYamlByCatalogKindNameVersionInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetLatestRawYamlByCatalogKindNameInternalErrorResponseBody is the type of
+// the "resource" service "GetLatestRawYamlByCatalogKindName" endpoint HTTP
+// response body for the "internal-error" error.
+type GetLatestRawYamlByCatalogKindNameInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetLatestRawYamlByCatalogKindNameNotFoundResponseBody is the type of the
+// "resource" service "GetLatestRawYamlByCatalogKindName" endpoint HTTP
+// response body for the "not-found" error.
+type GetLatestRawYamlByCatalogKindNameNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetLatestRawYamlByCatalogKindNameInvalidTokenResponseBody is the type of the
+// "resource" service "GetLatestRawYamlByCatalogKindName" endpoint HTTP
+// response body for the "invalid-token" error.
+type GetLatestRawYamlByCatalogKindNameInvalidTokenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ResourceDataResponseBodyWithoutVersionCollection is used to define fields on
+// response body types.
+type ResourceDataResponseBodyWithoutVersionCollection []*ResourceDataResponseBodyWithoutVersion
+
+// ResourceDataResponseBodyWithoutVersion is used to define fields on response
+// body types.
+type ResourceDataResponseBodyWithoutVersion struct {
+	// ID is the unique id of the resource
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Name of resource
+	Name string `form:"name" json:"name" xml:"name"`
+	// Type of catalog to which resource belongs
+	Catalog *CatalogResponseBodyMin `form:"catalog" json:"catalog" xml:"catalog"`
+	// Categories related to resource
+	Categories []*CategoryResponseBody `form:"categories" json:"categories" xml:"categories"`
+	// Kind of resource
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Url path of the resource in hub
+	HubURLPath string `form:"hubURLPath" json:"hubURLPath" xml:"hubURLPath"`
+	// Path of the api to get the raw yaml of resource from hub apiserver
+	HubRawURLPath string `form:"hubRawURLPath" json:"hubRawURLPath" xml:"hubRawURLPath"`
+	// Latest version of resource
+	LatestVersion *ResourceVersionDataResponseBodyWithoutResource `form:"latestVersion" json:"latestVersion" xml:"latestVersion"`
+	// Tags related to resource
+	Tags []*TagResponseBody `form:"tags" json:"tags" xml:"tags"`
+	// Platforms related to resource
+	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
+	// Rating of resource
+	Rating float64 `form:"rating" json:"rating" xml:"rating"`
+	// Number of downloads of all versions of resource
+	Downloads uint `form:"downloads" json:"downloads" xml:"downloads"`
+}
+
+// CatalogResponseBodyMin is used to define fields on response body types.
+type CatalogResponseBodyMin struct {
+	// ID is the unique id of the catalog
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Name of catalog
+	Name string `form:"name" json:"name" xml:"name"`
+	// Type of catalog
+	Type string `form:"type" json:"type" xml:"type"`
+}
+
+// CategoryResponseBody is used to define fields on response body types.
+type CategoryResponseBody struct {
+	// ID is the unique id of the category
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Name of category
+	Name string `form:"name" json:"name" xml:"name"`
+}
+
+// ResourceVersionDataResponseBodyWithoutResource is used to define fields on
+// response body types.
+type ResourceVersionDataResponseBodyWithoutResource struct {
+	// ID is the unique id of resource's version
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Version of resource
+	Version string `form:"version" json:"version" xml:"version"`
+	// Display name of version
+	DisplayName string `form:"displayName" json:"displayName" xml:"displayName"`
+	// Deprecation status of a version
+	Deprecated *bool `form:"deprecated,omitempty" json:"deprecated,omitempty" xml:"deprecated,omitempty"`
+	// Description of version
+	Description string `form:"description" json:"description" xml:"description"`
+	// Minimum pipelines version the resource's version is compatible with
+	MinPipelinesVersion string `form:"minPipelinesVersion" json:"minPipelinesVersion" xml:"minPipelinesVersion"`
+	// Raw URL of resource's yaml file of the version
+	RawURL string `form:"rawURL" json:"rawURL" xml:"rawURL"`
+	// Web URL of resource's yaml file of the version
+	WebURL string `form:"webURL" json:"webURL" xml:"webURL"`
+	// Path of the api to get the raw yaml of resource from hub apiserver
+	HubRawURLPath string `form:"hubRawURLPath" json:"hubRawURLPath" xml:"hubRawURLPath"`
+	// Url path of the resource in hub
+	HubURLPath string `form:"hubURLPath" json:"hubURLPath" xml:"hubURLPath"`
+	// Timestamp when version was last updated
+	UpdatedAt string `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+	// Platforms related to resource version
+	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
+	// Tekton bundle (OCI image) reference of the version
+	Bundle *string `form:"bundle,omitempty" json:"bundle,omitempty" xml:"bundle,omitempty"`
+	// Tekton apiVersion of the resource manifest
+	APIVersion *string `form:"apiVersion,omitempty" json:"apiVersion,omitempty" xml:"apiVersion,omitempty"`
+	// Verification of the trusted resource signature of the version with the
+	// public keys of its catalog
+	Verification *string `form:"verification,omitempty" json:"verification,omitempty" xml:"verification,omitempty"`
+}
+
+// PlatformResponseBody is used to define fields on response body types.
+type PlatformResponseBody struct {
+	// ID is the unique id of platform
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Name of platform
+	Name string `form:"name" json:"name" xml:"name"`
+}
+
+// TagResponseBody is used to define fields on response body types.
+type TagResponseBody struct {
+	// ID is the unique id of tag
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Name of tag
+	Name string `form:"name" json:"name" xml:"name"`
+}
+
+// VersionsResponseBody is used to define fields on response body types.
+type VersionsResponseBody struct {
+	// Latest Version of resource
+	Latest *ResourceVersionDataResponseBodyMin `form:"latest" json:"latest" xml:"latest"`
+	// List of all versions of resource
+	Versions []*ResourceVersionDataResponseBodyMin `form:"versions" json:"versions" xml:"versions"`
+}
+
+// ResourceVersionDataResponseBodyMin is used to define fields on response body
+// types.
+type ResourceVersionDataResponseBodyMin struct {
+	// ID is the unique id of resource's version
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Version of resource
+	Version string `form:"version" json:"version" xml:"version"`
+	// Raw URL of resource's yaml file of the version
+	RawURL string `form:"rawURL" json:"rawURL" xml:"rawURL"`
+	// Web URL of resource's yaml file of the version
+	WebURL string `form:"webURL" json:"webURL" xml:"webURL"`
+	// Path of the api to get the raw yaml of resource from hub apiserver
+	HubRawURLPath string `form:"hubRawURLPath" json:"hubRawURLPath" xml:"hubRawURLPath"`
+	// Url path of the resource in hub
+	HubURLPath string `form:"hubURLPath" json:"hubURLPath" xml:"hubURLPath"`
+	// Platforms related to resource version
+	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
+}
+
+// ReviewResponseBody is used to define fields on response body types.
+type ReviewResponseBody struct {
+	// ID of the review
+	ID uint `form:"id" json:"id" xml:"id"`
+	// ID of the resource
+	ResourceID uint `form:"resourceId" json:"resourceId" xml:"resourceId"`
+	// Version of the resource which is reviewed
+	Version string `form:"version" json:"version" xml:"version"`
+	// ID of the user who wrote the review
+	UserID uint `form:"userId" json:"userId" xml:"userId"`
+	// Text of the review
+	Text string `form:"text" json:"text" xml:"text"`
+	// Time at which the review was written
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// Time at which the review was last edited
+	UpdatedAt string `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+}
+
+// DependencyResponseBody is used to define fields on response body types.
+type DependencyResponseBody struct {
+	// Name of the task
+	Name string `form:"name" json:"name" xml:"name"`
+	// Kind of the task
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// How the pipeline references the task
+	Via string `form:"via" json:"via" xml:"via"`
+	// Version of the task, absent if the reference doesn't pin it
+	Version *string `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Name of the catalog of the task, if known
+	Catalog *string `form:"catalog,omitempty" json:"catalog,omitempty" xml:"catalog,omitempty"`
+	// Bundle of the task referenced through a bundle
+	Bundle *string `form:"bundle,omitempty" json:"bundle,omitempty" xml:"bundle,omitempty"`
+	// URL of the repository of the task referenced through git
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// ID of the resource in the hub the task resolves to
+	ResourceID *uint `form:"resourceId,omitempty" json:"resourceId,omitempty" xml:"resourceId,omitempty"`
+}
+
+// DependentResponseBody is used to define fields on response body types.
+type DependentResponseBody struct {
+	// ID of the pipeline
+	ResourceID uint `form:"resourceId" json:"resourceId" xml:"resourceId"`
+	// Name of the pipeline
+	Name string `form:"name" json:"name" xml:"name"`
+	// Name of the catalog of the pipeline
+	Catalog string `form:"catalog" json:"catalog" xml:"catalog"`
+	// Version of the pipeline
+	Version string `form:"version" json:"version" xml:"version"`
+	// Version of the task referenced by the pipeline, absent if the reference
+	// doesn't pin it
+	TaskVersion *string `form:"taskVersion,omitempty" json:"taskVersion,omitempty" xml:"taskVersion,omitempty"`
+}
+
+// ResourceVersionDataResponseBody is used to define fields on response body
+// types.
+type ResourceVersionDataResponseBody struct {
+	// ID is the unique id of resource's version
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Version of resource
+	Version string `form:"version" json:"version" xml:"version"`
+	// Display name of version
+	DisplayName string `form:"displayName" json:"displayName" xml:"displayName"`
+	// Deprecation status of a version
+	Deprecated *bool `form:"deprecated,omitempty" json:"deprecated,omitempty" xml:"deprecated,omitempty"`
+	// Description of version
+	Description string `form:"description" json:"description" xml:"description"`
+	// Minimum pipelines version the resource's version is compatible with
+	MinPipelinesVersion string `form:"minPipelinesVersion" json:"minPipelinesVersion" xml:"minPipelinesVersion"`
+	// Raw URL of resource's yaml file of the version
+	RawURL string `form:"rawURL" json:"rawURL" xml:"rawURL"`
+	// Web URL of resource's yaml file of the version
+	WebURL string `form:"webURL" json:"webURL" xml:"webURL"`
+	// Url path of the resource in hub
+	HubURLPath string `form:"hubURLPath" json:"hubURLPath" xml:"hubURLPath"`
+	// Path of the api to get the raw yaml of resource from hub apiserver
+	HubRawURLPath string `form:"hubRawURLPath" json:"hubRawURLPath" xml:"hubRawURLPath"`
+	// Timestamp when version was last updated
+	UpdatedAt string `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+	// Resource to which the version belongs
+	Resource *ResourceDataResponseBodyInfo `form:"resource" json:"resource" xml:"resource"`
+	// Platforms related to resource version
+	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
+	// Tekton bundle (OCI image) reference of the version
+	Bundle *string `form:"bundle,omitempty" json:"bundle,omitempty" xml:"bundle,omitempty"`
+	// Tekton apiVersion of the resource manifest
+	APIVersion *string `form:"apiVersion,omitempty" json:"apiVersion,omitempty" xml:"apiVersion,omitempty"`
+	// Verification of the trusted resource signature of the version with the
+	// public keys of its catalog
+	Verification *string `form:"verification,omitempty" json:"verification,omitempty" xml:"verification,omitempty"`
+	// Params of the version
+	Params []*ParamSpecResponseBody `form:"params,omitempty" json:"params,omitempty" xml:"params,omitempty"`
+	// Workspaces of the version
+	Workspaces []*WorkspaceSpecResponseBody `form:"workspaces,omitempty" json:"workspaces,omitempty" xml:"workspaces,omitempty"`
+	// Results of the version
+	Results []*ResultSpecResponseBody `form:"results,omitempty" json:"results,omitempty" xml:"results,omitempty"`
+}
+
+// ResourceDataResponseBodyInfo is used to define fields on response body types.
+type ResourceDataResponseBodyInfo struct {
+	// ID is the unique id of the resource
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Name of resource
+	Name string `form:"name" json:"name" xml:"name"`
+	// Type of catalog to which resource belongs
+	Catalog *CatalogResponseBodyMin `form:"catalog" json:"catalog" xml:"catalog"`
+	// Categories related to resource
+	Categories []*CategoryResponseBody `form:"categories" json:"categories" xml:"categories"`
+	// Kind of resource
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Url path of the resource in hub
+	HubURLPath string `form:"hubURLPath" json:"hubURLPath" xml:"hubURLPath"`
+	// Tags related to resource
+	Tags []*TagResponseBody `form:"tags" json:"tags" xml:"tags"`
+	// Platforms related to resource
+	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
+	// Rating of resource
+	Rating float64 `form:"rating" json:"rating" xml:"rating"`
+}
+
+// ParamSpecResponseBody is used to define fields on response body types.
+type ParamSpecResponseBody struct {
+	// Name of the param
+	Name string `form:"name" json:"name" xml:"name"`
+	// Type of the param
+	Type string `form:"type" json:"type" xml:"type"`
+	// Default of the param, JSON for array and object params
+	Default *string `form:"default,omitempty" json:"default,omitempty" xml:"default,omitempty"`
+	// Description of the param
+	Description string `form:"description" json:"description" xml:"description"`
+}
+
+// WorkspaceSpecResponseBody is used to define fields on response body types.
+type WorkspaceSpecResponseBody struct {
+	// Name of the workspace
+	Name string `form:"name" json:"name" xml:"name"`
+	// Description of the workspace
+	Description string `form:"description" json:"description" xml:"description"`
+	// Whether the workspace may not be bound
+	Optional bool `form:"optional" json:"optional" xml:"optional"`
+	// Whether the task only reads the workspace
+	ReadOnly bool `form:"readOnly" json:"readOnly" xml:"readOnly"`
+}
+
+// ResultSpecResponseBody is used to define fields on response body types.
+type ResultSpecResponseBody struct {
+	// Name of the result
+	Name string `form:"name" json:"name" xml:"name"`
+	// Type of the result
+	Type string `form:"type" json:"type" xml:"type"`
+	// Description of the result
+	Description string `form:"description" json:"description" xml:"description"`
+}
+
+// ResourceContentResponseBodyReadme is used to define fields on response body
+// types.
+type ResourceContentResponseBodyReadme struct {
+	// Readme
+	Readme *string `form:"readme,omitempty" json:"readme,omitempty" xml:"readme,omitempty"`
+}
+
+// ResourceContentResponseBodyYaml is used to define fields on response body
+// types.
+type ResourceContentResponseBodyYaml struct {
+	// Yaml
+	Yaml *string `form:"yaml,omitempty" json:"yaml,omitempty" xml:"yaml,omitempty"`
+}
+
+// LintResultResponseBody is used to define fields on response body types.
+type LintResultResponseBody struct {
+	// ID of the rule
+	Rule string `form:"rule" json:"rule" xml:"rule"`
+	// Severity of the problem
+	Severity string `form:"severity" json:"severity" xml:"severity"`
+	// Description of the problem
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ImageUserResponseBody is used to define fields on response body types.
+type ImageUserResponseBody struct {
+	// ID of the resource
+	ResourceID uint `form:"resourceId" json:"resourceId" xml:"resourceId"`
+	// Name of the catalog of the resource
+	Catalog string `form:"catalog" json:"catalog" xml:"catalog"`
+	// Kind of the resource
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Name of the resource
+	Name string `form:"name" json:"name" xml:"name"`
+	// Version of the resource
+	Version string `form:"version" json:"version" xml:"version"`
+	// Reference of the image used by the version
+	Image string `form:"image" json:"image" xml:"image"`
+}
+
+// ResourceDataResponseBody is used to define fields on response body types.
+type ResourceDataResponseBody struct {
+	// ID is the unique id of the resource
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Name of resource
+	Name string `form:"name" json:"name" xml:"name"`
+	// Type of catalog to which resource belongs
+	Catalog *CatalogResponseBodyMin `form:"catalog" json:"catalog" xml:"catalog"`
+	// Categories related to resource
+	Categories []*CategoryResponseBody `form:"categories" json:"categories" xml:"categories"`
+	// Kind of resource
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Url path of the resource in hub
+	HubURLPath string `form:"hubURLPath" json:"hubURLPath" xml:"hubURLPath"`
+	// Path of the api to get the raw yaml of resource from hub apiserver
+	HubRawURLPath string `form:"hubRawURLPath" json:"hubRawURLPath" xml:"hubRawURLPath"`
+	// Latest version of resource
+	LatestVersion *ResourceVersionDataResponseBodyWithoutResource `form:"latestVersion" json:"latestVersion" xml:"latestVersion"`
+	// Tags related to resource
+	Tags []*TagResponseBody `form:"tags" json:"tags" xml:"tags"`
+	// Platforms related to resource
+	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
+	// Rating of resource
+	Rating float64 `form:"rating" json:"rating" xml:"rating"`
+	// Number of downloads of all versions of resource
+	Downloads uint `form:"downloads" json:"downloads" xml:"downloads"`
+	// List of all versions of a resource
+	Versions []*ResourceVersionDataResponseBodyTiny `form:"versions" json:"versions" xml:"versions"`
+}
+
+// ResourceVersionDataResponseBodyTiny is used to define fields on response
+// body types.
+type ResourceVersionDataResponseBodyTiny struct {
+	// ID is the unique id of resource's version
+	ID uint `form:"id" json:"id" xml:"id"`
+	// Version of resource
+	Version string `form:"version" json:"version" xml:"version"`
+	// Tekton bundle (OCI image) reference of the version
+	Bundle *string `form:"bundle,omitempty" json:"bundle,omitempty" xml:"bundle,omitempty"`
+}
+
+// NewQueryResponseBody builds the HTTP response body from the result of the
+// "Query" endpoint of the "resource" service.
+func NewQueryResponseBody(res *resourceviews.ResourcesView) *QueryResponseBody {
+	body := &QueryResponseBody{
+		Next: res.Next,
+	}
+	if res.Data != nil {
+		body.Data = make([]*ResourceDataResponseBodyWithoutVersion, len(res.Data))
+		for i, val := range res.Data {
+			body.Data[i] = marshalResourceviewsResourceDataViewToResourceDataResponseBodyWithoutVersion(val)
+		}
+	} else {
+		body.Data = []*ResourceDataResponseBodyWithoutVersion{}
+	}
+	return body
+}
+
+// NewListResponseBody builds the HTTP response body from the result of the
+// "List" endpoint of the "resource" service.
+func NewListResponseBody(res *resourceviews.ResourcesView) *ListResponseBody {
+	body := &ListResponseBody{
+		Next: res.Next,
+	}
+	if res.Data != nil {
+		body.Data = make([]*ResourceDataResponseBodyWithoutVersion, len(res.Data))
+		for i, val := range res.Data {
+			body.Data[i] = marshalResourceviewsResourceDataViewToResourceDataResponseBodyWithoutVersion(val)
+		}
+	} else {
+		body.Data = []*ResourceDataResponseBodyWithoutVersion{}
+	}
+	return body
+}
+
+// NewVersionsByIDResponseBody builds the HTTP response body from the result of
+// the "VersionsByID" endpoint of the "resource" service.
+func NewVersionsByIDResponseBody(res *resourceviews.ResourceVersionsView) *VersionsByIDResponseBody {
+	body := &VersionsByIDResponseBody{}
+	if res.Data != nil {
+		body.Data = marshalResourceviewsVersionsViewToVersionsResponseBody(res.Data)
+	}
+	return body
+}
+
+// NewListReviewsResponseBody builds the HTTP response body from the result of
+// the "ListReviews" endpoint of the "resource" service.
+func NewListReviewsResponseBody(res *resource.Reviews) *ListReviewsResponseBody {
+	body := &ListReviewsResponseBody{
+		Next: res.Next,
+	}
+	if res.Data != nil {
+		body.Data = make([]*ReviewResponseBody, len(res.Data))
+		for i, val := range res.Data {
+			body.Data[i] = marshalResourceReviewToReviewResponseBody(val)
+		}
+	} else {
+		body.Data = []*ReviewResponseBody{}
+	}
+	return body
+}
+
+// NewCreateReviewResponseBody builds the HTTP response body from the result of
+// the "CreateReview" endpoint of the "resource" service.
+func NewCreateReviewResponseBody(res *resource.Review) *CreateReviewResponseBody {
+	body := &CreateReviewResponseBody{
+		ID:         res.ID,
+		ResourceID: res.ResourceID,
+		Version:    res.Version,
+		UserID:     res.UserID,
+		Text:       res.Text,
+		CreatedAt:  res.CreatedAt,
+		UpdatedAt:  res.UpdatedAt,
+	}
+	return body
+}
+
+// NewUpdateReviewResponseBody builds the HTTP response body from the result of
+// the "UpdateReview" endpoint of the "resource" service.
+func NewUpdateReviewResponseBody(res *resource.Review) *UpdateReviewResponseBody {
+	body := &UpdateReviewResponseBody{
+		ID:         res.ID,
+		ResourceID: res.ResourceID,
+		Version:    res.Version,
+		UserID:     res.UserID,
+		Text:       res.Text,
+		CreatedAt:  res.CreatedAt,
+		UpdatedAt:  res.UpdatedAt,
+	}
+	return body
+}
+
+// NewDependenciesResponseBody builds the HTTP response body from the result of
+// the "Dependencies" endpoint of the "resource" service.
+func NewDependenciesResponseBody(res *resource.PipelineDependencies) *DependenciesResponseBody {
+	body := &DependenciesResponseBody{
+		Version: res.Version,
+	}
+	if res.Data != nil {
+		body.Data = make([]*DependencyResponseBody, len(res.Data))
+		for i, val := range res.Data {
+			body.Data[i] = marshalResourceDependencyToDependencyResponseBody(val)
+		}
+	} else {
+		body.Data = []*DependencyResponseBody{}
+	}
+	return body
+}
+
+// NewDependentsResponseBody builds the HTTP response body from the result of
+// the "Dependents" endpoint of the "resource" service.
+func NewDependentsResponseBody(res *resource.TaskDependents) *DependentsResponseBody {
+	body := &DependentsResponseBody{}
+	if res.Data != nil {
+		body.Data = make([]*DependentResponseBody, len(res.Data))
+		for i, val := range res.Data {
+			body.Data[i] = marshalResourceDependentToDependentResponseBody(val)
+		}
+	} else {
+		body.Data = []*DependentResponseBody{}
+	}
+	return body
+}
+
+// NewByCatalogKindNameVersionResponseBody builds the HTTP response body from
+// the result of the "ByCatalogKindNameVersion" endpoint of the "resource"
+// service.
+func NewByCatalogKindNameVersionResponseBody(res *resourceviews.ResourceVersionView) *ByCatalogKindNameVersionResponseBody {
+	body := &ByCatalogKindNameVersionResponseBody{}
+	if res.Data != nil {
+		body.Data = marshalResourceviewsResourceVersionDataViewToResourceVersionDataResponseBody(res.Data)
+	}
+	return body
+}
+
+// NewByCatalogKindNameVersionReadmeResponseBody builds the HTTP response body
+// from the result of the "ByCatalogKindNameVersionReadme" endpoint of the
+// "resource" service.
+func NewByCatalogKindNameVersionReadmeResponseBody(res *resourceviews.ResourceVersionReadmeView) *ByCatalogKindNameVersionReadmeResponseBody {
+	body := &ByCatalogKindNameVersionReadmeResponseBody{}
+	if res.Data != nil {
+		body.Data = marshalResourceviewsResourceContentViewToResourceContentResponseBodyReadme(res.Data)
+	}
+	return body
+}
+
+// NewByCatalogKindNameVersionYamlResponseBody builds the HTTP response body
+// from the result of the "ByCatalogKindNameVersionYaml" endpoint of the
+// "resource" service.
+func NewByCatalogKindNameVersionYamlResponseBody(res *resourceviews.ResourceVersionYamlView) *ByCatalogKindNameVersionYamlResponseBody {
+	body := &ByCatalogKindNameVersionYamlResponseBody{}
+	if res.Data != nil {
+		body.Data = marshalResourceviewsResourceContentViewToResourceContentResponseBodyYaml(res.Data)
+	}
+	return body
+}
+
+// NewByCatalogKindNameVersionLintResponseBody builds the HTTP response body
+// from the result of the "ByCatalogKindNameVersionLint" endpoint of the
+// "resource" service.
+func NewByCatalogKindNameVersionLintResponseBody(res *resource.VersionLint) *ByCatalogKindNameVersionLintResponseBody {
+	body := &ByCatalogKindNameVersionLintResponseBody{}
+	if res.Data != nil {
+		body.Data = make([]*LintResultResponseBody, len(res.Data))
+		for i, val := range res.Data {
+			body.Data[i] = marshalResourceLintResultToLintResultResponseBody(val)
+		}
+	} else {
+		body.Data = []*LintResultResponseBody{}
+	}
+	return body
+}
+
+// NewByCatalogKindNameVersionImagesResponseBody builds the HTTP response body
+// from the result of the "ByCatalogKindNameVersionImages" endpoint of the
+// "resource" service.
+func NewByCatalogKindNameVersionImagesResponseBody(res *resource.VersionImages) *ByCatalogKindNameVersionImagesResponseBody {
+	body := &ByCatalogKindNameVersionImagesResponseBody{}
+	if res.Data != nil {
+		body.Data = make([]string, len(res.Data))
+		for i, val := range res.Data {
+			body.Data[i] = val
+		}
+	} else {
+		body.Data = []string{}
+	}
+	return body
+}
+
+// NewByImageResponseBody builds the HTTP response body from the result of the
+// "ByImage" endpoint of the "resource" service.
+func NewByImageResponseBody(res *resource.ImageUsers) *ByImageResponseBody {
+	body := &ByImageResponseBody{}
+	if res.Data != nil {
+		body.Data = make([]*ImageUserResponseBody, len(res.Data))
+		for i, val := range res.Data {
+			body.Data[i] = marshalResourceImageUserToImageUserResponseBody(val)
+		}
+	} else {
+		body.Data = []*ImageUserResponseBody{}
+	}
+	return body
+}
+
+// NewByVersionIDResponseBody builds the HTTP response body from the result of
+// the "ByVersionId" endpoint of the "resource" service.
+func NewByVersionIDResponseBody(res *resourceviews.ResourceVersionView) *ByVersionIDResponseBody {
+	body := &ByVersionIDResponseBody{}
+	if res.Data != nil {
+		body.Data = marshalResourceviewsResourceVersionDataViewToResourceVersionDataResponseBody(res.Data)
+	}
+	return body
+}
+
+// NewByCatalogKindNameResponseBody builds the HTTP response body from the
+// result of the "ByCatalogKindName" endpoint of the "resource" service.
+func NewByCatalogKindNameResponseBody(res *resourceviews.ResourceView) *ByCatalogKindNameResponseBody {
+	body := &ByCatalogKindNameResponseBody{}
+	if res.Data != nil {
+		body.Data = marshalResourceviewsResourceDataViewToResourceDataResponseBody(res.Data)
+	}
+	return body
+}
+
+// NewByIDResponseBody builds the HTTP response body from the result of the
+// "ById" endpoint of the "resource" service.
+func NewByIDResponseBody(res *resourceviews.ResourceView) *ByIDResponseBody {
+	body := &ByIDResponseBody{}
+	if res.Data != nil {
+		body.Data = marshalResourceviewsResourceDataViewToResourceDataResponseBody(res.Data)
+	}
+	return body
+}
+
+// NewQueryInternalErrorResponseBody builds the HTTP response body from the
+// result of the "Query" endpoint of the "resource" service.
+func NewQueryInternalErrorResponseBody(res *goa.ServiceError) *QueryInternalErrorResponseBody {
+	body := &QueryInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewQueryInvalidKindResponseBody builds the HTTP response body from the
+// result of the "Query" endpoint of the "resource" service.
+func NewQueryInvalidKindResponseBody(res *goa.ServiceError) *QueryInvalidKindResponseBody {
+	body := &QueryInvalidKindResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewQueryInvalidCursorResponseBody builds the HTTP response body from the
+// result of the "Query" endpoint of the "resource" service.
+func NewQueryInvalidCursorResponseBody(res *goa.ServiceError) *QueryInvalidCursorResponseBody {
+	body := &QueryInvalidCursorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewQueryNotFoundResponseBody builds the HTTP response body from the result
+// of the "Query" endpoint of the "resource" service.
+func NewQueryNotFoundResponseBody(res *goa.ServiceError) *QueryNotFoundResponseBody {
+	body := &QueryNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewQueryInvalidTokenResponseBody builds the HTTP response body from the
+// result of the "Query" endpoint of the "resource" service.
+func NewQueryInvalidTokenResponseBody(res *goa.ServiceError) *QueryInvalidTokenResponseBody {
+	body := &QueryInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListInternalErrorResponseBody builds the HTTP response body from the
+// result of the "List" endpoint of the "resource" service.
+func NewListInternalErrorResponseBody(res *goa.ServiceError) *ListInternalErrorResponseBody {
+	body := &ListInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListInvalidCursorResponseBody builds the HTTP response body from the
+// result of the "List" endpoint of the "resource" service.
+func NewListInvalidCursorResponseBody(res *goa.ServiceError) *ListInvalidCursorResponseBody {
+	body := &ListInvalidCursorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListNotFoundResponseBody builds the HTTP response body from the result of
+// the "List" endpoint of the "resource" service.
+func NewListNotFoundResponseBody(res *goa.ServiceError) *ListNotFoundResponseBody {
+	body := &ListNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListInvalidTokenResponseBody builds the HTTP response body from the
+// result of the "List" endpoint of the "resource" service.
+func NewListInvalidTokenResponseBody(res *goa.ServiceError) *ListInvalidTokenResponseBody {
+	body := &ListInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewVersionsByIDInternalErrorResponseBody builds the HTTP response body from
+// the result of the "VersionsByID" endpoint of the "resource" service.
+func NewVersionsByIDInternalErrorResponseBody(res *goa.ServiceError) *VersionsByIDInternalErrorResponseBody {
+	body := &VersionsByIDInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewVersionsByIDNotFoundResponseBody builds the HTTP response body from the
+// result of the "VersionsByID" endpoint of the "resource" service.
+func NewVersionsByIDNotFoundResponseBody(res *goa.ServiceError) *VersionsByIDNotFoundResponseBody {
+	body := &VersionsByIDNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewVersionsByIDInvalidTokenResponseBody builds the HTTP response body from
+// the result of the "VersionsByID" endpoint of the "resource" service.
+func NewVersionsByIDInvalidTokenResponseBody(res *goa.ServiceError) *VersionsByIDInvalidTokenResponseBody {
+	body := &VersionsByIDInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListReviewsInternalErrorResponseBody builds the HTTP response body from
+// the result of the "ListReviews" endpoint of the "resource" service.
+func NewListReviewsInternalErrorResponseBody(res *goa.ServiceError) *ListReviewsInternalErrorResponseBody {
+	body := &ListReviewsInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListReviewsInvalidCursorResponseBody builds the HTTP response body from
+// the result of the "ListReviews" endpoint of the "resource" service.
+func NewListReviewsInvalidCursorResponseBody(res *goa.ServiceError) *ListReviewsInvalidCursorResponseBody {
+	body := &ListReviewsInvalidCursorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListReviewsNotFoundResponseBody builds the HTTP response body from the
+// result of the "ListReviews" endpoint of the "resource" service.
+func NewListReviewsNotFoundResponseBody(res *goa.ServiceError) *ListReviewsNotFoundResponseBody {
+	body := &ListReviewsNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListReviewsInvalidTokenResponseBody builds the HTTP response body from
+// the result of the "ListReviews" endpoint of the "resource" service.
+func NewListReviewsInvalidTokenResponseBody(res *goa.ServiceError) *ListReviewsInvalidTokenResponseBody {
+	body := &ListReviewsInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateReviewInternalErrorResponseBody builds the HTTP response body from
+// the result of the "CreateReview" endpoint of the "resource" service.
+func NewCreateReviewInternalErrorResponseBody(res *goa.ServiceError) *CreateReviewInternalErrorResponseBody {
+	body := &CreateReviewInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateReviewInvalidPayloadResponseBody builds the HTTP response body from
+// the result of the "CreateReview" endpoint of the "resource" service.
+func NewCreateReviewInvalidPayloadResponseBody(res *goa.ServiceError) *CreateReviewInvalidPayloadResponseBody {
+	body := &CreateReviewInvalidPayloadResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateReviewInvalidScopesResponseBody builds the HTTP response body from
+// the result of the "CreateReview" endpoint of the "resource" service.
+func NewCreateReviewInvalidScopesResponseBody(res *goa.ServiceError) *CreateReviewInvalidScopesResponseBody {
+	body := &CreateReviewInvalidScopesResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateReviewNotFoundResponseBody builds the HTTP response body from the
+// result of the "CreateReview" endpoint of the "resource" service.
+func NewCreateReviewNotFoundResponseBody(res *goa.ServiceError) *CreateReviewNotFoundResponseBody {
+	body := &CreateReviewNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateReviewInvalidTokenResponseBody builds the HTTP response body from
+// the result of the "CreateReview" endpoint of the "resource" service.
+func NewCreateReviewInvalidTokenResponseBody(res *goa.ServiceError) *CreateReviewInvalidTokenResponseBody {
+	body := &CreateReviewInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateReviewInternalErrorResponseBody builds the HTTP response body from
+// the result of the "UpdateReview" endpoint of the "resource" service.
+func NewUpdateReviewInternalErrorResponseBody(res *goa.ServiceError) *UpdateReviewInternalErrorResponseBody {
+	body := &UpdateReviewInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
 }
 
-// ResourceVersionDataResponseBody is used to define fields on response body
-// types.
-type ResourceVersionDataResponseBody struct {
-	// ID is the unique id of resource's version
-	ID uint `form:"id" json:"id" xml:"id"`
-	// Version of resource
-	Version string `form:"version" json:"version" xml:"version"`
-	// Display name of version
-	DisplayName string `form:"displayName" json:"displayName" xml:"displayName"`
-	// Deprecation status of a version
-	Deprecated *bool `form:"deprecated,omitempty" json:"deprecated,omitempty" xml:"deprecated,omitempty"`
-	// Description of version
-	Description string `form:"description" json:"description" xml:"description"`
-	// Minimum pipelines version the resource's version is compatible with
-	MinPipelinesVersion string `form:"minPipelinesVersion" json:"minPipelinesVersion" xml:"minPipelinesVersion"`
-	// Raw URL of resource's yaml file of the version
-	RawURL string `form:"rawURL" json:"rawURL" xml:"rawURL"`
-	// Web URL of resource's yaml file of the version
-	WebURL string `form:"webURL" json:"webURL" xml:"webURL"`
-	// Url path of the resource in hub
-	HubURLPath string `form:"hubURLPath" json:"hubURLPath" xml:"hubURLPath"`
-	// Path of the api to get the raw yaml of resource from hub apiserver
-	HubRawURLPath string `form:"hubRawURLPath" json:"hubRawURLPath" xml:"hubRawURLPath"`
-	// Timestamp when version was last updated
-	UpdatedAt string `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
-	// Resource to which the version belongs
-	Resource *ResourceDataResponseBodyInfo `form:"resource" json:"resource" xml:"resource"`
-	// Platforms related to resource version
-	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
+// NewUpdateReviewInvalidScopesResponseBody builds the HTTP response body from
+// the result of the "UpdateReview" endpoint of the "resource" service.
+func NewUpdateReviewInvalidScopesResponseBody(res *goa.ServiceError) *UpdateReviewInvalidScopesResponseBody {
+	body := &UpdateReviewInvalidScopesResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
 }
 
-// ResourceDataResponseBodyInfo is used to define fields on response body types.
-type ResourceDataResponseBodyInfo struct {
-	// ID is the unique id of the resource
-	ID uint `form:"id" json:"id" xml:"id"`
-	// Name of resource
-	Name string `form:"name" json:"name" xml:"name"`
-	// Type of catalog to which resource belongs
-	Catalog *CatalogResponseBodyMin `form:"catalog" json:"catalog" xml:"catalog"`
-	// Categories related to resource
-	Categories []*CategoryResponseBody `form:"categories" json:"categories" xml:"categories"`
-	// Kind of resource
-	Kind string `form:"kind" json:"kind" xml:"kind"`
-	// Url path of the resource in hub
-	HubURLPath string `form:"hubURLPath" json:"hubURLPath" xml:"hubURLPath"`
-	// Tags related to resource
-	Tags []*TagResponseBody `form:"tags" json:"tags" xml:"tags"`
-	// Platforms related to resource
-	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
-	// Rating of resource
-	Rating float64 `form:"rating" json:"rating" xml:"rating"`
+// NewUpdateReviewNotFoundResponseBody builds the HTTP response body from the
+// result of the "UpdateReview" endpoint of the "resource" service.
+func NewUpdateReviewNotFoundResponseBody(res *goa.ServiceError) *UpdateReviewNotFoundResponseBody {
+	body := &UpdateReviewNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
 }
 
-// ResourceContentResponseBodyReadme is used to define fields on response body
-// types.
-type ResourceContentResponseBodyReadme struct {
-	// Readme
-	Readme *string `form:"readme,omitempty" json:"readme,omitempty" xml:"readme,omitempty"`
+// NewUpdateReviewInvalidTokenResponseBody builds the HTTP response body from
+// the result of the "UpdateReview" endpoint of the "resource" service.
+func NewUpdateReviewInvalidTokenResponseBody(res *goa.ServiceError) *UpdateReviewInvalidTokenResponseBody {
+	body := &UpdateReviewInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
 }
 
-// ResourceContentResponseBodyYaml is used to define fields on response body
-// types.
-type ResourceContentResponseBodyYaml struct {
-	// Yaml
-	Yaml *string `form:"yaml,omitempty" json:"yaml,omitempty" xml:"yaml,omitempty"`
+// NewDeleteReviewInternalErrorResponseBody builds the HTTP response body from
+// the result of the "DeleteReview" endpoint of the "resource" service.
+func NewDeleteReviewInternalErrorResponseBody(res *goa.ServiceError) *DeleteReviewInternalErrorResponseBody {
+	body := &DeleteReviewInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
 }
 
-// ResourceDataResponseBody is used to define fields on response body types.
-type ResourceDataResponseBody struct {
-	// ID is the unique id of the resource
-	ID uint `form:"id" json:"id" xml:"id"`
-	// Name of resource
-	Name string `form:"name" json:"name" xml:"name"`
-	// Type of catalog to which resource belongs
-	Catalog *CatalogResponseBodyMin `form:"catalog" json:"catalog" xml:"catalog"`
-	// Categories related to resource
-	Categories []*CategoryResponseBody `form:"categories" json:"categories" xml:"categories"`
-	// Kind of resource
-	Kind string `form:"kind" json:"kind" xml:"kind"`
-	// Url path of the resource in hub
-	HubURLPath string `form:"hubURLPath" json:"hubURLPath" xml:"hubURLPath"`
-	// Path of the api to get the raw yaml of resource from hub apiserver
-	HubRawURLPath string `form:"hubRawURLPath" json:"hubRawURLPath" xml:"hubRawURLPath"`
-	// Latest version of resource
-	LatestVersion *ResourceVersionDataResponseBodyWithoutResource `form:"latestVersion" json:"latestVersion" xml:"latestVersion"`
-	// Tags related to resource
-	Tags []*TagResponseBody `form:"tags" json:"tags" xml:"tags"`
-	// Platforms related to resource
-	Platforms []*PlatformResponseBody `form:"platforms" json:"platforms" xml:"platforms"`
-	// Rating of resource
-	Rating float64 `form:"rating" json:"rating" xml:"rating"`
-	// List of all versions of a resource
-	Versions []*ResourceVersionDataResponseBodyTiny `form:"versions" json:"versions" xml:"versions"`
+// NewDeleteReviewInvalidScopesResponseBody builds the HTTP response body from
+// the result of the "DeleteReview" endpoint of the "resource" service.
+func NewDeleteReviewInvalidScopesResponseBody(res *goa.ServiceError) *DeleteReviewInvalidScopesResponseBody {
+	body := &DeleteReviewInvalidScopesResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
 }
 
-// ResourceVersionDataResponseBodyTiny is used to define fields on response
-// body types.
-type ResourceVersionDataResponseBodyTiny struct {
-	// ID is the unique id of resource's version
-	ID uint `form:"id" json:"id" xml:"id"`
-	// Version of resource
-	Version string `form:"version" json:"version" xml:"version"`
+// NewDeleteReviewNotFoundResponseBody builds the HTTP response body from the
+// result of the "DeleteReview" endpoint of the "resource" service.
+func NewDeleteReviewNotFoundResponseBody(res *goa.ServiceError) *DeleteReviewNotFoundResponseBody {
+	body := &DeleteReviewNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
 }
 
-// NewQueryResponseBody builds the HTTP response body from the result of the
-// "Query" endpoint of the "resource" service.
-func NewQueryResponseBody(res *resourceviews.ResourcesView) *QueryResponseBody {
-	body := &QueryResponseBody{}
-	if res.Data != nil {
-		body.Data = make([]*ResourceDataResponseBodyWithoutVersion, len(res.Data))
-		for i, val := range res.Data {
-			body.Data[i] = marshalResourceviewsResourceDataViewToResourceDataResponseBodyWithoutVersion(val)
-		}
-	} else {
-		body.Data = []*ResourceDataResponseBodyWithoutVersion{}
+// NewDeleteReviewInvalidTokenResponseBody builds the HTTP response body from
+// the result of the "DeleteReview" endpoint of the "resource" service.
+func NewDeleteReviewInvalidTokenResponseBody(res *goa.ServiceError) *DeleteReviewInvalidTokenResponseBody {
+	body := &DeleteReviewInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
 	return body
 }
 
-// NewListResponseBody builds the HTTP response body from the result of the
-// "List" endpoint of the "resource" service.
-func NewListResponseBody(res *resourceviews.ResourcesView) *ListResponseBody {
-	body := &ListResponseBody{}
-	if res.Data != nil {
-		body.Data = make([]*ResourceDataResponseBodyWithoutVersion, len(res.Data))
-		for i, val := range res.Data {
-			body.Data[i] = marshalResourceviewsResourceDataViewToResourceDataResponseBodyWithoutVersion(val)
-		}
-	} else {
-		body.Data = []*ResourceDataResponseBodyWithoutVersion{}
+// NewDependenciesInternalErrorResponseBody builds the HTTP response body from
+// the result of the "Dependencies" endpoint of the "resource" service.
+func NewDependenciesInternalErrorResponseBody(res *goa.ServiceError) *DependenciesInternalErrorResponseBody {
+	body := &DependenciesInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
 	return body
 }
 
-// NewVersionsByIDResponseBody builds the HTTP response body from the result of
-// the "VersionsByID" endpoint of the "resource" service.
-func NewVersionsByIDResponseBody(res *resourceviews.ResourceVersionsView) *VersionsByIDResponseBody {
-	body := &VersionsByIDResponseBody{}
-	if res.Data != nil {
-		body.Data = marshalResourceviewsVersionsViewToVersionsResponseBody(res.Data)
+// NewDependenciesNotFoundResponseBody builds the HTTP response body from the
+// result of the "Dependencies" endpoint of the "resource" service.
+func NewDependenciesNotFoundResponseBody(res *goa.ServiceError) *DependenciesNotFoundResponseBody {
+	body := &DependenciesNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
 	return body
 }
 
-// NewByCatalogKindNameVersionResponseBody builds the HTTP response body from
-// the result of the "ByCatalogKindNameVersion" endpoint of the "resource"
-// service.
-func NewByCatalogKindNameVersionResponseBody(res *resourceviews.ResourceVersionView) *ByCatalogKindNameVersionResponseBody {
-	body := &ByCatalogKindNameVersionResponseBody{}
-	if res.Data != nil {
-		body.Data = marshalResourceviewsResourceVersionDataViewToResourceVersionDataResponseBody(res.Data)
+// NewDependenciesInvalidTokenResponseBody builds the HTTP response body from
+// the result of the "Dependencies" endpoint of the "resource" service.
+func NewDependenciesInvalidTokenResponseBody(res *goa.ServiceError) *DependenciesInvalidTokenResponseBody {
+	body := &DependenciesInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
 	return body
 }
 
-// NewByCatalogKindNameVersionReadmeResponseBody builds the HTTP response body
-// from the result of the "ByCatalogKindNameVersionReadme" endpoint of the
-// "resource" service.
-func NewByCatalogKindNameVersionReadmeResponseBody(res *resourceviews.ResourceVersionReadmeView) *ByCatalogKindNameVersionReadmeResponseBody {
-	body := &ByCatalogKindNameVersionReadmeResponseBody{}
-	if res.Data != nil {
-		body.Data = marshalResourceviewsResourceContentViewToResourceContentResponseBodyReadme(res.Data)
+// NewDependentsInternalErrorResponseBody builds the HTTP response body from
+// the result of the "Dependents" endpoint of the "resource" service.
+func NewDependentsInternalErrorResponseBody(res *goa.ServiceError) *DependentsInternalErrorResponseBody {
+	body := &DependentsInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
 	return body
 }
 
-// NewByCatalogKindNameVersionYamlResponseBody builds the HTTP response body
-// from the result of the "ByCatalogKindNameVersionYaml" endpoint of the
-// "resource" service.
-func NewByCatalogKindNameVersionYamlResponseBody(res *resourceviews.ResourceVersionYamlView) *ByCatalogKindNameVersionYamlResponseBody {
-	body := &ByCatalogKindNameVersionYamlResponseBody{}
-	if res.Data != nil {
-		body.Data = marshalResourceviewsResourceContentViewToResourceContentResponseBodyYaml(res.Data)
+// NewDependentsNotFoundResponseBody builds the HTTP response body from the
+// result of the "Dependents" endpoint of the "resource" service.
+func NewDependentsNotFoundResponseBody(res *goa.ServiceError) *DependentsNotFoundResponseBody {
+	body := &DependentsNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
 	return body
 }
 
-// NewByVersionIDResponseBody builds the HTTP response body from the result of
-// the "ByVersionId" endpoint of the "resource" service.
-func NewByVersionIDResponseBody(res *resourceviews.ResourceVersionView) *ByVersionIDResponseBody {
-	body := &ByVersionIDResponseBody{}
-	if res.Data != nil {
-		body.Data = marshalResourceviewsResourceVersionDataViewToResourceVersionDataResponseBody(res.Data)
+// NewDependentsInvalidTokenResponseBody builds the HTTP response body from the
+// result of the "Dependents" endpoint of the "resource" service.
+func NewDependentsInvalidTokenResponseBody(res *goa.ServiceError) *DependentsInvalidTokenResponseBody {
+	body := &DependentsInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewByCatalogKindNameVersionInternalErrorResponseBody builds the HTTP
+// response body from the result of the "ByCatalogKindNameVersion" endpoint of
+// the "resource" service.
+func NewByCatalogKindNameVersionInternalErrorResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionInternalErrorResponseBody {
+	body := &ByCatalogKindNameVersionInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewByCatalogKindNameVersionNotFoundResponseBody builds the HTTP response
+// body from the result of the "ByCatalogKindNameVersion" endpoint of the
+// "resource" service.
+func NewByCatalogKindNameVersionNotFoundResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionNotFoundResponseBody {
+	body := &ByCatalogKindNameVersionNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
 	return body
 }
 
-// NewByCatalogKindNameResponseBody builds the HTTP response body from the
-// result of the "ByCatalogKindName" endpoint of the "resource" service.
-func NewByCatalogKindNameResponseBody(res *resourceviews.ResourceView) *ByCatalogKindNameResponseBody {
-	body := &ByCatalogKindNameResponseBody{}
-	if res.Data != nil {
-		body.Data = marshalResourceviewsResourceDataViewToResourceDataResponseBody(res.Data)
+// NewByCatalogKindNameVersionInvalidTokenResponseBody builds the HTTP response
+// body from the result of the "ByCatalogKindNameVersion" endpoint of the
+// "resource" service.
+func NewByCatalogKindNameVersionInvalidTokenResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionInvalidTokenResponseBody {
+	body := &ByCatalogKindNameVersionInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
 	return body
 }
 
-// NewByIDResponseBody builds the HTTP response body from the result of the
-// "ById" endpoint of the "resource" service.
-func NewByIDResponseBody(res *resourceviews.ResourceView) *ByIDResponseBody {
-	body := &ByIDResponseBody{}
-	if res.Data != nil {
-		body.Data = marshalResourceviewsResourceDataViewToResourceDataResponseBody(res.Data)
+// NewByCatalogKindNameVersionReadmeInternalErrorResponseBody builds the HTTP
+// response body from the result of the "ByCatalogKindNameVersionReadme"
+// endpoint of the "resource" service.
+func NewByCatalogKindNameVersionReadmeInternalErrorResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionReadmeInternalErrorResponseBody {
+	body := &ByCatalogKindNameVersionReadmeInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
 	return body
 }
 
-// NewQueryInternalErrorResponseBody builds the HTTP response body from the
-// result of the "Query" endpoint of the "resource" service.
-func NewQueryInternalErrorResponseBody(res *goa.ServiceError) *QueryInternalErrorResponseBody {
-	body := &QueryInternalErrorResponseBody{
+// NewByCatalogKindNameVersionReadmeNotFoundResponseBody builds the HTTP
+// response body from the result of the "ByCatalogKindNameVersionReadme"
+// endpoint of the "resource" service.
+func NewByCatalogKindNameVersionReadmeNotFoundResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionReadmeNotFoundResponseBody {
+	body := &ByCatalogKindNameVersionReadmeNotFoundResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -834,10 +2559,11 @@ func NewQueryInternalErrorResponseBody(res *goa.ServiceError) *QueryInternalErro
 	return body
 }
 
-// NewQueryInvalidKindResponseBody builds the HTTP response body from the
-// result of the "Query" endpoint of the "resource" service.
-func NewQueryInvalidKindResponseBody(res *goa.ServiceError) *QueryInvalidKindResponseBody {
-	body := &QueryInvalidKindResponseBody{
+// NewByCatalogKindNameVersionReadmeInvalidTokenResponseBody builds the HTTP
+// response body from the result of the "ByCatalogKindNameVersionReadme"
+// endpoint of the "resource" service.
+func NewByCatalogKindNameVersionReadmeInvalidTokenResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionReadmeInvalidTokenResponseBody {
+	body := &ByCatalogKindNameVersionReadmeInvalidTokenResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -848,10 +2574,11 @@ func NewQueryInvalidKindResponseBody(res *goa.ServiceError) *QueryInvalidKindRes
 	return body
 }
 
-// NewQueryNotFoundResponseBody builds the HTTP response body from the result
-// of the "Query" endpoint of the "resource" service.
-func NewQueryNotFoundResponseBody(res *goa.ServiceError) *QueryNotFoundResponseBody {
-	body := &QueryNotFoundResponseBody{
+// NewByCatalogKindNameVersionYamlInternalErrorResponseBody builds the HTTP
+// response body from the result of the "ByCatalogKindNameVersionYaml" endpoint
+// of the "resource" service.
+func NewByCatalogKindNameVersionYamlInternalErrorResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionYamlInternalErrorResponseBody {
+	body := &ByCatalogKindNameVersionYamlInternalErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -862,10 +2589,11 @@ func NewQueryNotFoundResponseBody(res *goa.ServiceError) *QueryNotFoundResponseB
 	return body
 }
 
-// NewListInternalErrorResponseBody builds the HTTP response body from the
-// result of the "List" endpoint of the "resource" service.
-func NewListInternalErrorResponseBody(res *goa.ServiceError) *ListInternalErrorResponseBody {
-	body := &ListInternalErrorResponseBody{
+// NewByCatalogKindNameVersionYamlNotFoundResponseBody builds the HTTP response
+// body from the result of the "ByCatalogKindNameVersionYaml" endpoint of the
+// "resource" service.
+func NewByCatalogKindNameVersionYamlNotFoundResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionYamlNotFoundResponseBody {
+	body := &ByCatalogKindNameVersionYamlNotFoundResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -876,10 +2604,11 @@ func NewListInternalErrorResponseBody(res *goa.ServiceError) *ListInternalErrorR
 	return body
 }
 
-// NewListNotFoundResponseBody builds the HTTP response body from the result of
-// the "List" endpoint of the "resource" service.
-func NewListNotFoundResponseBody(res *goa.ServiceError) *ListNotFoundResponseBody {
-	body := &ListNotFoundResponseBody{
+// NewByCatalogKindNameVersionYamlInvalidTokenResponseBody builds the HTTP
+// response body from the result of the "ByCatalogKindNameVersionYaml" endpoint
+// of the "resource" service.
+func NewByCatalogKindNameVersionYamlInvalidTokenResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionYamlInvalidTokenResponseBody {
+	body := &ByCatalogKindNameVersionYamlInvalidTokenResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -890,10 +2619,11 @@ func NewListNotFoundResponseBody(res *goa.ServiceError) *ListNotFoundResponseBod
 	return body
 }
 
-// NewVersionsByIDInternalErrorResponseBody builds the HTTP response body from
-// the result of the "VersionsByID" endpoint of the "resource" service.
-func NewVersionsByIDInternalErrorResponseBody(res *goa.ServiceError) *VersionsByIDInternalErrorResponseBody {
-	body := &VersionsByIDInternalErrorResponseBody{
+// NewByCatalogKindNameVersionLintInternalErrorResponseBody builds the HTTP
+// response body from the result of the "ByCatalogKindNameVersionLint" endpoint
+// of the "resource" service.
+func NewByCatalogKindNameVersionLintInternalErrorResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionLintInternalErrorResponseBody {
+	body := &ByCatalogKindNameVersionLintInternalErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -904,10 +2634,11 @@ func NewVersionsByIDInternalErrorResponseBody(res *goa.ServiceError) *VersionsBy
 	return body
 }
 
-// NewVersionsByIDNotFoundResponseBody builds the HTTP response body from the
-// result of the "VersionsByID" endpoint of the "resource" service.
-func NewVersionsByIDNotFoundResponseBody(res *goa.ServiceError) *VersionsByIDNotFoundResponseBody {
-	body := &VersionsByIDNotFoundResponseBody{
+// NewByCatalogKindNameVersionLintNotFoundResponseBody builds the HTTP response
+// body from the result of the "ByCatalogKindNameVersionLint" endpoint of the
+// "resource" service.
+func NewByCatalogKindNameVersionLintNotFoundResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionLintNotFoundResponseBody {
+	body := &ByCatalogKindNameVersionLintNotFoundResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -918,11 +2649,11 @@ func NewVersionsByIDNotFoundResponseBody(res *goa.ServiceError) *VersionsByIDNot
 	return body
 }
 
-// NewByCatalogKindNameVersionInternalErrorResponseBody builds the HTTP
-// response body from the result of the "ByCatalogKindNameVersion" endpoint of
-// the "resource" service.
-func NewByCatalogKindNameVersionInternalErrorResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionInternalErrorResponseBody {
-	body := &ByCatalogKindNameVersionInternalErrorResponseBody{
+// NewByCatalogKindNameVersionLintInvalidTokenResponseBody builds the HTTP
+// response body from the result of the "ByCatalogKindNameVersionLint" endpoint
+// of the "resource" service.
+func NewByCatalogKindNameVersionLintInvalidTokenResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionLintInvalidTokenResponseBody {
+	body := &ByCatalogKindNameVersionLintInvalidTokenResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -933,11 +2664,11 @@ func NewByCatalogKindNameVersionInternalErrorResponseBody(res *goa.ServiceError)
 	return body
 }
 
-// NewByCatalogKindNameVersionNotFoundResponseBody builds the HTTP response
-// body from the result of the "ByCatalogKindNameVersion" endpoint of the
-// "resource" service.
-func NewByCatalogKindNameVersionNotFoundResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionNotFoundResponseBody {
-	body := &ByCatalogKindNameVersionNotFoundResponseBody{
+// NewByCatalogKindNameVersionImagesInternalErrorResponseBody builds the HTTP
+// response body from the result of the "ByCatalogKindNameVersionImages"
+// endpoint of the "resource" service.
+func NewByCatalogKindNameVersionImagesInternalErrorResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionImagesInternalErrorResponseBody {
+	body := &ByCatalogKindNameVersionImagesInternalErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -948,11 +2679,11 @@ func NewByCatalogKindNameVersionNotFoundResponseBody(res *goa.ServiceError) *ByC
 	return body
 }
 
-// NewByCatalogKindNameVersionReadmeInternalErrorResponseBody builds the HTTP
-// response body from the result of the "ByCatalogKindNameVersionReadme"
+// NewByCatalogKindNameVersionImagesNotFoundResponseBody builds the HTTP
+// response body from the result of the "ByCatalogKindNameVersionImages"
 // endpoint of the "resource" service.
-func NewByCatalogKindNameVersionReadmeInternalErrorResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionReadmeInternalErrorResponseBody {
-	body := &ByCatalogKindNameVersionReadmeInternalErrorResponseBody{
+func NewByCatalogKindNameVersionImagesNotFoundResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionImagesNotFoundResponseBody {
+	body := &ByCatalogKindNameVersionImagesNotFoundResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -963,11 +2694,11 @@ func NewByCatalogKindNameVersionReadmeInternalErrorResponseBody(res *goa.Service
 	return body
 }
 
-// NewByCatalogKindNameVersionReadmeNotFoundResponseBody builds the HTTP
-// response body from the result of the "ByCatalogKindNameVersionReadme"
+// NewByCatalogKindNameVersionImagesInvalidTokenResponseBody builds the HTTP
+// response body from the result of the "ByCatalogKindNameVersionImages"
 // endpoint of the "resource" service.
-func NewByCatalogKindNameVersionReadmeNotFoundResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionReadmeNotFoundResponseBody {
-	body := &ByCatalogKindNameVersionReadmeNotFoundResponseBody{
+func NewByCatalogKindNameVersionImagesInvalidTokenResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionImagesInvalidTokenResponseBody {
+	body := &ByCatalogKindNameVersionImagesInvalidTokenResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -978,11 +2709,10 @@ func NewByCatalogKindNameVersionReadmeNotFoundResponseBody(res *goa.ServiceError
 	return body
 }
 
-// NewByCatalogKindNameVersionYamlInternalErrorResponseBody builds the HTTP
-// response body from the result of the "ByCatalogKindNameVersionYaml" endpoint
-// of the "resource" service.
-func NewByCatalogKindNameVersionYamlInternalErrorResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionYamlInternalErrorResponseBody {
-	body := &ByCatalogKindNameVersionYamlInternalErrorResponseBody{
+// NewByImageInternalErrorResponseBody builds the HTTP response body from the
+// result of the "ByImage" endpoint of the "resource" service.
+func NewByImageInternalErrorResponseBody(res *goa.ServiceError) *ByImageInternalErrorResponseBody {
+	body := &ByImageInternalErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -993,11 +2723,10 @@ func NewByCatalogKindNameVersionYamlInternalErrorResponseBody(res *goa.ServiceEr
 	return body
 }
 
-// NewByCatalogKindNameVersionYamlNotFoundResponseBody builds the HTTP response
-// body from the result of the "ByCatalogKindNameVersionYaml" endpoint of the
-// "resource" service.
-func NewByCatalogKindNameVersionYamlNotFoundResponseBody(res *goa.ServiceError) *ByCatalogKindNameVersionYamlNotFoundResponseBody {
-	body := &ByCatalogKindNameVersionYamlNotFoundResponseBody{
+// NewByImageInvalidTokenResponseBody builds the HTTP response body from the
+// result of the "ByImage" endpoint of the "resource" service.
+func NewByImageInvalidTokenResponseBody(res *goa.ServiceError) *ByImageInvalidTokenResponseBody {
+	body := &ByImageInvalidTokenResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -1036,6 +2765,20 @@ func NewByVersionIDNotFoundResponseBody(res *goa.ServiceError) *ByVersionIDNotFo
 	return body
 }
 
+// NewByVersionIDInvalidTokenResponseBody builds the HTTP response body from
+// the result of the "ByVersionId" endpoint of the "resource" service.
+func NewByVersionIDInvalidTokenResponseBody(res *goa.ServiceError) *ByVersionIDInvalidTokenResponseBody {
+	body := &ByVersionIDInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewByCatalogKindNameInternalErrorResponseBody builds the HTTP response body
 // from the result of the "ByCatalogKindName" endpoint of the "resource"
 // service.
@@ -1065,6 +2808,21 @@ func NewByCatalogKindNameNotFoundResponseBody(res *goa.ServiceError) *ByCatalogK
 	return body
 }
 
+// NewByCatalogKindNameInvalidTokenResponseBody builds the HTTP response body
+// from the result of the "ByCatalogKindName" endpoint of the "resource"
+// service.
+func NewByCatalogKindNameInvalidTokenResponseBody(res *goa.ServiceError) *ByCatalogKindNameInvalidTokenResponseBody {
+	body := &ByCatalogKindNameInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewByIDInternalErrorResponseBody builds the HTTP response body from the
 // result of the "ById" endpoint of the "resource" service.
 func NewByIDInternalErrorResponseBody(res *goa.ServiceError) *ByIDInternalErrorResponseBody {
@@ -1093,6 +2851,20 @@ func NewByIDNotFoundResponseBody(res *goa.ServiceError) *ByIDNotFoundResponseBod
 	return body
 }
 
+// NewByIDInvalidTokenResponseBody builds the HTTP response body from the
+// result of the "ById" endpoint of the "resource" service.
+func NewByIDInvalidTokenResponseBody(res *goa.ServiceError) *ByIDInvalidTokenResponseBody {
+	body := &ByIDInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewGetRawYamlByCatalogKindNameVersionInternalErrorResponseBody builds the
 // HTTP response body from the result of the
 // "GetRawYamlByCatalogKindNameVersion" endpoint of the "resource" service.
@@ -1123,6 +2895,21 @@ func NewGetRawYamlByCatalogKindNameVersionNotFoundResponseBody(res *goa.ServiceE
 	return body
 }
 
+// NewGetRawYamlByCatalogKindNameVersionInvalidTokenResponseBody builds the
+// HTTP response body from the result of the
+// "GetRawYamlByCatalogKindNameVersion" endpoint of the "resource" service.
+func NewGetRawYamlByCatalogKindNameVersionInvalidTokenResponseBody(res *goa.ServiceError) *GetRawYamlByCatalogKindNameVersionInvalidTokenResponseBody {
+	body := &GetRawYamlByCatalogKindNameVersionInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewGetLatestRawYamlByCatalogKindNameInternalErrorResponseBody builds the
 // HTTP response body from the result of the
 // "GetLatestRawYamlByCatalogKindName" endpoint of the "resource" service.
@@ -1153,8 +2940,23 @@ func NewGetLatestRawYamlByCatalogKindNameNotFoundResponseBody(res *goa.ServiceEr
 	return body
 }
 
+// NewGetLatestRawYamlByCatalogKindNameInvalidTokenResponseBody builds the HTTP
+// response body from the result of the "GetLatestRawYamlByCatalogKindName"
+// endpoint of the "resource" service.
+func NewGetLatestRawYamlByCatalogKindNameInvalidTokenResponseBody(res *goa.ServiceError) *GetLatestRawYamlByCatalogKindNameInvalidTokenResponseBody {
+	body := &GetLatestRawYamlByCatalogKindNameInvalidTokenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewQueryPayload builds a resource service Query endpoint payload.
-func NewQueryPayload(name string, catalogs []string, categories []string, kinds []string, tags []string, platforms []string, limit uint, match string) *resource.QueryPayload {
+func NewQueryPayload(name string, catalogs []string, categories []string, kinds []string, tags []string, platforms []string, apiVersions []string, verifications []string, limit uint, match string, sort *string, cursor *string, prerelease bool, token *string) *resource.QueryPayload {
 	v := &resource.QueryPayload{}
 	v.Name = name
 	v.Catalogs = catalogs
@@ -1162,112 +2964,280 @@ func NewQueryPayload(name string, catalogs []string, categories []string, kinds
 	v.Kinds = kinds
 	v.Tags = tags
 	v.Platforms = platforms
+	v.APIVersions = apiVersions
+	v.Verifications = verifications
 	v.Limit = limit
 	v.Match = match
+	v.Sort = sort
+	v.Cursor = cursor
+	v.Prerelease = prerelease
+	v.Token = token
 
 	return v
 }
 
 // NewListPayload builds a resource service List endpoint payload.
-func NewListPayload(limit uint) *resource.ListPayload {
+func NewListPayload(limit uint, sort *string, cursor *string, prerelease bool, token *string) *resource.ListPayload {
 	v := &resource.ListPayload{}
 	v.Limit = limit
+	v.Sort = sort
+	v.Cursor = cursor
+	v.Prerelease = prerelease
+	v.Token = token
 
 	return v
 }
 
 // NewVersionsByIDPayload builds a resource service VersionsByID endpoint
 // payload.
-func NewVersionsByIDPayload(id uint) *resource.VersionsByIDPayload {
+func NewVersionsByIDPayload(id uint, prerelease bool, token *string) *resource.VersionsByIDPayload {
 	v := &resource.VersionsByIDPayload{}
 	v.ID = id
+	v.Prerelease = prerelease
+	v.Token = token
+
+	return v
+}
+
+// NewListReviewsPayload builds a resource service ListReviews endpoint payload.
+func NewListReviewsPayload(id uint, limit uint, cursor *string, token *string) *resource.ListReviewsPayload {
+	v := &resource.ListReviewsPayload{}
+	v.ID = id
+	v.Limit = limit
+	v.Cursor = cursor
+	v.Token = token
+
+	return v
+}
+
+// NewCreateReviewPayload builds a resource service CreateReview endpoint
+// payload.
+func NewCreateReviewPayload(body *CreateReviewRequestBody, id uint, token string) *resource.CreateReviewPayload {
+	v := &resource.CreateReviewPayload{
+		Version: *body.Version,
+		Text:    *body.Text,
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewUpdateReviewPayload builds a resource service UpdateReview endpoint
+// payload.
+func NewUpdateReviewPayload(body *UpdateReviewRequestBody, id uint, reviewID uint, token string) *resource.UpdateReviewPayload {
+	v := &resource.UpdateReviewPayload{
+		Text: *body.Text,
+	}
+	v.ID = id
+	v.ReviewID = reviewID
+	v.Token = token
+
+	return v
+}
+
+// NewDeleteReviewPayload builds a resource service DeleteReview endpoint
+// payload.
+func NewDeleteReviewPayload(id uint, reviewID uint, token string) *resource.DeleteReviewPayload {
+	v := &resource.DeleteReviewPayload{}
+	v.ID = id
+	v.ReviewID = reviewID
+	v.Token = token
+
+	return v
+}
+
+// NewDependenciesPayload builds a resource service Dependencies endpoint
+// payload.
+func NewDependenciesPayload(id uint, version *string, token *string) *resource.DependenciesPayload {
+	v := &resource.DependenciesPayload{}
+	v.ID = id
+	v.Version = version
+	v.Token = token
+
+	return v
+}
+
+// NewDependentsPayload builds a resource service Dependents endpoint payload.
+func NewDependentsPayload(id uint, version *string, token *string) *resource.DependentsPayload {
+	v := &resource.DependentsPayload{}
+	v.ID = id
+	v.Version = version
+	v.Token = token
 
 	return v
 }
 
 // NewByCatalogKindNameVersionPayload builds a resource service
 // ByCatalogKindNameVersion endpoint payload.
-func NewByCatalogKindNameVersionPayload(catalog string, kind string, name string, version string) *resource.ByCatalogKindNameVersionPayload {
+func NewByCatalogKindNameVersionPayload(catalog string, kind string, name string, version string, token *string) *resource.ByCatalogKindNameVersionPayload {
 	v := &resource.ByCatalogKindNameVersionPayload{}
 	v.Catalog = catalog
 	v.Kind = kind
 	v.Name = name
 	v.Version = version
+	v.Token = token
 
 	return v
 }
 
 // NewByCatalogKindNameVersionReadmePayload builds a resource service
 // ByCatalogKindNameVersionReadme endpoint payload.
-func NewByCatalogKindNameVersionReadmePayload(catalog string, kind string, name string, version string) *resource.ByCatalogKindNameVersionReadmePayload {
+func NewByCatalogKindNameVersionReadmePayload(catalog string, kind string, name string, version string, token *string) *resource.ByCatalogKindNameVersionReadmePayload {
 	v := &resource.ByCatalogKindNameVersionReadmePayload{}
 	v.Catalog = catalog
 	v.Kind = kind
 	v.Name = name
 	v.Version = version
+	v.Token = token
 
 	return v
 }
 
 // NewByCatalogKindNameVersionYamlPayload builds a resource service
 // ByCatalogKindNameVersionYaml endpoint payload.
-func NewByCatalogKindNameVersionYamlPayload(catalog string, kind string, name string, version string) *resource.ByCatalogKindNameVersionYamlPayload {
+func NewByCatalogKindNameVersionYamlPayload(catalog string, kind string, name string, version string, install bool, token *string) *resource.ByCatalogKindNameVersionYamlPayload {
 	v := &resource.ByCatalogKindNameVersionYamlPayload{}
 	v.Catalog = catalog
 	v.Kind = kind
 	v.Name = name
 	v.Version = version
+	v.Install = install
+	v.Token = token
+
+	return v
+}
+
+// NewByCatalogKindNameVersionLintPayload builds a resource service
+// ByCatalogKindNameVersionLint endpoint payload.
+func NewByCatalogKindNameVersionLintPayload(catalog string, kind string, name string, version string, token *string) *resource.ByCatalogKindNameVersionLintPayload {
+	v := &resource.ByCatalogKindNameVersionLintPayload{}
+	v.Catalog = catalog
+	v.Kind = kind
+	v.Name = name
+	v.Version = version
+	v.Token = token
+
+	return v
+}
+
+// NewByCatalogKindNameVersionImagesPayload builds a resource service
+// ByCatalogKindNameVersionImages endpoint payload.
+func NewByCatalogKindNameVersionImagesPayload(catalog string, kind string, name string, version string, token *string) *resource.ByCatalogKindNameVersionImagesPayload {
+	v := &resource.ByCatalogKindNameVersionImagesPayload{}
+	v.Catalog = catalog
+	v.Kind = kind
+	v.Name = name
+	v.Version = version
+	v.Token = token
+
+	return v
+}
+
+// NewByImagePayload builds a resource service ByImage endpoint payload.
+func NewByImagePayload(ref string, limit uint, token *string) *resource.ByImagePayload {
+	v := &resource.ByImagePayload{}
+	v.Ref = ref
+	v.Limit = limit
+	v.Token = token
 
 	return v
 }
 
 // NewByVersionIDPayload builds a resource service ByVersionId endpoint payload.
-func NewByVersionIDPayload(versionID uint) *resource.ByVersionIDPayload {
+func NewByVersionIDPayload(versionID uint, token *string) *resource.ByVersionIDPayload {
 	v := &resource.ByVersionIDPayload{}
 	v.VersionID = versionID
+	v.Token = token
 
 	return v
 }
 
 // NewByCatalogKindNamePayload builds a resource service ByCatalogKindName
 // endpoint payload.
-func NewByCatalogKindNamePayload(catalog string, kind string, name string, pipelinesversion *string) *resource.ByCatalogKindNamePayload {
+func NewByCatalogKindNamePayload(catalog string, kind string, name string, pipelinesversion *string, prerelease bool, token *string) *resource.ByCatalogKindNamePayload {
 	v := &resource.ByCatalogKindNamePayload{}
 	v.Catalog = catalog
 	v.Kind = kind
 	v.Name = name
 	v.Pipelinesversion = pipelinesversion
+	v.Prerelease = prerelease
+	v.Token = token
 
 	return v
 }
 
 // NewByIDPayload builds a resource service ById endpoint payload.
-func NewByIDPayload(id uint) *resource.ByIDPayload {
+func NewByIDPayload(id uint, token *string) *resource.ByIDPayload {
 	v := &resource.ByIDPayload{}
 	v.ID = id
+	v.Token = token
 
 	return v
 }
 
 // NewGetRawYamlByCatalogKindNameVersionPayload builds a resource service
 // GetRawYamlByCatalogKindNameVersion endpoint payload.
-func NewGetRawYamlByCatalogKindNameVersionPayload(catalog string, kind string, name string, version string) *resource.GetRawYamlByCatalogKindNameVersionPayload {
+func NewGetRawYamlByCatalogKindNameVersionPayload(catalog string, kind string, name string, version string, token *string) *resource.GetRawYamlByCatalogKindNameVersionPayload {
 	v := &resource.GetRawYamlByCatalogKindNameVersionPayload{}
 	v.Catalog = catalog
 	v.Kind = kind
 	v.Name = name
 	v.Version = version
+	v.Token = token
 
 	return v
 }
 
 // NewGetLatestRawYamlByCatalogKindNamePayload builds a resource service
 // GetLatestRawYamlByCatalogKindName endpoint payload.
-func NewGetLatestRawYamlByCatalogKindNamePayload(catalog string, kind string, name string) *resource.GetLatestRawYamlByCatalogKindNamePayload {
+func NewGetLatestRawYamlByCatalogKindNamePayload(catalog string, kind string, name string, token *string) *resource.GetLatestRawYamlByCatalogKindNamePayload {
 	v := &resource.GetLatestRawYamlByCatalogKindNamePayload{}
 	v.Catalog = catalog
 	v.Kind = kind
 	v.Name = name
+	v.Token = token
 
 	return v
 }
+
+// ValidateCreateReviewRequestBody runs the validations defined on
+// CreateReviewRequestBody
+func ValidateCreateReviewRequestBody(body *CreateReviewRequestBody) (err error) {
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.Text == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("text", "body"))
+	}
+	if body.Text != nil {
+		if utf8.RuneCountInString(*body.Text) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.text", *body.Text, utf8.RuneCountInString(*body.Text), 1, true))
+		}
+	}
+	if body.Text != nil {
+		if utf8.RuneCountInString(*body.Text) > 5000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.text", *body.Text, utf8.RuneCountInString(*body.Text), 5000, false))
+		}
+	}
+	return
+}
+
+// ValidateUpdateReviewRequestBody runs the validations defined on
+// UpdateReviewRequestBody
+func ValidateUpdateReviewRequestBody(body *UpdateReviewRequestBody) (err error) {
+	if body.Text == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("text", "body"))
+	}
+	if body.Text != nil {
+		if utf8.RuneCountInString(*body.Text) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.text", *body.Text, utf8.RuneCountInString(*body.Text), 1, true))
+		}
+	}
+	if body.Text != nil {
+		if utf8.RuneCountInString(*body.Text) > 5000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.text", *body.Text, utf8.RuneCountInString(*body.Text), 5000, false))
+		}
+	}
+	return
+}
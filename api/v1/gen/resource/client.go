@@ -19,9 +19,18 @@ type Client struct {
 	QueryEndpoint                              goa.Endpoint
 	ListEndpoint                               goa.Endpoint
 	VersionsByIDEndpoint                       goa.Endpoint
+	ListReviewsEndpoint                        goa.Endpoint
+	CreateReviewEndpoint                       goa.Endpoint
+	UpdateReviewEndpoint                       goa.Endpoint
+	DeleteReviewEndpoint                       goa.Endpoint
+	DependenciesEndpoint                       goa.Endpoint
+	DependentsEndpoint                         goa.Endpoint
 	ByCatalogKindNameVersionEndpoint           goa.Endpoint
 	ByCatalogKindNameVersionReadmeEndpoint     goa.Endpoint
 	ByCatalogKindNameVersionYamlEndpoint       goa.Endpoint
+	ByCatalogKindNameVersionLintEndpoint       goa.Endpoint
+	ByCatalogKindNameVersionImagesEndpoint     goa.Endpoint
+	ByImageEndpoint                            goa.Endpoint
 	ByVersionIDEndpoint                        goa.Endpoint
 	ByCatalogKindNameEndpoint                  goa.Endpoint
 	ByIDEndpoint                               goa.Endpoint
@@ -30,14 +39,23 @@ type Client struct {
 }
 
 // NewClient initializes a "resource" service client given the endpoints.
-func NewClient(query, list, versionsByID, byCatalogKindNameVersion, byCatalogKindNameVersionReadme, byCatalogKindNameVersionYaml, byVersionID, byCatalogKindName, byID, getRawYamlByCatalogKindNameVersion, getLatestRawYamlByCatalogKindName goa.Endpoint) *Client {
+func NewClient(query, list, versionsByID, listReviews, createReview, updateReview, deleteReview, dependencies, dependents, byCatalogKindNameVersion, byCatalogKindNameVersionReadme, byCatalogKindNameVersionYaml, byCatalogKindNameVersionLint, byCatalogKindNameVersionImages, byImage, byVersionID, byCatalogKindName, byID, getRawYamlByCatalogKindNameVersion, getLatestRawYamlByCatalogKindName goa.Endpoint) *Client {
 	return &Client{
 		QueryEndpoint:                              query,
 		ListEndpoint:                               list,
 		VersionsByIDEndpoint:                       versionsByID,
+		ListReviewsEndpoint:                        listReviews,
+		CreateReviewEndpoint:                       createReview,
+		UpdateReviewEndpoint:                       updateReview,
+		DeleteReviewEndpoint:                       deleteReview,
+		DependenciesEndpoint:                       dependencies,
+		DependentsEndpoint:                         dependents,
 		ByCatalogKindNameVersionEndpoint:           byCatalogKindNameVersion,
 		ByCatalogKindNameVersionReadmeEndpoint:     byCatalogKindNameVersionReadme,
 		ByCatalogKindNameVersionYamlEndpoint:       byCatalogKindNameVersionYaml,
+		ByCatalogKindNameVersionLintEndpoint:       byCatalogKindNameVersionLint,
+		ByCatalogKindNameVersionImagesEndpoint:     byCatalogKindNameVersionImages,
+		ByImageEndpoint:                            byImage,
 		ByVersionIDEndpoint:                        byVersionID,
 		ByCatalogKindNameEndpoint:                  byCatalogKindName,
 		ByIDEndpoint:                               byID,
@@ -51,6 +69,10 @@ func NewClient(query, list, versionsByID, byCatalogKindNameVersion, byCatalogKin
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
 //   - "not-found" (type *goa.ServiceError): Resource Not Found Error
 //   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
 //   - error: internal error
 func (c *Client) Query(ctx context.Context, p *QueryPayload) (res *Resources, err error) {
 	var ires any
@@ -66,6 +88,10 @@ func (c *Client) Query(ctx context.Context, p *QueryPayload) (res *Resources, er
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
 //   - "not-found" (type *goa.ServiceError): Resource Not Found Error
 //   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
 //   - error: internal error
 func (c *Client) List(ctx context.Context, p *ListPayload) (res *Resources, err error) {
 	var ires any
@@ -81,6 +107,10 @@ func (c *Client) List(ctx context.Context, p *ListPayload) (res *Resources, err
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
 //   - "not-found" (type *goa.ServiceError): Resource Not Found Error
 //   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
 //   - error: internal error
 func (c *Client) VersionsByID(ctx context.Context, p *VersionsByIDPayload) (res *ResourceVersions, err error) {
 	var ires any
@@ -91,12 +121,126 @@ func (c *Client) VersionsByID(ctx context.Context, p *VersionsByIDPayload) (res
 	return ires.(*ResourceVersions), nil
 }
 
+// ListReviews calls the "ListReviews" endpoint of the "resource" service.
+// ListReviews may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - error: internal error
+func (c *Client) ListReviews(ctx context.Context, p *ListReviewsPayload) (res *Reviews, err error) {
+	var ires any
+	ires, err = c.ListReviewsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Reviews), nil
+}
+
+// CreateReview calls the "CreateReview" endpoint of the "resource" service.
+// CreateReview may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - error: internal error
+func (c *Client) CreateReview(ctx context.Context, p *CreateReviewPayload) (res *Review, err error) {
+	var ires any
+	ires, err = c.CreateReviewEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Review), nil
+}
+
+// UpdateReview calls the "UpdateReview" endpoint of the "resource" service.
+// UpdateReview may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - error: internal error
+func (c *Client) UpdateReview(ctx context.Context, p *UpdateReviewPayload) (res *Review, err error) {
+	var ires any
+	ires, err = c.UpdateReviewEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Review), nil
+}
+
+// DeleteReview calls the "DeleteReview" endpoint of the "resource" service.
+// DeleteReview may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - error: internal error
+func (c *Client) DeleteReview(ctx context.Context, p *DeleteReviewPayload) (err error) {
+	_, err = c.DeleteReviewEndpoint(ctx, p)
+	return
+}
+
+// Dependencies calls the "Dependencies" endpoint of the "resource" service.
+// Dependencies may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - error: internal error
+func (c *Client) Dependencies(ctx context.Context, p *DependenciesPayload) (res *PipelineDependencies, err error) {
+	var ires any
+	ires, err = c.DependenciesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*PipelineDependencies), nil
+}
+
+// Dependents calls the "Dependents" endpoint of the "resource" service.
+// Dependents may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - error: internal error
+func (c *Client) Dependents(ctx context.Context, p *DependentsPayload) (res *TaskDependents, err error) {
+	var ires any
+	ires, err = c.DependentsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TaskDependents), nil
+}
+
 // ByCatalogKindNameVersion calls the "ByCatalogKindNameVersion" endpoint of
 // the "resource" service.
 // ByCatalogKindNameVersion may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
 //   - "not-found" (type *goa.ServiceError): Resource Not Found Error
 //   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
 //   - error: internal error
 func (c *Client) ByCatalogKindNameVersion(ctx context.Context, p *ByCatalogKindNameVersionPayload) (res *ResourceVersion, err error) {
 	var ires any
@@ -113,6 +257,10 @@ func (c *Client) ByCatalogKindNameVersion(ctx context.Context, p *ByCatalogKindN
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
 //   - "not-found" (type *goa.ServiceError): Resource Not Found Error
 //   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
 //   - error: internal error
 func (c *Client) ByCatalogKindNameVersionReadme(ctx context.Context, p *ByCatalogKindNameVersionReadmePayload) (res *ResourceVersionReadme, err error) {
 	var ires any
@@ -129,6 +277,10 @@ func (c *Client) ByCatalogKindNameVersionReadme(ctx context.Context, p *ByCatalo
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
 //   - "not-found" (type *goa.ServiceError): Resource Not Found Error
 //   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
 //   - error: internal error
 func (c *Client) ByCatalogKindNameVersionYaml(ctx context.Context, p *ByCatalogKindNameVersionYamlPayload) (res *ResourceVersionYaml, err error) {
 	var ires any
@@ -139,11 +291,74 @@ func (c *Client) ByCatalogKindNameVersionYaml(ctx context.Context, p *ByCatalogK
 	return ires.(*ResourceVersionYaml), nil
 }
 
+// ByCatalogKindNameVersionLint calls the "ByCatalogKindNameVersionLint"
+// endpoint of the "resource" service.
+// ByCatalogKindNameVersionLint may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - error: internal error
+func (c *Client) ByCatalogKindNameVersionLint(ctx context.Context, p *ByCatalogKindNameVersionLintPayload) (res *VersionLint, err error) {
+	var ires any
+	ires, err = c.ByCatalogKindNameVersionLintEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*VersionLint), nil
+}
+
+// ByCatalogKindNameVersionImages calls the "ByCatalogKindNameVersionImages"
+// endpoint of the "resource" service.
+// ByCatalogKindNameVersionImages may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - error: internal error
+func (c *Client) ByCatalogKindNameVersionImages(ctx context.Context, p *ByCatalogKindNameVersionImagesPayload) (res *VersionImages, err error) {
+	var ires any
+	ires, err = c.ByCatalogKindNameVersionImagesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*VersionImages), nil
+}
+
+// ByImage calls the "ByImage" endpoint of the "resource" service.
+// ByImage may return the following errors:
+//   - "internal-error" (type *goa.ServiceError): Internal Server Error
+//   - "not-found" (type *goa.ServiceError): Resource Not Found Error
+//   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
+//   - error: internal error
+func (c *Client) ByImage(ctx context.Context, p *ByImagePayload) (res *ImageUsers, err error) {
+	var ires any
+	ires, err = c.ByImageEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ImageUsers), nil
+}
+
 // ByVersionID calls the "ByVersionId" endpoint of the "resource" service.
 // ByVersionID may return the following errors:
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
 //   - "not-found" (type *goa.ServiceError): Resource Not Found Error
 //   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
 //   - error: internal error
 func (c *Client) ByVersionID(ctx context.Context, p *ByVersionIDPayload) (res *ResourceVersion, err error) {
 	var ires any
@@ -160,6 +375,10 @@ func (c *Client) ByVersionID(ctx context.Context, p *ByVersionIDPayload) (res *R
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
 //   - "not-found" (type *goa.ServiceError): Resource Not Found Error
 //   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
 //   - error: internal error
 func (c *Client) ByCatalogKindName(ctx context.Context, p *ByCatalogKindNamePayload) (res *Resource, err error) {
 	var ires any
@@ -175,6 +394,10 @@ func (c *Client) ByCatalogKindName(ctx context.Context, p *ByCatalogKindNamePayl
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
 //   - "not-found" (type *goa.ServiceError): Resource Not Found Error
 //   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
 //   - error: internal error
 func (c *Client) ByID(ctx context.Context, p *ByIDPayload) (res *Resource, err error) {
 	var ires any
@@ -191,6 +414,10 @@ func (c *Client) ByID(ctx context.Context, p *ByIDPayload) (res *Resource, err e
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
 //   - "not-found" (type *goa.ServiceError): Resource Not Found Error
 //   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
 //   - error: internal error
 func (c *Client) GetRawYamlByCatalogKindNameVersion(ctx context.Context, p *GetRawYamlByCatalogKindNameVersionPayload) (resp io.ReadCloser, err error) {
 	var ires any
@@ -208,6 +435,10 @@ func (c *Client) GetRawYamlByCatalogKindNameVersion(ctx context.Context, p *GetR
 //   - "internal-error" (type *goa.ServiceError): Internal Server Error
 //   - "not-found" (type *goa.ServiceError): Resource Not Found Error
 //   - "invalid-kind" (type *goa.ServiceError): Invalid Resource Kind
+//   - "invalid-cursor" (type *goa.ServiceError): Invalid Cursor
+//   - "invalid-token" (type *goa.ServiceError): Invalid User token
+//   - "invalid-scopes" (type *goa.ServiceError): Invalid User scope
+//   - "invalid-payload" (type *goa.ServiceError): Invalid request body
 //   - error: internal error
 func (c *Client) GetLatestRawYamlByCatalogKindName(ctx context.Context, p *GetLatestRawYamlByCatalogKindNamePayload) (resp io.ReadCloser, err error) {
 	var ires any
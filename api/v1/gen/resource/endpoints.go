@@ -12,6 +12,7 @@ import (
 	"io"
 
 	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
 )
 
 // Endpoints wraps the "resource" service endpoints.
@@ -19,9 +20,18 @@ type Endpoints struct {
 	Query                              goa.Endpoint
 	List                               goa.Endpoint
 	VersionsByID                       goa.Endpoint
+	ListReviews                        goa.Endpoint
+	CreateReview                       goa.Endpoint
+	UpdateReview                       goa.Endpoint
+	DeleteReview                       goa.Endpoint
+	Dependencies                       goa.Endpoint
+	Dependents                         goa.Endpoint
 	ByCatalogKindNameVersion           goa.Endpoint
 	ByCatalogKindNameVersionReadme     goa.Endpoint
 	ByCatalogKindNameVersionYaml       goa.Endpoint
+	ByCatalogKindNameVersionLint       goa.Endpoint
+	ByCatalogKindNameVersionImages     goa.Endpoint
+	ByImage                            goa.Endpoint
 	ByVersionID                        goa.Endpoint
 	ByCatalogKindName                  goa.Endpoint
 	ByID                               goa.Endpoint
@@ -45,18 +55,29 @@ type GetLatestRawYamlByCatalogKindNameResponseData struct {
 
 // NewEndpoints wraps the methods of the "resource" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
+	// Casting service to Auther interface
+	a := s.(Auther)
 	return &Endpoints{
-		Query:                              NewQueryEndpoint(s),
-		List:                               NewListEndpoint(s),
-		VersionsByID:                       NewVersionsByIDEndpoint(s),
-		ByCatalogKindNameVersion:           NewByCatalogKindNameVersionEndpoint(s),
-		ByCatalogKindNameVersionReadme:     NewByCatalogKindNameVersionReadmeEndpoint(s),
-		ByCatalogKindNameVersionYaml:       NewByCatalogKindNameVersionYamlEndpoint(s),
-		ByVersionID:                        NewByVersionIDEndpoint(s),
-		ByCatalogKindName:                  NewByCatalogKindNameEndpoint(s),
-		ByID:                               NewByIDEndpoint(s),
-		GetRawYamlByCatalogKindNameVersion: NewGetRawYamlByCatalogKindNameVersionEndpoint(s),
-		GetLatestRawYamlByCatalogKindName:  NewGetLatestRawYamlByCatalogKindNameEndpoint(s),
+		Query:                              NewQueryEndpoint(s, a.JWTAuth),
+		List:                               NewListEndpoint(s, a.JWTAuth),
+		VersionsByID:                       NewVersionsByIDEndpoint(s, a.JWTAuth),
+		ListReviews:                        NewListReviewsEndpoint(s, a.JWTAuth),
+		CreateReview:                       NewCreateReviewEndpoint(s, a.JWTAuth),
+		UpdateReview:                       NewUpdateReviewEndpoint(s, a.JWTAuth),
+		DeleteReview:                       NewDeleteReviewEndpoint(s, a.JWTAuth),
+		Dependencies:                       NewDependenciesEndpoint(s, a.JWTAuth),
+		Dependents:                         NewDependentsEndpoint(s, a.JWTAuth),
+		ByCatalogKindNameVersion:           NewByCatalogKindNameVersionEndpoint(s, a.JWTAuth),
+		ByCatalogKindNameVersionReadme:     NewByCatalogKindNameVersionReadmeEndpoint(s, a.JWTAuth),
+		ByCatalogKindNameVersionYaml:       NewByCatalogKindNameVersionYamlEndpoint(s, a.JWTAuth),
+		ByCatalogKindNameVersionLint:       NewByCatalogKindNameVersionLintEndpoint(s, a.JWTAuth),
+		ByCatalogKindNameVersionImages:     NewByCatalogKindNameVersionImagesEndpoint(s, a.JWTAuth),
+		ByImage:                            NewByImageEndpoint(s, a.JWTAuth),
+		ByVersionID:                        NewByVersionIDEndpoint(s, a.JWTAuth),
+		ByCatalogKindName:                  NewByCatalogKindNameEndpoint(s, a.JWTAuth),
+		ByID:                               NewByIDEndpoint(s, a.JWTAuth),
+		GetRawYamlByCatalogKindNameVersion: NewGetRawYamlByCatalogKindNameVersionEndpoint(s, a.JWTAuth),
+		GetLatestRawYamlByCatalogKindName:  NewGetLatestRawYamlByCatalogKindNameEndpoint(s, a.JWTAuth),
 	}
 }
 
@@ -65,9 +86,18 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Query = m(e.Query)
 	e.List = m(e.List)
 	e.VersionsByID = m(e.VersionsByID)
+	e.ListReviews = m(e.ListReviews)
+	e.CreateReview = m(e.CreateReview)
+	e.UpdateReview = m(e.UpdateReview)
+	e.DeleteReview = m(e.DeleteReview)
+	e.Dependencies = m(e.Dependencies)
+	e.Dependents = m(e.Dependents)
 	e.ByCatalogKindNameVersion = m(e.ByCatalogKindNameVersion)
 	e.ByCatalogKindNameVersionReadme = m(e.ByCatalogKindNameVersionReadme)
 	e.ByCatalogKindNameVersionYaml = m(e.ByCatalogKindNameVersionYaml)
+	e.ByCatalogKindNameVersionLint = m(e.ByCatalogKindNameVersionLint)
+	e.ByCatalogKindNameVersionImages = m(e.ByCatalogKindNameVersionImages)
+	e.ByImage = m(e.ByImage)
 	e.ByVersionID = m(e.ByVersionID)
 	e.ByCatalogKindName = m(e.ByCatalogKindName)
 	e.ByID = m(e.ByID)
@@ -77,9 +107,23 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 
 // NewQueryEndpoint returns an endpoint function that calls the method "Query"
 // of service "resource".
-func NewQueryEndpoint(s Service) goa.Endpoint {
+func NewQueryEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
 		p := req.(*QueryPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
 		res, err := s.Query(ctx, p)
 		if err != nil {
 			return nil, err
@@ -91,9 +135,23 @@ func NewQueryEndpoint(s Service) goa.Endpoint {
 
 // NewListEndpoint returns an endpoint function that calls the method "List" of
 // service "resource".
-func NewListEndpoint(s Service) goa.Endpoint {
+func NewListEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
 		p := req.(*ListPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
 		res, err := s.List(ctx, p)
 		if err != nil {
 			return nil, err
@@ -105,9 +163,23 @@ func NewListEndpoint(s Service) goa.Endpoint {
 
 // NewVersionsByIDEndpoint returns an endpoint function that calls the method
 // "VersionsByID" of service "resource".
-func NewVersionsByIDEndpoint(s Service) goa.Endpoint {
+func NewVersionsByIDEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
 		p := req.(*VersionsByIDPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
 		res, err := s.VersionsByID(ctx, p)
 		if err != nil {
 			return nil, err
@@ -117,11 +189,151 @@ func NewVersionsByIDEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewListReviewsEndpoint returns an endpoint function that calls the method
+// "ListReviews" of service "resource".
+func NewListReviewsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListReviewsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ListReviews(ctx, p)
+	}
+}
+
+// NewCreateReviewEndpoint returns an endpoint function that calls the method
+// "CreateReview" of service "resource".
+func NewCreateReviewEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateReviewPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{"rating:write"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.CreateReview(ctx, p)
+	}
+}
+
+// NewUpdateReviewEndpoint returns an endpoint function that calls the method
+// "UpdateReview" of service "resource".
+func NewUpdateReviewEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateReviewPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{"rating:write"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.UpdateReview(ctx, p)
+	}
+}
+
+// NewDeleteReviewEndpoint returns an endpoint function that calls the method
+// "DeleteReview" of service "resource".
+func NewDeleteReviewEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteReviewPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{"rating:write"},
+		}
+		ctx, err = authJWTFn(ctx, p.Token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.DeleteReview(ctx, p)
+	}
+}
+
+// NewDependenciesEndpoint returns an endpoint function that calls the method
+// "Dependencies" of service "resource".
+func NewDependenciesEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DependenciesPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Dependencies(ctx, p)
+	}
+}
+
+// NewDependentsEndpoint returns an endpoint function that calls the method
+// "Dependents" of service "resource".
+func NewDependentsEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DependentsPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Dependents(ctx, p)
+	}
+}
+
 // NewByCatalogKindNameVersionEndpoint returns an endpoint function that calls
 // the method "ByCatalogKindNameVersion" of service "resource".
-func NewByCatalogKindNameVersionEndpoint(s Service) goa.Endpoint {
+func NewByCatalogKindNameVersionEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
 		p := req.(*ByCatalogKindNameVersionPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
 		res, err := s.ByCatalogKindNameVersion(ctx, p)
 		if err != nil {
 			return nil, err
@@ -133,9 +345,23 @@ func NewByCatalogKindNameVersionEndpoint(s Service) goa.Endpoint {
 
 // NewByCatalogKindNameVersionReadmeEndpoint returns an endpoint function that
 // calls the method "ByCatalogKindNameVersionReadme" of service "resource".
-func NewByCatalogKindNameVersionReadmeEndpoint(s Service) goa.Endpoint {
+func NewByCatalogKindNameVersionReadmeEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
 		p := req.(*ByCatalogKindNameVersionReadmePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
 		res, err := s.ByCatalogKindNameVersionReadme(ctx, p)
 		if err != nil {
 			return nil, err
@@ -147,9 +373,23 @@ func NewByCatalogKindNameVersionReadmeEndpoint(s Service) goa.Endpoint {
 
 // NewByCatalogKindNameVersionYamlEndpoint returns an endpoint function that
 // calls the method "ByCatalogKindNameVersionYaml" of service "resource".
-func NewByCatalogKindNameVersionYamlEndpoint(s Service) goa.Endpoint {
+func NewByCatalogKindNameVersionYamlEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
 		p := req.(*ByCatalogKindNameVersionYamlPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
 		res, err := s.ByCatalogKindNameVersionYaml(ctx, p)
 		if err != nil {
 			return nil, err
@@ -159,11 +399,94 @@ func NewByCatalogKindNameVersionYamlEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewByCatalogKindNameVersionLintEndpoint returns an endpoint function that
+// calls the method "ByCatalogKindNameVersionLint" of service "resource".
+func NewByCatalogKindNameVersionLintEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ByCatalogKindNameVersionLintPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ByCatalogKindNameVersionLint(ctx, p)
+	}
+}
+
+// NewByCatalogKindNameVersionImagesEndpoint returns an endpoint function that
+// calls the method "ByCatalogKindNameVersionImages" of service "resource".
+func NewByCatalogKindNameVersionImagesEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ByCatalogKindNameVersionImagesPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ByCatalogKindNameVersionImages(ctx, p)
+	}
+}
+
+// NewByImageEndpoint returns an endpoint function that calls the method
+// "ByImage" of service "resource".
+func NewByImageEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ByImagePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.ByImage(ctx, p)
+	}
+}
+
 // NewByVersionIDEndpoint returns an endpoint function that calls the method
 // "ByVersionId" of service "resource".
-func NewByVersionIDEndpoint(s Service) goa.Endpoint {
+func NewByVersionIDEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
 		p := req.(*ByVersionIDPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
 		res, err := s.ByVersionID(ctx, p)
 		if err != nil {
 			return nil, err
@@ -175,9 +498,23 @@ func NewByVersionIDEndpoint(s Service) goa.Endpoint {
 
 // NewByCatalogKindNameEndpoint returns an endpoint function that calls the
 // method "ByCatalogKindName" of service "resource".
-func NewByCatalogKindNameEndpoint(s Service) goa.Endpoint {
+func NewByCatalogKindNameEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
 		p := req.(*ByCatalogKindNamePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
 		res, err := s.ByCatalogKindName(ctx, p)
 		if err != nil {
 			return nil, err
@@ -189,9 +526,23 @@ func NewByCatalogKindNameEndpoint(s Service) goa.Endpoint {
 
 // NewByIDEndpoint returns an endpoint function that calls the method "ById" of
 // service "resource".
-func NewByIDEndpoint(s Service) goa.Endpoint {
+func NewByIDEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
 		p := req.(*ByIDPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
 		res, err := s.ByID(ctx, p)
 		if err != nil {
 			return nil, err
@@ -204,9 +555,23 @@ func NewByIDEndpoint(s Service) goa.Endpoint {
 // NewGetRawYamlByCatalogKindNameVersionEndpoint returns an endpoint function
 // that calls the method "GetRawYamlByCatalogKindNameVersion" of service
 // "resource".
-func NewGetRawYamlByCatalogKindNameVersionEndpoint(s Service) goa.Endpoint {
+func NewGetRawYamlByCatalogKindNameVersionEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
 		p := req.(*GetRawYamlByCatalogKindNameVersionPayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
 		body, err := s.GetRawYamlByCatalogKindNameVersion(ctx, p)
 		if err != nil {
 			return nil, err
@@ -218,9 +583,23 @@ func NewGetRawYamlByCatalogKindNameVersionEndpoint(s Service) goa.Endpoint {
 // NewGetLatestRawYamlByCatalogKindNameEndpoint returns an endpoint function
 // that calls the method "GetLatestRawYamlByCatalogKindName" of service
 // "resource".
-func NewGetLatestRawYamlByCatalogKindNameEndpoint(s Service) goa.Endpoint {
+func NewGetLatestRawYamlByCatalogKindNameEndpoint(s Service, authJWTFn security.AuthJWTFunc) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
 		p := req.(*GetLatestRawYamlByCatalogKindNamePayload)
+		var err error
+		sc := security.JWTScheme{
+			Name:           "jwt",
+			Scopes:         []string{"rating:read", "rating:write", "agent:create", "catalog:refresh", "catalog:manage", "config:refresh", "audit:read", "refresh:token"},
+			RequiredScopes: []string{},
+		}
+		var token string
+		if p.Token != nil {
+			token = *p.Token
+		}
+		ctx, err = authJWTFn(ctx, token, &sc)
+		if err != nil {
+			return nil, err
+		}
 		body, err := s.GetLatestRawYamlByCatalogKindName(ctx, p)
 		if err != nil {
 			return nil, err
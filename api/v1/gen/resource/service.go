@@ -13,17 +13,31 @@ import (
 
 	resourceviews "github.com/tektoncd/hub/api/v1/gen/resource/views"
 	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
 )
 
 // The resource service provides details about all kind of resources
 type Service interface {
 	// Find resources by a combination of name, kind, catalog, categories,
-	// platforms and tags
+	// platforms, apiVersions, verifications and tags
 	Query(context.Context, *QueryPayload) (res *Resources, err error)
 	// List all resources sorted by rating and name
 	List(context.Context, *ListPayload) (res *Resources, err error)
 	// Find all versions of a resource by its id
 	VersionsByID(context.Context, *VersionsByIDPayload) (res *ResourceVersions, err error)
+	// List the reviews of a resource, latest first
+	ListReviews(context.Context, *ListReviewsPayload) (res *Reviews, err error)
+	// Write a review on a version of a resource
+	CreateReview(context.Context, *CreateReviewPayload) (res *Review, err error)
+	// Edit a review written by the user
+	UpdateReview(context.Context, *UpdateReviewPayload) (res *Review, err error)
+	// Delete a review written by the user
+	DeleteReview(context.Context, *DeleteReviewPayload) (err error)
+	// List the tasks referenced by a version of a pipeline, by default the latest
+	// version
+	Dependencies(context.Context, *DependenciesPayload) (res *PipelineDependencies, err error)
+	// List the versions of the pipelines referencing a task
+	Dependents(context.Context, *DependentsPayload) (res *TaskDependents, err error)
 	// Find resource using name of catalog & name, kind and version of resource
 	ByCatalogKindNameVersion(context.Context, *ByCatalogKindNameVersionPayload) (res *ResourceVersion, err error)
 	// Find resource README using name of catalog & name, kind and version of
@@ -31,6 +45,15 @@ type Service interface {
 	ByCatalogKindNameVersionReadme(context.Context, *ByCatalogKindNameVersionReadmePayload) (res *ResourceVersionReadme, err error)
 	// Find resource YAML using name of catalog & name, kind and version of resource
 	ByCatalogKindNameVersionYaml(context.Context, *ByCatalogKindNameVersionYamlPayload) (res *ResourceVersionYaml, err error)
+	// Find the problems found by the lint rules in a resource using name of
+	// catalog & name, kind and version of resource
+	ByCatalogKindNameVersionLint(context.Context, *ByCatalogKindNameVersionLintPayload) (res *VersionLint, err error)
+	// Find the images of the steps and sidecars of a resource using name of
+	// catalog & name, kind and version of resource
+	ByCatalogKindNameVersionImages(context.Context, *ByCatalogKindNameVersionImagesPayload) (res *VersionImages, err error)
+	// Find the versions of the resources using an image, an image without tag and
+	// digest matches all the tags and digests of the image
+	ByImage(context.Context, *ByImagePayload) (res *ImageUsers, err error)
 	// Find a resource using its version's id
 	ByVersionID(context.Context, *ByVersionIDPayload) (res *ResourceVersion, err error)
 	// Find resources using name of catalog, resource name and kind of resource
@@ -53,6 +76,12 @@ type Service interface {
 	GetLatestRawYamlByCatalogKindName(context.Context, *GetLatestRawYamlByCatalogKindNamePayload) (body io.ReadCloser, err error)
 }
 
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// JWTAuth implements the authorization logic for the JWT security scheme.
+	JWTAuth(ctx context.Context, token string, schema *security.JWTScheme) (context.Context, error)
+}
+
 // APIName is the name of the API as defined in the design.
 const APIName = "v1"
 
@@ -67,11 +96,13 @@ const ServiceName = "resource"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [11]string{"Query", "List", "VersionsByID", "ByCatalogKindNameVersion", "ByCatalogKindNameVersionReadme", "ByCatalogKindNameVersionYaml", "ByVersionId", "ByCatalogKindName", "ById", "GetRawYamlByCatalogKindNameVersion", "GetLatestRawYamlByCatalogKindName"}
+var MethodNames = [20]string{"Query", "List", "VersionsByID", "ListReviews", "CreateReview", "UpdateReview", "DeleteReview", "Dependencies", "Dependents", "ByCatalogKindNameVersion", "ByCatalogKindNameVersionReadme", "ByCatalogKindNameVersionYaml", "ByCatalogKindNameVersionLint", "ByCatalogKindNameVersionImages", "ByImage", "ByVersionId", "ByCatalogKindName", "ById", "GetRawYamlByCatalogKindNameVersion", "GetLatestRawYamlByCatalogKindName"}
 
 // ByCatalogKindNamePayload is the payload type of the resource service
 // ByCatalogKindName method.
 type ByCatalogKindNamePayload struct {
+	// JWT of the user to see the resources of private catalogs
+	Token *string
 	// name of catalog
 	Catalog string
 	// kind of resource
@@ -80,11 +111,45 @@ type ByCatalogKindNamePayload struct {
 	Name string
 	// To find resource compatible with a Tekton pipelines version, use this param
 	Pipelinesversion *string
+	// Include pre-release versions e.g. 0.3.0-rc1 of resources
+	Prerelease bool
+}
+
+// ByCatalogKindNameVersionImagesPayload is the payload type of the resource
+// service ByCatalogKindNameVersionImages method.
+type ByCatalogKindNameVersionImagesPayload struct {
+	// JWT of the user to see the resources of private catalogs
+	Token *string
+	// name of catalog
+	Catalog string
+	// kind of resource
+	Kind string
+	// name of resource
+	Name string
+	// version of resource
+	Version string
+}
+
+// ByCatalogKindNameVersionLintPayload is the payload type of the resource
+// service ByCatalogKindNameVersionLint method.
+type ByCatalogKindNameVersionLintPayload struct {
+	// JWT of the user to see the resources of private catalogs
+	Token *string
+	// name of catalog
+	Catalog string
+	// kind of resource
+	Kind string
+	// name of resource
+	Name string
+	// version of resource
+	Version string
 }
 
 // ByCatalogKindNameVersionPayload is the payload type of the resource service
 // ByCatalogKindNameVersion method.
 type ByCatalogKindNameVersionPayload struct {
+	// JWT of the user to see the resources of private catalogs
+	Token *string
 	// name of catalog
 	Catalog string
 	// kind of resource
@@ -98,6 +163,8 @@ type ByCatalogKindNameVersionPayload struct {
 // ByCatalogKindNameVersionReadmePayload is the payload type of the resource
 // service ByCatalogKindNameVersionReadme method.
 type ByCatalogKindNameVersionReadmePayload struct {
+	// JWT of the user to see the resources of private catalogs
+	Token *string
 	// name of catalog
 	Catalog string
 	// kind of resource
@@ -111,6 +178,8 @@ type ByCatalogKindNameVersionReadmePayload struct {
 // ByCatalogKindNameVersionYamlPayload is the payload type of the resource
 // service ByCatalogKindNameVersionYaml method.
 type ByCatalogKindNameVersionYamlPayload struct {
+	// JWT of the user to see the resources of private catalogs
+	Token *string
 	// name of catalog
 	Catalog string
 	// kind of resource
@@ -119,17 +188,34 @@ type ByCatalogKindNameVersionYamlPayload struct {
 	Name string
 	// version of resource
 	Version string
+	// The YAML is fetched to install the resource, installs are counted as
+	// downloads
+	Install bool
 }
 
 // ByIDPayload is the payload type of the resource service ById method.
 type ByIDPayload struct {
+	// JWT of the user to see the resources of private catalogs
+	Token *string
 	// ID of a resource
 	ID uint
 }
 
+// ByImagePayload is the payload type of the resource service ByImage method.
+type ByImagePayload struct {
+	// JWT of the user to see the resources of private catalogs
+	Token *string
+	// Reference of the image
+	Ref string
+	// Maximum number of versions to be returned
+	Limit uint
+}
+
 // ByVersionIDPayload is the payload type of the resource service ByVersionId
 // method.
 type ByVersionIDPayload struct {
+	// JWT of the user to see the resources of private catalogs
+	Token *string
 	// Version ID of a resource's version
 	VersionID uint
 }
@@ -154,9 +240,92 @@ type Category struct {
 	Name string
 }
 
+// CreateReviewPayload is the payload type of the resource service CreateReview
+// method.
+type CreateReviewPayload struct {
+	// JWT
+	Token string
+	// ID of a resource
+	ID uint
+	// Version of the resource which is reviewed
+	Version string
+	// Text of the review
+	Text string
+}
+
+// DeleteReviewPayload is the payload type of the resource service DeleteReview
+// method.
+type DeleteReviewPayload struct {
+	// JWT
+	Token string
+	// ID of a resource
+	ID uint
+	// ID of the review
+	ReviewID uint
+}
+
+// DependenciesPayload is the payload type of the resource service Dependencies
+// method.
+type DependenciesPayload struct {
+	// JWT of the user to see the resources of private catalogs
+	Token *string
+	// ID of a resource
+	ID uint
+	// Version of the pipeline
+	Version *string
+}
+
+// The Dependency type describes a task referenced by a pipeline
+type Dependency struct {
+	// Name of the task
+	Name string
+	// Kind of the task
+	Kind string
+	// How the pipeline references the task
+	Via string
+	// Version of the task, absent if the reference doesn't pin it
+	Version *string
+	// Name of the catalog of the task, if known
+	Catalog *string
+	// Bundle of the task referenced through a bundle
+	Bundle *string
+	// URL of the repository of the task referenced through git
+	URL *string
+	// ID of the resource in the hub the task resolves to
+	ResourceID *uint
+}
+
+// The Dependent type describes a version of a pipeline referencing a task
+type Dependent struct {
+	// ID of the pipeline
+	ResourceID uint
+	// Name of the pipeline
+	Name string
+	// Name of the catalog of the pipeline
+	Catalog string
+	// Version of the pipeline
+	Version string
+	// Version of the task referenced by the pipeline, absent if the reference
+	// doesn't pin it
+	TaskVersion *string
+}
+
+// DependentsPayload is the payload type of the resource service Dependents
+// method.
+type DependentsPayload struct {
+	// JWT of the user to see the resources of private catalogs
+	Token *string
+	// ID of a resource
+	ID uint
+	// Version of the task, only the references pinning the version are returned
+	Version *string
+}
+
 // GetLatestRawYamlByCatalogKindNamePayload is the payload type of the resource
 // service GetLatestRawYamlByCatalogKindName method.
 type GetLatestRawYamlByCatalogKindNamePayload struct {
+	// JWT of the user to see the resources of private catalogs
+	Token *string
 	// name of catalog
 	Catalog string
 	// kind of resource
@@ -168,6 +337,8 @@ type GetLatestRawYamlByCatalogKindNamePayload struct {
 // GetRawYamlByCatalogKindNameVersionPayload is the payload type of the
 // resource service GetRawYamlByCatalogKindNameVersion method.
 type GetRawYamlByCatalogKindNameVersionPayload struct {
+	// JWT of the user to see the resources of private catalogs
+	Token *string
 	// name of catalog
 	Catalog string
 	// kind of resource
@@ -178,10 +349,84 @@ type GetRawYamlByCatalogKindNameVersionPayload struct {
 	Version string
 }
 
+// The ImageUser type describes a version of a resource using an image
+type ImageUser struct {
+	// ID of the resource
+	ResourceID uint
+	// Name of the catalog of the resource
+	Catalog string
+	// Kind of the resource
+	Kind string
+	// Name of the resource
+	Name string
+	// Version of the resource
+	Version string
+	// Reference of the image used by the version
+	Image string
+}
+
+// ImageUsers is the result type of the resource service ByImage method.
+type ImageUsers struct {
+	Data []*ImageUser
+}
+
+// The LintResult type describes a problem found by a lint rule in a version of
+// a resource
+type LintResult struct {
+	// ID of the rule
+	Rule string
+	// Severity of the problem
+	Severity string
+	// Description of the problem
+	Message string
+}
+
 // ListPayload is the payload type of the resource service List method.
 type ListPayload struct {
+	// JWT of the user to see the resources of private catalogs
+	Token *string
 	// Maximum number of resources to be returned
 	Limit uint
+	// Order of the resources, by default rating and name. Popular resources are
+	// the most downloaded in the last 30 days
+	Sort *string
+	// Cursor returned as next by the previous page to fetch the next page
+	Cursor *string
+	// Include pre-release versions e.g. 0.3.0-rc1 of resources
+	Prerelease bool
+}
+
+// ListReviewsPayload is the payload type of the resource service ListReviews
+// method.
+type ListReviewsPayload struct {
+	// JWT of the user to see the resources of private catalogs
+	Token *string
+	// ID of a resource
+	ID uint
+	// Maximum number of reviews to be returned
+	Limit uint
+	// Cursor returned as next by the previous page to fetch the next page
+	Cursor *string
+}
+
+// The ParamSpec type describes a param of a version of a resource
+type ParamSpec struct {
+	// Name of the param
+	Name string
+	// Type of the param
+	Type string
+	// Default of the param, JSON for array and object params
+	Default *string
+	// Description of the param
+	Description string
+}
+
+// PipelineDependencies is the result type of the resource service Dependencies
+// method.
+type PipelineDependencies struct {
+	// Version of the pipeline
+	Version string
+	Data    []*Dependency
 }
 
 type Platform struct {
@@ -193,6 +438,8 @@ type Platform struct {
 
 // QueryPayload is the payload type of the resource service Query method.
 type QueryPayload struct {
+	// JWT of the user to see the resources of private catalogs
+	Token *string
 	// Name of resource
 	Name string
 	// Catalogs of resource to filter by
@@ -205,10 +452,21 @@ type QueryPayload struct {
 	Tags []string
 	// Platforms associated with a resource to filter by
 	Platforms []string
+	// Tekton apiVersions of resource versions to filter by
+	APIVersions []string
+	// Verifications of the signatures of resource versions to filter by
+	Verifications []string
 	// Maximum number of resources to be returned
 	Limit uint
 	// Strategy used to find matching resources
 	Match string
+	// Order of the resources, by default rating and name or relevance for fulltext
+	// match. Popular resources are the most downloaded in the last 30 days
+	Sort *string
+	// Cursor returned as next by the previous page to fetch the next page
+	Cursor *string
+	// Include pre-release versions e.g. 0.3.0-rc1 of resources
+	Prerelease bool
 }
 
 // Resource is the result type of the resource service ByCatalogKindName method.
@@ -247,6 +505,8 @@ type ResourceData struct {
 	Platforms []*Platform
 	// Rating of resource
 	Rating float64
+	// Number of downloads of all versions of resource
+	Downloads uint
 	// List of all versions of a resource
 	Versions []*ResourceVersionData
 }
@@ -285,6 +545,19 @@ type ResourceVersionData struct {
 	Platforms []*Platform
 	// Url path of the resource in hub
 	HubURLPath string
+	// Tekton bundle (OCI image) reference of the version
+	Bundle *string
+	// Tekton apiVersion of the resource manifest
+	APIVersion *string
+	// Verification of the trusted resource signature of the version with the
+	// public keys of its catalog
+	Verification *string
+	// Params of the version
+	Params []*ParamSpec
+	// Workspaces of the version
+	Workspaces []*WorkspaceSpec
+	// Results of the version
+	Results []*ResultSpec
 	// Resource to which the version belongs
 	Resource *ResourceData
 }
@@ -310,6 +583,43 @@ type ResourceVersions struct {
 // Resources is the result type of the resource service Query method.
 type Resources struct {
 	Data ResourceDataCollection
+	// Cursor to fetch the next page of resources, absent on the last page
+	Next *string
+}
+
+// The ResultSpec type describes a result of a version of a resource
+type ResultSpec struct {
+	// Name of the result
+	Name string
+	// Type of the result
+	Type string
+	// Description of the result
+	Description string
+}
+
+// Review is the result type of the resource service CreateReview method.
+type Review struct {
+	// ID of the review
+	ID uint
+	// ID of the resource
+	ResourceID uint
+	// Version of the resource which is reviewed
+	Version string
+	// ID of the user who wrote the review
+	UserID uint
+	// Text of the review
+	Text string
+	// Time at which the review was written
+	CreatedAt string
+	// Time at which the review was last edited
+	UpdatedAt string
+}
+
+// Reviews is the result type of the resource service ListReviews method.
+type Reviews struct {
+	Data []*Review
+	// Cursor to fetch the next page of reviews, absent on the last page
+	Next *string
 }
 
 type Tag struct {
@@ -319,6 +629,37 @@ type Tag struct {
 	Name string
 }
 
+// TaskDependents is the result type of the resource service Dependents method.
+type TaskDependents struct {
+	Data []*Dependent
+}
+
+// UpdateReviewPayload is the payload type of the resource service UpdateReview
+// method.
+type UpdateReviewPayload struct {
+	// JWT
+	Token string
+	// ID of a resource
+	ID uint
+	// ID of the review
+	ReviewID uint
+	// Text of the review
+	Text string
+}
+
+// VersionImages is the result type of the resource service
+// ByCatalogKindNameVersionImages method.
+type VersionImages struct {
+	// References of the images
+	Data []string
+}
+
+// VersionLint is the result type of the resource service
+// ByCatalogKindNameVersionLint method.
+type VersionLint struct {
+	Data []*LintResult
+}
+
 // The Versions type describes response for versions by resource id API.
 type Versions struct {
 	// Latest Version of resource
@@ -330,8 +671,25 @@ type Versions struct {
 // VersionsByIDPayload is the payload type of the resource service VersionsByID
 // method.
 type VersionsByIDPayload struct {
+	// JWT of the user to see the resources of private catalogs
+	Token *string
 	// ID of a resource
 	ID uint
+	// Include pre-release versions e.g. 0.3.0-rc1 of resources
+	Prerelease bool
+}
+
+// The WorkspaceSpec type describes a workspace of a version of a task or a
+// pipeline
+type WorkspaceSpec struct {
+	// Name of the workspace
+	Name string
+	// Description of the workspace
+	Description string
+	// Whether the workspace may not be bound
+	Optional bool
+	// Whether the task only reads the workspace
+	ReadOnly bool
 }
 
 // MakeInternalError builds a goa.ServiceError from an error.
@@ -349,6 +707,26 @@ func MakeInvalidKind(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "invalid-kind", false, false, false)
 }
 
+// MakeInvalidCursor builds a goa.ServiceError from an error.
+func MakeInvalidCursor(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid-cursor", false, false, false)
+}
+
+// MakeInvalidToken builds a goa.ServiceError from an error.
+func MakeInvalidToken(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid-token", false, false, false)
+}
+
+// MakeInvalidScopes builds a goa.ServiceError from an error.
+func MakeInvalidScopes(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid-scopes", false, false, false)
+}
+
+// MakeInvalidPayload builds a goa.ServiceError from an error.
+func MakeInvalidPayload(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid-payload", false, false, false)
+}
+
 // NewResources initializes result type Resources from viewed result type
 // Resources.
 func NewResources(vres *resourceviews.Resources) *Resources {
@@ -431,7 +809,9 @@ func NewViewedResource(res *Resource, view string) *resourceviews.Resource {
 
 // newResources converts projected type Resources to service type Resources.
 func newResources(vres *resourceviews.ResourcesView) *Resources {
-	res := &Resources{}
+	res := &Resources{
+		Next: vres.Next,
+	}
 	if vres.Data != nil {
 		res.Data = newResourceDataCollectionWithoutVersion(vres.Data)
 	}
@@ -441,7 +821,9 @@ func newResources(vres *resourceviews.ResourcesView) *Resources {
 // newResourcesView projects result type Resources to projected type
 // ResourcesView using the "default" view.
 func newResourcesView(res *Resources) *resourceviews.ResourcesView {
-	vres := &resourceviews.ResourcesView{}
+	vres := &resourceviews.ResourcesView{
+		Next: res.Next,
+	}
 	if res.Data != nil {
 		vres.Data = newResourceDataCollectionViewWithoutVersion(res.Data)
 	}
@@ -578,6 +960,9 @@ func newResourceDataWithoutVersion(vres *resourceviews.ResourceDataView) *Resour
 	if vres.Rating != nil {
 		res.Rating = *vres.Rating
 	}
+	if vres.Downloads != nil {
+		res.Downloads = *vres.Downloads
+	}
 	if vres.Categories != nil {
 		res.Categories = make([]*Category, len(vres.Categories))
 		for i, val := range vres.Categories {
@@ -627,6 +1012,9 @@ func newResourceData(vres *resourceviews.ResourceDataView) *ResourceData {
 	if vres.Rating != nil {
 		res.Rating = *vres.Rating
 	}
+	if vres.Downloads != nil {
+		res.Downloads = *vres.Downloads
+	}
 	if vres.Categories != nil {
 		res.Categories = make([]*Category, len(vres.Categories))
 		for i, val := range vres.Categories {
@@ -710,6 +1098,7 @@ func newResourceDataViewWithoutVersion(res *ResourceData) *resourceviews.Resourc
 		HubURLPath:    &res.HubURLPath,
 		HubRawURLPath: &res.HubRawURLPath,
 		Rating:        &res.Rating,
+		Downloads:     &res.Downloads,
 	}
 	if res.Categories != nil {
 		vres.Categories = make([]*resourceviews.CategoryView, len(res.Categories))
@@ -754,6 +1143,7 @@ func newResourceDataView(res *ResourceData) *resourceviews.ResourceDataView {
 		HubURLPath:    &res.HubURLPath,
 		HubRawURLPath: &res.HubRawURLPath,
 		Rating:        &res.Rating,
+		Downloads:     &res.Downloads,
 	}
 	if res.Categories != nil {
 		vres.Categories = make([]*resourceviews.CategoryView, len(res.Categories))
@@ -859,7 +1249,9 @@ func newCatalogView(res *Catalog) *resourceviews.CatalogView {
 // newResourceVersionDataTiny converts projected type ResourceVersionData to
 // service type ResourceVersionData.
 func newResourceVersionDataTiny(vres *resourceviews.ResourceVersionDataView) *ResourceVersionData {
-	res := &ResourceVersionData{}
+	res := &ResourceVersionData{
+		Bundle: vres.Bundle,
+	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
 	}
@@ -910,7 +1302,10 @@ func newResourceVersionDataMin(vres *resourceviews.ResourceVersionDataView) *Res
 // ResourceVersionData to service type ResourceVersionData.
 func newResourceVersionDataWithoutResource(vres *resourceviews.ResourceVersionDataView) *ResourceVersionData {
 	res := &ResourceVersionData{
-		Deprecated: vres.Deprecated,
+		Deprecated:   vres.Deprecated,
+		Bundle:       vres.Bundle,
+		APIVersion:   vres.APIVersion,
+		Verification: vres.Verification,
 	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
@@ -958,7 +1353,10 @@ func newResourceVersionDataWithoutResource(vres *resourceviews.ResourceVersionDa
 // service type ResourceVersionData.
 func newResourceVersionData(vres *resourceviews.ResourceVersionDataView) *ResourceVersionData {
 	res := &ResourceVersionData{
-		Deprecated: vres.Deprecated,
+		Deprecated:   vres.Deprecated,
+		Bundle:       vres.Bundle,
+		APIVersion:   vres.APIVersion,
+		Verification: vres.Verification,
 	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
@@ -996,6 +1394,24 @@ func newResourceVersionData(vres *resourceviews.ResourceVersionDataView) *Resour
 			res.Platforms[i] = transformResourceviewsPlatformViewToPlatform(val)
 		}
 	}
+	if vres.Params != nil {
+		res.Params = make([]*ParamSpec, len(vres.Params))
+		for i, val := range vres.Params {
+			res.Params[i] = transformResourceviewsParamSpecViewToParamSpec(val)
+		}
+	}
+	if vres.Workspaces != nil {
+		res.Workspaces = make([]*WorkspaceSpec, len(vres.Workspaces))
+		for i, val := range vres.Workspaces {
+			res.Workspaces[i] = transformResourceviewsWorkspaceSpecViewToWorkspaceSpec(val)
+		}
+	}
+	if vres.Results != nil {
+		res.Results = make([]*ResultSpec, len(vres.Results))
+		for i, val := range vres.Results {
+			res.Results[i] = transformResourceviewsResultSpecViewToResultSpec(val)
+		}
+	}
 	if vres.Resource != nil {
 		res.Resource = newResourceDataInfo(vres.Resource)
 	}
@@ -1008,6 +1424,7 @@ func newResourceVersionDataViewTiny(res *ResourceVersionData) *resourceviews.Res
 	vres := &resourceviews.ResourceVersionDataView{
 		ID:      &res.ID,
 		Version: &res.Version,
+		Bundle:  res.Bundle,
 	}
 	return vres
 }
@@ -1050,6 +1467,9 @@ func newResourceVersionDataViewWithoutResource(res *ResourceVersionData) *resour
 		HubRawURLPath:       &res.HubRawURLPath,
 		UpdatedAt:           &res.UpdatedAt,
 		HubURLPath:          &res.HubURLPath,
+		Bundle:              res.Bundle,
+		APIVersion:          res.APIVersion,
+		Verification:        res.Verification,
 	}
 	if res.Platforms != nil {
 		vres.Platforms = make([]*resourceviews.PlatformView, len(res.Platforms))
@@ -1077,6 +1497,9 @@ func newResourceVersionDataView(res *ResourceVersionData) *resourceviews.Resourc
 		HubRawURLPath:       &res.HubRawURLPath,
 		UpdatedAt:           &res.UpdatedAt,
 		HubURLPath:          &res.HubURLPath,
+		Bundle:              res.Bundle,
+		APIVersion:          res.APIVersion,
+		Verification:        res.Verification,
 	}
 	if res.Platforms != nil {
 		vres.Platforms = make([]*resourceviews.PlatformView, len(res.Platforms))
@@ -1086,6 +1509,24 @@ func newResourceVersionDataView(res *ResourceVersionData) *resourceviews.Resourc
 	} else {
 		vres.Platforms = []*resourceviews.PlatformView{}
 	}
+	if res.Params != nil {
+		vres.Params = make([]*resourceviews.ParamSpecView, len(res.Params))
+		for i, val := range res.Params {
+			vres.Params[i] = transformParamSpecToResourceviewsParamSpecView(val)
+		}
+	}
+	if res.Workspaces != nil {
+		vres.Workspaces = make([]*resourceviews.WorkspaceSpecView, len(res.Workspaces))
+		for i, val := range res.Workspaces {
+			vres.Workspaces[i] = transformWorkspaceSpecToResourceviewsWorkspaceSpecView(val)
+		}
+	}
+	if res.Results != nil {
+		vres.Results = make([]*resourceviews.ResultSpecView, len(res.Results))
+		for i, val := range res.Results {
+			vres.Results[i] = transformResultSpecToResourceviewsResultSpecView(val)
+		}
+	}
 	if res.Resource != nil {
 		vres.Resource = newResourceDataViewInfo(res.Resource)
 	}
@@ -1341,6 +1782,9 @@ func transformResourceviewsResourceVersionDataViewToResourceVersionData(v *resou
 		HubRawURLPath:       *v.HubRawURLPath,
 		UpdatedAt:           *v.UpdatedAt,
 		HubURLPath:          *v.HubURLPath,
+		Bundle:              v.Bundle,
+		APIVersion:          v.APIVersion,
+		Verification:        v.Verification,
 	}
 	if v.Platforms != nil {
 		res.Platforms = make([]*Platform, len(v.Platforms))
@@ -1350,6 +1794,24 @@ func transformResourceviewsResourceVersionDataViewToResourceVersionData(v *resou
 	} else {
 		res.Platforms = []*Platform{}
 	}
+	if v.Params != nil {
+		res.Params = make([]*ParamSpec, len(v.Params))
+		for i, val := range v.Params {
+			res.Params[i] = transformResourceviewsParamSpecViewToParamSpec(val)
+		}
+	}
+	if v.Workspaces != nil {
+		res.Workspaces = make([]*WorkspaceSpec, len(v.Workspaces))
+		for i, val := range v.Workspaces {
+			res.Workspaces[i] = transformResourceviewsWorkspaceSpecViewToWorkspaceSpec(val)
+		}
+	}
+	if v.Results != nil {
+		res.Results = make([]*ResultSpec, len(v.Results))
+		for i, val := range v.Results {
+			res.Results[i] = transformResourceviewsResultSpecViewToResultSpec(val)
+		}
+	}
 	if v.Resource != nil {
 		res.Resource = transformResourceviewsResourceDataViewToResourceData(v.Resource)
 	}
@@ -1357,6 +1819,53 @@ func transformResourceviewsResourceVersionDataViewToResourceVersionData(v *resou
 	return res
 }
 
+// transformResourceviewsParamSpecViewToParamSpec builds a value of type
+// *ParamSpec from a value of type *resourceviews.ParamSpecView.
+func transformResourceviewsParamSpecViewToParamSpec(v *resourceviews.ParamSpecView) *ParamSpec {
+	if v == nil {
+		return nil
+	}
+	res := &ParamSpec{
+		Name:        *v.Name,
+		Type:        *v.Type,
+		Default:     v.Default,
+		Description: *v.Description,
+	}
+
+	return res
+}
+
+// transformResourceviewsWorkspaceSpecViewToWorkspaceSpec builds a value of
+// type *WorkspaceSpec from a value of type *resourceviews.WorkspaceSpecView.
+func transformResourceviewsWorkspaceSpecViewToWorkspaceSpec(v *resourceviews.WorkspaceSpecView) *WorkspaceSpec {
+	if v == nil {
+		return nil
+	}
+	res := &WorkspaceSpec{
+		Name:        *v.Name,
+		Description: *v.Description,
+		Optional:    *v.Optional,
+		ReadOnly:    *v.ReadOnly,
+	}
+
+	return res
+}
+
+// transformResourceviewsResultSpecViewToResultSpec builds a value of type
+// *ResultSpec from a value of type *resourceviews.ResultSpecView.
+func transformResourceviewsResultSpecViewToResultSpec(v *resourceviews.ResultSpecView) *ResultSpec {
+	if v == nil {
+		return nil
+	}
+	res := &ResultSpec{
+		Name:        *v.Name,
+		Type:        *v.Type,
+		Description: *v.Description,
+	}
+
+	return res
+}
+
 // transformResourceviewsResourceDataViewToResourceData builds a value of type
 // *ResourceData from a value of type *resourceviews.ResourceDataView.
 func transformResourceviewsResourceDataViewToResourceData(v *resourceviews.ResourceDataView) *ResourceData {
@@ -1379,6 +1888,9 @@ func transformResourceviewsResourceDataViewToResourceData(v *resourceviews.Resou
 	if v.Rating != nil {
 		res.Rating = *v.Rating
 	}
+	if v.Downloads != nil {
+		res.Downloads = *v.Downloads
+	}
 	if v.Categories != nil {
 		res.Categories = make([]*Category, len(v.Categories))
 		for i, val := range v.Categories {
@@ -1456,6 +1968,9 @@ func transformResourceVersionDataToResourceviewsResourceVersionDataView(v *Resou
 		HubRawURLPath:       &v.HubRawURLPath,
 		UpdatedAt:           &v.UpdatedAt,
 		HubURLPath:          &v.HubURLPath,
+		Bundle:              v.Bundle,
+		APIVersion:          v.APIVersion,
+		Verification:        v.Verification,
 	}
 	if v.Platforms != nil {
 		res.Platforms = make([]*resourceviews.PlatformView, len(v.Platforms))
@@ -1465,6 +1980,24 @@ func transformResourceVersionDataToResourceviewsResourceVersionDataView(v *Resou
 	} else {
 		res.Platforms = []*resourceviews.PlatformView{}
 	}
+	if v.Params != nil {
+		res.Params = make([]*resourceviews.ParamSpecView, len(v.Params))
+		for i, val := range v.Params {
+			res.Params[i] = transformParamSpecToResourceviewsParamSpecView(val)
+		}
+	}
+	if v.Workspaces != nil {
+		res.Workspaces = make([]*resourceviews.WorkspaceSpecView, len(v.Workspaces))
+		for i, val := range v.Workspaces {
+			res.Workspaces[i] = transformWorkspaceSpecToResourceviewsWorkspaceSpecView(val)
+		}
+	}
+	if v.Results != nil {
+		res.Results = make([]*resourceviews.ResultSpecView, len(v.Results))
+		for i, val := range v.Results {
+			res.Results[i] = transformResultSpecToResourceviewsResultSpecView(val)
+		}
+	}
 	if v.Resource != nil {
 		res.Resource = transformResourceDataToResourceviewsResourceDataView(v.Resource)
 	}
@@ -1472,6 +2005,53 @@ func transformResourceVersionDataToResourceviewsResourceVersionDataView(v *Resou
 	return res
 }
 
+// transformParamSpecToResourceviewsParamSpecView builds a value of type
+// *resourceviews.ParamSpecView from a value of type *ParamSpec.
+func transformParamSpecToResourceviewsParamSpecView(v *ParamSpec) *resourceviews.ParamSpecView {
+	if v == nil {
+		return nil
+	}
+	res := &resourceviews.ParamSpecView{
+		Name:        &v.Name,
+		Type:        &v.Type,
+		Default:     v.Default,
+		Description: &v.Description,
+	}
+
+	return res
+}
+
+// transformWorkspaceSpecToResourceviewsWorkspaceSpecView builds a value of
+// type *resourceviews.WorkspaceSpecView from a value of type *WorkspaceSpec.
+func transformWorkspaceSpecToResourceviewsWorkspaceSpecView(v *WorkspaceSpec) *resourceviews.WorkspaceSpecView {
+	if v == nil {
+		return nil
+	}
+	res := &resourceviews.WorkspaceSpecView{
+		Name:        &v.Name,
+		Description: &v.Description,
+		Optional:    &v.Optional,
+		ReadOnly:    &v.ReadOnly,
+	}
+
+	return res
+}
+
+// transformResultSpecToResourceviewsResultSpecView builds a value of type
+// *resourceviews.ResultSpecView from a value of type *ResultSpec.
+func transformResultSpecToResourceviewsResultSpecView(v *ResultSpec) *resourceviews.ResultSpecView {
+	if v == nil {
+		return nil
+	}
+	res := &resourceviews.ResultSpecView{
+		Name:        &v.Name,
+		Type:        &v.Type,
+		Description: &v.Description,
+	}
+
+	return res
+}
+
 // transformResourceDataToResourceviewsResourceDataView builds a value of type
 // *resourceviews.ResourceDataView from a value of type *ResourceData.
 func transformResourceDataToResourceviewsResourceDataView(v *ResourceData) *resourceviews.ResourceDataView {
@@ -1482,6 +2062,7 @@ func transformResourceDataToResourceviewsResourceDataView(v *ResourceData) *reso
 		HubURLPath:    &v.HubURLPath,
 		HubRawURLPath: &v.HubRawURLPath,
 		Rating:        &v.Rating,
+		Downloads:     &v.Downloads,
 	}
 	if v.Categories != nil {
 		res.Categories = make([]*resourceviews.CategoryView, len(v.Categories))
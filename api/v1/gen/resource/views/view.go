@@ -64,6 +64,8 @@ type Resource struct {
 // ResourcesView is a type that runs validations on a projected type.
 type ResourcesView struct {
 	Data ResourceDataCollectionView
+	// Cursor to fetch the next page of resources, absent on the last page
+	Next *string
 }
 
 // ResourceDataCollectionView is a type that runs validations on a projected
@@ -94,6 +96,8 @@ type ResourceDataView struct {
 	Platforms []*PlatformView
 	// Rating of resource
 	Rating *float64
+	// Number of downloads of all versions of resource
+	Downloads *uint
 	// List of all versions of a resource
 	Versions []*ResourceVersionDataView
 }
@@ -146,6 +150,19 @@ type ResourceVersionDataView struct {
 	Platforms []*PlatformView
 	// Url path of the resource in hub
 	HubURLPath *string
+	// Tekton bundle (OCI image) reference of the version
+	Bundle *string
+	// Tekton apiVersion of the resource manifest
+	APIVersion *string
+	// Verification of the trusted resource signature of the version with the
+	// public keys of its catalog
+	Verification *string
+	// Params of the version
+	Params []*ParamSpecView
+	// Workspaces of the version
+	Workspaces []*WorkspaceSpecView
+	// Results of the version
+	Results []*ResultSpecView
 	// Resource to which the version belongs
 	Resource *ResourceDataView
 }
@@ -158,6 +175,40 @@ type PlatformView struct {
 	Name *string
 }
 
+// ParamSpecView is a type that runs validations on a projected type.
+type ParamSpecView struct {
+	// Name of the param
+	Name *string
+	// Type of the param
+	Type *string
+	// Default of the param, JSON for array and object params
+	Default *string
+	// Description of the param
+	Description *string
+}
+
+// WorkspaceSpecView is a type that runs validations on a projected type.
+type WorkspaceSpecView struct {
+	// Name of the workspace
+	Name *string
+	// Description of the workspace
+	Description *string
+	// Whether the workspace may not be bound
+	Optional *bool
+	// Whether the task only reads the workspace
+	ReadOnly *bool
+}
+
+// ResultSpecView is a type that runs validations on a projected type.
+type ResultSpecView struct {
+	// Name of the result
+	Name *string
+	// Type of the result
+	Type *string
+	// Description of the result
+	Description *string
+}
+
 // TagView is a type that runs validations on a projected type.
 type TagView struct {
 	// ID is the unique id of tag
@@ -213,6 +264,7 @@ var (
 	ResourcesMap = map[string][]string{
 		"default": {
 			"data",
+			"next",
 		},
 	}
 	// ResourceVersionsMap is a map indexing the attribute names of
@@ -275,6 +327,7 @@ var (
 			"tags",
 			"platforms",
 			"rating",
+			"downloads",
 		},
 		"default": {
 			"id",
@@ -288,6 +341,7 @@ var (
 			"tags",
 			"platforms",
 			"rating",
+			"downloads",
 			"versions",
 		},
 	}
@@ -317,6 +371,7 @@ var (
 			"tags",
 			"platforms",
 			"rating",
+			"downloads",
 		},
 		"default": {
 			"id",
@@ -330,6 +385,7 @@ var (
 			"tags",
 			"platforms",
 			"rating",
+			"downloads",
 			"versions",
 		},
 	}
@@ -354,6 +410,7 @@ var (
 		"tiny": {
 			"id",
 			"version",
+			"bundle",
 		},
 		"min": {
 			"id",
@@ -377,6 +434,9 @@ var (
 			"hubURLPath",
 			"updatedAt",
 			"platforms",
+			"bundle",
+			"apiVersion",
+			"verification",
 		},
 		"default": {
 			"id",
@@ -392,6 +452,12 @@ var (
 			"updatedAt",
 			"resource",
 			"platforms",
+			"bundle",
+			"apiVersion",
+			"verification",
+			"params",
+			"workspaces",
+			"results",
 		},
 	}
 	// VersionsMap is a map indexing the attribute names of Versions by view name.
@@ -617,6 +683,9 @@ func ValidateResourceDataViewWithoutVersion(result *ResourceDataView) (err error
 	if result.Rating == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("rating", "result"))
 	}
+	if result.Downloads == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("downloads", "result"))
+	}
 	if result.HubRawURLPath == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("hubRawURLPath", "result"))
 	}
@@ -681,6 +750,9 @@ func ValidateResourceDataView(result *ResourceDataView) (err error) {
 	if result.Rating == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("rating", "result"))
 	}
+	if result.Downloads == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("downloads", "result"))
+	}
 	if result.Versions == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("versions", "result"))
 	}
@@ -889,6 +961,11 @@ func ValidateResourceVersionDataViewWithoutResource(result *ResourceVersionDataV
 			}
 		}
 	}
+	if result.Verification != nil {
+		if !(*result.Verification == "verified" || *result.Verification == "unsigned" || *result.Verification == "invalid") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.verification", *result.Verification, []any{"verified", "unsigned", "invalid"}))
+		}
+	}
 	return
 }
 
@@ -944,6 +1021,32 @@ func ValidateResourceVersionDataView(result *ResourceVersionDataView) (err error
 			}
 		}
 	}
+	if result.Verification != nil {
+		if !(*result.Verification == "verified" || *result.Verification == "unsigned" || *result.Verification == "invalid") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.verification", *result.Verification, []any{"verified", "unsigned", "invalid"}))
+		}
+	}
+	for _, e := range result.Params {
+		if e != nil {
+			if err2 := ValidateParamSpecView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range result.Workspaces {
+		if e != nil {
+			if err2 := ValidateWorkspaceSpecView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range result.Results {
+		if e != nil {
+			if err2 := ValidateResultSpecView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	if result.Resource != nil {
 		if err2 := ValidateResourceDataViewInfo(result.Resource); err2 != nil {
 			err = goa.MergeErrors(err, err2)
@@ -963,6 +1066,61 @@ func ValidatePlatformView(result *PlatformView) (err error) {
 	return
 }
 
+// ValidateParamSpecView runs the validations defined on ParamSpecView.
+func ValidateParamSpecView(result *ParamSpecView) (err error) {
+	if result.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "result"))
+	}
+	if result.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "result"))
+	}
+	if result.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "result"))
+	}
+	if result.Type != nil {
+		if !(*result.Type == "string" || *result.Type == "array" || *result.Type == "object") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.type", *result.Type, []any{"string", "array", "object"}))
+		}
+	}
+	return
+}
+
+// ValidateWorkspaceSpecView runs the validations defined on WorkspaceSpecView.
+func ValidateWorkspaceSpecView(result *WorkspaceSpecView) (err error) {
+	if result.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "result"))
+	}
+	if result.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "result"))
+	}
+	if result.Optional == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("optional", "result"))
+	}
+	if result.ReadOnly == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("readOnly", "result"))
+	}
+	return
+}
+
+// ValidateResultSpecView runs the validations defined on ResultSpecView.
+func ValidateResultSpecView(result *ResultSpecView) (err error) {
+	if result.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "result"))
+	}
+	if result.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "result"))
+	}
+	if result.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "result"))
+	}
+	if result.Type != nil {
+		if !(*result.Type == "string" || *result.Type == "array" || *result.Type == "object") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.type", *result.Type, []any{"string", "array", "object"}))
+		}
+	}
+	return
+}
+
 // ValidateTagView runs the validations defined on TagView.
 func ValidateTagView(result *TagView) (err error) {
 	if result.ID == nil {
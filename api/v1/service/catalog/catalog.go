@@ -20,30 +20,62 @@ import (
 
 	"github.com/tektoncd/hub/api/pkg/app"
 	"github.com/tektoncd/hub/api/pkg/db/model"
+	"github.com/tektoncd/hub/api/pkg/service/validator"
+	"github.com/tektoncd/hub/api/pkg/token"
 	"github.com/tektoncd/hub/api/v1/gen/catalog"
+	"goa.design/goa/v3/security"
 )
 
 type service struct {
 	app.Service
+	jwtConfig *app.JWTConfig
 }
 
 var (
 	internalError = catalog.MakeInternalError(fmt.Errorf("failed to list all catalogs"))
+	tokenError    = catalog.MakeInvalidToken(fmt.Errorf("invalid or expired user token"))
 )
 
 // New returns the resource service implementation.
-func New(api app.BaseConfig) catalog.Service {
-	return &service{api.Service("catalog")}
+func New(api app.Config) catalog.Service {
+	return &service{
+		Service:   api.Service("catalog"),
+		jwtConfig: api.JWTConfig(),
+	}
+}
+
+// JWTAuth adds the scopes of the user to the context, the token is
+// optional and anonymous users see the public catalogs only
+func (s *service) JWTAuth(ctx context.Context, jwt string, scheme *security.JWTScheme) (context.Context, error) {
+	if jwt == "" {
+		return ctx, nil
+	}
+
+	claims, err := token.Verify(jwt, s.jwtConfig)
+	if err != nil {
+		return ctx, tokenError
+	}
+
+	revoked, err := token.Revoked(s.DB(ctx), claims)
+	if err != nil {
+		s.Logger(ctx).Error(err)
+		return ctx, internalError
+	}
+	if revoked {
+		return ctx, tokenError
+	}
+	return validator.WithScopes(ctx, token.Scopes(claims)), nil
 }
 
-// List of catalogs
-func (s *service) List(ctx context.Context) (*catalog.ListResult, error) {
+// List of catalogs visible to the user
+func (s *service) List(ctx context.Context, p *catalog.ListPayload) (*catalog.ListResult, error) {
 
 	log := s.Logger(ctx)
 	db := s.DB(ctx)
 
 	var all []model.Catalog
-	if err := db.Order("id").Find(&all).Error; err != nil {
+	q := db.Scopes(model.VisibleCatalogs(validator.Scopes(ctx))).Order("id")
+	if err := q.Find(&all).Error; err != nil {
 		log.Error(err)
 		return nil, internalError
 	}
@@ -25,9 +25,15 @@ import (
 	"github.com/tektoncd/hub/api/pkg/testutils"
 	"github.com/tektoncd/hub/api/v1/gen/catalog"
 	"github.com/tektoncd/hub/api/v1/gen/http/catalog/server"
+	"goa.design/goa/v3/security"
 	"gotest.tools/v3/golden"
 )
 
+// jwtAuth returns the authorization of the optional token of the service
+func jwtAuth(tc *testutils.TestConfig) security.AuthJWTFunc {
+	return New(tc).(*service).JWTAuth
+}
+
 func TestCatalog_List_Http(t *testing.T) {
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
@@ -36,7 +42,7 @@ func TestCatalog_List_Http(t *testing.T) {
 	checker.Mount(
 		server.NewListHandler,
 		server.MountListHandler,
-		catalog.NewListEndpoint(New(tc)))
+		catalog.NewListEndpoint(New(tc), jwtAuth(tc)))
 
 	checker.Test(t, http.MethodGet, "/v1/catalogs").Check().
 		HasStatus(http.StatusOK).Cb(func(r *http.Response) {
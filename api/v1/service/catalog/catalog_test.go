@@ -19,17 +19,45 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/tektoncd/hub/api/pkg/db/model"
+	"github.com/tektoncd/hub/api/pkg/service/validator"
 	"github.com/tektoncd/hub/api/pkg/testutils"
+	"github.com/tektoncd/hub/api/v1/gen/catalog"
 )
 
 func TestCatalog_List(t *testing.T) {
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
 
-	catalog := New(tc)
-	all, err := catalog.List(context.Background())
+	svc := New(tc)
+	all, err := svc.List(context.Background(), &catalog.ListPayload{})
 
 	assert.NoError(t, err)
 	assert.Equal(t, 4, len(all.Data))
 
 }
+
+func TestCatalog_List_Private(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	err := tc.DB().Model(&model.Catalog{}).Where("name = ?", "catalog-private").
+		Updates(map[string]interface{}{"private": true, "scope": "catalog:internal"}).Error
+	assert.NoError(t, err)
+
+	svc := New(tc)
+
+	all, err := svc.List(context.Background(), &catalog.ListPayload{})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(all.Data))
+
+	ctx := validator.WithScopes(context.Background(), []string{model.PrivateScope})
+	all, err = svc.List(ctx, &catalog.ListPayload{})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(all.Data))
+
+	ctx = validator.WithScopes(context.Background(), []string{"catalog:internal"})
+	all, err = svc.List(ctx, &catalog.ListPayload{})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(all.Data))
+}
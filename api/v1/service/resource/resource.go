@@ -25,15 +25,30 @@ import (
 	"time"
 
 	"github.com/tektoncd/hub/api/pkg/app"
+	"github.com/tektoncd/hub/api/pkg/audit"
 	"github.com/tektoncd/hub/api/pkg/db/model"
+	"github.com/tektoncd/hub/api/pkg/semver"
+	"github.com/tektoncd/hub/api/pkg/service/validator"
 	res "github.com/tektoncd/hub/api/pkg/shared/resource"
+	"github.com/tektoncd/hub/api/pkg/token"
 	"github.com/tektoncd/hub/api/v1/gen/resource"
+	"goa.design/goa/v3/security"
+	"gorm.io/gorm/clause"
 )
 
 type service struct {
 	app.Service
+	jwtConfig *app.JWTConfig
 }
 
+var (
+	tokenError    = resource.MakeInvalidToken(fmt.Errorf("invalid or expired user token"))
+	internalError = resource.MakeInternalError(fmt.Errorf("failed to verify user token"))
+
+	// the reviews of other users are not found as they can't be edited
+	reviewNotFoundError = resource.MakeNotFound(fmt.Errorf("review not found"))
+)
+
 var replacerStrings = []string{"github.com", "raw.githubusercontent.com", "/tree/", "/", "/blob/", "/raw/", "/src/", "/raw/"}
 
 // Returns a replacer object which replaces a list of strings with replacements.
@@ -48,24 +63,98 @@ func getStringReplacer(resourceUrl, provider string) *strings.Replacer {
 }
 
 // New returns the resource service implementation.
-func New(api app.BaseConfig) resource.Service {
-	return &service{api.Service("resource")}
+func New(api app.Config) resource.Service {
+	return &service{
+		Service:   api.Service("resource"),
+		jwtConfig: api.JWTConfig(),
+	}
+}
+
+// JWTAuth adds the scopes of the user to the context, the token is
+// optional and anonymous users see the resources of public catalogs only.
+// Methods requiring scopes e.g. writing reviews need a token having the
+// scopes, the id of the user is added to the context for them
+func (s *service) JWTAuth(ctx context.Context, jwt string, scheme *security.JWTScheme) (context.Context, error) {
+	required := len(scheme.RequiredScopes) > 0
+	if jwt == "" {
+		if required {
+			return ctx, tokenError
+		}
+		return ctx, nil
+	}
+
+	claims, err := token.Verify(jwt, s.jwtConfig)
+	if err != nil {
+		return ctx, tokenError
+	}
+
+	if required {
+		if err := token.ValidateScopes(claims, scheme); err != nil {
+			return ctx, resource.MakeInvalidScopes(err)
+		}
+
+		userID, ok := claims["id"].(float64)
+		if !ok {
+			return ctx, tokenError
+		}
+		ctx = validator.WithUserID(ctx, uint(userID))
+	}
+
+	revoked, err := token.Revoked(s.DB(ctx), claims)
+	if err != nil {
+		s.Logger(ctx).Error(err)
+		return ctx, internalError
+	}
+	if revoked {
+		return ctx, tokenError
+	}
+	return validator.WithScopes(ctx, token.Scopes(claims)), nil
+}
+
+// catalogVisible checks the catalog is visible to the user before reading
+// the files of its resources from the clone
+func (s *service) catalogVisible(ctx context.Context, catalog string) error {
+
+	req := res.Request{
+		Db:      s.DB(ctx),
+		Log:     s.Logger(ctx),
+		Catalog: catalog,
+		Scopes:  validator.Scopes(ctx),
+	}
+
+	if err := req.CatalogVisible(); err != nil {
+		if err == res.NotFoundError {
+			return resource.MakeNotFound(fmt.Errorf("resource not found"))
+		}
+		return resource.MakeInternalError(err)
+	}
+	return nil
 }
 
 // Find resources based on name, kind or both
 func (s *service) Query(ctx context.Context, p *resource.QueryPayload) (*resource.Resources, error) {
 
 	req := res.Request{
-		Db:         s.DB(ctx),
-		Log:        s.Logger(ctx),
-		Name:       p.Name,
-		Kinds:      p.Kinds,
-		Catalogs:   p.Catalogs,
-		Categories: p.Categories,
-		Tags:       p.Tags,
-		Platforms:  p.Platforms,
-		Limit:      p.Limit,
-		Match:      p.Match,
+		Db:            s.DB(ctx),
+		Log:           s.Logger(ctx),
+		Name:          p.Name,
+		Kinds:         p.Kinds,
+		Catalogs:      p.Catalogs,
+		Categories:    p.Categories,
+		Tags:          p.Tags,
+		Platforms:     p.Platforms,
+		APIVersions:   p.APIVersions,
+		Limit:         p.Limit,
+		Match:         p.Match,
+		Prerelease:    p.Prerelease,
+		Verifications: p.Verifications,
+		Scopes:        validator.Scopes(ctx),
+	}
+	if p.Sort != nil {
+		req.Sort = *p.Sort
+	}
+	if p.Cursor != nil {
+		req.Cursor = *p.Cursor
 	}
 
 	rArr, err := req.Query()
@@ -73,6 +162,9 @@ func (s *service) Query(ctx context.Context, p *resource.QueryPayload) (*resourc
 		if strings.Contains(err.Error(), "not supported") {
 			return nil, resource.MakeInvalidKind(err)
 		}
+		if err == res.InvalidCursorError {
+			return nil, resource.MakeInvalidCursor(err)
+		}
 		if err == res.NotFoundError {
 			return nil, resource.MakeNotFound(err)
 		}
@@ -81,25 +173,31 @@ func (s *service) Query(ctx context.Context, p *resource.QueryPayload) (*resourc
 		}
 	}
 
-	var rd []*resource.ResourceData
-	for _, r := range rArr {
-		rd = append(rd, initResource(r))
-	}
-
-	return &resource.Resources{Data: rd}, nil
+	return resources(rArr, req.Next), nil
 }
 
 // List all resources sorted by rating and name
 func (s *service) List(ctx context.Context, p *resource.ListPayload) (*resource.Resources, error) {
 
 	req := res.Request{
-		Db:    s.DB(ctx),
-		Log:   s.Logger(ctx),
-		Limit: p.Limit,
+		Db:         s.DB(ctx),
+		Log:        s.Logger(ctx),
+		Limit:      p.Limit,
+		Prerelease: p.Prerelease,
+		Scopes:     validator.Scopes(ctx),
+	}
+	if p.Sort != nil {
+		req.Sort = *p.Sort
+	}
+	if p.Cursor != nil {
+		req.Cursor = *p.Cursor
 	}
 
 	rArr, err := req.AllResources()
 	if err != nil {
+		if err == res.InvalidCursorError {
+			return nil, resource.MakeInvalidCursor(err)
+		}
 		if err == res.NotFoundError {
 			return nil, resource.MakeNotFound(err)
 		}
@@ -108,21 +206,33 @@ func (s *service) List(ctx context.Context, p *resource.ListPayload) (*resource.
 		}
 	}
 
+	return resources(rArr, req.Next), nil
+}
+
+// resources returns a page of resources along with the cursor of the next
+// page if there is one
+func resources(rArr []model.Resource, next string) *resource.Resources {
 	var rd []*resource.ResourceData
 	for _, r := range rArr {
 		rd = append(rd, initResource(r))
 	}
 
-	return &resource.Resources{Data: rd}, nil
+	result := &resource.Resources{Data: rd}
+	if next != "" {
+		result.Next = &next
+	}
+	return result
 }
 
 // VersionsByID returns all versions of a resource given its resource id
 func (s *service) VersionsByID(ctx context.Context, p *resource.VersionsByIDPayload) (*resource.ResourceVersions, error) {
 
 	req := res.Request{
-		Db:  s.DB(ctx),
-		Log: s.Logger(ctx),
-		ID:  p.ID,
+		Db:         s.DB(ctx),
+		Log:        s.Logger(ctx),
+		ID:         p.ID,
+		Prerelease: p.Prerelease,
+		Scopes:     validator.Scopes(ctx),
 	}
 
 	versions, err := req.AllVersions()
@@ -145,6 +255,254 @@ func (s *service) VersionsByID(ctx context.Context, p *resource.VersionsByIDPayl
 	return &resource.ResourceVersions{Data: &rv}, nil
 }
 
+// ListReviews returns a page of the reviews of a resource, latest first
+func (s *service) ListReviews(ctx context.Context, p *resource.ListReviewsPayload) (*resource.Reviews, error) {
+
+	req := res.Request{
+		Db:     s.DB(ctx),
+		Log:    s.Logger(ctx),
+		ID:     p.ID,
+		Limit:  p.Limit,
+		Scopes: validator.Scopes(ctx),
+	}
+	if p.Cursor != nil {
+		req.Cursor = *p.Cursor
+	}
+
+	reviews, err := req.Reviews()
+	if err != nil {
+		return nil, reviewError(err)
+	}
+
+	result := &resource.Reviews{Data: []*resource.Review{}}
+	for _, r := range reviews {
+		result.Data = append(result.Data, initReview(r))
+	}
+	if req.Next != "" {
+		result.Next = &req.Next
+	}
+	return result, nil
+}
+
+// CreateReview adds a review written by the user on a version of a resource
+func (s *service) CreateReview(ctx context.Context, p *resource.CreateReviewPayload) (*resource.Review, error) {
+
+	log := s.Logger(ctx)
+	req := res.Request{
+		Db:      s.DB(ctx),
+		Log:     log,
+		ID:      p.ID,
+		Version: p.Version,
+		Scopes:  validator.Scopes(ctx),
+	}
+
+	version, err := req.ReviewedVersion()
+	if err != nil {
+		if err == res.VersionNotFoundError {
+			return nil, resource.MakeInvalidPayload(fmt.Errorf("version %s not found for resource", p.Version))
+		}
+		return nil, reviewError(err)
+	}
+
+	review := model.Review{
+		UserID:     validator.UserID(ctx),
+		ResourceID: p.ID,
+		VersionID:  version.ID,
+		Text:       p.Text,
+	}
+	if err := req.Db.Create(&review).Error; err != nil {
+		log.Error(err)
+		return nil, resource.MakeInternalError(fmt.Errorf("failed to create review"))
+	}
+	review.Version = version
+
+	s.recordReview(ctx, audit.ReviewCreate, review)
+	return initReview(review), nil
+}
+
+// UpdateReview changes the text of a review written by the user
+func (s *service) UpdateReview(ctx context.Context, p *resource.UpdateReviewPayload) (*resource.Review, error) {
+
+	log := s.Logger(ctx)
+	req := res.Request{
+		Db:     s.DB(ctx),
+		Log:    log,
+		ID:     p.ID,
+		Scopes: validator.Scopes(ctx),
+	}
+
+	review, err := req.UserReview(validator.UserID(ctx), p.ReviewID)
+	if err != nil {
+		if err == res.NotFoundError {
+			return nil, reviewNotFoundError
+		}
+		return nil, reviewError(err)
+	}
+
+	if err := req.Db.Model(&review).Omit(clause.Associations).Update("text", p.Text).Error; err != nil {
+		log.Error(err)
+		return nil, resource.MakeInternalError(fmt.Errorf("failed to update review"))
+	}
+
+	s.recordReview(ctx, audit.ReviewUpdate, review)
+	return initReview(review), nil
+}
+
+// DeleteReview deletes a review written by the user
+func (s *service) DeleteReview(ctx context.Context, p *resource.DeleteReviewPayload) error {
+
+	log := s.Logger(ctx)
+	req := res.Request{
+		Db:     s.DB(ctx),
+		Log:    log,
+		ID:     p.ID,
+		Scopes: validator.Scopes(ctx),
+	}
+
+	review, err := req.UserReview(validator.UserID(ctx), p.ReviewID)
+	if err != nil {
+		if err == res.NotFoundError {
+			return reviewNotFoundError
+		}
+		return reviewError(err)
+	}
+
+	if err := req.Db.Delete(&review).Error; err != nil {
+		log.Error(err)
+		return resource.MakeInternalError(fmt.Errorf("failed to delete review"))
+	}
+
+	s.recordReview(ctx, audit.ReviewDelete, review)
+	return nil
+}
+
+// recordReview records the audit event of a write on a review, a failure
+// is only logged as the review is already written
+func (s *service) recordReview(ctx context.Context, action string, r model.Review) {
+	payload := map[string]interface{}{"id": r.ID, "version": r.Version.Version, "text": r.Text}
+	target := fmt.Sprintf("resource:%d", r.ResourceID)
+	if err := audit.Record(s.DB(ctx), validator.UserID(ctx), action, target, payload); err != nil {
+		s.Logger(ctx).Error(err)
+	}
+}
+
+// reviewError maps the errors of the shared resource package to the
+// errors of the review methods
+func reviewError(err error) error {
+	switch err {
+	case res.InvalidCursorError:
+		return resource.MakeInvalidCursor(err)
+	case res.NotFoundError:
+		return resource.MakeNotFound(err)
+	}
+	return resource.MakeInternalError(err)
+}
+
+func initReview(r model.Review) *resource.Review {
+	return &resource.Review{
+		ID:         r.ID,
+		ResourceID: r.ResourceID,
+		Version:    r.Version.Version,
+		UserID:     r.UserID,
+		Text:       r.Text,
+		CreatedAt:  r.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:  r.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// Dependencies lists the tasks referenced by a version of a pipeline
+func (s *service) Dependencies(ctx context.Context, p *resource.DependenciesPayload) (*resource.PipelineDependencies, error) {
+
+	req := res.Request{
+		Db:     s.DB(ctx),
+		Log:    s.Logger(ctx),
+		ID:     p.ID,
+		Scopes: validator.Scopes(ctx),
+	}
+	if p.Version != nil {
+		req.Version = *p.Version
+	}
+
+	version, deps, err := req.Dependencies()
+	if err != nil {
+		return nil, dependencyError(err)
+	}
+
+	result := &resource.PipelineDependencies{Version: version.Version, Data: []*resource.Dependency{}}
+	for _, d := range deps {
+		result.Data = append(result.Data, initDependency(d))
+	}
+	return result, nil
+}
+
+// Dependents lists the versions of the pipelines referencing a task
+func (s *service) Dependents(ctx context.Context, p *resource.DependentsPayload) (*resource.TaskDependents, error) {
+
+	req := res.Request{
+		Db:     s.DB(ctx),
+		Log:    s.Logger(ctx),
+		ID:     p.ID,
+		Scopes: validator.Scopes(ctx),
+	}
+	if p.Version != nil {
+		req.Version = *p.Version
+	}
+
+	dependents, err := req.Dependents()
+	if err != nil {
+		return nil, dependencyError(err)
+	}
+
+	result := &resource.TaskDependents{Data: []*resource.Dependent{}}
+	for _, d := range dependents {
+		dependent := &resource.Dependent{
+			ResourceID: d.ResourceID,
+			Name:       d.Name,
+			Catalog:    d.Catalog,
+			Version:    d.Version,
+		}
+		if d.TaskVersion != "" {
+			dependent.TaskVersion = &d.TaskVersion
+		}
+		result.Data = append(result.Data, dependent)
+	}
+	return result, nil
+}
+
+// dependencyError maps the errors of the shared resource package to the
+// errors of the dependency methods
+func dependencyError(err error) error {
+	switch err {
+	case res.NotFoundError, res.VersionNotFoundError:
+		return resource.MakeNotFound(err)
+	}
+	return resource.MakeInternalError(err)
+}
+
+func initDependency(d res.Dependency) *resource.Dependency {
+	dep := &resource.Dependency{
+		Name: d.Name,
+		Kind: d.Kind,
+		Via:  d.Via,
+	}
+	if d.TaskVersion != "" {
+		dep.Version = &d.TaskVersion
+	}
+	if d.Catalog != "" {
+		dep.Catalog = &d.Catalog
+	}
+	if d.Bundle != "" {
+		dep.Bundle = &d.Bundle
+	}
+	if d.URL != "" {
+		dep.URL = &d.URL
+	}
+	if d.ResourceID != 0 {
+		dep.ResourceID = &d.ResourceID
+	}
+	return dep
+}
+
 // Find resource using name of catalog & name, kind and version of resource
 func (s *service) ByCatalogKindNameVersion(ctx context.Context, p *resource.ByCatalogKindNameVersionPayload) (*resource.ResourceVersion, error) {
 
@@ -155,6 +513,7 @@ func (s *service) ByCatalogKindNameVersion(ctx context.Context, p *resource.ByCa
 		Kind:    p.Kind,
 		Name:    p.Name,
 		Version: p.Version,
+		Scopes:  validator.Scopes(ctx),
 	}
 
 	r, err := req.ByCatalogKindNameVersion()
@@ -169,13 +528,13 @@ func (s *service) ByCatalogKindNameVersion(ctx context.Context, p *resource.ByCa
 
 	switch count := len(r.Versions); {
 	case count == 1:
-		return versionInfoFromResource(r, p.Version), nil
+		return s.withSchema(ctx, versionInfoFromResource(r, p.Version))
 	case count == 0:
 		return nil, resource.MakeNotFound(fmt.Errorf("resource not found"))
 	default:
 		s.Logger(ctx).Warnf("expected to find one version but found %d", count)
 		r.Versions = []model.ResourceVersion{r.Versions[0]}
-		return versionInfoFromResource(r, p.Version), nil
+		return s.withSchema(ctx, versionInfoFromResource(r, p.Version))
 	}
 }
 
@@ -183,6 +542,10 @@ func (s *service) ByCatalogKindNameVersion(ctx context.Context, p *resource.ByCa
 func (s *service) ByCatalogKindNameVersionReadme(ctx context.Context,
 	p *resource.ByCatalogKindNameVersionReadmePayload) (*resource.ResourceVersionReadme, error) {
 
+	if err := s.catalogVisible(ctx, p.Catalog); err != nil {
+		return nil, err
+	}
+
 	readmePath := fmt.Sprintf("%s/%s/%s/%s/%s/README.md", s.CatalogClonePath(), strings.ToLower(p.Catalog), strings.ToLower(p.Kind), p.Name, p.Version)
 	s.Logger(ctx).Info(fmt.Sprintf("Fetching README for resource %s", p.Name))
 	content, err := os.ReadFile(readmePath)
@@ -204,6 +567,10 @@ func (s *service) ByCatalogKindNameVersionReadme(ctx context.Context,
 func (s *service) ByCatalogKindNameVersionYaml(ctx context.Context,
 	p *resource.ByCatalogKindNameVersionYamlPayload) (*resource.ResourceVersionYaml, error) {
 
+	if err := s.catalogVisible(ctx, p.Catalog); err != nil {
+		return nil, err
+	}
+
 	yamlPath := fmt.Sprintf("%s/%s/%s/%s/%s", s.CatalogClonePath(), strings.ToLower(p.Catalog), strings.ToLower(p.Kind), p.Name, p.Version)
 	yamlPath = fmt.Sprintf("%s/%s.yaml", yamlPath, p.Name)
 	s.Logger(ctx).Info(fmt.Sprintf("Fetching YAML for resource %s", p.Name))
@@ -212,6 +579,10 @@ func (s *service) ByCatalogKindNameVersionYaml(ctx context.Context,
 		return nil, resource.MakeNotFound(fmt.Errorf("resource not found"))
 	}
 
+	if p.Install {
+		s.countDownload(ctx, p.Catalog, p.Kind, p.Name, p.Version)
+	}
+
 	yamlContent := string(content)
 
 	res := resource.ResourceVersionYaml{
@@ -222,6 +593,97 @@ func (s *service) ByCatalogKindNameVersionYaml(ctx context.Context,
 	return &res, nil
 }
 
+// Returns the problems found by the lint rules in a version of a resource
+func (s *service) ByCatalogKindNameVersionLint(ctx context.Context,
+	p *resource.ByCatalogKindNameVersionLintPayload) (*resource.VersionLint, error) {
+
+	req := res.Request{
+		Db:      s.DB(ctx),
+		Log:     s.Logger(ctx),
+		Catalog: p.Catalog,
+		Kind:    p.Kind,
+		Name:    p.Name,
+		Version: p.Version,
+		Scopes:  validator.Scopes(ctx),
+	}
+
+	results, err := req.LintResults()
+	if err != nil {
+		if err == res.NotFoundError {
+			return nil, resource.MakeNotFound(err)
+		}
+		return nil, resource.MakeInternalError(err)
+	}
+
+	lint := &resource.VersionLint{Data: []*resource.LintResult{}}
+	for _, r := range results {
+		lint.Data = append(lint.Data, &resource.LintResult{
+			Rule:     r.Rule,
+			Severity: r.Severity,
+			Message:  r.Message,
+		})
+	}
+	return lint, nil
+}
+
+// Returns the images of the steps and sidecars of a version of a resource
+func (s *service) ByCatalogKindNameVersionImages(ctx context.Context,
+	p *resource.ByCatalogKindNameVersionImagesPayload) (*resource.VersionImages, error) {
+
+	req := res.Request{
+		Db:      s.DB(ctx),
+		Log:     s.Logger(ctx),
+		Catalog: p.Catalog,
+		Kind:    p.Kind,
+		Name:    p.Name,
+		Version: p.Version,
+		Scopes:  validator.Scopes(ctx),
+	}
+
+	images, err := req.Images()
+	if err != nil {
+		if err == res.NotFoundError {
+			return nil, resource.MakeNotFound(err)
+		}
+		return nil, resource.MakeInternalError(err)
+	}
+
+	result := &resource.VersionImages{Data: []string{}}
+	for _, i := range images {
+		result.Data = append(result.Data, i.Ref)
+	}
+	return result, nil
+}
+
+// Returns the versions of the resources using an image
+func (s *service) ByImage(ctx context.Context, p *resource.ByImagePayload) (*resource.ImageUsers, error) {
+
+	req := res.Request{
+		Db:     s.DB(ctx),
+		Log:    s.Logger(ctx),
+		Limit:  p.Limit,
+		Scopes: validator.Scopes(ctx),
+	}
+
+	users, err := req.ByImage(p.Ref)
+	if err != nil {
+		return nil, resource.MakeInternalError(err)
+	}
+
+	result := &resource.ImageUsers{Data: []*resource.ImageUser{}}
+	for _, u := range users {
+		result.Data = append(result.Data, &resource.ImageUser{
+			ResourceID: u.ResourceID,
+			Catalog:    u.Catalog,
+			Kind:       u.Kind,
+			Name:       u.Name,
+			Version:    u.Version,
+			Image:      u.Image,
+		})
+	}
+	return result, nil
+}
+
 // Find a resource using its version's id
 func (s *service) ByVersionID(ctx context.Context, p *resource.ByVersionIDPayload) (*resource.ResourceVersion, error) {
 
@@ -229,6 +691,7 @@ func (s *service) ByVersionID(ctx context.Context, p *resource.ByVersionIDPayloa
 		Db:        s.DB(ctx),
 		Log:       s.Logger(ctx),
 		VersionID: p.VersionID,
+		Scopes:    validator.Scopes(ctx),
 	}
 
 	v, err := req.ByVersionID()
@@ -241,18 +704,61 @@ func (s *service) ByVersionID(ctx context.Context, p *resource.ByVersionIDPayloa
 		}
 	}
 
-	return versionInfoFromVersion(v), nil
+	return s.withSchema(ctx, versionInfoFromVersion(v))
+}
+
+// withSchema adds the params, workspaces and results of the version
+func (s *service) withSchema(ctx context.Context, v *resource.ResourceVersion) (*resource.ResourceVersion, error) {
+
+	req := res.Request{
+		Db:        s.DB(ctx),
+		Log:       s.Logger(ctx),
+		VersionID: v.Data.ID,
+	}
+
+	schema, err := req.Schema()
+	if err != nil {
+		return nil, resource.MakeInternalError(err)
+	}
+
+	for _, p := range schema.Params {
+		v.Data.Params = append(v.Data.Params, &resource.ParamSpec{
+			Name:        p.Name,
+			Type:        p.Type,
+			Default:     p.Default,
+			Description: p.Description,
+		})
+	}
+	for _, w := range schema.Workspaces {
+		v.Data.Workspaces = append(v.Data.Workspaces, &resource.WorkspaceSpec{
+			Name:        w.Name,
+			Description: w.Description,
+			Optional:    w.Optional,
+			ReadOnly:    w.ReadOnly,
+		})
+	}
+	for _, r := range schema.Results {
+		v.Data.Results = append(v.Data.Results, &resource.ResultSpec{
+			Name:        r.Name,
+			Type:        r.Type,
+			Description: r.Description,
+		})
+	}
+
+	return v, nil
 }
 
 // Find resources using name of catalog, resource name and kind of resource
 func (s *service) ByCatalogKindName(ctx context.Context, p *resource.ByCatalogKindNamePayload) (*resource.Resource, error) {
 
 	req := res.Request{
-		Db:      s.DB(ctx),
-		Log:     s.Logger(ctx),
-		Catalog: p.Catalog,
-		Kind:    p.Kind,
-		Name:    p.Name,
+		Db:         s.DB(ctx),
+		Log:        s.Logger(ctx),
+		Catalog:    p.Catalog,
+		Kind:       p.Kind,
+		Name:       p.Name,
+		Prerelease: p.Prerelease,
+		Scopes:     validator.Scopes(ctx),
 	}
 
 	r, err := req.ByCatalogKindName()
@@ -285,9 +791,10 @@ func (s *service) ByCatalogKindName(ctx context.Context, p *resource.ByCatalogKi
 func (s *service) ByID(ctx context.Context, p *resource.ByIDPayload) (*resource.Resource, error) {
 
 	req := res.Request{
-		Db:  s.DB(ctx),
-		Log: s.Logger(ctx),
-		ID:  p.ID,
+		Db:     s.DB(ctx),
+		Log:    s.Logger(ctx),
+		ID:     p.ID,
+		Scopes: validator.Scopes(ctx),
 	}
 
 	r, err := req.ByID()
@@ -312,7 +819,7 @@ func filterCompatibleVersions(r model.Resource, pipelinesVersion string) model.R
 
 	var compatibleVersions []model.ResourceVersion
 	for _, v := range r.Versions {
-		if v.MinPipelinesVersion <= pipelinesVersion {
+		if semver.Compare(v.MinPipelinesVersion, pipelinesVersion) <= 0 {
 			compatibleVersions = append(compatibleVersions, v)
 		}
 	}
@@ -334,6 +841,7 @@ func initResource(r model.Resource) *resource.ResourceData {
 	res.HubURLPath = fmt.Sprintf("%s/%s/%s", r.Catalog.Name, r.Kind, r.Name)
 	res.HubRawURLPath = fmt.Sprintf("resource/%s/%s/%s/raw", r.Catalog.Name, strings.ToLower(r.Kind), r.Name)
 	res.Rating = r.Rating
+	res.Downloads = r.Downloads
 
 	lv := (r.Versions)[len(r.Versions)-1]
 
@@ -365,6 +873,21 @@ func initResource(r model.Resource) *resource.ResourceData {
 		res.LatestVersion.Deprecated = &lv.Deprecated
 	}
 
+	// Adds bundle reference of resource's latest version if exists
+	if lv.Bundle != "" {
+		res.LatestVersion.Bundle = &lv.Bundle
+	}
+
+	// Adds apiVersion of resource's latest version if recorded
+	if lv.APIVersion != "" {
+		res.LatestVersion.APIVersion = &lv.APIVersion
+	}
+
+	// Adds verification of the signature of resource's latest version
+	if lv.Verification != "" {
+		res.LatestVersion.Verification = &lv.Verification
+	}
+
 	res.Tags = []*resource.Tag{}
 	for _, tag := range r.Tags {
 		res.Tags = append(res.Tags, &resource.Tag{
@@ -400,6 +923,10 @@ func tinyVersionInfo(r model.ResourceVersion) *resource.ResourceVersionData {
 		Version: r.Version,
 	}
 
+	if r.Bundle != "" {
+		res.Bundle = &r.Bundle
+	}
+
 	return res
 }
 
@@ -495,6 +1022,21 @@ func versionInfoFromResource(r model.Resource, version string) *resource.Resourc
 		ver.Deprecated = &v.Deprecated
 	}
 
+	// Adds bundle reference of resource's version if exists
+	if v.Bundle != "" {
+		ver.Bundle = &v.Bundle
+	}
+
+	// Adds apiVersion of resource's version if recorded
+	if v.APIVersion != "" {
+		ver.APIVersion = &v.APIVersion
+	}
+
+	// Adds verification of the signature of resource's version
+	if v.Verification != "" {
+		ver.Verification = &v.Verification
+	}
+
 	return &resource.ResourceVersion{Data: ver}
 }
 
@@ -511,6 +1053,10 @@ func versionInfoFromVersion(v model.ResourceVersion) *resource.ResourceVersion {
 func (s *service) GetRawYamlByCatalogKindNameVersion(ctx context.Context, p *resource.GetRawYamlByCatalogKindNameVersionPayload) (io.ReadCloser, error) {
 	s.Logger(ctx).Info(fmt.Sprintf("Fetching YAML for resource %s", p.Name))
 
+	if err := s.catalogVisible(ctx, p.Catalog); err != nil {
+		return nil, err
+	}
+
 	yamlPath := fmt.Sprintf("%s/%s/%s/%s/%s", s.CatalogClonePath(), strings.ToLower(p.Catalog), strings.ToLower(p.Kind), p.Name, p.Version)
 	yamlPath = fmt.Sprintf("%s/%s.yaml", yamlPath, p.Name)
 
@@ -519,6 +1065,7 @@ func (s *service) GetRawYamlByCatalogKindNameVersion(ctx context.Context, p *res
 		return nil, resource.MakeNotFound(fmt.Errorf("resource not found"))
 	}
 
+	s.countDownload(ctx, p.Catalog, p.Kind, p.Name, p.Version)
 	return io.NopCloser(bytes.NewBuffer(content)), nil
 }
 
@@ -532,6 +1079,7 @@ func (s *service) GetLatestRawYamlByCatalogKindName(ctx context.Context, p *reso
 		Kind:    p.Kind,
 		Catalog: p.Catalog,
 		Name:    p.Name,
+		Scopes:  validator.Scopes(ctx),
 	}
 
 	version, err := req.GetLatestVersion()
@@ -552,5 +1100,24 @@ func (s *service) GetLatestRawYamlByCatalogKindName(ctx context.Context, p *reso
 		return nil, resource.MakeNotFound(fmt.Errorf("resource not found"))
 	}
 
+	s.countDownload(ctx, p.Catalog, p.Kind, p.Name, version)
 	return io.NopCloser(bytes.NewBuffer(content)), nil
 }
+
+// countDownload counts a download of the version of the resource, a
+// failure is only logged as the YAML is served anyway
+func (s *service) countDownload(ctx context.Context, catalog, kind, name, version string) {
+
+	req := res.Request{
+		Db:      s.DB(ctx),
+		Log:     s.Logger(ctx),
+		Catalog: catalog,
+		Kind:    kind,
+		Name:    name,
+		Version: version,
+	}
+
+	if err := req.CountDownload(); err != nil {
+		s.Logger(ctx).Error(err)
+	}
+}
@@ -24,19 +24,34 @@ import (
 
 	"github.com/ikawaha/goahttpcheck"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tektoncd/hub/api/pkg/db/model"
 	"github.com/tektoncd/hub/api/pkg/testutils"
 	"github.com/tektoncd/hub/api/v1/gen/http/resource/server"
 	"github.com/tektoncd/hub/api/v1/gen/resource"
 	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
 	"gotest.tools/v3/golden"
 	"sigs.k8s.io/yaml"
 )
 
+// jwtAuth returns the authorization of the optional token of the service
+func jwtAuth(tc *testutils.TestConfig) security.AuthJWTFunc {
+	return New(tc).(*service).JWTAuth
+}
+
+// makePrivate marks the catalog private, visible to the users having the scope
+func makePrivate(t *testing.T, tc *testutils.TestConfig, catalog, scope string) {
+	err := tc.DB().Model(&model.Catalog{}).Where("name = ?", catalog).
+		Updates(map[string]interface{}{"private": true, "scope": scope}).Error
+	require.NoError(t, err)
+}
+
 func QueryChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
 	checker := goahttpcheck.New()
 	checker.Mount(server.NewQueryHandler,
 		server.MountQueryHandler,
-		resource.NewQueryEndpoint(New(tc)))
+		resource.NewQueryEndpoint(New(tc), jwtAuth(tc)))
 	return checker
 }
 
@@ -127,6 +142,58 @@ func TestQueryWithPlatforms_Http(t *testing.T) {
 	})
 }
 
+func TestQueryWithAPIVersions_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	QueryChecker(tc).Test(t, http.MethodGet, "/v1/query?apiVersions=tekton.dev/v1").Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res, err := testutils.FormatJSON(b)
+		assert.NoError(t, err)
+
+		golden.Assert(t, res, fmt.Sprintf("%s.golden", t.Name()))
+	})
+}
+
+func TestQueryWithVerifications_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	QueryChecker(tc).Test(t, http.MethodGet, "/v1/query?verifications=verified").Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res, err := testutils.FormatJSON(b)
+		assert.NoError(t, err)
+
+		golden.Assert(t, res, fmt.Sprintf("%s.golden", t.Name()))
+	})
+}
+
+func TestQueryWithInvalidVerification_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	QueryChecker(tc).Test(t, http.MethodGet, "/v1/query?verifications=trusted").Check().
+		HasStatus(400).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		err := goa.ServiceError{}
+		marshallErr := json.Unmarshal([]byte(b), &err)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, "invalid_enum_value", err.Name)
+	})
+}
+
 func TestQueryWithExactName_Http(t *testing.T) {
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
@@ -302,7 +369,7 @@ func ListChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
 	checker := goahttpcheck.New()
 	checker.Mount(server.NewListHandler,
 		server.MountListHandler,
-		resource.NewListEndpoint(New(tc)))
+		resource.NewListEndpoint(New(tc), jwtAuth(tc)))
 	return checker
 }
 
@@ -346,10 +413,28 @@ func VersionsByIDChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
 	checker.Mount(
 		server.NewVersionsByIDHandler,
 		server.MountVersionsByIDHandler,
-		resource.NewVersionsByIDEndpoint(New(tc)))
+		resource.NewVersionsByIDEndpoint(New(tc), jwtAuth(tc)))
 	return checker
 }
 
+func TestList_Http_InvalidCursor(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	ListChecker(tc).Test(t, http.MethodGet, "/v1/resources?limit=2&sort=name&cursor=foo").Check().
+		HasStatus(400).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		err := goa.ServiceError{}
+		marshallErr := json.Unmarshal([]byte(b), &err)
+		assert.NoError(t, marshallErr)
+
+		assert.Equal(t, "invalid-cursor", err.Name)
+	})
+}
+
 func TestVersionsByID_Http(t *testing.T) {
 	tc := testutils.Setup(t)
 	testutils.LoadFixtures(t, tc.FixturePath())
@@ -390,7 +475,7 @@ func ByCatalogKindNameVersionChecker(tc *testutils.TestConfig) *goahttpcheck.API
 	checker.Mount(
 		server.NewByCatalogKindNameVersionHandler,
 		server.MountByCatalogKindNameVersionHandler,
-		resource.NewByCatalogKindNameVersionEndpoint(New(tc)))
+		resource.NewByCatalogKindNameVersionEndpoint(New(tc), jwtAuth(tc)))
 	return checker
 }
 
@@ -434,7 +519,7 @@ func ByCatalogKindNameVersionReadmeChecker(tc *testutils.TestConfig) *goahttpche
 	checker.Mount(
 		server.NewByCatalogKindNameVersionReadmeHandler,
 		server.MountByCatalogKindNameVersionReadmeHandler,
-		resource.NewByCatalogKindNameVersionReadmeEndpoint(New(tc)))
+		resource.NewByCatalogKindNameVersionReadmeEndpoint(New(tc), jwtAuth(tc)))
 	return checker
 }
 
@@ -481,7 +566,7 @@ func ByCatalogKindNameVersionYamlChecker(tc *testutils.TestConfig) *goahttpcheck
 	checker.Mount(
 		server.NewByCatalogKindNameVersionYamlHandler,
 		server.MountByCatalogKindNameVersionYamlHandler,
-		resource.NewByCatalogKindNameVersionYamlEndpoint(New(tc)))
+		resource.NewByCatalogKindNameVersionYamlEndpoint(New(tc), jwtAuth(tc)))
 	return checker
 }
 
@@ -528,7 +613,7 @@ func ByVersionIDChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
 	checker.Mount(
 		server.NewByVersionIDHandler,
 		server.MountByVersionIDHandler,
-		resource.NewByVersionIDEndpoint(New(tc)))
+		resource.NewByVersionIDEndpoint(New(tc), jwtAuth(tc)))
 	return checker
 }
 
@@ -572,7 +657,7 @@ func ByCatalogKindNameChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker
 	checker.Mount(
 		server.NewByCatalogKindNameHandler,
 		server.MountByCatalogKindNameHandler,
-		resource.NewByCatalogKindNameEndpoint(New(tc)))
+		resource.NewByCatalogKindNameEndpoint(New(tc), jwtAuth(tc)))
 	return checker
 }
 
@@ -716,7 +801,7 @@ func ByIDChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
 	checker.Mount(
 		server.NewByIDHandler,
 		server.MountByIDHandler,
-		resource.NewByIDEndpoint(New(tc)))
+		resource.NewByIDEndpoint(New(tc), jwtAuth(tc)))
 	return checker
 }
 
@@ -832,7 +917,7 @@ func GetYamlByCatalogKindNameVersion_Checker(tc *testutils.TestConfig) *goahttpc
 	checker.Mount(
 		server.NewGetRawYamlByCatalogKindNameVersionHandler,
 		server.MountGetRawYamlByCatalogKindNameVersionHandler,
-		resource.NewGetRawYamlByCatalogKindNameVersionEndpoint(New(tc)))
+		resource.NewGetRawYamlByCatalogKindNameVersionEndpoint(New(tc), jwtAuth(tc)))
 	return checker
 }
 
@@ -841,6 +926,480 @@ func GetYamlByCatalogKindName_Checker(tc *testutils.TestConfig) *goahttpcheck.AP
 	checker.Mount(
 		server.NewGetLatestRawYamlByCatalogKindNameHandler,
 		server.MountGetLatestRawYamlByCatalogKindNameHandler,
-		resource.NewGetLatestRawYamlByCatalogKindNameEndpoint(New(tc)))
+		resource.NewGetLatestRawYamlByCatalogKindNameEndpoint(New(tc), jwtAuth(tc)))
 	return checker
 }
+
+func TestPrivateCatalog_ByCatalogKindName_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	makePrivate(t, tc, "catalog-enterprise", "catalog:enterprise")
+
+	_, allowed, err := tc.UserWithScopes("foo", "foo@bar.com", "catalog:enterprise")
+	assert.NoError(t, err)
+	_, denied, err := tc.UserWithScopes("bar", "bar@foo.com", "rating:read")
+	assert.NoError(t, err)
+
+	path := "/v1/resource/catalog-enterprise/task/tkn-enterprise"
+
+	ByCatalogKindNameChecker(tc).Test(t, http.MethodGet, path).Check().
+		HasStatus(404)
+
+	ByCatalogKindNameChecker(tc).Test(t, http.MethodGet, path).
+		WithHeader("Authorization", denied).Check().
+		HasStatus(404)
+
+	ByCatalogKindNameChecker(tc).Test(t, http.MethodGet, path).
+		WithHeader("Authorization", allowed).Check().
+		HasStatus(200)
+
+	ByCatalogKindNameChecker(tc).Test(t, http.MethodGet, path).
+		WithHeader("Authorization", "invalid").Check().
+		HasStatus(401)
+}
+
+func TestPrivateCatalog_Query_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	makePrivate(t, tc, "catalog-enterprise", "")
+
+	_, allowed, err := tc.UserWithScopes("foo", "foo@bar.com", model.PrivateScope)
+	assert.NoError(t, err)
+
+	QueryChecker(tc).Test(t, http.MethodGet, "/v1/query?name=tkn-enterprise").Check().
+		HasStatus(404)
+
+	QueryChecker(tc).Test(t, http.MethodGet, "/v1/query?name=tkn-enterprise").
+		WithHeader("Authorization", allowed).Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := resource.Resources{}
+		assert.NoError(t, json.Unmarshal(b, &res))
+		assert.Equal(t, 1, len(res.Data))
+	})
+}
+
+func TestPrivateCatalog_GetLatestRawYaml_Http(t *testing.T) {
+	os.Setenv("CLONE_BASE_PATH", "testdata/catalog")
+	defer os.Unsetenv("CLONE_BASE_PATH")
+
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	makePrivate(t, tc, "catalog-official", "catalog:official")
+
+	_, allowed, err := tc.UserWithScopes("foo", "foo@bar.com", "catalog:official")
+	assert.NoError(t, err)
+
+	GetYamlByCatalogKindName_Checker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/tkn/raw").Check().
+		HasStatus(404)
+
+	GetYamlByCatalogKindNameVersion_Checker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/tkn/0.1/raw").Check().
+		HasStatus(404)
+
+	GetYamlByCatalogKindName_Checker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/tkn/raw").
+		WithHeader("Authorization", allowed).Check().
+		HasStatus(200)
+}
+
+func ListReviewsChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(
+		server.NewListReviewsHandler,
+		server.MountListReviewsHandler,
+		resource.NewListReviewsEndpoint(New(tc), jwtAuth(tc)))
+	return checker
+}
+
+func CreateReviewChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(
+		server.NewCreateReviewHandler,
+		server.MountCreateReviewHandler,
+		resource.NewCreateReviewEndpoint(New(tc), jwtAuth(tc)))
+	return checker
+}
+
+func UpdateReviewChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(
+		server.NewUpdateReviewHandler,
+		server.MountUpdateReviewHandler,
+		resource.NewUpdateReviewEndpoint(New(tc), jwtAuth(tc)))
+	return checker
+}
+
+func DeleteReviewChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(
+		server.NewDeleteReviewHandler,
+		server.MountDeleteReviewHandler,
+		resource.NewDeleteReviewEndpoint(New(tc), jwtAuth(tc)))
+	return checker
+}
+
+func TestListReviews_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	ListReviewsChecker(tc).Test(t, http.MethodGet, "/v1/resource/1/reviews?limit=2").Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := resource.Reviews{}
+		assert.NoError(t, json.Unmarshal(b, &res))
+		assert.Equal(t, 2, len(res.Data))
+		assert.Equal(t, uint(3), res.Data[0].ID)
+		assert.Equal(t, "0.1.1", res.Data[0].Version)
+		assert.Equal(t, "2016-01-03T12:30:12Z", res.Data[0].CreatedAt)
+		assert.NotNil(t, res.Next)
+	})
+}
+
+func TestListReviews_Http_ErrorCase(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	ListReviewsChecker(tc).Test(t, http.MethodGet, "/v1/resource/111/reviews").Check().
+		HasStatus(404)
+
+	ListReviewsChecker(tc).Test(t, http.MethodGet, "/v1/resource/1/reviews?cursor=foo").Check().
+		HasStatus(400)
+}
+
+func TestCreateReview_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	user, token, err := tc.UserWithScopes("foo", "foo@bar.com", "rating:write")
+	assert.NoError(t, err)
+
+	data := []byte(`{"version": "0.2", "text": "Works fine"}`)
+	CreateReviewChecker(tc).Test(t, http.MethodPost, "/v1/resource/1/reviews").
+		WithHeader("Authorization", token).WithBody(data).Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := resource.Review{}
+		assert.NoError(t, json.Unmarshal(b, &res))
+		assert.Equal(t, user.ID, res.UserID)
+		assert.Equal(t, "0.2", res.Version)
+		assert.Equal(t, "Works fine", res.Text)
+	})
+
+	var count int64
+	assert.NoError(t, tc.DB().Model(&model.AuditEvent{}).Where("action = ?", "review.create").Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestCreateReview_Http_InvalidVersion(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.UserWithScopes("foo", "foo@bar.com", "rating:write")
+	assert.NoError(t, err)
+
+	data := []byte(`{"version": "9.9", "text": "Works fine"}`)
+	CreateReviewChecker(tc).Test(t, http.MethodPost, "/v1/resource/1/reviews").
+		WithHeader("Authorization", token).WithBody(data).Check().
+		HasStatus(400).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		err := goa.ServiceError{}
+		assert.NoError(t, json.Unmarshal(b, &err))
+		assert.Equal(t, "invalid-payload", err.Name)
+	})
+}
+
+func TestCreateReview_Http_InsufficientScope(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.UserWithScopes("foo", "foo@bar.com", "rating:read")
+	assert.NoError(t, err)
+
+	data := []byte(`{"version": "0.2", "text": "Works fine"}`)
+	CreateReviewChecker(tc).Test(t, http.MethodPost, "/v1/resource/1/reviews").
+		WithHeader("Authorization", token).WithBody(data).Check().
+		HasStatus(403)
+}
+
+func TestCreateReview_Http_PrivateCatalog(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	makePrivate(t, tc, "catalog-official", "catalog:official")
+
+	_, token, err := tc.UserWithScopes("foo", "foo@bar.com", "rating:write")
+	assert.NoError(t, err)
+
+	data := []byte(`{"version": "0.2", "text": "Works fine"}`)
+	CreateReviewChecker(tc).Test(t, http.MethodPost, "/v1/resource/1/reviews").
+		WithHeader("Authorization", token).WithBody(data).Check().
+		HasStatus(404)
+}
+
+func TestUpdateReview_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.UserWithScopes("foo", "foo@bar.com", "rating:write")
+	assert.NoError(t, err)
+
+	data := []byte(`{"text": "Edited"}`)
+	UpdateReviewChecker(tc).Test(t, http.MethodPut, "/v1/resource/1/reviews/1").
+		WithHeader("Authorization", token).WithBody(data).Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := resource.Review{}
+		assert.NoError(t, json.Unmarshal(b, &res))
+		assert.Equal(t, "Edited", res.Text)
+		assert.Equal(t, "0.1", res.Version)
+	})
+
+	// the review 2 is written by another user
+	UpdateReviewChecker(tc).Test(t, http.MethodPut, "/v1/resource/1/reviews/2").
+		WithHeader("Authorization", token).WithBody(data).Check().
+		HasStatus(404)
+}
+
+func TestDeleteReview_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	_, token, err := tc.UserWithScopes("foo", "foo@bar.com", "rating:write")
+	assert.NoError(t, err)
+
+	DeleteReviewChecker(tc).Test(t, http.MethodDelete, "/v1/resource/1/reviews/2").
+		WithHeader("Authorization", token).Check().
+		HasStatus(404)
+
+	DeleteReviewChecker(tc).Test(t, http.MethodDelete, "/v1/resource/1/reviews/1").
+		WithHeader("Authorization", token).Check().
+		HasStatus(200)
+
+	var count int64
+	assert.NoError(t, tc.DB().Model(&model.Review{}).Where("resource_id = ?", 1).Count(&count).Error)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestGetYaml_CountsDownloads_Http(t *testing.T) {
+	os.Setenv("CLONE_BASE_PATH", "testdata/catalog")
+	defer os.Unsetenv("CLONE_BASE_PATH")
+
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	downloads := func() uint {
+		var res model.Resource
+		assert.NoError(t, tc.DB().First(&res, 5).Error)
+		return res.Downloads
+	}
+
+	GetYamlByCatalogKindNameVersion_Checker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/tkn/0.1/raw").Check().
+		HasStatus(200)
+	assert.Equal(t, uint(1), downloads())
+
+	GetYamlByCatalogKindName_Checker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/tkn/raw").Check().
+		HasStatus(200)
+	assert.Equal(t, uint(2), downloads())
+
+	// the YAML shown by the UI is not a download
+	ByCatalogKindNameVersionYamlChecker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/tkn/0.1/yaml").Check().
+		HasStatus(200)
+	assert.Equal(t, uint(2), downloads())
+
+	ByCatalogKindNameVersionYamlChecker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/tkn/0.1/yaml?install=true").Check().
+		HasStatus(200)
+	assert.Equal(t, uint(3), downloads())
+}
+
+func DependenciesChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(
+		server.NewDependenciesHandler,
+		server.MountDependenciesHandler,
+		resource.NewDependenciesEndpoint(New(tc), jwtAuth(tc)))
+	return checker
+}
+
+func DependentsChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(
+		server.NewDependentsHandler,
+		server.MountDependentsHandler,
+		resource.NewDependentsEndpoint(New(tc), jwtAuth(tc)))
+	return checker
+}
+
+func TestDependencies_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	DependenciesChecker(tc).Test(t, http.MethodGet, "/v1/resource/2/dependencies").Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := resource.PipelineDependencies{}
+		assert.NoError(t, json.Unmarshal(b, &res))
+		assert.Equal(t, "0.2", res.Version)
+		assert.Equal(t, 4, len(res.Data))
+		assert.Equal(t, "tkn", res.Data[1].Name)
+		assert.Equal(t, "bundle", res.Data[1].Via)
+		assert.Equal(t, "0.1", *res.Data[1].Version)
+		assert.Equal(t, uint(5), *res.Data[1].ResourceID)
+		assert.Nil(t, res.Data[2].ResourceID)
+	})
+}
+
+func TestDependencies_Http_ErrorCase(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	DependenciesChecker(tc).Test(t, http.MethodGet, "/v1/resource/111/dependencies").Check().
+		HasStatus(404)
+
+	DependenciesChecker(tc).Test(t, http.MethodGet, "/v1/resource/2/dependencies?version=0.9").Check().
+		HasStatus(404)
+}
+
+func TestDependents_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	DependentsChecker(tc).Test(t, http.MethodGet, "/v1/resource/1/dependents?version=0.1").Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := resource.TaskDependents{}
+		assert.NoError(t, json.Unmarshal(b, &res))
+		assert.Equal(t, 1, len(res.Data))
+		assert.Equal(t, uint(2), res.Data[0].ResourceID)
+		assert.Equal(t, "build-pipeline", res.Data[0].Name)
+		assert.Equal(t, "0.1", *res.Data[0].TaskVersion)
+	})
+}
+
+func TestDependents_Http_PrivateCatalog(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+	makePrivate(t, tc, "catalog-official", "catalog:official")
+
+	DependentsChecker(tc).Test(t, http.MethodGet, "/v1/resource/1/dependents").Check().
+		HasStatus(404)
+}
+
+func ByCatalogKindNameVersionLintChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(
+		server.NewByCatalogKindNameVersionLintHandler,
+		server.MountByCatalogKindNameVersionLintHandler,
+		resource.NewByCatalogKindNameVersionLintEndpoint(New(tc), jwtAuth(tc)))
+	return checker
+}
+
+func TestByCatalogKindNameVersionLint_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	ByCatalogKindNameVersionLintChecker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/tkn/0.1/lint").Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := resource.VersionLint{}
+		assert.NoError(t, json.Unmarshal(b, &res))
+		assert.Equal(t, 3, len(res.Data))
+		assert.Equal(t, "image-latest-tag", res.Data[0].Rule)
+		assert.Equal(t, "warning", res.Data[0].Severity)
+		assert.Equal(t, "step tkn may run as root", res.Data[1].Message)
+	})
+}
+
+func TestByCatalogKindNameVersionLint_Http_ErrorCase(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	ByCatalogKindNameVersionLintChecker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/tkn/0.9/lint").Check().
+		HasStatus(404)
+}
+
+func ByCatalogKindNameVersionImagesChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(
+		server.NewByCatalogKindNameVersionImagesHandler,
+		server.MountByCatalogKindNameVersionImagesHandler,
+		resource.NewByCatalogKindNameVersionImagesEndpoint(New(tc), jwtAuth(tc)))
+	return checker
+}
+
+func ByImageChecker(tc *testutils.TestConfig) *goahttpcheck.APIChecker {
+	checker := goahttpcheck.New()
+	checker.Mount(
+		server.NewByImageHandler,
+		server.MountByImageHandler,
+		resource.NewByImageEndpoint(New(tc), jwtAuth(tc)))
+	return checker
+}
+
+func TestByCatalogKindNameVersionImages_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	ByCatalogKindNameVersionImagesChecker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/tkn/0.1/images").Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := resource.VersionImages{}
+		assert.NoError(t, json.Unmarshal(b, &res))
+		assert.Equal(t, []string{"alpine:3.18", "gcr.io/tekton-releases/dogfooding/tkn@sha256:4f8b"}, res.Data)
+	})
+
+	ByCatalogKindNameVersionImagesChecker(tc).Test(t, http.MethodGet, "/v1/resource/catalog-official/task/tkn/0.9/images").Check().
+		HasStatus(404)
+}
+
+func TestByImage_Http(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	ByImageChecker(tc).Test(t, http.MethodGet, "/v1/images?ref=alpine:3.18").Check().
+		HasStatus(200).Cb(func(r *http.Response) {
+		b, readErr := io.ReadAll(r.Body)
+		assert.NoError(t, readErr)
+		defer r.Body.Close()
+
+		res := resource.ImageUsers{}
+		assert.NoError(t, json.Unmarshal(b, &res))
+		assert.Equal(t, 3, len(res.Data))
+		assert.Equal(t, uint(1), res.Data[1].ResourceID)
+		assert.Equal(t, "catalog-official", res.Data[1].Catalog)
+		assert.Equal(t, "0.1", res.Data[1].Version)
+	})
+}
+
+func TestByImage_Http_ErrorCase(t *testing.T) {
+	tc := testutils.Setup(t)
+	testutils.LoadFixtures(t, tc.FixturePath())
+
+	// ref is required
+	ByImageChecker(tc).Test(t, http.MethodGet, "/v1/images").Check().
+		HasStatus(400)
+}